@@ -313,6 +313,607 @@ func (*DelCalendarResponse) Descriptor() ([]byte, []int) {
 	return file_calendar_v1_calendar_proto_rawDescGZIP(), []int{6}
 }
 
+// 日期的格式均为"2006-01-02"
+type Holiday struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	StartDate     string                 `protobuf:"bytes,2,opt,name=startDate,proto3" json:"startDate,omitempty"`
+	EndDate       string                 `protobuf:"bytes,3,opt,name=endDate,proto3" json:"endDate,omitempty"` //包含这一天
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Holiday) Reset() {
+	*x = Holiday{}
+	mi := &file_calendar_v1_calendar_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Holiday) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Holiday) ProtoMessage() {}
+
+func (x *Holiday) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_v1_calendar_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Holiday.ProtoReflect.Descriptor instead.
+func (*Holiday) Descriptor() ([]byte, []int) {
+	return file_calendar_v1_calendar_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Holiday) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Holiday) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *Holiday) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+// 调休,在date这一天按照replaceDate那一天的课表上课
+type MakeupDay struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	ReplaceDate   string                 `protobuf:"bytes,3,opt,name=replaceDate,proto3" json:"replaceDate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MakeupDay) Reset() {
+	*x = MakeupDay{}
+	mi := &file_calendar_v1_calendar_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MakeupDay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MakeupDay) ProtoMessage() {}
+
+func (x *MakeupDay) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_v1_calendar_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MakeupDay.ProtoReflect.Descriptor instead.
+func (*MakeupDay) Descriptor() ([]byte, []int) {
+	return file_calendar_v1_calendar_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MakeupDay) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MakeupDay) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *MakeupDay) GetReplaceDate() string {
+	if x != nil {
+		return x.ReplaceDate
+	}
+	return ""
+}
+
+type Semester struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 学年  "2024" 代表"2024-2025学年"
+	Year string `protobuf:"bytes,1,opt,name=year,proto3" json:"year,omitempty"`
+	// 学期 "1"代表第一学期，"2"代表第二学期，"3"代表第三学期
+	Semester      string       `protobuf:"bytes,2,opt,name=semester,proto3" json:"semester,omitempty"`
+	StartDate     string       `protobuf:"bytes,3,opt,name=startDate,proto3" json:"startDate,omitempty"` //正式上学的第一天,这一周为第一周
+	EndDate       string       `protobuf:"bytes,4,opt,name=endDate,proto3" json:"endDate,omitempty"`     //学期的最后一天,之后为假期
+	Holidays      []*Holiday   `protobuf:"bytes,5,rep,name=holidays,proto3" json:"holidays,omitempty"`
+	MakeupDays    []*MakeupDay `protobuf:"bytes,6,rep,name=makeupDays,proto3" json:"makeupDays,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Semester) Reset() {
+	*x = Semester{}
+	mi := &file_calendar_v1_calendar_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Semester) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Semester) ProtoMessage() {}
+
+func (x *Semester) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_v1_calendar_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Semester.ProtoReflect.Descriptor instead.
+func (*Semester) Descriptor() ([]byte, []int) {
+	return file_calendar_v1_calendar_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Semester) GetYear() string {
+	if x != nil {
+		return x.Year
+	}
+	return ""
+}
+
+func (x *Semester) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+func (x *Semester) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *Semester) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *Semester) GetHolidays() []*Holiday {
+	if x != nil {
+		return x.Holidays
+	}
+	return nil
+}
+
+func (x *Semester) GetMakeupDays() []*MakeupDay {
+	if x != nil {
+		return x.MakeupDays
+	}
+	return nil
+}
+
+type GetSemestersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSemestersRequest) Reset() {
+	*x = GetSemestersRequest{}
+	mi := &file_calendar_v1_calendar_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSemestersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSemestersRequest) ProtoMessage() {}
+
+func (x *GetSemestersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_v1_calendar_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSemestersRequest.ProtoReflect.Descriptor instead.
+func (*GetSemestersRequest) Descriptor() ([]byte, []int) {
+	return file_calendar_v1_calendar_proto_rawDescGZIP(), []int{10}
+}
+
+type GetSemestersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Semesters     []*Semester            `protobuf:"bytes,1,rep,name=semesters,proto3" json:"semesters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSemestersResponse) Reset() {
+	*x = GetSemestersResponse{}
+	mi := &file_calendar_v1_calendar_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSemestersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSemestersResponse) ProtoMessage() {}
+
+func (x *GetSemestersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_v1_calendar_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSemestersResponse.ProtoReflect.Descriptor instead.
+func (*GetSemestersResponse) Descriptor() ([]byte, []int) {
+	return file_calendar_v1_calendar_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetSemestersResponse) GetSemesters() []*Semester {
+	if x != nil {
+		return x.Semesters
+	}
+	return nil
+}
+
+type SaveSemesterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Semester      *Semester              `protobuf:"bytes,1,opt,name=semester,proto3" json:"semester,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveSemesterRequest) Reset() {
+	*x = SaveSemesterRequest{}
+	mi := &file_calendar_v1_calendar_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveSemesterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveSemesterRequest) ProtoMessage() {}
+
+func (x *SaveSemesterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_v1_calendar_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveSemesterRequest.ProtoReflect.Descriptor instead.
+func (*SaveSemesterRequest) Descriptor() ([]byte, []int) {
+	return file_calendar_v1_calendar_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SaveSemesterRequest) GetSemester() *Semester {
+	if x != nil {
+		return x.Semester
+	}
+	return nil
+}
+
+type SaveSemesterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveSemesterResponse) Reset() {
+	*x = SaveSemesterResponse{}
+	mi := &file_calendar_v1_calendar_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveSemesterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveSemesterResponse) ProtoMessage() {}
+
+func (x *SaveSemesterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_v1_calendar_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveSemesterResponse.ProtoReflect.Descriptor instead.
+func (*SaveSemesterResponse) Descriptor() ([]byte, []int) {
+	return file_calendar_v1_calendar_proto_rawDescGZIP(), []int{13}
+}
+
+type DelSemesterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Year          string                 `protobuf:"bytes,1,opt,name=year,proto3" json:"year,omitempty"`
+	Semester      string                 `protobuf:"bytes,2,opt,name=semester,proto3" json:"semester,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DelSemesterRequest) Reset() {
+	*x = DelSemesterRequest{}
+	mi := &file_calendar_v1_calendar_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DelSemesterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelSemesterRequest) ProtoMessage() {}
+
+func (x *DelSemesterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_v1_calendar_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelSemesterRequest.ProtoReflect.Descriptor instead.
+func (*DelSemesterRequest) Descriptor() ([]byte, []int) {
+	return file_calendar_v1_calendar_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DelSemesterRequest) GetYear() string {
+	if x != nil {
+		return x.Year
+	}
+	return ""
+}
+
+func (x *DelSemesterRequest) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+type DelSemesterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DelSemesterResponse) Reset() {
+	*x = DelSemesterResponse{}
+	mi := &file_calendar_v1_calendar_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DelSemesterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelSemesterResponse) ProtoMessage() {}
+
+func (x *DelSemesterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_v1_calendar_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelSemesterResponse.ProtoReflect.Descriptor instead.
+func (*DelSemesterResponse) Descriptor() ([]byte, []int) {
+	return file_calendar_v1_calendar_proto_rawDescGZIP(), []int{15}
+}
+
+type GetSemesterDayRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"` //为空时查询今天
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSemesterDayRequest) Reset() {
+	*x = GetSemesterDayRequest{}
+	mi := &file_calendar_v1_calendar_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSemesterDayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSemesterDayRequest) ProtoMessage() {}
+
+func (x *GetSemesterDayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_v1_calendar_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSemesterDayRequest.ProtoReflect.Descriptor instead.
+func (*GetSemesterDayRequest) Descriptor() ([]byte, []int) {
+	return file_calendar_v1_calendar_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetSemesterDayRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+type GetSemesterDayResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// date所在的学期,在假期中时为假期前的学期
+	Semester      *Semester `protobuf:"bytes,1,opt,name=semester,proto3" json:"semester,omitempty"`
+	InSemester    bool      `protobuf:"varint,2,opt,name=inSemester,proto3" json:"inSemester,omitempty"` //是否在学期的起止时间内
+	Week          int64     `protobuf:"varint,3,opt,name=week,proto3" json:"week,omitempty"`             //第几周,不在学期内时为0
+	Weekday       int64     `protobuf:"varint,4,opt,name=weekday,proto3" json:"weekday,omitempty"`       //按星期几的课表上课,1-7,调休时为被替换的那一天
+	IsHoliday     bool      `protobuf:"varint,5,opt,name=isHoliday,proto3" json:"isHoliday,omitempty"`
+	HolidayName   string    `protobuf:"bytes,6,opt,name=holidayName,proto3" json:"holidayName,omitempty"`
+	IsMakeup      bool      `protobuf:"varint,7,opt,name=isMakeup,proto3" json:"isMakeup,omitempty"`
+	MakeupName    string    `protobuf:"bytes,8,opt,name=makeupName,proto3" json:"makeupName,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSemesterDayResponse) Reset() {
+	*x = GetSemesterDayResponse{}
+	mi := &file_calendar_v1_calendar_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSemesterDayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSemesterDayResponse) ProtoMessage() {}
+
+func (x *GetSemesterDayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_v1_calendar_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSemesterDayResponse.ProtoReflect.Descriptor instead.
+func (*GetSemesterDayResponse) Descriptor() ([]byte, []int) {
+	return file_calendar_v1_calendar_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetSemesterDayResponse) GetSemester() *Semester {
+	if x != nil {
+		return x.Semester
+	}
+	return nil
+}
+
+func (x *GetSemesterDayResponse) GetInSemester() bool {
+	if x != nil {
+		return x.InSemester
+	}
+	return false
+}
+
+func (x *GetSemesterDayResponse) GetWeek() int64 {
+	if x != nil {
+		return x.Week
+	}
+	return 0
+}
+
+func (x *GetSemesterDayResponse) GetWeekday() int64 {
+	if x != nil {
+		return x.Weekday
+	}
+	return 0
+}
+
+func (x *GetSemesterDayResponse) GetIsHoliday() bool {
+	if x != nil {
+		return x.IsHoliday
+	}
+	return false
+}
+
+func (x *GetSemesterDayResponse) GetHolidayName() string {
+	if x != nil {
+		return x.HolidayName
+	}
+	return ""
+}
+
+func (x *GetSemesterDayResponse) GetIsMakeup() bool {
+	if x != nil {
+		return x.IsMakeup
+	}
+	return false
+}
+
+func (x *GetSemesterDayResponse) GetMakeupName() string {
+	if x != nil {
+		return x.MakeupName
+	}
+	return ""
+}
+
 var File_calendar_v1_calendar_proto protoreflect.FileDescriptor
 
 const file_calendar_v1_calendar_proto_rawDesc = "" +
@@ -329,11 +930,57 @@ const file_calendar_v1_calendar_proto_rawDesc = "" +
 	"\x14SaveCalendarResponse\"(\n" +
 	"\x12DelCalendarRequest\x12\x12\n" +
 	"\x04year\x18\x01 \x01(\x03R\x04year\"\x15\n" +
-	"\x13DelCalendarResponse2\x8d\x02\n" +
+	"\x13DelCalendarResponse\"U\n" +
+	"\aHoliday\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1c\n" +
+	"\tstartDate\x18\x02 \x01(\tR\tstartDate\x12\x18\n" +
+	"\aendDate\x18\x03 \x01(\tR\aendDate\"U\n" +
+	"\tMakeupDay\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12 \n" +
+	"\vreplaceDate\x18\x03 \x01(\tR\vreplaceDate\"\xdc\x01\n" +
+	"\bSemester\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\tR\x04year\x12\x1a\n" +
+	"\bsemester\x18\x02 \x01(\tR\bsemester\x12\x1c\n" +
+	"\tstartDate\x18\x03 \x01(\tR\tstartDate\x12\x18\n" +
+	"\aendDate\x18\x04 \x01(\tR\aendDate\x120\n" +
+	"\bholidays\x18\x05 \x03(\v2\x14.calendar.v1.HolidayR\bholidays\x126\n" +
+	"\n" +
+	"makeupDays\x18\x06 \x03(\v2\x16.calendar.v1.MakeupDayR\n" +
+	"makeupDays\"\x15\n" +
+	"\x13GetSemestersRequest\"K\n" +
+	"\x14GetSemestersResponse\x123\n" +
+	"\tsemesters\x18\x01 \x03(\v2\x15.calendar.v1.SemesterR\tsemesters\"H\n" +
+	"\x13SaveSemesterRequest\x121\n" +
+	"\bsemester\x18\x01 \x01(\v2\x15.calendar.v1.SemesterR\bsemester\"\x16\n" +
+	"\x14SaveSemesterResponse\"D\n" +
+	"\x12DelSemesterRequest\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\tR\x04year\x12\x1a\n" +
+	"\bsemester\x18\x02 \x01(\tR\bsemester\"\x15\n" +
+	"\x13DelSemesterResponse\"+\n" +
+	"\x15GetSemesterDayRequest\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\"\x95\x02\n" +
+	"\x16GetSemesterDayResponse\x121\n" +
+	"\bsemester\x18\x01 \x01(\v2\x15.calendar.v1.SemesterR\bsemester\x12\x1e\n" +
+	"\n" +
+	"inSemester\x18\x02 \x01(\bR\n" +
+	"inSemester\x12\x12\n" +
+	"\x04week\x18\x03 \x01(\x03R\x04week\x12\x18\n" +
+	"\aweekday\x18\x04 \x01(\x03R\aweekday\x12\x1c\n" +
+	"\tisHoliday\x18\x05 \x01(\bR\tisHoliday\x12 \n" +
+	"\vholidayName\x18\x06 \x01(\tR\vholidayName\x12\x1a\n" +
+	"\bisMakeup\x18\a \x01(\bR\bisMakeup\x12\x1e\n" +
+	"\n" +
+	"makeupName\x18\b \x01(\tR\n" +
+	"makeupName2\xe4\x04\n" +
 	"\x0fCalendarService\x12S\n" +
 	"\fGetCalendars\x12 .calendar.v1.GetCalendarsRequest\x1a!.calendar.v1.GetCalendarsResponse\x12S\n" +
 	"\fSaveCalendar\x12 .calendar.v1.SaveCalendarRequest\x1a!.calendar.v1.SaveCalendarResponse\x12P\n" +
-	"\vDelCalendar\x12\x1f.calendar.v1.DelCalendarRequest\x1a .calendar.v1.DelCalendarResponseBHZFgithub.com/asynccnu/ccnubox-be/be-api/gen/proto/calendar/v1;calendarv1b\x06proto3"
+	"\vDelCalendar\x12\x1f.calendar.v1.DelCalendarRequest\x1a .calendar.v1.DelCalendarResponse\x12S\n" +
+	"\fGetSemesters\x12 .calendar.v1.GetSemestersRequest\x1a!.calendar.v1.GetSemestersResponse\x12S\n" +
+	"\fSaveSemester\x12 .calendar.v1.SaveSemesterRequest\x1a!.calendar.v1.SaveSemesterResponse\x12P\n" +
+	"\vDelSemester\x12\x1f.calendar.v1.DelSemesterRequest\x1a .calendar.v1.DelSemesterResponse\x12Y\n" +
+	"\x0eGetSemesterDay\x12\".calendar.v1.GetSemesterDayRequest\x1a#.calendar.v1.GetSemesterDayResponseBHZFgithub.com/asynccnu/ccnubox-be/be-api/gen/proto/calendar/v1;calendarv1b\x06proto3"
 
 var (
 	file_calendar_v1_calendar_proto_rawDescOnce sync.Once
@@ -347,30 +994,54 @@ func file_calendar_v1_calendar_proto_rawDescGZIP() []byte {
 	return file_calendar_v1_calendar_proto_rawDescData
 }
 
-var file_calendar_v1_calendar_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_calendar_v1_calendar_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_calendar_v1_calendar_proto_goTypes = []any{
-	(*Calendar)(nil),             // 0: calendar.v1.Calendar
-	(*GetCalendarsRequest)(nil),  // 1: calendar.v1.GetCalendarsRequest
-	(*GetCalendarsResponse)(nil), // 2: calendar.v1.GetCalendarsResponse
-	(*SaveCalendarRequest)(nil),  // 3: calendar.v1.SaveCalendarRequest
-	(*SaveCalendarResponse)(nil), // 4: calendar.v1.SaveCalendarResponse
-	(*DelCalendarRequest)(nil),   // 5: calendar.v1.DelCalendarRequest
-	(*DelCalendarResponse)(nil),  // 6: calendar.v1.DelCalendarResponse
+	(*Calendar)(nil),               // 0: calendar.v1.Calendar
+	(*GetCalendarsRequest)(nil),    // 1: calendar.v1.GetCalendarsRequest
+	(*GetCalendarsResponse)(nil),   // 2: calendar.v1.GetCalendarsResponse
+	(*SaveCalendarRequest)(nil),    // 3: calendar.v1.SaveCalendarRequest
+	(*SaveCalendarResponse)(nil),   // 4: calendar.v1.SaveCalendarResponse
+	(*DelCalendarRequest)(nil),     // 5: calendar.v1.DelCalendarRequest
+	(*DelCalendarResponse)(nil),    // 6: calendar.v1.DelCalendarResponse
+	(*Holiday)(nil),                // 7: calendar.v1.Holiday
+	(*MakeupDay)(nil),              // 8: calendar.v1.MakeupDay
+	(*Semester)(nil),               // 9: calendar.v1.Semester
+	(*GetSemestersRequest)(nil),    // 10: calendar.v1.GetSemestersRequest
+	(*GetSemestersResponse)(nil),   // 11: calendar.v1.GetSemestersResponse
+	(*SaveSemesterRequest)(nil),    // 12: calendar.v1.SaveSemesterRequest
+	(*SaveSemesterResponse)(nil),   // 13: calendar.v1.SaveSemesterResponse
+	(*DelSemesterRequest)(nil),     // 14: calendar.v1.DelSemesterRequest
+	(*DelSemesterResponse)(nil),    // 15: calendar.v1.DelSemesterResponse
+	(*GetSemesterDayRequest)(nil),  // 16: calendar.v1.GetSemesterDayRequest
+	(*GetSemesterDayResponse)(nil), // 17: calendar.v1.GetSemesterDayResponse
 }
 var file_calendar_v1_calendar_proto_depIdxs = []int32{
-	0, // 0: calendar.v1.GetCalendarsResponse.calendars:type_name -> calendar.v1.Calendar
-	0, // 1: calendar.v1.SaveCalendarRequest.calendar:type_name -> calendar.v1.Calendar
-	1, // 2: calendar.v1.CalendarService.GetCalendars:input_type -> calendar.v1.GetCalendarsRequest
-	3, // 3: calendar.v1.CalendarService.SaveCalendar:input_type -> calendar.v1.SaveCalendarRequest
-	5, // 4: calendar.v1.CalendarService.DelCalendar:input_type -> calendar.v1.DelCalendarRequest
-	2, // 5: calendar.v1.CalendarService.GetCalendars:output_type -> calendar.v1.GetCalendarsResponse
-	4, // 6: calendar.v1.CalendarService.SaveCalendar:output_type -> calendar.v1.SaveCalendarResponse
-	6, // 7: calendar.v1.CalendarService.DelCalendar:output_type -> calendar.v1.DelCalendarResponse
-	5, // [5:8] is the sub-list for method output_type
-	2, // [2:5] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	0,  // 0: calendar.v1.GetCalendarsResponse.calendars:type_name -> calendar.v1.Calendar
+	0,  // 1: calendar.v1.SaveCalendarRequest.calendar:type_name -> calendar.v1.Calendar
+	7,  // 2: calendar.v1.Semester.holidays:type_name -> calendar.v1.Holiday
+	8,  // 3: calendar.v1.Semester.makeupDays:type_name -> calendar.v1.MakeupDay
+	9,  // 4: calendar.v1.GetSemestersResponse.semesters:type_name -> calendar.v1.Semester
+	9,  // 5: calendar.v1.SaveSemesterRequest.semester:type_name -> calendar.v1.Semester
+	9,  // 6: calendar.v1.GetSemesterDayResponse.semester:type_name -> calendar.v1.Semester
+	1,  // 7: calendar.v1.CalendarService.GetCalendars:input_type -> calendar.v1.GetCalendarsRequest
+	3,  // 8: calendar.v1.CalendarService.SaveCalendar:input_type -> calendar.v1.SaveCalendarRequest
+	5,  // 9: calendar.v1.CalendarService.DelCalendar:input_type -> calendar.v1.DelCalendarRequest
+	10, // 10: calendar.v1.CalendarService.GetSemesters:input_type -> calendar.v1.GetSemestersRequest
+	12, // 11: calendar.v1.CalendarService.SaveSemester:input_type -> calendar.v1.SaveSemesterRequest
+	14, // 12: calendar.v1.CalendarService.DelSemester:input_type -> calendar.v1.DelSemesterRequest
+	16, // 13: calendar.v1.CalendarService.GetSemesterDay:input_type -> calendar.v1.GetSemesterDayRequest
+	2,  // 14: calendar.v1.CalendarService.GetCalendars:output_type -> calendar.v1.GetCalendarsResponse
+	4,  // 15: calendar.v1.CalendarService.SaveCalendar:output_type -> calendar.v1.SaveCalendarResponse
+	6,  // 16: calendar.v1.CalendarService.DelCalendar:output_type -> calendar.v1.DelCalendarResponse
+	11, // 17: calendar.v1.CalendarService.GetSemesters:output_type -> calendar.v1.GetSemestersResponse
+	13, // 18: calendar.v1.CalendarService.SaveSemester:output_type -> calendar.v1.SaveSemesterResponse
+	15, // 19: calendar.v1.CalendarService.DelSemester:output_type -> calendar.v1.DelSemesterResponse
+	17, // 20: calendar.v1.CalendarService.GetSemesterDay:output_type -> calendar.v1.GetSemesterDayResponse
+	14, // [14:21] is the sub-list for method output_type
+	7,  // [7:14] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_calendar_v1_calendar_proto_init() }
@@ -384,7 +1055,7 @@ func file_calendar_v1_calendar_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_calendar_v1_calendar_proto_rawDesc), len(file_calendar_v1_calendar_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
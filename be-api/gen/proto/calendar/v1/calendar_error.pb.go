@@ -25,9 +25,14 @@ const (
 type CalendarErrorReason int32
 
 const (
-	CalendarErrorReason_GET_CALENDAR_ERROR  CalendarErrorReason = 0
-	CalendarErrorReason_DEL_CALENDAR_ERROR  CalendarErrorReason = 1
-	CalendarErrorReason_SAVE_CALENDAR_ERROR CalendarErrorReason = 2
+	CalendarErrorReason_GET_CALENDAR_ERROR     CalendarErrorReason = 0
+	CalendarErrorReason_DEL_CALENDAR_ERROR     CalendarErrorReason = 1
+	CalendarErrorReason_SAVE_CALENDAR_ERROR    CalendarErrorReason = 2
+	CalendarErrorReason_GET_SEMESTER_ERROR     CalendarErrorReason = 3
+	CalendarErrorReason_DEL_SEMESTER_ERROR     CalendarErrorReason = 4
+	CalendarErrorReason_SAVE_SEMESTER_ERROR    CalendarErrorReason = 5
+	CalendarErrorReason_INVALID_SEMESTER_ERROR CalendarErrorReason = 6
+	CalendarErrorReason_SEMESTER_NOT_FOUND     CalendarErrorReason = 7
 )
 
 // Enum value maps for CalendarErrorReason.
@@ -36,11 +41,21 @@ var (
 		0: "GET_CALENDAR_ERROR",
 		1: "DEL_CALENDAR_ERROR",
 		2: "SAVE_CALENDAR_ERROR",
+		3: "GET_SEMESTER_ERROR",
+		4: "DEL_SEMESTER_ERROR",
+		5: "SAVE_SEMESTER_ERROR",
+		6: "INVALID_SEMESTER_ERROR",
+		7: "SEMESTER_NOT_FOUND",
 	}
 	CalendarErrorReason_value = map[string]int32{
-		"GET_CALENDAR_ERROR":  0,
-		"DEL_CALENDAR_ERROR":  1,
-		"SAVE_CALENDAR_ERROR": 2,
+		"GET_CALENDAR_ERROR":     0,
+		"DEL_CALENDAR_ERROR":     1,
+		"SAVE_CALENDAR_ERROR":    2,
+		"GET_SEMESTER_ERROR":     3,
+		"DEL_SEMESTER_ERROR":     4,
+		"SAVE_SEMESTER_ERROR":    5,
+		"INVALID_SEMESTER_ERROR": 6,
+		"SEMESTER_NOT_FOUND":     7,
 	}
 )
 
@@ -75,11 +90,16 @@ var File_calendar_v1_calendar_error_proto protoreflect.FileDescriptor
 
 const file_calendar_v1_calendar_error_proto_rawDesc = "" +
 	"\n" +
-	" calendar/v1/calendar_error.proto\x12\vcalendar.v1\x1a\x13errors/errors.proto*v\n" +
+	" calendar/v1/calendar_error.proto\x12\vcalendar.v1\x1a\x13errors/errors.proto*\x91\x02\n" +
 	"\x13CalendarErrorReason\x12\x1c\n" +
 	"\x12GET_CALENDAR_ERROR\x10\x00\x1a\x04\xa8E\xf5\x03\x12\x1c\n" +
 	"\x12DEL_CALENDAR_ERROR\x10\x01\x1a\x04\xa8E\xf6\x03\x12\x1d\n" +
-	"\x13SAVE_CALENDAR_ERROR\x10\x02\x1a\x04\xa8E\xf7\x03\x1a\x04\xa0E\xf4\x03BHZFgithub.com/asynccnu/ccnubox-be/be-api/gen/proto/calendar/v1;calendarv1b\x06proto3"
+	"\x13SAVE_CALENDAR_ERROR\x10\x02\x1a\x04\xa8E\xf7\x03\x12\x1c\n" +
+	"\x12GET_SEMESTER_ERROR\x10\x03\x1a\x04\xa8E\xf8\x03\x12\x1c\n" +
+	"\x12DEL_SEMESTER_ERROR\x10\x04\x1a\x04\xa8E\xf9\x03\x12\x1d\n" +
+	"\x13SAVE_SEMESTER_ERROR\x10\x05\x1a\x04\xa8E\xfa\x03\x12 \n" +
+	"\x16INVALID_SEMESTER_ERROR\x10\x06\x1a\x04\xa8E\x90\x03\x12\x1c\n" +
+	"\x12SEMESTER_NOT_FOUND\x10\a\x1a\x04\xa8E\x94\x03\x1a\x04\xa0E\xf4\x03BHZFgithub.com/asynccnu/ccnubox-be/be-api/gen/proto/calendar/v1;calendarv1b\x06proto3"
 
 var (
 	file_calendar_v1_calendar_error_proto_rawDescOnce sync.Once
@@ -46,3 +46,63 @@ func IsSaveCalendarError(err error) bool {
 func ErrorSaveCalendarError(format string, args ...interface{}) *errors.Error {
 	return errors.New(503, CalendarErrorReason_SAVE_CALENDAR_ERROR.String(), fmt.Sprintf(format, args...))
 }
+
+func IsGetSemesterError(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == CalendarErrorReason_GET_SEMESTER_ERROR.String() && e.Code == 504
+}
+
+func ErrorGetSemesterError(format string, args ...interface{}) *errors.Error {
+	return errors.New(504, CalendarErrorReason_GET_SEMESTER_ERROR.String(), fmt.Sprintf(format, args...))
+}
+
+func IsDelSemesterError(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == CalendarErrorReason_DEL_SEMESTER_ERROR.String() && e.Code == 505
+}
+
+func ErrorDelSemesterError(format string, args ...interface{}) *errors.Error {
+	return errors.New(505, CalendarErrorReason_DEL_SEMESTER_ERROR.String(), fmt.Sprintf(format, args...))
+}
+
+func IsSaveSemesterError(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == CalendarErrorReason_SAVE_SEMESTER_ERROR.String() && e.Code == 506
+}
+
+func ErrorSaveSemesterError(format string, args ...interface{}) *errors.Error {
+	return errors.New(506, CalendarErrorReason_SAVE_SEMESTER_ERROR.String(), fmt.Sprintf(format, args...))
+}
+
+func IsInvalidSemesterError(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == CalendarErrorReason_INVALID_SEMESTER_ERROR.String() && e.Code == 400
+}
+
+func ErrorInvalidSemesterError(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, CalendarErrorReason_INVALID_SEMESTER_ERROR.String(), fmt.Sprintf(format, args...))
+}
+
+func IsSemesterNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == CalendarErrorReason_SEMESTER_NOT_FOUND.String() && e.Code == 404
+}
+
+func ErrorSemesterNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, CalendarErrorReason_SEMESTER_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
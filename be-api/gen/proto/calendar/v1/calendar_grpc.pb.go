@@ -19,9 +19,13 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	CalendarService_GetCalendars_FullMethodName = "/calendar.v1.CalendarService/GetCalendars"
-	CalendarService_SaveCalendar_FullMethodName = "/calendar.v1.CalendarService/SaveCalendar"
-	CalendarService_DelCalendar_FullMethodName  = "/calendar.v1.CalendarService/DelCalendar"
+	CalendarService_GetCalendars_FullMethodName   = "/calendar.v1.CalendarService/GetCalendars"
+	CalendarService_SaveCalendar_FullMethodName   = "/calendar.v1.CalendarService/SaveCalendar"
+	CalendarService_DelCalendar_FullMethodName    = "/calendar.v1.CalendarService/DelCalendar"
+	CalendarService_GetSemesters_FullMethodName   = "/calendar.v1.CalendarService/GetSemesters"
+	CalendarService_SaveSemester_FullMethodName   = "/calendar.v1.CalendarService/SaveSemester"
+	CalendarService_DelSemester_FullMethodName    = "/calendar.v1.CalendarService/DelSemester"
+	CalendarService_GetSemesterDay_FullMethodName = "/calendar.v1.CalendarService/GetSemesterDay"
 )
 
 // CalendarServiceClient is the client API for CalendarService service.
@@ -31,6 +35,12 @@ type CalendarServiceClient interface {
 	GetCalendars(ctx context.Context, in *GetCalendarsRequest, opts ...grpc.CallOption) (*GetCalendarsResponse, error)
 	SaveCalendar(ctx context.Context, in *SaveCalendarRequest, opts ...grpc.CallOption) (*SaveCalendarResponse, error)
 	DelCalendar(ctx context.Context, in *DelCalendarRequest, opts ...grpc.CallOption) (*DelCalendarResponse, error)
+	// 校历,学期的起止时间、节假日和调休,由管理员在运行时维护
+	GetSemesters(ctx context.Context, in *GetSemestersRequest, opts ...grpc.CallOption) (*GetSemestersResponse, error)
+	SaveSemester(ctx context.Context, in *SaveSemesterRequest, opts ...grpc.CallOption) (*SaveSemesterResponse, error)
+	DelSemester(ctx context.Context, in *DelSemesterRequest, opts ...grpc.CallOption) (*DelSemesterResponse, error)
+	// 查询某一天属于哪个学期、第几周,以及是否放假或调休
+	GetSemesterDay(ctx context.Context, in *GetSemesterDayRequest, opts ...grpc.CallOption) (*GetSemesterDayResponse, error)
 }
 
 type calendarServiceClient struct {
@@ -71,6 +81,46 @@ func (c *calendarServiceClient) DelCalendar(ctx context.Context, in *DelCalendar
 	return out, nil
 }
 
+func (c *calendarServiceClient) GetSemesters(ctx context.Context, in *GetSemestersRequest, opts ...grpc.CallOption) (*GetSemestersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSemestersResponse)
+	err := c.cc.Invoke(ctx, CalendarService_GetSemesters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calendarServiceClient) SaveSemester(ctx context.Context, in *SaveSemesterRequest, opts ...grpc.CallOption) (*SaveSemesterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaveSemesterResponse)
+	err := c.cc.Invoke(ctx, CalendarService_SaveSemester_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calendarServiceClient) DelSemester(ctx context.Context, in *DelSemesterRequest, opts ...grpc.CallOption) (*DelSemesterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DelSemesterResponse)
+	err := c.cc.Invoke(ctx, CalendarService_DelSemester_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calendarServiceClient) GetSemesterDay(ctx context.Context, in *GetSemesterDayRequest, opts ...grpc.CallOption) (*GetSemesterDayResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSemesterDayResponse)
+	err := c.cc.Invoke(ctx, CalendarService_GetSemesterDay_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CalendarServiceServer is the server API for CalendarService service.
 // All implementations must embed UnimplementedCalendarServiceServer
 // for forward compatibility.
@@ -78,6 +128,12 @@ type CalendarServiceServer interface {
 	GetCalendars(context.Context, *GetCalendarsRequest) (*GetCalendarsResponse, error)
 	SaveCalendar(context.Context, *SaveCalendarRequest) (*SaveCalendarResponse, error)
 	DelCalendar(context.Context, *DelCalendarRequest) (*DelCalendarResponse, error)
+	// 校历,学期的起止时间、节假日和调休,由管理员在运行时维护
+	GetSemesters(context.Context, *GetSemestersRequest) (*GetSemestersResponse, error)
+	SaveSemester(context.Context, *SaveSemesterRequest) (*SaveSemesterResponse, error)
+	DelSemester(context.Context, *DelSemesterRequest) (*DelSemesterResponse, error)
+	// 查询某一天属于哪个学期、第几周,以及是否放假或调休
+	GetSemesterDay(context.Context, *GetSemesterDayRequest) (*GetSemesterDayResponse, error)
 	mustEmbedUnimplementedCalendarServiceServer()
 }
 
@@ -97,6 +153,18 @@ func (UnimplementedCalendarServiceServer) SaveCalendar(context.Context, *SaveCal
 func (UnimplementedCalendarServiceServer) DelCalendar(context.Context, *DelCalendarRequest) (*DelCalendarResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelCalendar not implemented")
 }
+func (UnimplementedCalendarServiceServer) GetSemesters(context.Context, *GetSemestersRequest) (*GetSemestersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSemesters not implemented")
+}
+func (UnimplementedCalendarServiceServer) SaveSemester(context.Context, *SaveSemesterRequest) (*SaveSemesterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveSemester not implemented")
+}
+func (UnimplementedCalendarServiceServer) DelSemester(context.Context, *DelSemesterRequest) (*DelSemesterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelSemester not implemented")
+}
+func (UnimplementedCalendarServiceServer) GetSemesterDay(context.Context, *GetSemesterDayRequest) (*GetSemesterDayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSemesterDay not implemented")
+}
 func (UnimplementedCalendarServiceServer) mustEmbedUnimplementedCalendarServiceServer() {}
 func (UnimplementedCalendarServiceServer) testEmbeddedByValue()                         {}
 
@@ -172,6 +240,78 @@ func _CalendarService_DelCalendar_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CalendarService_GetSemesters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSemestersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalendarServiceServer).GetSemesters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CalendarService_GetSemesters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalendarServiceServer).GetSemesters(ctx, req.(*GetSemestersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalendarService_SaveSemester_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveSemesterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalendarServiceServer).SaveSemester(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CalendarService_SaveSemester_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalendarServiceServer).SaveSemester(ctx, req.(*SaveSemesterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalendarService_DelSemester_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelSemesterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalendarServiceServer).DelSemester(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CalendarService_DelSemester_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalendarServiceServer).DelSemester(ctx, req.(*DelSemesterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalendarService_GetSemesterDay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSemesterDayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalendarServiceServer).GetSemesterDay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CalendarService_GetSemesterDay_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalendarServiceServer).GetSemesterDay(ctx, req.(*GetSemesterDayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CalendarService_ServiceDesc is the grpc.ServiceDesc for CalendarService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -191,6 +331,22 @@ var CalendarService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DelCalendar",
 			Handler:    _CalendarService_DelCalendar_Handler,
 		},
+		{
+			MethodName: "GetSemesters",
+			Handler:    _CalendarService_GetSemesters_Handler,
+		},
+		{
+			MethodName: "SaveSemester",
+			Handler:    _CalendarService_SaveSemester_Handler,
+		},
+		{
+			MethodName: "DelSemester",
+			Handler:    _CalendarService_DelSemester_Handler,
+		},
+		{
+			MethodName: "GetSemesterDay",
+			Handler:    _CalendarService_GetSemesterDay_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "calendar/v1/calendar.proto",
@@ -21,16 +21,82 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type SearchSort int32
+
+const (
+	// 按匹配程度
+	SearchSort_SEARCH_SORT_RELEVANCE SearchSort = 0
+	// 按学分从高到低
+	SearchSort_SEARCH_SORT_CREDIT_DESC SearchSort = 1
+	// 按学分从低到高
+	SearchSort_SEARCH_SORT_CREDIT_ASC SearchSort = 2
+	// 按上课时间,先星期后节次
+	SearchSort_SEARCH_SORT_TIME SearchSort = 3
+)
+
+// Enum value maps for SearchSort.
+var (
+	SearchSort_name = map[int32]string{
+		0: "SEARCH_SORT_RELEVANCE",
+		1: "SEARCH_SORT_CREDIT_DESC",
+		2: "SEARCH_SORT_CREDIT_ASC",
+		3: "SEARCH_SORT_TIME",
+	}
+	SearchSort_value = map[string]int32{
+		"SEARCH_SORT_RELEVANCE":   0,
+		"SEARCH_SORT_CREDIT_DESC": 1,
+		"SEARCH_SORT_CREDIT_ASC":  2,
+		"SEARCH_SORT_TIME":        3,
+	}
+)
+
+func (x SearchSort) Enum() *SearchSort {
+	p := new(SearchSort)
+	*p = x
+	return p
+}
+
+func (x SearchSort) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SearchSort) Descriptor() protoreflect.EnumDescriptor {
+	return file_classService_v1_classService_proto_enumTypes[0].Descriptor()
+}
+
+func (SearchSort) Type() protoreflect.EnumType {
+	return &file_classService_v1_classService_proto_enumTypes[0]
+}
+
+func (x SearchSort) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SearchSort.Descriptor instead.
+func (SearchSort) EnumDescriptor() ([]byte, []int) {
+	return file_classService_v1_classService_proto_rawDescGZIP(), []int{0}
+}
+
 type SearchRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 搜索关键词,匹配的是课程名称和教师姓名
+	// 搜索关键词,匹配的是课程名称和教师姓名,为空时只按筛选条件查询
 	SearchKeyWords string `protobuf:"bytes,1,opt,name=searchKeyWords,proto3" json:"searchKeyWords,omitempty"`
 	Year           string `protobuf:"bytes,2,opt,name=year,proto3" json:"year,omitempty"`
 	Semester       string `protobuf:"bytes,3,opt,name=semester,proto3" json:"semester,omitempty"`
 	Page           int32  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
 	PageSize       int32  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// 筛选条件
+	Filter *SearchFilter `protobuf:"bytes,6,opt,name=filter,proto3" json:"filter,omitempty"`
+	// 排序方式
+	Sort SearchSort `protobuf:"varint,7,opt,name=sort,proto3,enum=classService.v1.SearchSort" json:"sort,omitempty"`
+	// 深度分页的游标,取上一次返回的next_cursor,不为空时忽略page
+	Cursor string `protobuf:"bytes,8,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// 是否返回各筛选维度的统计
+	WithFacets bool `protobuf:"varint,9,opt,name=with_facets,json=withFacets,proto3" json:"with_facets,omitempty"`
+	// 学号,filter.fit_free_time为true时必填
+	StuId         string `protobuf:"bytes,10,opt,name=stu_id,json=stuId,proto3" json:"stu_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SearchRequest) Reset() {
@@ -98,17 +164,173 @@ func (x *SearchRequest) GetPageSize() int32 {
 	return 0
 }
 
+func (x *SearchRequest) GetFilter() *SearchFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *SearchRequest) GetSort() SearchSort {
+	if x != nil {
+		return x.Sort
+	}
+	return SearchSort_SEARCH_SORT_RELEVANCE
+}
+
+func (x *SearchRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetWithFacets() bool {
+	if x != nil {
+		return x.WithFacets
+	}
+	return false
+}
+
+func (x *SearchRequest) GetStuId() string {
+	if x != nil {
+		return x.StuId
+	}
+	return ""
+}
+
+type SearchFilter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 星期几,可多选
+	Days []int64 `protobuf:"varint,1,rep,packed,name=days,proto3" json:"days,omitempty"`
+	// 节次范围,只返回节次完全落在[section_start,section_end]内的课程,0表示不限
+	SectionStart int32 `protobuf:"varint,2,opt,name=section_start,json=sectionStart,proto3" json:"section_start,omitempty"`
+	SectionEnd   int32 `protobuf:"varint,3,opt,name=section_end,json=sectionEnd,proto3" json:"section_end,omitempty"`
+	// 第几周有课,0表示不限
+	Week int32 `protobuf:"varint,4,opt,name=week,proto3" json:"week,omitempty"`
+	// 上课地点前缀,比如南湖1楼就是"n1",7号教学楼就是"7"
+	WherePrefix string `protobuf:"bytes,5,opt,name=where_prefix,json=wherePrefix,proto3" json:"where_prefix,omitempty"`
+	// 学分范围
+	MinCredit *float64 `protobuf:"fixed64,6,opt,name=min_credit,json=minCredit,proto3,oneof" json:"min_credit,omitempty"`
+	MaxCredit *float64 `protobuf:"fixed64,7,opt,name=max_credit,json=maxCredit,proto3,oneof" json:"max_credit,omitempty"`
+	// 课程性质,可多选
+	Natures []string `protobuf:"bytes,8,rep,name=natures,proto3" json:"natures,omitempty"`
+	// 只返回和自己课表不冲突的课程
+	FitFreeTime   bool `protobuf:"varint,9,opt,name=fit_free_time,json=fitFreeTime,proto3" json:"fit_free_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchFilter) Reset() {
+	*x = SearchFilter{}
+	mi := &file_classService_v1_classService_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchFilter) ProtoMessage() {}
+
+func (x *SearchFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_classService_v1_classService_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchFilter.ProtoReflect.Descriptor instead.
+func (*SearchFilter) Descriptor() ([]byte, []int) {
+	return file_classService_v1_classService_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SearchFilter) GetDays() []int64 {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *SearchFilter) GetSectionStart() int32 {
+	if x != nil {
+		return x.SectionStart
+	}
+	return 0
+}
+
+func (x *SearchFilter) GetSectionEnd() int32 {
+	if x != nil {
+		return x.SectionEnd
+	}
+	return 0
+}
+
+func (x *SearchFilter) GetWeek() int32 {
+	if x != nil {
+		return x.Week
+	}
+	return 0
+}
+
+func (x *SearchFilter) GetWherePrefix() string {
+	if x != nil {
+		return x.WherePrefix
+	}
+	return ""
+}
+
+func (x *SearchFilter) GetMinCredit() float64 {
+	if x != nil && x.MinCredit != nil {
+		return *x.MinCredit
+	}
+	return 0
+}
+
+func (x *SearchFilter) GetMaxCredit() float64 {
+	if x != nil && x.MaxCredit != nil {
+		return *x.MaxCredit
+	}
+	return 0
+}
+
+func (x *SearchFilter) GetNatures() []string {
+	if x != nil {
+		return x.Natures
+	}
+	return nil
+}
+
+func (x *SearchFilter) GetFitFreeTime() bool {
+	if x != nil {
+		return x.FitFreeTime
+	}
+	return false
+}
+
 type SearchReply struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// 课程信息
-	ClassInfos    []*ClassInfo `protobuf:"bytes,1,rep,name=class_infos,json=classInfos,proto3" json:"class_infos,omitempty"`
+	ClassInfos []*ClassInfo `protobuf:"bytes,1,rep,name=class_infos,json=classInfos,proto3" json:"class_infos,omitempty"`
+	// 各筛选维度的统计,在当前筛选条件下计算
+	Facets []*SearchFacet `protobuf:"bytes,2,rep,name=facets,proto3" json:"facets,omitempty"`
+	// 下一页的游标,为空表示没有更多了
+	NextCursor string `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	// 符合条件的课程总数
+	Total         int64 `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SearchReply) Reset() {
 	*x = SearchReply{}
-	mi := &file_classService_v1_classService_proto_msgTypes[1]
+	mi := &file_classService_v1_classService_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -120,7 +342,7 @@ func (x *SearchReply) String() string {
 func (*SearchReply) ProtoMessage() {}
 
 func (x *SearchReply) ProtoReflect() protoreflect.Message {
-	mi := &file_classService_v1_classService_proto_msgTypes[1]
+	mi := &file_classService_v1_classService_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -133,7 +355,7 @@ func (x *SearchReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchReply.ProtoReflect.Descriptor instead.
 func (*SearchReply) Descriptor() ([]byte, []int) {
-	return file_classService_v1_classService_proto_rawDescGZIP(), []int{1}
+	return file_classService_v1_classService_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *SearchReply) GetClassInfos() []*ClassInfo {
@@ -143,6 +365,309 @@ func (x *SearchReply) GetClassInfos() []*ClassInfo {
 	return nil
 }
 
+func (x *SearchReply) GetFacets() []*SearchFacet {
+	if x != nil {
+		return x.Facets
+	}
+	return nil
+}
+
+func (x *SearchReply) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *SearchReply) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type SearchFacet struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 维度:day,building,nature,credit,class_when,week
+	Field         string         `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Buckets       []*FacetBucket `protobuf:"bytes,2,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchFacet) Reset() {
+	*x = SearchFacet{}
+	mi := &file_classService_v1_classService_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchFacet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchFacet) ProtoMessage() {}
+
+func (x *SearchFacet) ProtoReflect() protoreflect.Message {
+	mi := &file_classService_v1_classService_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchFacet.ProtoReflect.Descriptor instead.
+func (*SearchFacet) Descriptor() ([]byte, []int) {
+	return file_classService_v1_classService_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SearchFacet) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *SearchFacet) GetBuckets() []*FacetBucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+type FacetBucket struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FacetBucket) Reset() {
+	*x = FacetBucket{}
+	mi := &file_classService_v1_classService_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FacetBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FacetBucket) ProtoMessage() {}
+
+func (x *FacetBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_classService_v1_classService_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FacetBucket.ProtoReflect.Descriptor instead.
+func (*FacetBucket) Descriptor() ([]byte, []int) {
+	return file_classService_v1_classService_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *FacetBucket) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *FacetBucket) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type SuggestRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 已输入的内容
+	Prefix   string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Year     string `protobuf:"bytes,2,opt,name=year,proto3" json:"year,omitempty"`
+	Semester string `protobuf:"bytes,3,opt,name=semester,proto3" json:"semester,omitempty"`
+	// 返回的联想词数量,默认10
+	Size          int32 `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestRequest) Reset() {
+	*x = SuggestRequest{}
+	mi := &file_classService_v1_classService_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestRequest) ProtoMessage() {}
+
+func (x *SuggestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_classService_v1_classService_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestRequest.ProtoReflect.Descriptor instead.
+func (*SuggestRequest) Descriptor() ([]byte, []int) {
+	return file_classService_v1_classService_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SuggestRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *SuggestRequest) GetYear() string {
+	if x != nil {
+		return x.Year
+	}
+	return ""
+}
+
+func (x *SuggestRequest) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+func (x *SuggestRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type SuggestReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Suggestions   []*Suggestion          `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestReply) Reset() {
+	*x = SuggestReply{}
+	mi := &file_classService_v1_classService_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestReply) ProtoMessage() {}
+
+func (x *SuggestReply) ProtoReflect() protoreflect.Message {
+	mi := &file_classService_v1_classService_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestReply.ProtoReflect.Descriptor instead.
+func (*SuggestReply) Descriptor() ([]byte, []int) {
+	return file_classService_v1_classService_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SuggestReply) GetSuggestions() []*Suggestion {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+type Suggestion struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 联想词
+	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	// 类型:classname表示课程名称,teacher表示教师
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// 包含该联想词的课程数
+	Count         int64 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Suggestion) Reset() {
+	*x = Suggestion{}
+	mi := &file_classService_v1_classService_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Suggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Suggestion) ProtoMessage() {}
+
+func (x *Suggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_classService_v1_classService_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Suggestion.ProtoReflect.Descriptor instead.
+func (*Suggestion) Descriptor() ([]byte, []int) {
+	return file_classService_v1_classService_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Suggestion) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Suggestion) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Suggestion) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
 type AddClassRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// 学号
@@ -164,14 +689,16 @@ type AddClassRequest struct {
 	// 星期几
 	Day int64 `protobuf:"varint,9,opt,name=day,proto3" json:"day,omitempty"`
 	// 学分
-	Credit        *float64 `protobuf:"fixed64,10,opt,name=credit,proto3,oneof" json:"credit,omitempty"`
+	Credit *float64 `protobuf:"fixed64,10,opt,name=credit,proto3,oneof" json:"credit,omitempty"`
+	// 与已有课程时间冲突时是否仍然强制添加,默认冲突时拒绝添加
+	Force         bool `protobuf:"varint,11,opt,name=force,proto3" json:"force,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AddClassRequest) Reset() {
 	*x = AddClassRequest{}
-	mi := &file_classService_v1_classService_proto_msgTypes[2]
+	mi := &file_classService_v1_classService_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -183,7 +710,7 @@ func (x *AddClassRequest) String() string {
 func (*AddClassRequest) ProtoMessage() {}
 
 func (x *AddClassRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_classService_v1_classService_proto_msgTypes[2]
+	mi := &file_classService_v1_classService_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -196,7 +723,7 @@ func (x *AddClassRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddClassRequest.ProtoReflect.Descriptor instead.
 func (*AddClassRequest) Descriptor() ([]byte, []int) {
-	return file_classService_v1_classService_proto_rawDescGZIP(), []int{2}
+	return file_classService_v1_classService_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *AddClassRequest) GetStuId() string {
@@ -269,6 +796,13 @@ func (x *AddClassRequest) GetCredit() float64 {
 	return 0
 }
 
+func (x *AddClassRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
 type AddClassReply struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// 添加的课程ID
@@ -280,7 +814,7 @@ type AddClassReply struct {
 
 func (x *AddClassReply) Reset() {
 	*x = AddClassReply{}
-	mi := &file_classService_v1_classService_proto_msgTypes[3]
+	mi := &file_classService_v1_classService_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -292,7 +826,7 @@ func (x *AddClassReply) String() string {
 func (*AddClassReply) ProtoMessage() {}
 
 func (x *AddClassReply) ProtoReflect() protoreflect.Message {
-	mi := &file_classService_v1_classService_proto_msgTypes[3]
+	mi := &file_classService_v1_classService_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -305,7 +839,7 @@ func (x *AddClassReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddClassReply.ProtoReflect.Descriptor instead.
 func (*AddClassReply) Descriptor() ([]byte, []int) {
-	return file_classService_v1_classService_proto_rawDescGZIP(), []int{3}
+	return file_classService_v1_classService_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *AddClassReply) GetId() string {
@@ -346,14 +880,16 @@ type ClassInfo struct {
 	// 学年  "2024" 代表"2024-2025学年"
 	Year string `protobuf:"bytes,10,opt,name=year,proto3" json:"year,omitempty"`
 	// 课程唯一标识id
-	Id            string `protobuf:"bytes,11,opt,name=id,proto3" json:"id,omitempty"`
+	Id string `protobuf:"bytes,11,opt,name=id,proto3" json:"id,omitempty"`
+	// 课程性质
+	Nature        string `protobuf:"bytes,12,opt,name=nature,proto3" json:"nature,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ClassInfo) Reset() {
 	*x = ClassInfo{}
-	mi := &file_classService_v1_classService_proto_msgTypes[4]
+	mi := &file_classService_v1_classService_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -365,7 +901,7 @@ func (x *ClassInfo) String() string {
 func (*ClassInfo) ProtoMessage() {}
 
 func (x *ClassInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_classService_v1_classService_proto_msgTypes[4]
+	mi := &file_classService_v1_classService_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -378,7 +914,7 @@ func (x *ClassInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClassInfo.ProtoReflect.Descriptor instead.
 func (*ClassInfo) Descriptor() ([]byte, []int) {
-	return file_classService_v1_classService_proto_rawDescGZIP(), []int{4}
+	return file_classService_v1_classService_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ClassInfo) GetDay() int64 {
@@ -458,20 +994,71 @@ func (x *ClassInfo) GetId() string {
 	return ""
 }
 
+func (x *ClassInfo) GetNature() string {
+	if x != nil {
+		return x.Nature
+	}
+	return ""
+}
+
 var File_classService_v1_classService_proto protoreflect.FileDescriptor
 
 const file_classService_v1_classService_proto_rawDesc = "" +
 	"\n" +
-	"\"classService/v1/classService.proto\x12\x0fclassService.v1\"\x98\x01\n" +
+	"\"classService/v1/classService.proto\x12\x0fclassService.v1\"\xd0\x02\n" +
 	"\rSearchRequest\x12&\n" +
 	"\x0esearchKeyWords\x18\x01 \x01(\tR\x0esearchKeyWords\x12\x12\n" +
 	"\x04year\x18\x02 \x01(\tR\x04year\x12\x1a\n" +
 	"\bsemester\x18\x03 \x01(\tR\bsemester\x12\x12\n" +
 	"\x04page\x18\x04 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\"J\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\x125\n" +
+	"\x06filter\x18\x06 \x01(\v2\x1d.classService.v1.SearchFilterR\x06filter\x12/\n" +
+	"\x04sort\x18\a \x01(\x0e2\x1b.classService.v1.SearchSortR\x04sort\x12\x16\n" +
+	"\x06cursor\x18\b \x01(\tR\x06cursor\x12\x1f\n" +
+	"\vwith_facets\x18\t \x01(\bR\n" +
+	"withFacets\x12\x15\n" +
+	"\x06stu_id\x18\n" +
+	" \x01(\tR\x05stuId\"\xc3\x02\n" +
+	"\fSearchFilter\x12\x12\n" +
+	"\x04days\x18\x01 \x03(\x03R\x04days\x12#\n" +
+	"\rsection_start\x18\x02 \x01(\x05R\fsectionStart\x12\x1f\n" +
+	"\vsection_end\x18\x03 \x01(\x05R\n" +
+	"sectionEnd\x12\x12\n" +
+	"\x04week\x18\x04 \x01(\x05R\x04week\x12!\n" +
+	"\fwhere_prefix\x18\x05 \x01(\tR\vwherePrefix\x12\"\n" +
+	"\n" +
+	"min_credit\x18\x06 \x01(\x01H\x00R\tminCredit\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"max_credit\x18\a \x01(\x01H\x01R\tmaxCredit\x88\x01\x01\x12\x18\n" +
+	"\anatures\x18\b \x03(\tR\anatures\x12\"\n" +
+	"\rfit_free_time\x18\t \x01(\bR\vfitFreeTimeB\r\n" +
+	"\v_min_creditB\r\n" +
+	"\v_max_credit\"\xb7\x01\n" +
 	"\vSearchReply\x12;\n" +
 	"\vclass_infos\x18\x01 \x03(\v2\x1a.classService.v1.ClassInfoR\n" +
-	"classInfos\"\x89\x02\n" +
+	"classInfos\x124\n" +
+	"\x06facets\x18\x02 \x03(\v2\x1c.classService.v1.SearchFacetR\x06facets\x12\x1f\n" +
+	"\vnext_cursor\x18\x03 \x01(\tR\n" +
+	"nextCursor\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\x03R\x05total\"[\n" +
+	"\vSearchFacet\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x126\n" +
+	"\abuckets\x18\x02 \x03(\v2\x1c.classService.v1.FacetBucketR\abuckets\"9\n" +
+	"\vFacetBucket\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"l\n" +
+	"\x0eSuggestRequest\x12\x16\n" +
+	"\x06prefix\x18\x01 \x01(\tR\x06prefix\x12\x12\n" +
+	"\x04year\x18\x02 \x01(\tR\x04year\x12\x1a\n" +
+	"\bsemester\x18\x03 \x01(\tR\bsemester\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\x05R\x04size\"M\n" +
+	"\fSuggestReply\x12=\n" +
+	"\vsuggestions\x18\x01 \x03(\v2\x1b.classService.v1.SuggestionR\vsuggestions\"J\n" +
+	"\n" +
+	"Suggestion\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x03R\x05count\"\x9f\x02\n" +
 	"\x0fAddClassRequest\x12\x15\n" +
 	"\x06stu_id\x18\x01 \x01(\tR\x05stuId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
@@ -483,11 +1070,12 @@ const file_classService_v1_classService_proto_rawDesc = "" +
 	"\x04year\x18\b \x01(\tR\x04year\x12\x10\n" +
 	"\x03day\x18\t \x01(\x03R\x03day\x12\x1b\n" +
 	"\x06credit\x18\n" +
-	" \x01(\x01H\x00R\x06credit\x88\x01\x01B\t\n" +
+	" \x01(\x01H\x00R\x06credit\x88\x01\x01\x12\x14\n" +
+	"\x05force\x18\v \x01(\bR\x05forceB\t\n" +
 	"\a_credit\"1\n" +
 	"\rAddClassReply\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
-	"\x03msg\x18\x02 \x01(\tR\x03msg\"\x9d\x02\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\"\xb5\x02\n" +
 	"\tClassInfo\x12\x10\n" +
 	"\x03day\x18\x01 \x01(\x03R\x03day\x12\x18\n" +
 	"\ateacher\x18\x02 \x01(\tR\ateacher\x12\x14\n" +
@@ -501,9 +1089,17 @@ const file_classService_v1_classService_proto_rawDesc = "" +
 	"\bsemester\x18\t \x01(\tR\bsemester\x12\x12\n" +
 	"\x04year\x18\n" +
 	" \x01(\tR\x04year\x12\x0e\n" +
-	"\x02id\x18\v \x01(\tR\x02id2\xa9\x01\n" +
+	"\x02id\x18\v \x01(\tR\x02id\x12\x16\n" +
+	"\x06nature\x18\f \x01(\tR\x06nature*v\n" +
+	"\n" +
+	"SearchSort\x12\x19\n" +
+	"\x15SEARCH_SORT_RELEVANCE\x10\x00\x12\x1b\n" +
+	"\x17SEARCH_SORT_CREDIT_DESC\x10\x01\x12\x1a\n" +
+	"\x16SEARCH_SORT_CREDIT_ASC\x10\x02\x12\x14\n" +
+	"\x10SEARCH_SORT_TIME\x10\x032\xf9\x01\n" +
 	"\fClassService\x12K\n" +
-	"\vSearchClass\x12\x1e.classService.v1.SearchRequest\x1a\x1c.classService.v1.SearchReply\x12L\n" +
+	"\vSearchClass\x12\x1e.classService.v1.SearchRequest\x1a\x1c.classService.v1.SearchReply\x12N\n" +
+	"\fSuggestClass\x12\x1f.classService.v1.SuggestRequest\x1a\x1d.classService.v1.SuggestReply\x12L\n" +
 	"\bAddClass\x12 .classService.v1.AddClassRequest\x1a\x1e.classService.v1.AddClassReplyBPZNgithub.com/asynccnu/ccnubox-be/be-api/gen/proto/classService/v1;classServicev1b\x06proto3"
 
 var (
@@ -518,25 +1114,40 @@ func file_classService_v1_classService_proto_rawDescGZIP() []byte {
 	return file_classService_v1_classService_proto_rawDescData
 }
 
-var file_classService_v1_classService_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_classService_v1_classService_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_classService_v1_classService_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_classService_v1_classService_proto_goTypes = []any{
-	(*SearchRequest)(nil),   // 0: classService.v1.SearchRequest
-	(*SearchReply)(nil),     // 1: classService.v1.SearchReply
-	(*AddClassRequest)(nil), // 2: classService.v1.AddClassRequest
-	(*AddClassReply)(nil),   // 3: classService.v1.AddClassReply
-	(*ClassInfo)(nil),       // 4: classService.v1.ClassInfo
+	(SearchSort)(0),         // 0: classService.v1.SearchSort
+	(*SearchRequest)(nil),   // 1: classService.v1.SearchRequest
+	(*SearchFilter)(nil),    // 2: classService.v1.SearchFilter
+	(*SearchReply)(nil),     // 3: classService.v1.SearchReply
+	(*SearchFacet)(nil),     // 4: classService.v1.SearchFacet
+	(*FacetBucket)(nil),     // 5: classService.v1.FacetBucket
+	(*SuggestRequest)(nil),  // 6: classService.v1.SuggestRequest
+	(*SuggestReply)(nil),    // 7: classService.v1.SuggestReply
+	(*Suggestion)(nil),      // 8: classService.v1.Suggestion
+	(*AddClassRequest)(nil), // 9: classService.v1.AddClassRequest
+	(*AddClassReply)(nil),   // 10: classService.v1.AddClassReply
+	(*ClassInfo)(nil),       // 11: classService.v1.ClassInfo
 }
 var file_classService_v1_classService_proto_depIdxs = []int32{
-	4, // 0: classService.v1.SearchReply.class_infos:type_name -> classService.v1.ClassInfo
-	0, // 1: classService.v1.ClassService.SearchClass:input_type -> classService.v1.SearchRequest
-	2, // 2: classService.v1.ClassService.AddClass:input_type -> classService.v1.AddClassRequest
-	1, // 3: classService.v1.ClassService.SearchClass:output_type -> classService.v1.SearchReply
-	3, // 4: classService.v1.ClassService.AddClass:output_type -> classService.v1.AddClassReply
-	3, // [3:5] is the sub-list for method output_type
-	1, // [1:3] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	2,  // 0: classService.v1.SearchRequest.filter:type_name -> classService.v1.SearchFilter
+	0,  // 1: classService.v1.SearchRequest.sort:type_name -> classService.v1.SearchSort
+	11, // 2: classService.v1.SearchReply.class_infos:type_name -> classService.v1.ClassInfo
+	4,  // 3: classService.v1.SearchReply.facets:type_name -> classService.v1.SearchFacet
+	5,  // 4: classService.v1.SearchFacet.buckets:type_name -> classService.v1.FacetBucket
+	8,  // 5: classService.v1.SuggestReply.suggestions:type_name -> classService.v1.Suggestion
+	1,  // 6: classService.v1.ClassService.SearchClass:input_type -> classService.v1.SearchRequest
+	6,  // 7: classService.v1.ClassService.SuggestClass:input_type -> classService.v1.SuggestRequest
+	9,  // 8: classService.v1.ClassService.AddClass:input_type -> classService.v1.AddClassRequest
+	3,  // 9: classService.v1.ClassService.SearchClass:output_type -> classService.v1.SearchReply
+	7,  // 10: classService.v1.ClassService.SuggestClass:output_type -> classService.v1.SuggestReply
+	10, // 11: classService.v1.ClassService.AddClass:output_type -> classService.v1.AddClassReply
+	9,  // [9:12] is the sub-list for method output_type
+	6,  // [6:9] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_classService_v1_classService_proto_init() }
@@ -544,19 +1155,21 @@ func file_classService_v1_classService_proto_init() {
 	if File_classService_v1_classService_proto != nil {
 		return
 	}
-	file_classService_v1_classService_proto_msgTypes[2].OneofWrappers = []any{}
+	file_classService_v1_classService_proto_msgTypes[1].OneofWrappers = []any{}
+	file_classService_v1_classService_proto_msgTypes[8].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_classService_v1_classService_proto_rawDesc), len(file_classService_v1_classService_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   5,
+			NumEnums:      1,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_classService_v1_classService_proto_goTypes,
 		DependencyIndexes: file_classService_v1_classService_proto_depIdxs,
+		EnumInfos:         file_classService_v1_classService_proto_enumTypes,
 		MessageInfos:      file_classService_v1_classService_proto_msgTypes,
 	}.Build()
 	File_classService_v1_classService_proto = out.File
@@ -19,8 +19,9 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ClassService_SearchClass_FullMethodName = "/classService.v1.ClassService/SearchClass"
-	ClassService_AddClass_FullMethodName    = "/classService.v1.ClassService/AddClass"
+	ClassService_SearchClass_FullMethodName  = "/classService.v1.ClassService/SearchClass"
+	ClassService_SuggestClass_FullMethodName = "/classService.v1.ClassService/SuggestClass"
+	ClassService_AddClass_FullMethodName     = "/classService.v1.ClassService/AddClass"
 )
 
 // ClassServiceClient is the client API for ClassService service.
@@ -31,6 +32,8 @@ const (
 type ClassServiceClient interface {
 	// 数据源是所有使用匣子的用户的课表，从其中搜索相应的课程
 	SearchClass(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchReply, error)
+	// 搜索框的联想词,支持汉字、拼音全拼和首字母
+	SuggestClass(ctx context.Context, in *SuggestRequest, opts ...grpc.CallOption) (*SuggestReply, error)
 	// 添加课程
 	AddClass(ctx context.Context, in *AddClassRequest, opts ...grpc.CallOption) (*AddClassReply, error)
 }
@@ -53,6 +56,16 @@ func (c *classServiceClient) SearchClass(ctx context.Context, in *SearchRequest,
 	return out, nil
 }
 
+func (c *classServiceClient) SuggestClass(ctx context.Context, in *SuggestRequest, opts ...grpc.CallOption) (*SuggestReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuggestReply)
+	err := c.cc.Invoke(ctx, ClassService_SuggestClass_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *classServiceClient) AddClass(ctx context.Context, in *AddClassRequest, opts ...grpc.CallOption) (*AddClassReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AddClassReply)
@@ -71,6 +84,8 @@ func (c *classServiceClient) AddClass(ctx context.Context, in *AddClassRequest,
 type ClassServiceServer interface {
 	// 数据源是所有使用匣子的用户的课表，从其中搜索相应的课程
 	SearchClass(context.Context, *SearchRequest) (*SearchReply, error)
+	// 搜索框的联想词,支持汉字、拼音全拼和首字母
+	SuggestClass(context.Context, *SuggestRequest) (*SuggestReply, error)
 	// 添加课程
 	AddClass(context.Context, *AddClassRequest) (*AddClassReply, error)
 	mustEmbedUnimplementedClassServiceServer()
@@ -86,6 +101,9 @@ type UnimplementedClassServiceServer struct{}
 func (UnimplementedClassServiceServer) SearchClass(context.Context, *SearchRequest) (*SearchReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchClass not implemented")
 }
+func (UnimplementedClassServiceServer) SuggestClass(context.Context, *SuggestRequest) (*SuggestReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestClass not implemented")
+}
 func (UnimplementedClassServiceServer) AddClass(context.Context, *AddClassRequest) (*AddClassReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddClass not implemented")
 }
@@ -128,6 +146,24 @@ func _ClassService_SearchClass_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClassService_SuggestClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClassServiceServer).SuggestClass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClassService_SuggestClass_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClassServiceServer).SuggestClass(ctx, req.(*SuggestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ClassService_AddClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddClassRequest)
 	if err := dec(in); err != nil {
@@ -157,6 +193,10 @@ var ClassService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SearchClass",
 			Handler:    _ClassService_SearchClass_Handler,
 		},
+		{
+			MethodName: "SuggestClass",
+			Handler:    _ClassService_SuggestClass_Handler,
+		},
 		{
 			MethodName: "AddClass",
 			Handler:    _ClassService_AddClass_Handler,
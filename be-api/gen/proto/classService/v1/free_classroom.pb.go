@@ -22,14 +22,18 @@ const (
 )
 
 type QueryFreeClassroomReq struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Year          string                 `protobuf:"bytes,1,opt,name=year,proto3" json:"year,omitempty"`
-	Semester      string                 `protobuf:"bytes,2,opt,name=semester,proto3" json:"semester,omitempty"`
-	Week          int32                  `protobuf:"varint,3,opt,name=week,proto3" json:"week,omitempty"`                //哪一周
-	Day           int32                  `protobuf:"varint,4,opt,name=day,proto3" json:"day,omitempty"`                  //哪一天
-	Sections      []int32                `protobuf:"varint,5,rep,packed,name=sections,proto3" json:"sections,omitempty"` //哪几节课
-	WherePrefix   string                 `protobuf:"bytes,6,opt,name=wherePrefix,proto3" json:"wherePrefix,omitempty"`   //查询的地点前缀，比如南湖1楼，就是 "n1"，7号教学楼2楼就是"72"
-	StuID         string                 `protobuf:"bytes,7,opt,name=stuID,proto3" json:"stuID,omitempty"`               //学号
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Year        string                 `protobuf:"bytes,1,opt,name=year,proto3" json:"year,omitempty"`
+	Semester    string                 `protobuf:"bytes,2,opt,name=semester,proto3" json:"semester,omitempty"`
+	Week        int32                  `protobuf:"varint,3,opt,name=week,proto3" json:"week,omitempty"`                //哪一周
+	Day         int32                  `protobuf:"varint,4,opt,name=day,proto3" json:"day,omitempty"`                  //哪一天
+	Sections    []int32                `protobuf:"varint,5,rep,packed,name=sections,proto3" json:"sections,omitempty"` //哪几节课
+	WherePrefix string                 `protobuf:"bytes,6,opt,name=wherePrefix,proto3" json:"wherePrefix,omitempty"`   //查询的地点前缀，比如南湖1楼，就是 "n1"，7号教学楼2楼就是"72"
+	StuID       string                 `protobuf:"bytes,7,opt,name=stuID,proto3" json:"stuID,omitempty"`               //学号
+	// 按位置查询,wherePrefix为空时使用,为0或空的字段不参与筛选
+	Campus        int32  `protobuf:"varint,8,opt,name=campus,proto3" json:"campus,omitempty"`    //校区 1:本部 2:南湖校区
+	Building      string `protobuf:"bytes,9,opt,name=building,proto3" json:"building,omitempty"` //教学楼编号，见GetClassroomCatalog，比如"7"、"n"
+	Floor         int32  `protobuf:"varint,10,opt,name=floor,proto3" json:"floor,omitempty"`     //楼层，需要同时指定教学楼
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -113,9 +117,31 @@ func (x *QueryFreeClassroomReq) GetStuID() string {
 	return ""
 }
 
+func (x *QueryFreeClassroomReq) GetCampus() int32 {
+	if x != nil {
+		return x.Campus
+	}
+	return 0
+}
+
+func (x *QueryFreeClassroomReq) GetBuilding() string {
+	if x != nil {
+		return x.Building
+	}
+	return ""
+}
+
+func (x *QueryFreeClassroomReq) GetFloor() int32 {
+	if x != nil {
+		return x.Floor
+	}
+	return 0
+}
+
 type QueryFreeClassroomResp struct {
 	state         protoimpl.MessageState    `protogen:"open.v1"`
 	Stat          []*ClassroomAvailableStat `protobuf:"bytes,1,rep,name=stat,proto3" json:"stat,omitempty"`
+	Buildings     []*BuildingAvailableStat  `protobuf:"bytes,2,rep,name=buildings,proto3" json:"buildings,omitempty"` //与stat相同，按教学楼和楼层分组
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -157,6 +183,133 @@ func (x *QueryFreeClassroomResp) GetStat() []*ClassroomAvailableStat {
 	return nil
 }
 
+func (x *QueryFreeClassroomResp) GetBuildings() []*BuildingAvailableStat {
+	if x != nil {
+		return x.Buildings
+	}
+	return nil
+}
+
+type BuildingAvailableStat struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Campus        int32                  `protobuf:"varint,1,opt,name=campus,proto3" json:"campus,omitempty"`
+	Building      string                 `protobuf:"bytes,2,opt,name=building,proto3" json:"building,omitempty"` //教学楼编号,推不出教学楼的教室为空
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Floors        []*FloorAvailableStat  `protobuf:"bytes,4,rep,name=floors,proto3" json:"floors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuildingAvailableStat) Reset() {
+	*x = BuildingAvailableStat{}
+	mi := &file_classService_v1_free_classroom_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildingAvailableStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildingAvailableStat) ProtoMessage() {}
+
+func (x *BuildingAvailableStat) ProtoReflect() protoreflect.Message {
+	mi := &file_classService_v1_free_classroom_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildingAvailableStat.ProtoReflect.Descriptor instead.
+func (*BuildingAvailableStat) Descriptor() ([]byte, []int) {
+	return file_classService_v1_free_classroom_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BuildingAvailableStat) GetCampus() int32 {
+	if x != nil {
+		return x.Campus
+	}
+	return 0
+}
+
+func (x *BuildingAvailableStat) GetBuilding() string {
+	if x != nil {
+		return x.Building
+	}
+	return ""
+}
+
+func (x *BuildingAvailableStat) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BuildingAvailableStat) GetFloors() []*FloorAvailableStat {
+	if x != nil {
+		return x.Floors
+	}
+	return nil
+}
+
+type FloorAvailableStat struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Floor         int32                     `protobuf:"varint,1,opt,name=floor,proto3" json:"floor,omitempty"`
+	Stat          []*ClassroomAvailableStat `protobuf:"bytes,2,rep,name=stat,proto3" json:"stat,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FloorAvailableStat) Reset() {
+	*x = FloorAvailableStat{}
+	mi := &file_classService_v1_free_classroom_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FloorAvailableStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FloorAvailableStat) ProtoMessage() {}
+
+func (x *FloorAvailableStat) ProtoReflect() protoreflect.Message {
+	mi := &file_classService_v1_free_classroom_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FloorAvailableStat.ProtoReflect.Descriptor instead.
+func (*FloorAvailableStat) Descriptor() ([]byte, []int) {
+	return file_classService_v1_free_classroom_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FloorAvailableStat) GetFloor() int32 {
+	if x != nil {
+		return x.Floor
+	}
+	return 0
+}
+
+func (x *FloorAvailableStat) GetStat() []*ClassroomAvailableStat {
+	if x != nil {
+		return x.Stat
+	}
+	return nil
+}
+
 type ClassroomAvailableStat struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Classroom     string                 `protobuf:"bytes,1,opt,name=classroom,proto3" json:"classroom,omitempty"`
@@ -167,7 +320,7 @@ type ClassroomAvailableStat struct {
 
 func (x *ClassroomAvailableStat) Reset() {
 	*x = ClassroomAvailableStat{}
-	mi := &file_classService_v1_free_classroom_proto_msgTypes[2]
+	mi := &file_classService_v1_free_classroom_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -179,7 +332,7 @@ func (x *ClassroomAvailableStat) String() string {
 func (*ClassroomAvailableStat) ProtoMessage() {}
 
 func (x *ClassroomAvailableStat) ProtoReflect() protoreflect.Message {
-	mi := &file_classService_v1_free_classroom_proto_msgTypes[2]
+	mi := &file_classService_v1_free_classroom_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -192,7 +345,7 @@ func (x *ClassroomAvailableStat) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClassroomAvailableStat.ProtoReflect.Descriptor instead.
 func (*ClassroomAvailableStat) Descriptor() ([]byte, []int) {
-	return file_classService_v1_free_classroom_proto_rawDescGZIP(), []int{2}
+	return file_classService_v1_free_classroom_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ClassroomAvailableStat) GetClassroom() string {
@@ -209,26 +362,1044 @@ func (x *ClassroomAvailableStat) GetAvailableStat() []bool {
 	return nil
 }
 
-var File_classService_v1_free_classroom_proto protoreflect.FileDescriptor
+type RecommendQuietClassroomReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Year          string                 `protobuf:"bytes,1,opt,name=year,proto3" json:"year,omitempty"`
+	Semester      string                 `protobuf:"bytes,2,opt,name=semester,proto3" json:"semester,omitempty"`
+	Week          int32                  `protobuf:"varint,3,opt,name=week,proto3" json:"week,omitempty"`                 //哪一周
+	Day           int32                  `protobuf:"varint,4,opt,name=day,proto3" json:"day,omitempty"`                   //星期几
+	StartSection  int32                  `protobuf:"varint,5,opt,name=startSection,proto3" json:"startSection,omitempty"` //从第几节开始自习
+	Duration      int32                  `protobuf:"varint,6,opt,name=duration,proto3" json:"duration,omitempty"`         //需要连续空闲的节数
+	WherePrefix   string                 `protobuf:"bytes,7,opt,name=wherePrefix,proto3" json:"wherePrefix,omitempty"`    //在哪些教室里找,同QueryFreeClassroomReq
+	Location      string                 `protobuf:"bytes,8,opt,name=location,proto3" json:"location,omitempty"`          //用户当前的位置,比如"n12"或者"n1203",越近的教室越靠前,为空时不考虑距离
+	StuID         string                 `protobuf:"bytes,9,opt,name=stuID,proto3" json:"stuID,omitempty"`                //学号
+	Limit         int32                  `protobuf:"varint,10,opt,name=limit,proto3" json:"limit,omitempty"`              //返回的教室数量,默认5个
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_classService_v1_free_classroom_proto_rawDesc = "" +
-	"\n" +
-	"$classService/v1/free_classroom.proto\x12\x0fclassService.v1\"\xc1\x01\n" +
-	"\x15QueryFreeClassroomReq\x12\x12\n" +
-	"\x04year\x18\x01 \x01(\tR\x04year\x12\x1a\n" +
-	"\bsemester\x18\x02 \x01(\tR\bsemester\x12\x12\n" +
-	"\x04week\x18\x03 \x01(\x05R\x04week\x12\x10\n" +
-	"\x03day\x18\x04 \x01(\x05R\x03day\x12\x1a\n" +
-	"\bsections\x18\x05 \x03(\x05R\bsections\x12 \n" +
-	"\vwherePrefix\x18\x06 \x01(\tR\vwherePrefix\x12\x14\n" +
-	"\x05stuID\x18\a \x01(\tR\x05stuID\"U\n" +
-	"\x16QueryFreeClassroomResp\x12;\n" +
-	"\x04stat\x18\x01 \x03(\v2'.classService.v1.ClassroomAvailableStatR\x04stat\"\\\n" +
-	"\x16ClassroomAvailableStat\x12\x1c\n" +
-	"\tclassroom\x18\x01 \x01(\tR\tclassroom\x12$\n" +
-	"\ravailableStat\x18\x02 \x03(\bR\ravailableStat2y\n" +
+func (x *RecommendQuietClassroomReq) Reset() {
+	*x = RecommendQuietClassroomReq{}
+	mi := &file_classService_v1_free_classroom_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecommendQuietClassroomReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendQuietClassroomReq) ProtoMessage() {}
+
+func (x *RecommendQuietClassroomReq) ProtoReflect() protoreflect.Message {
+	mi := &file_classService_v1_free_classroom_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendQuietClassroomReq.ProtoReflect.Descriptor instead.
+func (*RecommendQuietClassroomReq) Descriptor() ([]byte, []int) {
+	return file_classService_v1_free_classroom_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RecommendQuietClassroomReq) GetYear() string {
+	if x != nil {
+		return x.Year
+	}
+	return ""
+}
+
+func (x *RecommendQuietClassroomReq) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+func (x *RecommendQuietClassroomReq) GetWeek() int32 {
+	if x != nil {
+		return x.Week
+	}
+	return 0
+}
+
+func (x *RecommendQuietClassroomReq) GetDay() int32 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+func (x *RecommendQuietClassroomReq) GetStartSection() int32 {
+	if x != nil {
+		return x.StartSection
+	}
+	return 0
+}
+
+func (x *RecommendQuietClassroomReq) GetDuration() int32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *RecommendQuietClassroomReq) GetWherePrefix() string {
+	if x != nil {
+		return x.WherePrefix
+	}
+	return ""
+}
+
+func (x *RecommendQuietClassroomReq) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *RecommendQuietClassroomReq) GetStuID() string {
+	if x != nil {
+		return x.StuID
+	}
+	return ""
+}
+
+func (x *RecommendQuietClassroomReq) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type RecommendQuietClassroomResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Classrooms    []*QuietClassroom      `protobuf:"bytes,1,rep,name=classrooms,proto3" json:"classrooms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecommendQuietClassroomResp) Reset() {
+	*x = RecommendQuietClassroomResp{}
+	mi := &file_classService_v1_free_classroom_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecommendQuietClassroomResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendQuietClassroomResp) ProtoMessage() {}
+
+func (x *RecommendQuietClassroomResp) ProtoReflect() protoreflect.Message {
+	mi := &file_classService_v1_free_classroom_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendQuietClassroomResp.ProtoReflect.Descriptor instead.
+func (*RecommendQuietClassroomResp) Descriptor() ([]byte, []int) {
+	return file_classService_v1_free_classroom_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RecommendQuietClassroomResp) GetClassrooms() []*QuietClassroom {
+	if x != nil {
+		return x.Classrooms
+	}
+	return nil
+}
+
+type QuietClassroom struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Classroom        string                 `protobuf:"bytes,1,opt,name=classroom,proto3" json:"classroom,omitempty"`
+	FreeStart        int32                  `protobuf:"varint,2,opt,name=freeStart,proto3" json:"freeStart,omitempty"`               //连续空闲的第一节
+	FreeEnd          int32                  `protobuf:"varint,3,opt,name=freeEnd,proto3" json:"freeEnd,omitempty"`                   //连续空闲的最后一节
+	OccupiedSections int32                  `protobuf:"varint,4,opt,name=occupiedSections,proto3" json:"occupiedSections,omitempty"` //这一天有课的节数,-1表示没有数据
+	Score            float64                `protobuf:"fixed64,5,opt,name=score,proto3" json:"score,omitempty"`                      //推荐分数,越大越推荐
+	Reasons          []string               `protobuf:"bytes,6,rep,name=reasons,proto3" json:"reasons,omitempty"`                    //推荐的理由
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *QuietClassroom) Reset() {
+	*x = QuietClassroom{}
+	mi := &file_classService_v1_free_classroom_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuietClassroom) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuietClassroom) ProtoMessage() {}
+
+func (x *QuietClassroom) ProtoReflect() protoreflect.Message {
+	mi := &file_classService_v1_free_classroom_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuietClassroom.ProtoReflect.Descriptor instead.
+func (*QuietClassroom) Descriptor() ([]byte, []int) {
+	return file_classService_v1_free_classroom_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *QuietClassroom) GetClassroom() string {
+	if x != nil {
+		return x.Classroom
+	}
+	return ""
+}
+
+func (x *QuietClassroom) GetFreeStart() int32 {
+	if x != nil {
+		return x.FreeStart
+	}
+	return 0
+}
+
+func (x *QuietClassroom) GetFreeEnd() int32 {
+	if x != nil {
+		return x.FreeEnd
+	}
+	return 0
+}
+
+func (x *QuietClassroom) GetOccupiedSections() int32 {
+	if x != nil {
+		return x.OccupiedSections
+	}
+	return 0
+}
+
+func (x *QuietClassroom) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *QuietClassroom) GetReasons() []string {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+type GetClassroomScheduleReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Year          string                 `protobuf:"bytes,1,opt,name=year,proto3" json:"year,omitempty"`
+	Semester      string                 `protobuf:"bytes,2,opt,name=semester,proto3" json:"semester,omitempty"`
+	Classroom     string                 `protobuf:"bytes,3,opt,name=classroom,proto3" json:"classroom,omitempty"` //教室,比如"n101"
+	Week          int32                  `protobuf:"varint,4,opt,name=week,proto3" json:"week,omitempty"`          //哪一周
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClassroomScheduleReq) Reset() {
+	*x = GetClassroomScheduleReq{}
+	mi := &file_classService_v1_free_classroom_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClassroomScheduleReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClassroomScheduleReq) ProtoMessage() {}
+
+func (x *GetClassroomScheduleReq) ProtoReflect() protoreflect.Message {
+	mi := &file_classService_v1_free_classroom_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClassroomScheduleReq.ProtoReflect.Descriptor instead.
+func (*GetClassroomScheduleReq) Descriptor() ([]byte, []int) {
+	return file_classService_v1_free_classroom_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetClassroomScheduleReq) GetYear() string {
+	if x != nil {
+		return x.Year
+	}
+	return ""
+}
+
+func (x *GetClassroomScheduleReq) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+func (x *GetClassroomScheduleReq) GetClassroom() string {
+	if x != nil {
+		return x.Classroom
+	}
+	return ""
+}
+
+func (x *GetClassroomScheduleReq) GetWeek() int32 {
+	if x != nil {
+		return x.Week
+	}
+	return 0
+}
+
+type GetClassroomScheduleResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ClassroomMeta         `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`   //没有维护该教室的信息时为空
+	Slots         []*ClassroomSlot       `protobuf:"bytes,2,rep,name=slots,proto3" json:"slots,omitempty"` //按星期和节次排序,只返回被占用的节次
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClassroomScheduleResp) Reset() {
+	*x = GetClassroomScheduleResp{}
+	mi := &file_classService_v1_free_classroom_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClassroomScheduleResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClassroomScheduleResp) ProtoMessage() {}
+
+func (x *GetClassroomScheduleResp) ProtoReflect() protoreflect.Message {
+	mi := &file_classService_v1_free_classroom_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClassroomScheduleResp.ProtoReflect.Descriptor instead.
+func (*GetClassroomScheduleResp) Descriptor() ([]byte, []int) {
+	return file_classService_v1_free_classroom_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetClassroomScheduleResp) GetMeta() *ClassroomMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *GetClassroomScheduleResp) GetSlots() []*ClassroomSlot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+type ClassroomSlot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Day           int32                  `protobuf:"varint,1,opt,name=day,proto3" json:"day,omitempty"`         //星期几
+	Section       int32                  `protobuf:"varint,2,opt,name=section,proto3" json:"section,omitempty"` //第几节
+	Classes       []*OccupyingClass      `protobuf:"bytes,3,rep,name=classes,proto3" json:"classes,omitempty"`  //占用该节次的课程,只有选课手册中的占用信息时为空
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClassroomSlot) Reset() {
+	*x = ClassroomSlot{}
+	mi := &file_classService_v1_free_classroom_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClassroomSlot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClassroomSlot) ProtoMessage() {}
+
+func (x *ClassroomSlot) ProtoReflect() protoreflect.Message {
+	mi := &file_classService_v1_free_classroom_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClassroomSlot.ProtoReflect.Descriptor instead.
+func (*ClassroomSlot) Descriptor() ([]byte, []int) {
+	return file_classService_v1_free_classroom_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ClassroomSlot) GetDay() int32 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+func (x *ClassroomSlot) GetSection() int32 {
+	if x != nil {
+		return x.Section
+	}
+	return 0
+}
+
+func (x *ClassroomSlot) GetClasses() []*OccupyingClass {
+	if x != nil {
+		return x.Classes
+	}
+	return nil
+}
+
+type OccupyingClass struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Classname     string                 `protobuf:"bytes,2,opt,name=classname,proto3" json:"classname,omitempty"`
+	Teacher       string                 `protobuf:"bytes,3,opt,name=teacher,proto3" json:"teacher,omitempty"`
+	ClassWhen     string                 `protobuf:"bytes,4,opt,name=classWhen,proto3" json:"classWhen,omitempty"`       //上课是第几节,如"1-2"
+	WeekDuration  string                 `protobuf:"bytes,5,opt,name=weekDuration,proto3" json:"weekDuration,omitempty"` //上课的周数,如"1-17周"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OccupyingClass) Reset() {
+	*x = OccupyingClass{}
+	mi := &file_classService_v1_free_classroom_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OccupyingClass) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OccupyingClass) ProtoMessage() {}
+
+func (x *OccupyingClass) ProtoReflect() protoreflect.Message {
+	mi := &file_classService_v1_free_classroom_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OccupyingClass.ProtoReflect.Descriptor instead.
+func (*OccupyingClass) Descriptor() ([]byte, []int) {
+	return file_classService_v1_free_classroom_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *OccupyingClass) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *OccupyingClass) GetClassname() string {
+	if x != nil {
+		return x.Classname
+	}
+	return ""
+}
+
+func (x *OccupyingClass) GetTeacher() string {
+	if x != nil {
+		return x.Teacher
+	}
+	return ""
+}
+
+func (x *OccupyingClass) GetClassWhen() string {
+	if x != nil {
+		return x.ClassWhen
+	}
+	return ""
+}
+
+func (x *OccupyingClass) GetWeekDuration() string {
+	if x != nil {
+		return x.WeekDuration
+	}
+	return ""
+}
+
+type ClassroomMeta struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Classroom     string                 `protobuf:"bytes,1,opt,name=classroom,proto3" json:"classroom,omitempty"`
+	Capacity      int32                  `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`   //座位数
+	Equipment     []string               `protobuf:"bytes,3,rep,name=equipment,proto3" json:"equipment,omitempty"`  //设备,比如"投影仪","空调"
+	Note          string                 `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`            //备注
+	UpdatedAt     int64                  `protobuf:"varint,5,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"` //最后修改的时间,单位s
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClassroomMeta) Reset() {
+	*x = ClassroomMeta{}
+	mi := &file_classService_v1_free_classroom_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClassroomMeta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClassroomMeta) ProtoMessage() {}
+
+func (x *ClassroomMeta) ProtoReflect() protoreflect.Message {
+	mi := &file_classService_v1_free_classroom_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClassroomMeta.ProtoReflect.Descriptor instead.
+func (*ClassroomMeta) Descriptor() ([]byte, []int) {
+	return file_classService_v1_free_classroom_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ClassroomMeta) GetClassroom() string {
+	if x != nil {
+		return x.Classroom
+	}
+	return ""
+}
+
+func (x *ClassroomMeta) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *ClassroomMeta) GetEquipment() []string {
+	if x != nil {
+		return x.Equipment
+	}
+	return nil
+}
+
+func (x *ClassroomMeta) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *ClassroomMeta) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type SaveClassroomMetaReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *ClassroomMeta         `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"` //会覆盖该教室已有的信息,updatedAt不需要传
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveClassroomMetaReq) Reset() {
+	*x = SaveClassroomMetaReq{}
+	mi := &file_classService_v1_free_classroom_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveClassroomMetaReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveClassroomMetaReq) ProtoMessage() {}
+
+func (x *SaveClassroomMetaReq) ProtoReflect() protoreflect.Message {
+	mi := &file_classService_v1_free_classroom_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveClassroomMetaReq.ProtoReflect.Descriptor instead.
+func (*SaveClassroomMetaReq) Descriptor() ([]byte, []int) {
+	return file_classService_v1_free_classroom_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SaveClassroomMetaReq) GetMeta() *ClassroomMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+type SaveClassroomMetaResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveClassroomMetaResp) Reset() {
+	*x = SaveClassroomMetaResp{}
+	mi := &file_classService_v1_free_classroom_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveClassroomMetaResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveClassroomMetaResp) ProtoMessage() {}
+
+func (x *SaveClassroomMetaResp) ProtoReflect() protoreflect.Message {
+	mi := &file_classService_v1_free_classroom_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveClassroomMetaResp.ProtoReflect.Descriptor instead.
+func (*SaveClassroomMetaResp) Descriptor() ([]byte, []int) {
+	return file_classService_v1_free_classroom_proto_rawDescGZIP(), []int{14}
+}
+
+type DeleteClassroomMetaReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Classroom     string                 `protobuf:"bytes,1,opt,name=classroom,proto3" json:"classroom,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteClassroomMetaReq) Reset() {
+	*x = DeleteClassroomMetaReq{}
+	mi := &file_classService_v1_free_classroom_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteClassroomMetaReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteClassroomMetaReq) ProtoMessage() {}
+
+func (x *DeleteClassroomMetaReq) ProtoReflect() protoreflect.Message {
+	mi := &file_classService_v1_free_classroom_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteClassroomMetaReq.ProtoReflect.Descriptor instead.
+func (*DeleteClassroomMetaReq) Descriptor() ([]byte, []int) {
+	return file_classService_v1_free_classroom_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DeleteClassroomMetaReq) GetClassroom() string {
+	if x != nil {
+		return x.Classroom
+	}
+	return ""
+}
+
+type DeleteClassroomMetaResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteClassroomMetaResp) Reset() {
+	*x = DeleteClassroomMetaResp{}
+	mi := &file_classService_v1_free_classroom_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteClassroomMetaResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteClassroomMetaResp) ProtoMessage() {}
+
+func (x *DeleteClassroomMetaResp) ProtoReflect() protoreflect.Message {
+	mi := &file_classService_v1_free_classroom_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteClassroomMetaResp.ProtoReflect.Descriptor instead.
+func (*DeleteClassroomMetaResp) Descriptor() ([]byte, []int) {
+	return file_classService_v1_free_classroom_proto_rawDescGZIP(), []int{16}
+}
+
+type GetClassroomCatalogReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClassroomCatalogReq) Reset() {
+	*x = GetClassroomCatalogReq{}
+	mi := &file_classService_v1_free_classroom_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClassroomCatalogReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClassroomCatalogReq) ProtoMessage() {}
+
+func (x *GetClassroomCatalogReq) ProtoReflect() protoreflect.Message {
+	mi := &file_classService_v1_free_classroom_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClassroomCatalogReq.ProtoReflect.Descriptor instead.
+func (*GetClassroomCatalogReq) Descriptor() ([]byte, []int) {
+	return file_classService_v1_free_classroom_proto_rawDescGZIP(), []int{17}
+}
+
+type GetClassroomCatalogResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Campuses      []*Campus              `protobuf:"bytes,1,rep,name=campuses,proto3" json:"campuses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClassroomCatalogResp) Reset() {
+	*x = GetClassroomCatalogResp{}
+	mi := &file_classService_v1_free_classroom_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClassroomCatalogResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClassroomCatalogResp) ProtoMessage() {}
+
+func (x *GetClassroomCatalogResp) ProtoReflect() protoreflect.Message {
+	mi := &file_classService_v1_free_classroom_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClassroomCatalogResp.ProtoReflect.Descriptor instead.
+func (*GetClassroomCatalogResp) Descriptor() ([]byte, []int) {
+	return file_classService_v1_free_classroom_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetClassroomCatalogResp) GetCampuses() []*Campus {
+	if x != nil {
+		return x.Campuses
+	}
+	return nil
+}
+
+type Campus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` //教务系统中的校区号
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Buildings     []*Building            `protobuf:"bytes,3,rep,name=buildings,proto3" json:"buildings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Campus) Reset() {
+	*x = Campus{}
+	mi := &file_classService_v1_free_classroom_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Campus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Campus) ProtoMessage() {}
+
+func (x *Campus) ProtoReflect() protoreflect.Message {
+	mi := &file_classService_v1_free_classroom_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Campus.ProtoReflect.Descriptor instead.
+func (*Campus) Descriptor() ([]byte, []int) {
+	return file_classService_v1_free_classroom_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Campus) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Campus) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Campus) GetBuildings() []*Building {
+	if x != nil {
+		return x.Buildings
+	}
+	return nil
+}
+
+type Building struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` //教学楼编号，即教室号的前缀
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Floors        []int32                `protobuf:"varint,3,rep,packed,name=floors,proto3" json:"floors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Building) Reset() {
+	*x = Building{}
+	mi := &file_classService_v1_free_classroom_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Building) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Building) ProtoMessage() {}
+
+func (x *Building) ProtoReflect() protoreflect.Message {
+	mi := &file_classService_v1_free_classroom_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Building.ProtoReflect.Descriptor instead.
+func (*Building) Descriptor() ([]byte, []int) {
+	return file_classService_v1_free_classroom_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Building) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Building) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Building) GetFloors() []int32 {
+	if x != nil {
+		return x.Floors
+	}
+	return nil
+}
+
+var File_classService_v1_free_classroom_proto protoreflect.FileDescriptor
+
+const file_classService_v1_free_classroom_proto_rawDesc = "" +
+	"\n" +
+	"$classService/v1/free_classroom.proto\x12\x0fclassService.v1\"\x8b\x02\n" +
+	"\x15QueryFreeClassroomReq\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\tR\x04year\x12\x1a\n" +
+	"\bsemester\x18\x02 \x01(\tR\bsemester\x12\x12\n" +
+	"\x04week\x18\x03 \x01(\x05R\x04week\x12\x10\n" +
+	"\x03day\x18\x04 \x01(\x05R\x03day\x12\x1a\n" +
+	"\bsections\x18\x05 \x03(\x05R\bsections\x12 \n" +
+	"\vwherePrefix\x18\x06 \x01(\tR\vwherePrefix\x12\x14\n" +
+	"\x05stuID\x18\a \x01(\tR\x05stuID\x12\x16\n" +
+	"\x06campus\x18\b \x01(\x05R\x06campus\x12\x1a\n" +
+	"\bbuilding\x18\t \x01(\tR\bbuilding\x12\x14\n" +
+	"\x05floor\x18\n" +
+	" \x01(\x05R\x05floor\"\x9b\x01\n" +
+	"\x16QueryFreeClassroomResp\x12;\n" +
+	"\x04stat\x18\x01 \x03(\v2'.classService.v1.ClassroomAvailableStatR\x04stat\x12D\n" +
+	"\tbuildings\x18\x02 \x03(\v2&.classService.v1.BuildingAvailableStatR\tbuildings\"\x9c\x01\n" +
+	"\x15BuildingAvailableStat\x12\x16\n" +
+	"\x06campus\x18\x01 \x01(\x05R\x06campus\x12\x1a\n" +
+	"\bbuilding\x18\x02 \x01(\tR\bbuilding\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12;\n" +
+	"\x06floors\x18\x04 \x03(\v2#.classService.v1.FloorAvailableStatR\x06floors\"g\n" +
+	"\x12FloorAvailableStat\x12\x14\n" +
+	"\x05floor\x18\x01 \x01(\x05R\x05floor\x12;\n" +
+	"\x04stat\x18\x02 \x03(\v2'.classService.v1.ClassroomAvailableStatR\x04stat\"\\\n" +
+	"\x16ClassroomAvailableStat\x12\x1c\n" +
+	"\tclassroom\x18\x01 \x01(\tR\tclassroom\x12$\n" +
+	"\ravailableStat\x18\x02 \x03(\bR\ravailableStat\"\x9c\x02\n" +
+	"\x1aRecommendQuietClassroomReq\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\tR\x04year\x12\x1a\n" +
+	"\bsemester\x18\x02 \x01(\tR\bsemester\x12\x12\n" +
+	"\x04week\x18\x03 \x01(\x05R\x04week\x12\x10\n" +
+	"\x03day\x18\x04 \x01(\x05R\x03day\x12\"\n" +
+	"\fstartSection\x18\x05 \x01(\x05R\fstartSection\x12\x1a\n" +
+	"\bduration\x18\x06 \x01(\x05R\bduration\x12 \n" +
+	"\vwherePrefix\x18\a \x01(\tR\vwherePrefix\x12\x1a\n" +
+	"\blocation\x18\b \x01(\tR\blocation\x12\x14\n" +
+	"\x05stuID\x18\t \x01(\tR\x05stuID\x12\x14\n" +
+	"\x05limit\x18\n" +
+	" \x01(\x05R\x05limit\"^\n" +
+	"\x1bRecommendQuietClassroomResp\x12?\n" +
+	"\n" +
+	"classrooms\x18\x01 \x03(\v2\x1f.classService.v1.QuietClassroomR\n" +
+	"classrooms\"\xc2\x01\n" +
+	"\x0eQuietClassroom\x12\x1c\n" +
+	"\tclassroom\x18\x01 \x01(\tR\tclassroom\x12\x1c\n" +
+	"\tfreeStart\x18\x02 \x01(\x05R\tfreeStart\x12\x18\n" +
+	"\afreeEnd\x18\x03 \x01(\x05R\afreeEnd\x12*\n" +
+	"\x10occupiedSections\x18\x04 \x01(\x05R\x10occupiedSections\x12\x14\n" +
+	"\x05score\x18\x05 \x01(\x01R\x05score\x12\x18\n" +
+	"\areasons\x18\x06 \x03(\tR\areasons\"{\n" +
+	"\x17GetClassroomScheduleReq\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\tR\x04year\x12\x1a\n" +
+	"\bsemester\x18\x02 \x01(\tR\bsemester\x12\x1c\n" +
+	"\tclassroom\x18\x03 \x01(\tR\tclassroom\x12\x12\n" +
+	"\x04week\x18\x04 \x01(\x05R\x04week\"\x84\x01\n" +
+	"\x18GetClassroomScheduleResp\x122\n" +
+	"\x04meta\x18\x01 \x01(\v2\x1e.classService.v1.ClassroomMetaR\x04meta\x124\n" +
+	"\x05slots\x18\x02 \x03(\v2\x1e.classService.v1.ClassroomSlotR\x05slots\"v\n" +
+	"\rClassroomSlot\x12\x10\n" +
+	"\x03day\x18\x01 \x01(\x05R\x03day\x12\x18\n" +
+	"\asection\x18\x02 \x01(\x05R\asection\x129\n" +
+	"\aclasses\x18\x03 \x03(\v2\x1f.classService.v1.OccupyingClassR\aclasses\"\x9a\x01\n" +
+	"\x0eOccupyingClass\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\tclassname\x18\x02 \x01(\tR\tclassname\x12\x18\n" +
+	"\ateacher\x18\x03 \x01(\tR\ateacher\x12\x1c\n" +
+	"\tclassWhen\x18\x04 \x01(\tR\tclassWhen\x12\"\n" +
+	"\fweekDuration\x18\x05 \x01(\tR\fweekDuration\"\x99\x01\n" +
+	"\rClassroomMeta\x12\x1c\n" +
+	"\tclassroom\x18\x01 \x01(\tR\tclassroom\x12\x1a\n" +
+	"\bcapacity\x18\x02 \x01(\x05R\bcapacity\x12\x1c\n" +
+	"\tequipment\x18\x03 \x03(\tR\tequipment\x12\x12\n" +
+	"\x04note\x18\x04 \x01(\tR\x04note\x12\x1c\n" +
+	"\tupdatedAt\x18\x05 \x01(\x03R\tupdatedAt\"J\n" +
+	"\x14SaveClassroomMetaReq\x122\n" +
+	"\x04meta\x18\x01 \x01(\v2\x1e.classService.v1.ClassroomMetaR\x04meta\"\x17\n" +
+	"\x15SaveClassroomMetaResp\"6\n" +
+	"\x16DeleteClassroomMetaReq\x12\x1c\n" +
+	"\tclassroom\x18\x01 \x01(\tR\tclassroom\"\x19\n" +
+	"\x17DeleteClassroomMetaResp\"\x18\n" +
+	"\x16GetClassroomCatalogReq\"N\n" +
+	"\x17GetClassroomCatalogResp\x123\n" +
+	"\bcampuses\x18\x01 \x03(\v2\x17.classService.v1.CampusR\bcampuses\"e\n" +
+	"\x06Campus\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x127\n" +
+	"\tbuildings\x18\x03 \x03(\v2\x19.classService.v1.BuildingR\tbuildings\"F\n" +
+	"\bBuilding\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06floors\x18\x03 \x03(\x05R\x06floors2\x94\x05\n" +
 	"\x10FreeClassroomSvc\x12e\n" +
-	"\x12QueryFreeClassroom\x12&.classService.v1.QueryFreeClassroomReq\x1a'.classService.v1.QueryFreeClassroomRespBPZNgithub.com/asynccnu/ccnubox-be/be-api/gen/proto/classService/v1;classServicev1b\x06proto3"
+	"\x12QueryFreeClassroom\x12&.classService.v1.QueryFreeClassroomReq\x1a'.classService.v1.QueryFreeClassroomResp\x12t\n" +
+	"\x17RecommendQuietClassroom\x12+.classService.v1.RecommendQuietClassroomReq\x1a,.classService.v1.RecommendQuietClassroomResp\x12k\n" +
+	"\x14GetClassroomSchedule\x12(.classService.v1.GetClassroomScheduleReq\x1a).classService.v1.GetClassroomScheduleResp\x12b\n" +
+	"\x11SaveClassroomMeta\x12%.classService.v1.SaveClassroomMetaReq\x1a&.classService.v1.SaveClassroomMetaResp\x12h\n" +
+	"\x13DeleteClassroomMeta\x12'.classService.v1.DeleteClassroomMetaReq\x1a(.classService.v1.DeleteClassroomMetaResp\x12h\n" +
+	"\x13GetClassroomCatalog\x12'.classService.v1.GetClassroomCatalogReq\x1a(.classService.v1.GetClassroomCatalogRespBPZNgithub.com/asynccnu/ccnubox-be/be-api/gen/proto/classService/v1;classServicev1b\x06proto3"
 
 var (
 	file_classService_v1_free_classroom_proto_rawDescOnce sync.Once
@@ -242,21 +1413,59 @@ func file_classService_v1_free_classroom_proto_rawDescGZIP() []byte {
 	return file_classService_v1_free_classroom_proto_rawDescData
 }
 
-var file_classService_v1_free_classroom_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_classService_v1_free_classroom_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_classService_v1_free_classroom_proto_goTypes = []any{
-	(*QueryFreeClassroomReq)(nil),  // 0: classService.v1.QueryFreeClassroomReq
-	(*QueryFreeClassroomResp)(nil), // 1: classService.v1.QueryFreeClassroomResp
-	(*ClassroomAvailableStat)(nil), // 2: classService.v1.ClassroomAvailableStat
+	(*QueryFreeClassroomReq)(nil),       // 0: classService.v1.QueryFreeClassroomReq
+	(*QueryFreeClassroomResp)(nil),      // 1: classService.v1.QueryFreeClassroomResp
+	(*BuildingAvailableStat)(nil),       // 2: classService.v1.BuildingAvailableStat
+	(*FloorAvailableStat)(nil),          // 3: classService.v1.FloorAvailableStat
+	(*ClassroomAvailableStat)(nil),      // 4: classService.v1.ClassroomAvailableStat
+	(*RecommendQuietClassroomReq)(nil),  // 5: classService.v1.RecommendQuietClassroomReq
+	(*RecommendQuietClassroomResp)(nil), // 6: classService.v1.RecommendQuietClassroomResp
+	(*QuietClassroom)(nil),              // 7: classService.v1.QuietClassroom
+	(*GetClassroomScheduleReq)(nil),     // 8: classService.v1.GetClassroomScheduleReq
+	(*GetClassroomScheduleResp)(nil),    // 9: classService.v1.GetClassroomScheduleResp
+	(*ClassroomSlot)(nil),               // 10: classService.v1.ClassroomSlot
+	(*OccupyingClass)(nil),              // 11: classService.v1.OccupyingClass
+	(*ClassroomMeta)(nil),               // 12: classService.v1.ClassroomMeta
+	(*SaveClassroomMetaReq)(nil),        // 13: classService.v1.SaveClassroomMetaReq
+	(*SaveClassroomMetaResp)(nil),       // 14: classService.v1.SaveClassroomMetaResp
+	(*DeleteClassroomMetaReq)(nil),      // 15: classService.v1.DeleteClassroomMetaReq
+	(*DeleteClassroomMetaResp)(nil),     // 16: classService.v1.DeleteClassroomMetaResp
+	(*GetClassroomCatalogReq)(nil),      // 17: classService.v1.GetClassroomCatalogReq
+	(*GetClassroomCatalogResp)(nil),     // 18: classService.v1.GetClassroomCatalogResp
+	(*Campus)(nil),                      // 19: classService.v1.Campus
+	(*Building)(nil),                    // 20: classService.v1.Building
 }
 var file_classService_v1_free_classroom_proto_depIdxs = []int32{
-	2, // 0: classService.v1.QueryFreeClassroomResp.stat:type_name -> classService.v1.ClassroomAvailableStat
-	0, // 1: classService.v1.FreeClassroomSvc.QueryFreeClassroom:input_type -> classService.v1.QueryFreeClassroomReq
-	1, // 2: classService.v1.FreeClassroomSvc.QueryFreeClassroom:output_type -> classService.v1.QueryFreeClassroomResp
-	2, // [2:3] is the sub-list for method output_type
-	1, // [1:2] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	4,  // 0: classService.v1.QueryFreeClassroomResp.stat:type_name -> classService.v1.ClassroomAvailableStat
+	2,  // 1: classService.v1.QueryFreeClassroomResp.buildings:type_name -> classService.v1.BuildingAvailableStat
+	3,  // 2: classService.v1.BuildingAvailableStat.floors:type_name -> classService.v1.FloorAvailableStat
+	4,  // 3: classService.v1.FloorAvailableStat.stat:type_name -> classService.v1.ClassroomAvailableStat
+	7,  // 4: classService.v1.RecommendQuietClassroomResp.classrooms:type_name -> classService.v1.QuietClassroom
+	12, // 5: classService.v1.GetClassroomScheduleResp.meta:type_name -> classService.v1.ClassroomMeta
+	10, // 6: classService.v1.GetClassroomScheduleResp.slots:type_name -> classService.v1.ClassroomSlot
+	11, // 7: classService.v1.ClassroomSlot.classes:type_name -> classService.v1.OccupyingClass
+	12, // 8: classService.v1.SaveClassroomMetaReq.meta:type_name -> classService.v1.ClassroomMeta
+	19, // 9: classService.v1.GetClassroomCatalogResp.campuses:type_name -> classService.v1.Campus
+	20, // 10: classService.v1.Campus.buildings:type_name -> classService.v1.Building
+	0,  // 11: classService.v1.FreeClassroomSvc.QueryFreeClassroom:input_type -> classService.v1.QueryFreeClassroomReq
+	5,  // 12: classService.v1.FreeClassroomSvc.RecommendQuietClassroom:input_type -> classService.v1.RecommendQuietClassroomReq
+	8,  // 13: classService.v1.FreeClassroomSvc.GetClassroomSchedule:input_type -> classService.v1.GetClassroomScheduleReq
+	13, // 14: classService.v1.FreeClassroomSvc.SaveClassroomMeta:input_type -> classService.v1.SaveClassroomMetaReq
+	15, // 15: classService.v1.FreeClassroomSvc.DeleteClassroomMeta:input_type -> classService.v1.DeleteClassroomMetaReq
+	17, // 16: classService.v1.FreeClassroomSvc.GetClassroomCatalog:input_type -> classService.v1.GetClassroomCatalogReq
+	1,  // 17: classService.v1.FreeClassroomSvc.QueryFreeClassroom:output_type -> classService.v1.QueryFreeClassroomResp
+	6,  // 18: classService.v1.FreeClassroomSvc.RecommendQuietClassroom:output_type -> classService.v1.RecommendQuietClassroomResp
+	9,  // 19: classService.v1.FreeClassroomSvc.GetClassroomSchedule:output_type -> classService.v1.GetClassroomScheduleResp
+	14, // 20: classService.v1.FreeClassroomSvc.SaveClassroomMeta:output_type -> classService.v1.SaveClassroomMetaResp
+	16, // 21: classService.v1.FreeClassroomSvc.DeleteClassroomMeta:output_type -> classService.v1.DeleteClassroomMetaResp
+	18, // 22: classService.v1.FreeClassroomSvc.GetClassroomCatalog:output_type -> classService.v1.GetClassroomCatalogResp
+	17, // [17:23] is the sub-list for method output_type
+	11, // [11:17] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_classService_v1_free_classroom_proto_init() }
@@ -270,7 +1479,7 @@ func file_classService_v1_free_classroom_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_classService_v1_free_classroom_proto_rawDesc), len(file_classService_v1_free_classroom_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
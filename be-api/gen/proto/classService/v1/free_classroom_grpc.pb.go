@@ -19,7 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	FreeClassroomSvc_QueryFreeClassroom_FullMethodName = "/classService.v1.FreeClassroomSvc/QueryFreeClassroom"
+	FreeClassroomSvc_QueryFreeClassroom_FullMethodName      = "/classService.v1.FreeClassroomSvc/QueryFreeClassroom"
+	FreeClassroomSvc_RecommendQuietClassroom_FullMethodName = "/classService.v1.FreeClassroomSvc/RecommendQuietClassroom"
+	FreeClassroomSvc_GetClassroomSchedule_FullMethodName    = "/classService.v1.FreeClassroomSvc/GetClassroomSchedule"
+	FreeClassroomSvc_SaveClassroomMeta_FullMethodName       = "/classService.v1.FreeClassroomSvc/SaveClassroomMeta"
+	FreeClassroomSvc_DeleteClassroomMeta_FullMethodName     = "/classService.v1.FreeClassroomSvc/DeleteClassroomMeta"
+	FreeClassroomSvc_GetClassroomCatalog_FullMethodName     = "/classService.v1.FreeClassroomSvc/GetClassroomCatalog"
 )
 
 // FreeClassroomSvcClient is the client API for FreeClassroomSvc service.
@@ -27,6 +32,15 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type FreeClassroomSvcClient interface {
 	QueryFreeClassroom(ctx context.Context, in *QueryFreeClassroomReq, opts ...grpc.CallOption) (*QueryFreeClassroomResp, error)
+	// 推荐适合自习的安静教室
+	RecommendQuietClassroom(ctx context.Context, in *RecommendQuietClassroomReq, opts ...grpc.CallOption) (*RecommendQuietClassroomResp, error)
+	// 获取某个教室一周的占用情况
+	GetClassroomSchedule(ctx context.Context, in *GetClassroomScheduleReq, opts ...grpc.CallOption) (*GetClassroomScheduleResp, error)
+	// 管理员维护教室的座位数、设备等信息
+	SaveClassroomMeta(ctx context.Context, in *SaveClassroomMetaReq, opts ...grpc.CallOption) (*SaveClassroomMetaResp, error)
+	DeleteClassroomMeta(ctx context.Context, in *DeleteClassroomMetaReq, opts ...grpc.CallOption) (*DeleteClassroomMetaResp, error)
+	// 获取所有的校区、教学楼和楼层,用于按位置查询空闲教室
+	GetClassroomCatalog(ctx context.Context, in *GetClassroomCatalogReq, opts ...grpc.CallOption) (*GetClassroomCatalogResp, error)
 }
 
 type freeClassroomSvcClient struct {
@@ -47,11 +61,70 @@ func (c *freeClassroomSvcClient) QueryFreeClassroom(ctx context.Context, in *Que
 	return out, nil
 }
 
+func (c *freeClassroomSvcClient) RecommendQuietClassroom(ctx context.Context, in *RecommendQuietClassroomReq, opts ...grpc.CallOption) (*RecommendQuietClassroomResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecommendQuietClassroomResp)
+	err := c.cc.Invoke(ctx, FreeClassroomSvc_RecommendQuietClassroom_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *freeClassroomSvcClient) GetClassroomSchedule(ctx context.Context, in *GetClassroomScheduleReq, opts ...grpc.CallOption) (*GetClassroomScheduleResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetClassroomScheduleResp)
+	err := c.cc.Invoke(ctx, FreeClassroomSvc_GetClassroomSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *freeClassroomSvcClient) SaveClassroomMeta(ctx context.Context, in *SaveClassroomMetaReq, opts ...grpc.CallOption) (*SaveClassroomMetaResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaveClassroomMetaResp)
+	err := c.cc.Invoke(ctx, FreeClassroomSvc_SaveClassroomMeta_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *freeClassroomSvcClient) DeleteClassroomMeta(ctx context.Context, in *DeleteClassroomMetaReq, opts ...grpc.CallOption) (*DeleteClassroomMetaResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteClassroomMetaResp)
+	err := c.cc.Invoke(ctx, FreeClassroomSvc_DeleteClassroomMeta_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *freeClassroomSvcClient) GetClassroomCatalog(ctx context.Context, in *GetClassroomCatalogReq, opts ...grpc.CallOption) (*GetClassroomCatalogResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetClassroomCatalogResp)
+	err := c.cc.Invoke(ctx, FreeClassroomSvc_GetClassroomCatalog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FreeClassroomSvcServer is the server API for FreeClassroomSvc service.
 // All implementations must embed UnimplementedFreeClassroomSvcServer
 // for forward compatibility.
 type FreeClassroomSvcServer interface {
 	QueryFreeClassroom(context.Context, *QueryFreeClassroomReq) (*QueryFreeClassroomResp, error)
+	// 推荐适合自习的安静教室
+	RecommendQuietClassroom(context.Context, *RecommendQuietClassroomReq) (*RecommendQuietClassroomResp, error)
+	// 获取某个教室一周的占用情况
+	GetClassroomSchedule(context.Context, *GetClassroomScheduleReq) (*GetClassroomScheduleResp, error)
+	// 管理员维护教室的座位数、设备等信息
+	SaveClassroomMeta(context.Context, *SaveClassroomMetaReq) (*SaveClassroomMetaResp, error)
+	DeleteClassroomMeta(context.Context, *DeleteClassroomMetaReq) (*DeleteClassroomMetaResp, error)
+	// 获取所有的校区、教学楼和楼层,用于按位置查询空闲教室
+	GetClassroomCatalog(context.Context, *GetClassroomCatalogReq) (*GetClassroomCatalogResp, error)
 	mustEmbedUnimplementedFreeClassroomSvcServer()
 }
 
@@ -65,6 +138,21 @@ type UnimplementedFreeClassroomSvcServer struct{}
 func (UnimplementedFreeClassroomSvcServer) QueryFreeClassroom(context.Context, *QueryFreeClassroomReq) (*QueryFreeClassroomResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryFreeClassroom not implemented")
 }
+func (UnimplementedFreeClassroomSvcServer) RecommendQuietClassroom(context.Context, *RecommendQuietClassroomReq) (*RecommendQuietClassroomResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecommendQuietClassroom not implemented")
+}
+func (UnimplementedFreeClassroomSvcServer) GetClassroomSchedule(context.Context, *GetClassroomScheduleReq) (*GetClassroomScheduleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClassroomSchedule not implemented")
+}
+func (UnimplementedFreeClassroomSvcServer) SaveClassroomMeta(context.Context, *SaveClassroomMetaReq) (*SaveClassroomMetaResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveClassroomMeta not implemented")
+}
+func (UnimplementedFreeClassroomSvcServer) DeleteClassroomMeta(context.Context, *DeleteClassroomMetaReq) (*DeleteClassroomMetaResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteClassroomMeta not implemented")
+}
+func (UnimplementedFreeClassroomSvcServer) GetClassroomCatalog(context.Context, *GetClassroomCatalogReq) (*GetClassroomCatalogResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClassroomCatalog not implemented")
+}
 func (UnimplementedFreeClassroomSvcServer) mustEmbedUnimplementedFreeClassroomSvcServer() {}
 func (UnimplementedFreeClassroomSvcServer) testEmbeddedByValue()                          {}
 
@@ -104,6 +192,96 @@ func _FreeClassroomSvc_QueryFreeClassroom_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FreeClassroomSvc_RecommendQuietClassroom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecommendQuietClassroomReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FreeClassroomSvcServer).RecommendQuietClassroom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FreeClassroomSvc_RecommendQuietClassroom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FreeClassroomSvcServer).RecommendQuietClassroom(ctx, req.(*RecommendQuietClassroomReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FreeClassroomSvc_GetClassroomSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClassroomScheduleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FreeClassroomSvcServer).GetClassroomSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FreeClassroomSvc_GetClassroomSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FreeClassroomSvcServer).GetClassroomSchedule(ctx, req.(*GetClassroomScheduleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FreeClassroomSvc_SaveClassroomMeta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveClassroomMetaReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FreeClassroomSvcServer).SaveClassroomMeta(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FreeClassroomSvc_SaveClassroomMeta_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FreeClassroomSvcServer).SaveClassroomMeta(ctx, req.(*SaveClassroomMetaReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FreeClassroomSvc_DeleteClassroomMeta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteClassroomMetaReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FreeClassroomSvcServer).DeleteClassroomMeta(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FreeClassroomSvc_DeleteClassroomMeta_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FreeClassroomSvcServer).DeleteClassroomMeta(ctx, req.(*DeleteClassroomMetaReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FreeClassroomSvc_GetClassroomCatalog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClassroomCatalogReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FreeClassroomSvcServer).GetClassroomCatalog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FreeClassroomSvc_GetClassroomCatalog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FreeClassroomSvcServer).GetClassroomCatalog(ctx, req.(*GetClassroomCatalogReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FreeClassroomSvc_ServiceDesc is the grpc.ServiceDesc for FreeClassroomSvc service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -115,6 +293,26 @@ var FreeClassroomSvc_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "QueryFreeClassroom",
 			Handler:    _FreeClassroomSvc_QueryFreeClassroom_Handler,
 		},
+		{
+			MethodName: "RecommendQuietClassroom",
+			Handler:    _FreeClassroomSvc_RecommendQuietClassroom_Handler,
+		},
+		{
+			MethodName: "GetClassroomSchedule",
+			Handler:    _FreeClassroomSvc_GetClassroomSchedule_Handler,
+		},
+		{
+			MethodName: "SaveClassroomMeta",
+			Handler:    _FreeClassroomSvc_SaveClassroomMeta_Handler,
+		},
+		{
+			MethodName: "DeleteClassroomMeta",
+			Handler:    _FreeClassroomSvc_DeleteClassroomMeta_Handler,
+		},
+		{
+			MethodName: "GetClassroomCatalog",
+			Handler:    _FreeClassroomSvc_GetClassroomCatalog_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "classService/v1/free_classroom.proto",
@@ -171,7 +171,9 @@ type AddClassRequest struct {
 	// 星期几
 	Day int64 `protobuf:"varint,9,opt,name=day,proto3" json:"day,omitempty"`
 	// 学分
-	Credit        *float64 `protobuf:"fixed64,10,opt,name=credit,proto3,oneof" json:"credit,omitempty"`
+	Credit *float64 `protobuf:"fixed64,10,opt,name=credit,proto3,oneof" json:"credit,omitempty"`
+	// 与已有课程时间冲突时是否仍然强制添加,默认冲突时拒绝添加
+	Force         bool `protobuf:"varint,11,opt,name=force,proto3" json:"force,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -276,6 +278,13 @@ func (x *AddClassRequest) GetCredit() float64 {
 	return 0
 }
 
+func (x *AddClassRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
 type AddClassResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// 添加的课程ID
@@ -1092,7 +1101,9 @@ type ClassInfo struct {
 	// 备注
 	Note string `protobuf:"bytes,13,opt,name=note,proto3" json:"note,omitempty"`
 	// 是否为官方课程
-	IsOfficial    bool `protobuf:"varint,14,opt,name=is_official,json=isOfficial,proto3" json:"is_official,omitempty"`
+	IsOfficial bool `protobuf:"varint,14,opt,name=is_official,json=isOfficial,proto3" json:"is_official,omitempty"`
+	// 课程性质,如"专业主干课程",手动添加的课程为空
+	Nature        string `protobuf:"bytes,15,opt,name=nature,proto3" json:"nature,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1218,6 +1229,13 @@ func (x *ClassInfo) GetIsOfficial() bool {
 	return false
 }
 
+func (x *ClassInfo) GetNature() string {
+	if x != nil {
+		return x.Nature
+	}
+	return ""
+}
+
 type Class struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// 课程信息
@@ -1592,134 +1610,1217 @@ func (x *DeleteClassNoteResp) GetMsg() string {
 	return ""
 }
 
-var File_classlist_v1_classer_proto protoreflect.FileDescriptor
+type CheckClassConflictReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 学号
+	StuId string `protobuf:"bytes,1,opt,name=stu_id,json=stuId,proto3" json:"stu_id,omitempty"`
+	// 学年  "2024" 代表"2024-2025学年"
+	Year string `protobuf:"bytes,2,opt,name=year,proto3" json:"year,omitempty"`
+	// 学期 "1"代表第一学期，"2"代表第二学期，"3"代表第三学期
+	Semester string `protobuf:"bytes,3,opt,name=semester,proto3" json:"semester,omitempty"`
+	// 星期几
+	Day int64 `protobuf:"varint,4,opt,name=day,proto3" json:"day,omitempty"`
+	// 第几节 '形如 "1-3","1-1"'
+	DurClass string `protobuf:"bytes,5,opt,name=dur_class,json=durClass,proto3" json:"dur_class,omitempty"`
+	// 哪些周
+	Weeks int64 `protobuf:"varint,6,opt,name=weeks,proto3" json:"weeks,omitempty"`
+	// 检查时忽略的课程ID(修改课程时用于排除自身),可不填
+	ExcludeClassId string `protobuf:"bytes,7,opt,name=exclude_class_id,json=excludeClassId,proto3" json:"exclude_class_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
 
-const file_classlist_v1_classer_proto_rawDesc = "" +
-	"\n" +
-	"\x1aclasslist/v1/classer.proto\x12\n" +
-	"classer.v1\"r\n" +
-	"\x0fGetClassRequest\x12\x15\n" +
-	"\x06stu_id\x18\x01 \x01(\tR\x05stuId\x12\x1a\n" +
-	"\bsemester\x18\x02 \x01(\tR\bsemester\x12\x12\n" +
-	"\x04year\x18\x03 \x01(\tR\x04year\x12\x18\n" +
-	"\arefresh\x18\x04 \x01(\bR\arefresh\"\\\n" +
-	"\x10GetClassResponse\x12+\n" +
-	"\aclasses\x18\x01 \x03(\v2\x11.classer.v1.ClassR\aclasses\x12\x1b\n" +
-	"\tlast_time\x18\x02 \x01(\x03R\blastTime\"\x89\x02\n" +
-	"\x0fAddClassRequest\x12\x15\n" +
-	"\x06stu_id\x18\x01 \x01(\tR\x05stuId\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
-	"\tdur_class\x18\x03 \x01(\tR\bdurClass\x12\x14\n" +
-	"\x05where\x18\x04 \x01(\tR\x05where\x12\x18\n" +
-	"\ateacher\x18\x05 \x01(\tR\ateacher\x12\x14\n" +
-	"\x05weeks\x18\x06 \x01(\x03R\x05weeks\x12\x1a\n" +
-	"\bsemester\x18\a \x01(\tR\bsemester\x12\x12\n" +
-	"\x04year\x18\b \x01(\tR\x04year\x12\x10\n" +
-	"\x03day\x18\t \x01(\x03R\x03day\x12\x1b\n" +
-	"\x06credit\x18\n" +
-	" \x01(\x01H\x00R\x06credit\x88\x01\x01B\t\n" +
-	"\a_credit\"4\n" +
-	"\x10AddClassResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
-	"\x03msg\x18\x02 \x01(\tR\x03msg\"j\n" +
-	"\x12DeleteClassRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
-	"\x05stuId\x18\x02 \x01(\tR\x05stuId\x12\x12\n" +
-	"\x04year\x18\x03 \x01(\tR\x04year\x12\x1a\n" +
-	"\bsemester\x18\x04 \x01(\tR\bsemester\"'\n" +
-	"\x13DeleteClassResponse\x12\x10\n" +
-	"\x03msg\x18\x01 \x01(\tR\x03msg\"\x83\x03\n" +
-	"\x12UpdateClassRequest\x12\x15\n" +
-	"\x06stu_id\x18\x01 \x01(\tR\x05stuId\x12\x17\n" +
-	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12 \n" +
-	"\tdur_class\x18\x03 \x01(\tH\x01R\bdurClass\x88\x01\x01\x12\x19\n" +
-	"\x05where\x18\x04 \x01(\tH\x02R\x05where\x88\x01\x01\x12\x1d\n" +
-	"\ateacher\x18\x05 \x01(\tH\x03R\ateacher\x88\x01\x01\x12\x19\n" +
-	"\x05weeks\x18\x06 \x01(\x03H\x04R\x05weeks\x88\x01\x01\x12\x1a\n" +
-	"\bsemester\x18\a \x01(\tR\bsemester\x12\x12\n" +
-	"\x04year\x18\b \x01(\tR\x04year\x12\x15\n" +
-	"\x03day\x18\t \x01(\x03H\x05R\x03day\x88\x01\x01\x12\x1b\n" +
-	"\x06credit\x18\n" +
-	" \x01(\x01H\x06R\x06credit\x88\x01\x01\x12\x18\n" +
-	"\aclassId\x18\v \x01(\tR\aclassIdB\a\n" +
-	"\x05_nameB\f\n" +
-	"\n" +
-	"_dur_classB\b\n" +
-	"\x06_whereB\n" +
-	"\n" +
-	"\b_teacherB\b\n" +
-	"\x06_weeksB\x06\n" +
-	"\x04_dayB\t\n" +
-	"\a_credit\"A\n" +
-	"\x13UpdateClassResponse\x12\x10\n" +
-	"\x03msg\x18\x01 \x01(\tR\x03msg\x12\x18\n" +
-	"\aclassId\x18\x02 \x01(\tR\aclassId\"`\n" +
-	"\x16GetAllClassInfoRequest\x12\x12\n" +
-	"\x04year\x18\x01 \x01(\tR\x04year\x12\x1a\n" +
-	"\bsemester\x18\x02 \x01(\tR\bsemester\x12\x16\n" +
-	"\x06cursor\x18\x03 \x01(\tR\x06cursor\"m\n" +
-	"\x17GetAllClassInfoResponse\x126\n" +
-	"\vclass_infos\x18\x01 \x03(\v2\x15.classer.v1.ClassInfoR\n" +
-	"classInfos\x12\x1a\n" +
-	"\blastTime\x18\x02 \x01(\tR\blastTime\"a\n" +
-	"\x19GetRecycleBinClassRequest\x12\x14\n" +
-	"\x05stuId\x18\x01 \x01(\tR\x05stuId\x12\x12\n" +
-	"\x04year\x18\x02 \x01(\tR\x04year\x12\x1a\n" +
-	"\bsemester\x18\x03 \x01(\tR\bsemester\"T\n" +
-	"\x1aGetRecycleBinClassResponse\x126\n" +
-	"\vclass_infos\x18\x01 \x03(\v2\x15.classer.v1.ClassInfoR\n" +
-	"classInfos\"u\n" +
-	"\x13RecoverClassRequest\x12\x14\n" +
-	"\x05stuId\x18\x01 \x01(\tR\x05stuId\x12\x12\n" +
-	"\x04year\x18\x02 \x01(\tR\x04year\x12\x1a\n" +
-	"\bsemester\x18\x03 \x01(\tR\bsemester\x12\x18\n" +
-	"\aclassId\x18\v \x01(\tR\aclassId\"(\n" +
-	"\x14RecoverClassResponse\x12\x10\n" +
-	"\x03msg\x18\x01 \x01(\tR\x03msg\"/\n" +
-	"\x16GetStuIdByJxbIdRequest\x12\x15\n" +
-	"\x06jxb_id\x18\x01 \x01(\tR\x05jxbId\"0\n" +
-	"\x17GetStuIdByJxbIdResponse\x12\x15\n" +
-	"\x06stu_id\x18\x01 \x03(\tR\x05stuId\"\xd2\x02\n" +
-	"\tClassInfo\x12\x10\n" +
-	"\x03day\x18\x01 \x01(\x03R\x03day\x12\x18\n" +
-	"\ateacher\x18\x02 \x01(\tR\ateacher\x12\x14\n" +
-	"\x05where\x18\x03 \x01(\tR\x05where\x12\x1d\n" +
-	"\n" +
-	"class_when\x18\x04 \x01(\tR\tclassWhen\x12#\n" +
-	"\rweek_duration\x18\x05 \x01(\tR\fweekDuration\x12\x1c\n" +
-	"\tclassname\x18\x06 \x01(\tR\tclassname\x12\x16\n" +
-	"\x06credit\x18\a \x01(\x01R\x06credit\x12\x14\n" +
-	"\x05weeks\x18\t \x01(\x03R\x05weeks\x12\x1a\n" +
-	"\bsemester\x18\n" +
-	" \x01(\tR\bsemester\x12\x12\n" +
-	"\x04year\x18\v \x01(\tR\x04year\x12\x0e\n" +
-	"\x02id\x18\f \x01(\tR\x02id\x12\x12\n" +
-	"\x04note\x18\r \x01(\tR\x04note\x12\x1f\n" +
-	"\vis_official\x18\x0e \x01(\bR\n" +
-	"isOfficial\"2\n" +
-	"\x05Class\x12)\n" +
-	"\x04info\x18\x01 \x01(\v2\x15.classer.v1.ClassInfoR\x04info\"\x11\n" +
-	"\x0fGetSchoolDayReq\"T\n" +
-	"\x10GetSchoolDayResp\x12 \n" +
-	"\vholidayTime\x18\x01 \x01(\tR\vholidayTime\x12\x1e\n" +
-	"\n" +
-	"schoolTime\x18\x02 \x01(\tR\n" +
-	"schoolTime\"\x88\x01\n" +
-	"\x12UpdateClassNoteReq\x12\x14\n" +
-	"\x05stuId\x18\x01 \x01(\tR\x05stuId\x12\x12\n" +
-	"\x04year\x18\x02 \x01(\tR\x04year\x12\x1a\n" +
-	"\bsemester\x18\x03 \x01(\tR\bsemester\x12\x18\n" +
-	"\aclassId\x18\x04 \x01(\tR\aclassId\x12\x12\n" +
-	"\x04note\x18\x05 \x01(\tR\x04note\"'\n" +
-	"\x13UpdateClassNoteResp\x12\x10\n" +
-	"\x03msg\x18\x01 \x01(\tR\x03msg\"t\n" +
-	"\x12DeleteClassNoteReq\x12\x14\n" +
-	"\x05stuId\x18\x01 \x01(\tR\x05stuId\x12\x12\n" +
-	"\x04year\x18\x02 \x01(\tR\x04year\x12\x1a\n" +
-	"\bsemester\x18\x03 \x01(\tR\bsemester\x12\x18\n" +
-	"\aclassId\x18\x04 \x01(\tR\aclassId\"'\n" +
-	"\x13DeleteClassNoteResp\x12\x10\n" +
-	"\x03msg\x18\x01 \x01(\tR\x03msg2\xa1\a\n" +
+func (x *CheckClassConflictReq) Reset() {
+	*x = CheckClassConflictReq{}
+	mi := &file_classlist_v1_classer_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckClassConflictReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckClassConflictReq) ProtoMessage() {}
+
+func (x *CheckClassConflictReq) ProtoReflect() protoreflect.Message {
+	mi := &file_classlist_v1_classer_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckClassConflictReq.ProtoReflect.Descriptor instead.
+func (*CheckClassConflictReq) Descriptor() ([]byte, []int) {
+	return file_classlist_v1_classer_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CheckClassConflictReq) GetStuId() string {
+	if x != nil {
+		return x.StuId
+	}
+	return ""
+}
+
+func (x *CheckClassConflictReq) GetYear() string {
+	if x != nil {
+		return x.Year
+	}
+	return ""
+}
+
+func (x *CheckClassConflictReq) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+func (x *CheckClassConflictReq) GetDay() int64 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+func (x *CheckClassConflictReq) GetDurClass() string {
+	if x != nil {
+		return x.DurClass
+	}
+	return ""
+}
+
+func (x *CheckClassConflictReq) GetWeeks() int64 {
+	if x != nil {
+		return x.Weeks
+	}
+	return 0
+}
+
+func (x *CheckClassConflictReq) GetExcludeClassId() string {
+	if x != nil {
+		return x.ExcludeClassId
+	}
+	return ""
+}
+
+type CheckClassConflictResp struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 是否存在冲突
+	HasConflict bool `protobuf:"varint,1,opt,name=has_conflict,json=hasConflict,proto3" json:"has_conflict,omitempty"`
+	// 与之冲突的已有课程
+	Conflicts     []*ClassConflict `protobuf:"bytes,2,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckClassConflictResp) Reset() {
+	*x = CheckClassConflictResp{}
+	mi := &file_classlist_v1_classer_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckClassConflictResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckClassConflictResp) ProtoMessage() {}
+
+func (x *CheckClassConflictResp) ProtoReflect() protoreflect.Message {
+	mi := &file_classlist_v1_classer_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckClassConflictResp.ProtoReflect.Descriptor instead.
+func (*CheckClassConflictResp) Descriptor() ([]byte, []int) {
+	return file_classlist_v1_classer_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CheckClassConflictResp) GetHasConflict() bool {
+	if x != nil {
+		return x.HasConflict
+	}
+	return false
+}
+
+func (x *CheckClassConflictResp) GetConflicts() []*ClassConflict {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+type ClassConflict struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 冲突的已有课程
+	Info *ClassInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	// 重叠的时间段
+	Slots         []*ConflictSlot `protobuf:"bytes,2,rep,name=slots,proto3" json:"slots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClassConflict) Reset() {
+	*x = ClassConflict{}
+	mi := &file_classlist_v1_classer_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClassConflict) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClassConflict) ProtoMessage() {}
+
+func (x *ClassConflict) ProtoReflect() protoreflect.Message {
+	mi := &file_classlist_v1_classer_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClassConflict.ProtoReflect.Descriptor instead.
+func (*ClassConflict) Descriptor() ([]byte, []int) {
+	return file_classlist_v1_classer_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ClassConflict) GetInfo() *ClassInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+func (x *ClassConflict) GetSlots() []*ConflictSlot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+type ConflictSlot struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 第几周
+	Week int64 `protobuf:"varint,1,opt,name=week,proto3" json:"week,omitempty"`
+	// 星期几
+	Day int64 `protobuf:"varint,2,opt,name=day,proto3" json:"day,omitempty"`
+	// 第几节
+	Section       int64 `protobuf:"varint,3,opt,name=section,proto3" json:"section,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConflictSlot) Reset() {
+	*x = ConflictSlot{}
+	mi := &file_classlist_v1_classer_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConflictSlot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConflictSlot) ProtoMessage() {}
+
+func (x *ConflictSlot) ProtoReflect() protoreflect.Message {
+	mi := &file_classlist_v1_classer_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConflictSlot.ProtoReflect.Descriptor instead.
+func (*ConflictSlot) Descriptor() ([]byte, []int) {
+	return file_classlist_v1_classer_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ConflictSlot) GetWeek() int64 {
+	if x != nil {
+		return x.Week
+	}
+	return 0
+}
+
+func (x *ConflictSlot) GetDay() int64 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+func (x *ConflictSlot) GetSection() int64 {
+	if x != nil {
+		return x.Section
+	}
+	return 0
+}
+
+type GetTermsReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 学号
+	StuId         string `protobuf:"bytes,1,opt,name=stu_id,json=stuId,proto3" json:"stu_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTermsReq) Reset() {
+	*x = GetTermsReq{}
+	mi := &file_classlist_v1_classer_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTermsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTermsReq) ProtoMessage() {}
+
+func (x *GetTermsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_classlist_v1_classer_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTermsReq.ProtoReflect.Descriptor instead.
+func (*GetTermsReq) Descriptor() ([]byte, []int) {
+	return file_classlist_v1_classer_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetTermsReq) GetStuId() string {
+	if x != nil {
+		return x.StuId
+	}
+	return ""
+}
+
+type GetTermsResp struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 按学年学期倒序排列
+	Terms         []*TermInfo `protobuf:"bytes,1,rep,name=terms,proto3" json:"terms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTermsResp) Reset() {
+	*x = GetTermsResp{}
+	mi := &file_classlist_v1_classer_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTermsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTermsResp) ProtoMessage() {}
+
+func (x *GetTermsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_classlist_v1_classer_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTermsResp.ProtoReflect.Descriptor instead.
+func (*GetTermsResp) Descriptor() ([]byte, []int) {
+	return file_classlist_v1_classer_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetTermsResp) GetTerms() []*TermInfo {
+	if x != nil {
+		return x.Terms
+	}
+	return nil
+}
+
+type TermInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 学年  "2024" 代表"2024-2025学年"
+	Year string `protobuf:"bytes,1,opt,name=year,proto3" json:"year,omitempty"`
+	// 学期 "1"代表第一学期，"2"代表第二学期，"3"代表第三学期
+	Semester string `protobuf:"bytes,2,opt,name=semester,proto3" json:"semester,omitempty"`
+	// 官方课程数量
+	ClassNum int64 `protobuf:"varint,3,opt,name=class_num,json=classNum,proto3" json:"class_num,omitempty"`
+	// 手动添加的课程数量
+	AddedClassNum int64 `protobuf:"varint,4,opt,name=added_class_num,json=addedClassNum,proto3" json:"added_class_num,omitempty"`
+	// 上次成功刷新课表的时间戳(上海时区),从未刷新过为0
+	LastRefreshTime int64 `protobuf:"varint,5,opt,name=last_refresh_time,json=lastRefreshTime,proto3" json:"last_refresh_time,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TermInfo) Reset() {
+	*x = TermInfo{}
+	mi := &file_classlist_v1_classer_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TermInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TermInfo) ProtoMessage() {}
+
+func (x *TermInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_classlist_v1_classer_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TermInfo.ProtoReflect.Descriptor instead.
+func (*TermInfo) Descriptor() ([]byte, []int) {
+	return file_classlist_v1_classer_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *TermInfo) GetYear() string {
+	if x != nil {
+		return x.Year
+	}
+	return ""
+}
+
+func (x *TermInfo) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+func (x *TermInfo) GetClassNum() int64 {
+	if x != nil {
+		return x.ClassNum
+	}
+	return 0
+}
+
+func (x *TermInfo) GetAddedClassNum() int64 {
+	if x != nil {
+		return x.AddedClassNum
+	}
+	return 0
+}
+
+func (x *TermInfo) GetLastRefreshTime() int64 {
+	if x != nil {
+		return x.LastRefreshTime
+	}
+	return 0
+}
+
+type CopyAddedClassesReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 学号
+	StuId string `protobuf:"bytes,1,opt,name=stu_id,json=stuId,proto3" json:"stu_id,omitempty"`
+	// 源学年
+	FromYear string `protobuf:"bytes,2,opt,name=from_year,json=fromYear,proto3" json:"from_year,omitempty"`
+	// 源学期
+	FromSemester string `protobuf:"bytes,3,opt,name=from_semester,json=fromSemester,proto3" json:"from_semester,omitempty"`
+	// 目标学年
+	ToYear string `protobuf:"bytes,4,opt,name=to_year,json=toYear,proto3" json:"to_year,omitempty"`
+	// 目标学期
+	ToSemester string `protobuf:"bytes,5,opt,name=to_semester,json=toSemester,proto3" json:"to_semester,omitempty"`
+	// 是否同时复制课程备注
+	WithNote bool `protobuf:"varint,6,opt,name=with_note,json=withNote,proto3" json:"with_note,omitempty"`
+	// 为true时即使与目标学期已有课程时间冲突也复制
+	Force         bool `protobuf:"varint,7,opt,name=force,proto3" json:"force,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CopyAddedClassesReq) Reset() {
+	*x = CopyAddedClassesReq{}
+	mi := &file_classlist_v1_classer_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CopyAddedClassesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CopyAddedClassesReq) ProtoMessage() {}
+
+func (x *CopyAddedClassesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_classlist_v1_classer_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CopyAddedClassesReq.ProtoReflect.Descriptor instead.
+func (*CopyAddedClassesReq) Descriptor() ([]byte, []int) {
+	return file_classlist_v1_classer_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CopyAddedClassesReq) GetStuId() string {
+	if x != nil {
+		return x.StuId
+	}
+	return ""
+}
+
+func (x *CopyAddedClassesReq) GetFromYear() string {
+	if x != nil {
+		return x.FromYear
+	}
+	return ""
+}
+
+func (x *CopyAddedClassesReq) GetFromSemester() string {
+	if x != nil {
+		return x.FromSemester
+	}
+	return ""
+}
+
+func (x *CopyAddedClassesReq) GetToYear() string {
+	if x != nil {
+		return x.ToYear
+	}
+	return ""
+}
+
+func (x *CopyAddedClassesReq) GetToSemester() string {
+	if x != nil {
+		return x.ToSemester
+	}
+	return ""
+}
+
+func (x *CopyAddedClassesReq) GetWithNote() bool {
+	if x != nil {
+		return x.WithNote
+	}
+	return false
+}
+
+func (x *CopyAddedClassesReq) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type CopyAddedClassesResp struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 成功复制的课程
+	Copied []*ClassInfo `protobuf:"bytes,1,rep,name=copied,proto3" json:"copied,omitempty"`
+	// 因目标学期已存在或时间冲突而跳过的课程
+	Skipped       []*ClassInfo `protobuf:"bytes,2,rep,name=skipped,proto3" json:"skipped,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CopyAddedClassesResp) Reset() {
+	*x = CopyAddedClassesResp{}
+	mi := &file_classlist_v1_classer_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CopyAddedClassesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CopyAddedClassesResp) ProtoMessage() {}
+
+func (x *CopyAddedClassesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_classlist_v1_classer_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CopyAddedClassesResp.ProtoReflect.Descriptor instead.
+func (*CopyAddedClassesResp) Descriptor() ([]byte, []int) {
+	return file_classlist_v1_classer_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CopyAddedClassesResp) GetCopied() []*ClassInfo {
+	if x != nil {
+		return x.Copied
+	}
+	return nil
+}
+
+func (x *CopyAddedClassesResp) GetSkipped() []*ClassInfo {
+	if x != nil {
+		return x.Skipped
+	}
+	return nil
+}
+
+type ImportClassesReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 学号
+	StuId string `protobuf:"bytes,1,opt,name=stu_id,json=stuId,proto3" json:"stu_id,omitempty"`
+	// 学年  "2024" 代表"2024-2025学年"
+	Year string `protobuf:"bytes,2,opt,name=year,proto3" json:"year,omitempty"`
+	// 学期 "1"代表第一学期，"2"代表第二学期，"3"代表第三学期
+	Semester string `protobuf:"bytes,3,opt,name=semester,proto3" json:"semester,omitempty"`
+	// 文件格式 "excel","csv" 或 "wakeup"(WakeUp课程表导出的备份文件)
+	Format string `protobuf:"bytes,4,opt,name=format,proto3" json:"format,omitempty"`
+	// 文件内容
+	Content []byte `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	// 为true时即使与已有课程时间冲突也导入
+	Force         bool `protobuf:"varint,6,opt,name=force,proto3" json:"force,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportClassesReq) Reset() {
+	*x = ImportClassesReq{}
+	mi := &file_classlist_v1_classer_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportClassesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportClassesReq) ProtoMessage() {}
+
+func (x *ImportClassesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_classlist_v1_classer_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportClassesReq.ProtoReflect.Descriptor instead.
+func (*ImportClassesReq) Descriptor() ([]byte, []int) {
+	return file_classlist_v1_classer_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ImportClassesReq) GetStuId() string {
+	if x != nil {
+		return x.StuId
+	}
+	return ""
+}
+
+func (x *ImportClassesReq) GetYear() string {
+	if x != nil {
+		return x.Year
+	}
+	return ""
+}
+
+func (x *ImportClassesReq) GetSemester() string {
+	if x != nil {
+		return x.Semester
+	}
+	return ""
+}
+
+func (x *ImportClassesReq) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ImportClassesReq) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ImportClassesReq) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type ImportClassesResp struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 成功导入的课程
+	Imported []*ClassInfo `protobuf:"bytes,1,rep,name=imported,proto3" json:"imported,omitempty"`
+	// 导入失败的行及原因
+	Errors        []*ImportRowError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportClassesResp) Reset() {
+	*x = ImportClassesResp{}
+	mi := &file_classlist_v1_classer_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportClassesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportClassesResp) ProtoMessage() {}
+
+func (x *ImportClassesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_classlist_v1_classer_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportClassesResp.ProtoReflect.Descriptor instead.
+func (*ImportClassesResp) Descriptor() ([]byte, []int) {
+	return file_classlist_v1_classer_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ImportClassesResp) GetImported() []*ClassInfo {
+	if x != nil {
+		return x.Imported
+	}
+	return nil
+}
+
+func (x *ImportClassesResp) GetErrors() []*ImportRowError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type ImportRowError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 源文件中的行号(从1开始),wakeup格式为上课安排的序号
+	Line int64 `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	// 失败原因
+	Msg           string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRowError) Reset() {
+	*x = ImportRowError{}
+	mi := &file_classlist_v1_classer_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRowError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRowError) ProtoMessage() {}
+
+func (x *ImportRowError) ProtoReflect() protoreflect.Message {
+	mi := &file_classlist_v1_classer_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRowError.ProtoReflect.Descriptor instead.
+func (*ImportRowError) Descriptor() ([]byte, []int) {
+	return file_classlist_v1_classer_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ImportRowError) GetLine() int64 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ImportRowError) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+type GetCrawlerHealthReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCrawlerHealthReq) Reset() {
+	*x = GetCrawlerHealthReq{}
+	mi := &file_classlist_v1_classer_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCrawlerHealthReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCrawlerHealthReq) ProtoMessage() {}
+
+func (x *GetCrawlerHealthReq) ProtoReflect() protoreflect.Message {
+	mi := &file_classlist_v1_classer_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCrawlerHealthReq.ProtoReflect.Descriptor instead.
+func (*GetCrawlerHealthReq) Descriptor() ([]byte, []int) {
+	return file_classlist_v1_classer_proto_rawDescGZIP(), []int{36}
+}
+
+type GetCrawlerHealthResp struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 先本科生后研究生,各自按配置的尝试顺序排列
+	Strategies    []*CrawlerHealth `protobuf:"bytes,1,rep,name=strategies,proto3" json:"strategies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCrawlerHealthResp) Reset() {
+	*x = GetCrawlerHealthResp{}
+	mi := &file_classlist_v1_classer_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCrawlerHealthResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCrawlerHealthResp) ProtoMessage() {}
+
+func (x *GetCrawlerHealthResp) ProtoReflect() protoreflect.Message {
+	mi := &file_classlist_v1_classer_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCrawlerHealthResp.ProtoReflect.Descriptor instead.
+func (*GetCrawlerHealthResp) Descriptor() ([]byte, []int) {
+	return file_classlist_v1_classer_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetCrawlerHealthResp) GetStrategies() []*CrawlerHealth {
+	if x != nil {
+		return x.Strategies
+	}
+	return nil
+}
+
+type CrawlerHealth struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 策略名称
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// 是否健康,降级中的策略为false
+	Healthy bool `protobuf:"varint,2,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	// 滑动窗口内的成功率
+	SuccessRate float64 `protobuf:"fixed64,3,opt,name=success_rate,json=successRate,proto3" json:"success_rate,omitempty"`
+	// 滑动窗口内的平均耗时,单位ms
+	AvgLatencyMs int64 `protobuf:"varint,4,opt,name=avg_latency_ms,json=avgLatencyMs,proto3" json:"avg_latency_ms,omitempty"`
+	// 总请求次数
+	Total int64 `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
+	// 总失败次数
+	Failures int64 `protobuf:"varint,6,opt,name=failures,proto3" json:"failures,omitempty"`
+	// 连续失败次数
+	ConsecutiveFailures int64 `protobuf:"varint,7,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+	// 降级截止时间戳,未降级为0
+	DemotedUntil int64 `protobuf:"varint,8,opt,name=demoted_until,json=demotedUntil,proto3" json:"demoted_until,omitempty"`
+	// 最近一次失败原因
+	LastError string `protobuf:"bytes,9,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// 最近一次成功的时间戳,从未成功为0
+	LastSuccessTime int64 `protobuf:"varint,10,opt,name=last_success_time,json=lastSuccessTime,proto3" json:"last_success_time,omitempty"`
+	// 学生类型,undergraduate或graduate,本科生和研究生的状态分开统计
+	StudentType   string `protobuf:"bytes,11,opt,name=student_type,json=studentType,proto3" json:"student_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CrawlerHealth) Reset() {
+	*x = CrawlerHealth{}
+	mi := &file_classlist_v1_classer_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CrawlerHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CrawlerHealth) ProtoMessage() {}
+
+func (x *CrawlerHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_classlist_v1_classer_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CrawlerHealth.ProtoReflect.Descriptor instead.
+func (*CrawlerHealth) Descriptor() ([]byte, []int) {
+	return file_classlist_v1_classer_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CrawlerHealth) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CrawlerHealth) GetHealthy() bool {
+	if x != nil {
+		return x.Healthy
+	}
+	return false
+}
+
+func (x *CrawlerHealth) GetSuccessRate() float64 {
+	if x != nil {
+		return x.SuccessRate
+	}
+	return 0
+}
+
+func (x *CrawlerHealth) GetAvgLatencyMs() int64 {
+	if x != nil {
+		return x.AvgLatencyMs
+	}
+	return 0
+}
+
+func (x *CrawlerHealth) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *CrawlerHealth) GetFailures() int64 {
+	if x != nil {
+		return x.Failures
+	}
+	return 0
+}
+
+func (x *CrawlerHealth) GetConsecutiveFailures() int64 {
+	if x != nil {
+		return x.ConsecutiveFailures
+	}
+	return 0
+}
+
+func (x *CrawlerHealth) GetDemotedUntil() int64 {
+	if x != nil {
+		return x.DemotedUntil
+	}
+	return 0
+}
+
+func (x *CrawlerHealth) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *CrawlerHealth) GetLastSuccessTime() int64 {
+	if x != nil {
+		return x.LastSuccessTime
+	}
+	return 0
+}
+
+func (x *CrawlerHealth) GetStudentType() string {
+	if x != nil {
+		return x.StudentType
+	}
+	return ""
+}
+
+var File_classlist_v1_classer_proto protoreflect.FileDescriptor
+
+const file_classlist_v1_classer_proto_rawDesc = "" +
+	"\n" +
+	"\x1aclasslist/v1/classer.proto\x12\n" +
+	"classer.v1\"r\n" +
+	"\x0fGetClassRequest\x12\x15\n" +
+	"\x06stu_id\x18\x01 \x01(\tR\x05stuId\x12\x1a\n" +
+	"\bsemester\x18\x02 \x01(\tR\bsemester\x12\x12\n" +
+	"\x04year\x18\x03 \x01(\tR\x04year\x12\x18\n" +
+	"\arefresh\x18\x04 \x01(\bR\arefresh\"\\\n" +
+	"\x10GetClassResponse\x12+\n" +
+	"\aclasses\x18\x01 \x03(\v2\x11.classer.v1.ClassR\aclasses\x12\x1b\n" +
+	"\tlast_time\x18\x02 \x01(\x03R\blastTime\"\x9f\x02\n" +
+	"\x0fAddClassRequest\x12\x15\n" +
+	"\x06stu_id\x18\x01 \x01(\tR\x05stuId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
+	"\tdur_class\x18\x03 \x01(\tR\bdurClass\x12\x14\n" +
+	"\x05where\x18\x04 \x01(\tR\x05where\x12\x18\n" +
+	"\ateacher\x18\x05 \x01(\tR\ateacher\x12\x14\n" +
+	"\x05weeks\x18\x06 \x01(\x03R\x05weeks\x12\x1a\n" +
+	"\bsemester\x18\a \x01(\tR\bsemester\x12\x12\n" +
+	"\x04year\x18\b \x01(\tR\x04year\x12\x10\n" +
+	"\x03day\x18\t \x01(\x03R\x03day\x12\x1b\n" +
+	"\x06credit\x18\n" +
+	" \x01(\x01H\x00R\x06credit\x88\x01\x01\x12\x14\n" +
+	"\x05force\x18\v \x01(\bR\x05forceB\t\n" +
+	"\a_credit\"4\n" +
+	"\x10AddClassResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\"j\n" +
+	"\x12DeleteClassRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05stuId\x18\x02 \x01(\tR\x05stuId\x12\x12\n" +
+	"\x04year\x18\x03 \x01(\tR\x04year\x12\x1a\n" +
+	"\bsemester\x18\x04 \x01(\tR\bsemester\"'\n" +
+	"\x13DeleteClassResponse\x12\x10\n" +
+	"\x03msg\x18\x01 \x01(\tR\x03msg\"\x83\x03\n" +
+	"\x12UpdateClassRequest\x12\x15\n" +
+	"\x06stu_id\x18\x01 \x01(\tR\x05stuId\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12 \n" +
+	"\tdur_class\x18\x03 \x01(\tH\x01R\bdurClass\x88\x01\x01\x12\x19\n" +
+	"\x05where\x18\x04 \x01(\tH\x02R\x05where\x88\x01\x01\x12\x1d\n" +
+	"\ateacher\x18\x05 \x01(\tH\x03R\ateacher\x88\x01\x01\x12\x19\n" +
+	"\x05weeks\x18\x06 \x01(\x03H\x04R\x05weeks\x88\x01\x01\x12\x1a\n" +
+	"\bsemester\x18\a \x01(\tR\bsemester\x12\x12\n" +
+	"\x04year\x18\b \x01(\tR\x04year\x12\x15\n" +
+	"\x03day\x18\t \x01(\x03H\x05R\x03day\x88\x01\x01\x12\x1b\n" +
+	"\x06credit\x18\n" +
+	" \x01(\x01H\x06R\x06credit\x88\x01\x01\x12\x18\n" +
+	"\aclassId\x18\v \x01(\tR\aclassIdB\a\n" +
+	"\x05_nameB\f\n" +
+	"\n" +
+	"_dur_classB\b\n" +
+	"\x06_whereB\n" +
+	"\n" +
+	"\b_teacherB\b\n" +
+	"\x06_weeksB\x06\n" +
+	"\x04_dayB\t\n" +
+	"\a_credit\"A\n" +
+	"\x13UpdateClassResponse\x12\x10\n" +
+	"\x03msg\x18\x01 \x01(\tR\x03msg\x12\x18\n" +
+	"\aclassId\x18\x02 \x01(\tR\aclassId\"`\n" +
+	"\x16GetAllClassInfoRequest\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\tR\x04year\x12\x1a\n" +
+	"\bsemester\x18\x02 \x01(\tR\bsemester\x12\x16\n" +
+	"\x06cursor\x18\x03 \x01(\tR\x06cursor\"m\n" +
+	"\x17GetAllClassInfoResponse\x126\n" +
+	"\vclass_infos\x18\x01 \x03(\v2\x15.classer.v1.ClassInfoR\n" +
+	"classInfos\x12\x1a\n" +
+	"\blastTime\x18\x02 \x01(\tR\blastTime\"a\n" +
+	"\x19GetRecycleBinClassRequest\x12\x14\n" +
+	"\x05stuId\x18\x01 \x01(\tR\x05stuId\x12\x12\n" +
+	"\x04year\x18\x02 \x01(\tR\x04year\x12\x1a\n" +
+	"\bsemester\x18\x03 \x01(\tR\bsemester\"T\n" +
+	"\x1aGetRecycleBinClassResponse\x126\n" +
+	"\vclass_infos\x18\x01 \x03(\v2\x15.classer.v1.ClassInfoR\n" +
+	"classInfos\"u\n" +
+	"\x13RecoverClassRequest\x12\x14\n" +
+	"\x05stuId\x18\x01 \x01(\tR\x05stuId\x12\x12\n" +
+	"\x04year\x18\x02 \x01(\tR\x04year\x12\x1a\n" +
+	"\bsemester\x18\x03 \x01(\tR\bsemester\x12\x18\n" +
+	"\aclassId\x18\v \x01(\tR\aclassId\"(\n" +
+	"\x14RecoverClassResponse\x12\x10\n" +
+	"\x03msg\x18\x01 \x01(\tR\x03msg\"/\n" +
+	"\x16GetStuIdByJxbIdRequest\x12\x15\n" +
+	"\x06jxb_id\x18\x01 \x01(\tR\x05jxbId\"0\n" +
+	"\x17GetStuIdByJxbIdResponse\x12\x15\n" +
+	"\x06stu_id\x18\x01 \x03(\tR\x05stuId\"\xea\x02\n" +
+	"\tClassInfo\x12\x10\n" +
+	"\x03day\x18\x01 \x01(\x03R\x03day\x12\x18\n" +
+	"\ateacher\x18\x02 \x01(\tR\ateacher\x12\x14\n" +
+	"\x05where\x18\x03 \x01(\tR\x05where\x12\x1d\n" +
+	"\n" +
+	"class_when\x18\x04 \x01(\tR\tclassWhen\x12#\n" +
+	"\rweek_duration\x18\x05 \x01(\tR\fweekDuration\x12\x1c\n" +
+	"\tclassname\x18\x06 \x01(\tR\tclassname\x12\x16\n" +
+	"\x06credit\x18\a \x01(\x01R\x06credit\x12\x14\n" +
+	"\x05weeks\x18\t \x01(\x03R\x05weeks\x12\x1a\n" +
+	"\bsemester\x18\n" +
+	" \x01(\tR\bsemester\x12\x12\n" +
+	"\x04year\x18\v \x01(\tR\x04year\x12\x0e\n" +
+	"\x02id\x18\f \x01(\tR\x02id\x12\x12\n" +
+	"\x04note\x18\r \x01(\tR\x04note\x12\x1f\n" +
+	"\vis_official\x18\x0e \x01(\bR\n" +
+	"isOfficial\x12\x16\n" +
+	"\x06nature\x18\x0f \x01(\tR\x06nature\"2\n" +
+	"\x05Class\x12)\n" +
+	"\x04info\x18\x01 \x01(\v2\x15.classer.v1.ClassInfoR\x04info\"\x11\n" +
+	"\x0fGetSchoolDayReq\"T\n" +
+	"\x10GetSchoolDayResp\x12 \n" +
+	"\vholidayTime\x18\x01 \x01(\tR\vholidayTime\x12\x1e\n" +
+	"\n" +
+	"schoolTime\x18\x02 \x01(\tR\n" +
+	"schoolTime\"\x88\x01\n" +
+	"\x12UpdateClassNoteReq\x12\x14\n" +
+	"\x05stuId\x18\x01 \x01(\tR\x05stuId\x12\x12\n" +
+	"\x04year\x18\x02 \x01(\tR\x04year\x12\x1a\n" +
+	"\bsemester\x18\x03 \x01(\tR\bsemester\x12\x18\n" +
+	"\aclassId\x18\x04 \x01(\tR\aclassId\x12\x12\n" +
+	"\x04note\x18\x05 \x01(\tR\x04note\"'\n" +
+	"\x13UpdateClassNoteResp\x12\x10\n" +
+	"\x03msg\x18\x01 \x01(\tR\x03msg\"t\n" +
+	"\x12DeleteClassNoteReq\x12\x14\n" +
+	"\x05stuId\x18\x01 \x01(\tR\x05stuId\x12\x12\n" +
+	"\x04year\x18\x02 \x01(\tR\x04year\x12\x1a\n" +
+	"\bsemester\x18\x03 \x01(\tR\bsemester\x12\x18\n" +
+	"\aclassId\x18\x04 \x01(\tR\aclassId\"'\n" +
+	"\x13DeleteClassNoteResp\x12\x10\n" +
+	"\x03msg\x18\x01 \x01(\tR\x03msg\"\xcd\x01\n" +
+	"\x15CheckClassConflictReq\x12\x15\n" +
+	"\x06stu_id\x18\x01 \x01(\tR\x05stuId\x12\x12\n" +
+	"\x04year\x18\x02 \x01(\tR\x04year\x12\x1a\n" +
+	"\bsemester\x18\x03 \x01(\tR\bsemester\x12\x10\n" +
+	"\x03day\x18\x04 \x01(\x03R\x03day\x12\x1b\n" +
+	"\tdur_class\x18\x05 \x01(\tR\bdurClass\x12\x14\n" +
+	"\x05weeks\x18\x06 \x01(\x03R\x05weeks\x12(\n" +
+	"\x10exclude_class_id\x18\a \x01(\tR\x0eexcludeClassId\"t\n" +
+	"\x16CheckClassConflictResp\x12!\n" +
+	"\fhas_conflict\x18\x01 \x01(\bR\vhasConflict\x127\n" +
+	"\tconflicts\x18\x02 \x03(\v2\x19.classer.v1.ClassConflictR\tconflicts\"j\n" +
+	"\rClassConflict\x12)\n" +
+	"\x04info\x18\x01 \x01(\v2\x15.classer.v1.ClassInfoR\x04info\x12.\n" +
+	"\x05slots\x18\x02 \x03(\v2\x18.classer.v1.ConflictSlotR\x05slots\"N\n" +
+	"\fConflictSlot\x12\x12\n" +
+	"\x04week\x18\x01 \x01(\x03R\x04week\x12\x10\n" +
+	"\x03day\x18\x02 \x01(\x03R\x03day\x12\x18\n" +
+	"\asection\x18\x03 \x01(\x03R\asection\"$\n" +
+	"\vGetTermsReq\x12\x15\n" +
+	"\x06stu_id\x18\x01 \x01(\tR\x05stuId\":\n" +
+	"\fGetTermsResp\x12*\n" +
+	"\x05terms\x18\x01 \x03(\v2\x14.classer.v1.TermInfoR\x05terms\"\xab\x01\n" +
+	"\bTermInfo\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\tR\x04year\x12\x1a\n" +
+	"\bsemester\x18\x02 \x01(\tR\bsemester\x12\x1b\n" +
+	"\tclass_num\x18\x03 \x01(\x03R\bclassNum\x12&\n" +
+	"\x0fadded_class_num\x18\x04 \x01(\x03R\raddedClassNum\x12*\n" +
+	"\x11last_refresh_time\x18\x05 \x01(\x03R\x0flastRefreshTime\"\xdb\x01\n" +
+	"\x13CopyAddedClassesReq\x12\x15\n" +
+	"\x06stu_id\x18\x01 \x01(\tR\x05stuId\x12\x1b\n" +
+	"\tfrom_year\x18\x02 \x01(\tR\bfromYear\x12#\n" +
+	"\rfrom_semester\x18\x03 \x01(\tR\ffromSemester\x12\x17\n" +
+	"\ato_year\x18\x04 \x01(\tR\x06toYear\x12\x1f\n" +
+	"\vto_semester\x18\x05 \x01(\tR\n" +
+	"toSemester\x12\x1b\n" +
+	"\twith_note\x18\x06 \x01(\bR\bwithNote\x12\x14\n" +
+	"\x05force\x18\a \x01(\bR\x05force\"v\n" +
+	"\x14CopyAddedClassesResp\x12-\n" +
+	"\x06copied\x18\x01 \x03(\v2\x15.classer.v1.ClassInfoR\x06copied\x12/\n" +
+	"\askipped\x18\x02 \x03(\v2\x15.classer.v1.ClassInfoR\askipped\"\xa1\x01\n" +
+	"\x10ImportClassesReq\x12\x15\n" +
+	"\x06stu_id\x18\x01 \x01(\tR\x05stuId\x12\x12\n" +
+	"\x04year\x18\x02 \x01(\tR\x04year\x12\x1a\n" +
+	"\bsemester\x18\x03 \x01(\tR\bsemester\x12\x16\n" +
+	"\x06format\x18\x04 \x01(\tR\x06format\x12\x18\n" +
+	"\acontent\x18\x05 \x01(\fR\acontent\x12\x14\n" +
+	"\x05force\x18\x06 \x01(\bR\x05force\"z\n" +
+	"\x11ImportClassesResp\x121\n" +
+	"\bimported\x18\x01 \x03(\v2\x15.classer.v1.ClassInfoR\bimported\x122\n" +
+	"\x06errors\x18\x02 \x03(\v2\x1a.classer.v1.ImportRowErrorR\x06errors\"6\n" +
+	"\x0eImportRowError\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x03R\x04line\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\"\x15\n" +
+	"\x13GetCrawlerHealthReq\"Q\n" +
+	"\x14GetCrawlerHealthResp\x129\n" +
+	"\n" +
+	"strategies\x18\x01 \x03(\v2\x19.classer.v1.CrawlerHealthR\n" +
+	"strategies\"\xfe\x02\n" +
+	"\rCrawlerHealth\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\ahealthy\x18\x02 \x01(\bR\ahealthy\x12!\n" +
+	"\fsuccess_rate\x18\x03 \x01(\x01R\vsuccessRate\x12$\n" +
+	"\x0eavg_latency_ms\x18\x04 \x01(\x03R\favgLatencyMs\x12\x14\n" +
+	"\x05total\x18\x05 \x01(\x03R\x05total\x12\x1a\n" +
+	"\bfailures\x18\x06 \x01(\x03R\bfailures\x121\n" +
+	"\x14consecutive_failures\x18\a \x01(\x03R\x13consecutiveFailures\x12#\n" +
+	"\rdemoted_until\x18\b \x01(\x03R\fdemotedUntil\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\t \x01(\tR\tlastError\x12*\n" +
+	"\x11last_success_time\x18\n" +
+	" \x01(\x03R\x0flastSuccessTime\x12!\n" +
+	"\fstudent_type\x18\v \x01(\tR\vstudentType2\xb9\n" +
+	"\n" +
 	"\aClasser\x12E\n" +
 	"\bGetClass\x12\x1b.classer.v1.GetClassRequest\x1a\x1c.classer.v1.GetClassResponse\x12E\n" +
 	"\bAddClass\x12\x1b.classer.v1.AddClassRequest\x1a\x1c.classer.v1.AddClassResponse\x12N\n" +
@@ -1731,7 +2832,12 @@ const file_classlist_v1_classer_proto_rawDesc = "" +
 	"\x0fGetStuIdByJxbId\x12\".classer.v1.GetStuIdByJxbIdRequest\x1a#.classer.v1.GetStuIdByJxbIdResponse\x12K\n" +
 	"\fGetSchoolDay\x12\x1b.classer.v1.GetSchoolDayReq\x1a\x1c.classer.v1.GetSchoolDayResp\"\x00\x12R\n" +
 	"\x0fUpdateClassNote\x12\x1e.classer.v1.UpdateClassNoteReq\x1a\x1f.classer.v1.UpdateClassNoteResp\x12R\n" +
-	"\x0fDeleteClassNote\x12\x1e.classer.v1.DeleteClassNoteReq\x1a\x1f.classer.v1.DeleteClassNoteRespBHZFgithub.com/asynccnu/ccnubox-be/be-api/gen/proto/classlist/v1;classerv1b\x06proto3"
+	"\x0fDeleteClassNote\x12\x1e.classer.v1.DeleteClassNoteReq\x1a\x1f.classer.v1.DeleteClassNoteResp\x12[\n" +
+	"\x12CheckClassConflict\x12!.classer.v1.CheckClassConflictReq\x1a\".classer.v1.CheckClassConflictResp\x12=\n" +
+	"\bGetTerms\x12\x17.classer.v1.GetTermsReq\x1a\x18.classer.v1.GetTermsResp\x12U\n" +
+	"\x10CopyAddedClasses\x12\x1f.classer.v1.CopyAddedClassesReq\x1a .classer.v1.CopyAddedClassesResp\x12L\n" +
+	"\rImportClasses\x12\x1c.classer.v1.ImportClassesReq\x1a\x1d.classer.v1.ImportClassesResp\x12U\n" +
+	"\x10GetCrawlerHealth\x12\x1f.classer.v1.GetCrawlerHealthReq\x1a .classer.v1.GetCrawlerHealthRespBHZFgithub.com/asynccnu/ccnubox-be/be-api/gen/proto/classlist/v1;classerv1b\x06proto3"
 
 var (
 	file_classlist_v1_classer_proto_rawDescOnce sync.Once
@@ -1745,7 +2851,7 @@ func file_classlist_v1_classer_proto_rawDescGZIP() []byte {
 	return file_classlist_v1_classer_proto_rawDescData
 }
 
-var file_classlist_v1_classer_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_classlist_v1_classer_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
 var file_classlist_v1_classer_proto_goTypes = []any{
 	(*GetClassRequest)(nil),            // 0: classer.v1.GetClassRequest
 	(*GetClassResponse)(nil),           // 1: classer.v1.GetClassResponse
@@ -1771,39 +2877,73 @@ var file_classlist_v1_classer_proto_goTypes = []any{
 	(*UpdateClassNoteResp)(nil),        // 21: classer.v1.UpdateClassNoteResp
 	(*DeleteClassNoteReq)(nil),         // 22: classer.v1.DeleteClassNoteReq
 	(*DeleteClassNoteResp)(nil),        // 23: classer.v1.DeleteClassNoteResp
+	(*CheckClassConflictReq)(nil),      // 24: classer.v1.CheckClassConflictReq
+	(*CheckClassConflictResp)(nil),     // 25: classer.v1.CheckClassConflictResp
+	(*ClassConflict)(nil),              // 26: classer.v1.ClassConflict
+	(*ConflictSlot)(nil),               // 27: classer.v1.ConflictSlot
+	(*GetTermsReq)(nil),                // 28: classer.v1.GetTermsReq
+	(*GetTermsResp)(nil),               // 29: classer.v1.GetTermsResp
+	(*TermInfo)(nil),                   // 30: classer.v1.TermInfo
+	(*CopyAddedClassesReq)(nil),        // 31: classer.v1.CopyAddedClassesReq
+	(*CopyAddedClassesResp)(nil),       // 32: classer.v1.CopyAddedClassesResp
+	(*ImportClassesReq)(nil),           // 33: classer.v1.ImportClassesReq
+	(*ImportClassesResp)(nil),          // 34: classer.v1.ImportClassesResp
+	(*ImportRowError)(nil),             // 35: classer.v1.ImportRowError
+	(*GetCrawlerHealthReq)(nil),        // 36: classer.v1.GetCrawlerHealthReq
+	(*GetCrawlerHealthResp)(nil),       // 37: classer.v1.GetCrawlerHealthResp
+	(*CrawlerHealth)(nil),              // 38: classer.v1.CrawlerHealth
 }
 var file_classlist_v1_classer_proto_depIdxs = []int32{
 	17, // 0: classer.v1.GetClassResponse.classes:type_name -> classer.v1.Class
 	16, // 1: classer.v1.GetAllClassInfoResponse.class_infos:type_name -> classer.v1.ClassInfo
 	16, // 2: classer.v1.GetRecycleBinClassResponse.class_infos:type_name -> classer.v1.ClassInfo
 	16, // 3: classer.v1.Class.info:type_name -> classer.v1.ClassInfo
-	0,  // 4: classer.v1.Classer.GetClass:input_type -> classer.v1.GetClassRequest
-	2,  // 5: classer.v1.Classer.AddClass:input_type -> classer.v1.AddClassRequest
-	4,  // 6: classer.v1.Classer.DeleteClass:input_type -> classer.v1.DeleteClassRequest
-	6,  // 7: classer.v1.Classer.UpdateClass:input_type -> classer.v1.UpdateClassRequest
-	10, // 8: classer.v1.Classer.GetRecycleBinClassInfos:input_type -> classer.v1.GetRecycleBinClassRequest
-	12, // 9: classer.v1.Classer.RecoverClass:input_type -> classer.v1.RecoverClassRequest
-	8,  // 10: classer.v1.Classer.GetAllClassInfo:input_type -> classer.v1.GetAllClassInfoRequest
-	14, // 11: classer.v1.Classer.GetStuIdByJxbId:input_type -> classer.v1.GetStuIdByJxbIdRequest
-	18, // 12: classer.v1.Classer.GetSchoolDay:input_type -> classer.v1.GetSchoolDayReq
-	20, // 13: classer.v1.Classer.UpdateClassNote:input_type -> classer.v1.UpdateClassNoteReq
-	22, // 14: classer.v1.Classer.DeleteClassNote:input_type -> classer.v1.DeleteClassNoteReq
-	1,  // 15: classer.v1.Classer.GetClass:output_type -> classer.v1.GetClassResponse
-	3,  // 16: classer.v1.Classer.AddClass:output_type -> classer.v1.AddClassResponse
-	5,  // 17: classer.v1.Classer.DeleteClass:output_type -> classer.v1.DeleteClassResponse
-	7,  // 18: classer.v1.Classer.UpdateClass:output_type -> classer.v1.UpdateClassResponse
-	11, // 19: classer.v1.Classer.GetRecycleBinClassInfos:output_type -> classer.v1.GetRecycleBinClassResponse
-	13, // 20: classer.v1.Classer.RecoverClass:output_type -> classer.v1.RecoverClassResponse
-	9,  // 21: classer.v1.Classer.GetAllClassInfo:output_type -> classer.v1.GetAllClassInfoResponse
-	15, // 22: classer.v1.Classer.GetStuIdByJxbId:output_type -> classer.v1.GetStuIdByJxbIdResponse
-	19, // 23: classer.v1.Classer.GetSchoolDay:output_type -> classer.v1.GetSchoolDayResp
-	21, // 24: classer.v1.Classer.UpdateClassNote:output_type -> classer.v1.UpdateClassNoteResp
-	23, // 25: classer.v1.Classer.DeleteClassNote:output_type -> classer.v1.DeleteClassNoteResp
-	15, // [15:26] is the sub-list for method output_type
-	4,  // [4:15] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	26, // 4: classer.v1.CheckClassConflictResp.conflicts:type_name -> classer.v1.ClassConflict
+	16, // 5: classer.v1.ClassConflict.info:type_name -> classer.v1.ClassInfo
+	27, // 6: classer.v1.ClassConflict.slots:type_name -> classer.v1.ConflictSlot
+	30, // 7: classer.v1.GetTermsResp.terms:type_name -> classer.v1.TermInfo
+	16, // 8: classer.v1.CopyAddedClassesResp.copied:type_name -> classer.v1.ClassInfo
+	16, // 9: classer.v1.CopyAddedClassesResp.skipped:type_name -> classer.v1.ClassInfo
+	16, // 10: classer.v1.ImportClassesResp.imported:type_name -> classer.v1.ClassInfo
+	35, // 11: classer.v1.ImportClassesResp.errors:type_name -> classer.v1.ImportRowError
+	38, // 12: classer.v1.GetCrawlerHealthResp.strategies:type_name -> classer.v1.CrawlerHealth
+	0,  // 13: classer.v1.Classer.GetClass:input_type -> classer.v1.GetClassRequest
+	2,  // 14: classer.v1.Classer.AddClass:input_type -> classer.v1.AddClassRequest
+	4,  // 15: classer.v1.Classer.DeleteClass:input_type -> classer.v1.DeleteClassRequest
+	6,  // 16: classer.v1.Classer.UpdateClass:input_type -> classer.v1.UpdateClassRequest
+	10, // 17: classer.v1.Classer.GetRecycleBinClassInfos:input_type -> classer.v1.GetRecycleBinClassRequest
+	12, // 18: classer.v1.Classer.RecoverClass:input_type -> classer.v1.RecoverClassRequest
+	8,  // 19: classer.v1.Classer.GetAllClassInfo:input_type -> classer.v1.GetAllClassInfoRequest
+	14, // 20: classer.v1.Classer.GetStuIdByJxbId:input_type -> classer.v1.GetStuIdByJxbIdRequest
+	18, // 21: classer.v1.Classer.GetSchoolDay:input_type -> classer.v1.GetSchoolDayReq
+	20, // 22: classer.v1.Classer.UpdateClassNote:input_type -> classer.v1.UpdateClassNoteReq
+	22, // 23: classer.v1.Classer.DeleteClassNote:input_type -> classer.v1.DeleteClassNoteReq
+	24, // 24: classer.v1.Classer.CheckClassConflict:input_type -> classer.v1.CheckClassConflictReq
+	28, // 25: classer.v1.Classer.GetTerms:input_type -> classer.v1.GetTermsReq
+	31, // 26: classer.v1.Classer.CopyAddedClasses:input_type -> classer.v1.CopyAddedClassesReq
+	33, // 27: classer.v1.Classer.ImportClasses:input_type -> classer.v1.ImportClassesReq
+	36, // 28: classer.v1.Classer.GetCrawlerHealth:input_type -> classer.v1.GetCrawlerHealthReq
+	1,  // 29: classer.v1.Classer.GetClass:output_type -> classer.v1.GetClassResponse
+	3,  // 30: classer.v1.Classer.AddClass:output_type -> classer.v1.AddClassResponse
+	5,  // 31: classer.v1.Classer.DeleteClass:output_type -> classer.v1.DeleteClassResponse
+	7,  // 32: classer.v1.Classer.UpdateClass:output_type -> classer.v1.UpdateClassResponse
+	11, // 33: classer.v1.Classer.GetRecycleBinClassInfos:output_type -> classer.v1.GetRecycleBinClassResponse
+	13, // 34: classer.v1.Classer.RecoverClass:output_type -> classer.v1.RecoverClassResponse
+	9,  // 35: classer.v1.Classer.GetAllClassInfo:output_type -> classer.v1.GetAllClassInfoResponse
+	15, // 36: classer.v1.Classer.GetStuIdByJxbId:output_type -> classer.v1.GetStuIdByJxbIdResponse
+	19, // 37: classer.v1.Classer.GetSchoolDay:output_type -> classer.v1.GetSchoolDayResp
+	21, // 38: classer.v1.Classer.UpdateClassNote:output_type -> classer.v1.UpdateClassNoteResp
+	23, // 39: classer.v1.Classer.DeleteClassNote:output_type -> classer.v1.DeleteClassNoteResp
+	25, // 40: classer.v1.Classer.CheckClassConflict:output_type -> classer.v1.CheckClassConflictResp
+	29, // 41: classer.v1.Classer.GetTerms:output_type -> classer.v1.GetTermsResp
+	32, // 42: classer.v1.Classer.CopyAddedClasses:output_type -> classer.v1.CopyAddedClassesResp
+	34, // 43: classer.v1.Classer.ImportClasses:output_type -> classer.v1.ImportClassesResp
+	37, // 44: classer.v1.Classer.GetCrawlerHealth:output_type -> classer.v1.GetCrawlerHealthResp
+	29, // [29:45] is the sub-list for method output_type
+	13, // [13:29] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_classlist_v1_classer_proto_init() }
@@ -1819,7 +2959,7 @@ func file_classlist_v1_classer_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_classlist_v1_classer_proto_rawDesc), len(file_classlist_v1_classer_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   24,
+			NumMessages:   39,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -30,6 +30,11 @@ const (
 	Classer_GetSchoolDay_FullMethodName            = "/classer.v1.Classer/GetSchoolDay"
 	Classer_UpdateClassNote_FullMethodName         = "/classer.v1.Classer/UpdateClassNote"
 	Classer_DeleteClassNote_FullMethodName         = "/classer.v1.Classer/DeleteClassNote"
+	Classer_CheckClassConflict_FullMethodName      = "/classer.v1.Classer/CheckClassConflict"
+	Classer_GetTerms_FullMethodName                = "/classer.v1.Classer/GetTerms"
+	Classer_CopyAddedClasses_FullMethodName        = "/classer.v1.Classer/CopyAddedClasses"
+	Classer_ImportClasses_FullMethodName           = "/classer.v1.Classer/ImportClasses"
+	Classer_GetCrawlerHealth_FullMethodName        = "/classer.v1.Classer/GetCrawlerHealth"
 )
 
 // ClasserClient is the client API for Classer service.
@@ -58,6 +63,16 @@ type ClasserClient interface {
 	UpdateClassNote(ctx context.Context, in *UpdateClassNoteReq, opts ...grpc.CallOption) (*UpdateClassNoteResp, error)
 	// 删除课程备注
 	DeleteClassNote(ctx context.Context, in *DeleteClassNoteReq, opts ...grpc.CallOption) (*DeleteClassNoteResp, error)
+	// 检查课程是否与已有课程时间冲突
+	CheckClassConflict(ctx context.Context, in *CheckClassConflictReq, opts ...grpc.CallOption) (*CheckClassConflictResp, error)
+	// 获取学生有课表数据的所有学期
+	GetTerms(ctx context.Context, in *GetTermsReq, opts ...grpc.CallOption) (*GetTermsResp, error)
+	// 将某学期手动添加的课程(及备注)复制到另一个学期
+	CopyAddedClasses(ctx context.Context, in *CopyAddedClassesReq, opts ...grpc.CallOption) (*CopyAddedClassesResp, error)
+	// 从Excel/CSV模板或其他课表App的导出文件导入课程
+	ImportClasses(ctx context.Context, in *ImportClassesReq, opts ...grpc.CallOption) (*ImportClassesResp, error)
+	// 获取各爬虫策略的健康状态(管理员使用)
+	GetCrawlerHealth(ctx context.Context, in *GetCrawlerHealthReq, opts ...grpc.CallOption) (*GetCrawlerHealthResp, error)
 }
 
 type classerClient struct {
@@ -178,6 +193,56 @@ func (c *classerClient) DeleteClassNote(ctx context.Context, in *DeleteClassNote
 	return out, nil
 }
 
+func (c *classerClient) CheckClassConflict(ctx context.Context, in *CheckClassConflictReq, opts ...grpc.CallOption) (*CheckClassConflictResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckClassConflictResp)
+	err := c.cc.Invoke(ctx, Classer_CheckClassConflict_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *classerClient) GetTerms(ctx context.Context, in *GetTermsReq, opts ...grpc.CallOption) (*GetTermsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTermsResp)
+	err := c.cc.Invoke(ctx, Classer_GetTerms_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *classerClient) CopyAddedClasses(ctx context.Context, in *CopyAddedClassesReq, opts ...grpc.CallOption) (*CopyAddedClassesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CopyAddedClassesResp)
+	err := c.cc.Invoke(ctx, Classer_CopyAddedClasses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *classerClient) ImportClasses(ctx context.Context, in *ImportClassesReq, opts ...grpc.CallOption) (*ImportClassesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportClassesResp)
+	err := c.cc.Invoke(ctx, Classer_ImportClasses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *classerClient) GetCrawlerHealth(ctx context.Context, in *GetCrawlerHealthReq, opts ...grpc.CallOption) (*GetCrawlerHealthResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCrawlerHealthResp)
+	err := c.cc.Invoke(ctx, Classer_GetCrawlerHealth_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ClasserServer is the server API for Classer service.
 // All implementations must embed UnimplementedClasserServer
 // for forward compatibility.
@@ -204,6 +269,16 @@ type ClasserServer interface {
 	UpdateClassNote(context.Context, *UpdateClassNoteReq) (*UpdateClassNoteResp, error)
 	// 删除课程备注
 	DeleteClassNote(context.Context, *DeleteClassNoteReq) (*DeleteClassNoteResp, error)
+	// 检查课程是否与已有课程时间冲突
+	CheckClassConflict(context.Context, *CheckClassConflictReq) (*CheckClassConflictResp, error)
+	// 获取学生有课表数据的所有学期
+	GetTerms(context.Context, *GetTermsReq) (*GetTermsResp, error)
+	// 将某学期手动添加的课程(及备注)复制到另一个学期
+	CopyAddedClasses(context.Context, *CopyAddedClassesReq) (*CopyAddedClassesResp, error)
+	// 从Excel/CSV模板或其他课表App的导出文件导入课程
+	ImportClasses(context.Context, *ImportClassesReq) (*ImportClassesResp, error)
+	// 获取各爬虫策略的健康状态(管理员使用)
+	GetCrawlerHealth(context.Context, *GetCrawlerHealthReq) (*GetCrawlerHealthResp, error)
 	mustEmbedUnimplementedClasserServer()
 }
 
@@ -247,6 +322,21 @@ func (UnimplementedClasserServer) UpdateClassNote(context.Context, *UpdateClassN
 func (UnimplementedClasserServer) DeleteClassNote(context.Context, *DeleteClassNoteReq) (*DeleteClassNoteResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteClassNote not implemented")
 }
+func (UnimplementedClasserServer) CheckClassConflict(context.Context, *CheckClassConflictReq) (*CheckClassConflictResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckClassConflict not implemented")
+}
+func (UnimplementedClasserServer) GetTerms(context.Context, *GetTermsReq) (*GetTermsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTerms not implemented")
+}
+func (UnimplementedClasserServer) CopyAddedClasses(context.Context, *CopyAddedClassesReq) (*CopyAddedClassesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CopyAddedClasses not implemented")
+}
+func (UnimplementedClasserServer) ImportClasses(context.Context, *ImportClassesReq) (*ImportClassesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportClasses not implemented")
+}
+func (UnimplementedClasserServer) GetCrawlerHealth(context.Context, *GetCrawlerHealthReq) (*GetCrawlerHealthResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCrawlerHealth not implemented")
+}
 func (UnimplementedClasserServer) mustEmbedUnimplementedClasserServer() {}
 func (UnimplementedClasserServer) testEmbeddedByValue()                 {}
 
@@ -466,6 +556,96 @@ func _Classer_DeleteClassNote_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Classer_CheckClassConflict_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckClassConflictReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClasserServer).CheckClassConflict(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Classer_CheckClassConflict_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClasserServer).CheckClassConflict(ctx, req.(*CheckClassConflictReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Classer_GetTerms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTermsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClasserServer).GetTerms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Classer_GetTerms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClasserServer).GetTerms(ctx, req.(*GetTermsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Classer_CopyAddedClasses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CopyAddedClassesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClasserServer).CopyAddedClasses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Classer_CopyAddedClasses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClasserServer).CopyAddedClasses(ctx, req.(*CopyAddedClassesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Classer_ImportClasses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportClassesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClasserServer).ImportClasses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Classer_ImportClasses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClasserServer).ImportClasses(ctx, req.(*ImportClassesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Classer_GetCrawlerHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCrawlerHealthReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClasserServer).GetCrawlerHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Classer_GetCrawlerHealth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClasserServer).GetCrawlerHealth(ctx, req.(*GetCrawlerHealthReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Classer_ServiceDesc is the grpc.ServiceDesc for Classer service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -517,6 +697,26 @@ var Classer_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteClassNote",
 			Handler:    _Classer_DeleteClassNote_Handler,
 		},
+		{
+			MethodName: "CheckClassConflict",
+			Handler:    _Classer_CheckClassConflict_Handler,
+		},
+		{
+			MethodName: "GetTerms",
+			Handler:    _Classer_GetTerms_Handler,
+		},
+		{
+			MethodName: "CopyAddedClasses",
+			Handler:    _Classer_CopyAddedClasses_Handler,
+		},
+		{
+			MethodName: "ImportClasses",
+			Handler:    _Classer_ImportClasses_Handler,
+		},
+		{
+			MethodName: "GetCrawlerHealth",
+			Handler:    _Classer_GetCrawlerHealth_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "classlist/v1/classer.proto",
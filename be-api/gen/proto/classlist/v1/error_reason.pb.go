@@ -38,6 +38,8 @@ const (
 	ErrorReason_RECOVERFAILED               ErrorReason = 10
 	ErrorReason_GETSTUIDBYJXBID             ErrorReason = 11
 	ErrorReason_CLASSISEXIST                ErrorReason = 12
+	ErrorReason_CLASSCONFLICT               ErrorReason = 13
+	ErrorReason_IMPORTFILEERROR             ErrorReason = 14
 )
 
 // Enum value maps for ErrorReason.
@@ -56,6 +58,8 @@ var (
 		10: "RECOVERFAILED",
 		11: "GETSTUIDBYJXBID",
 		12: "CLASSISEXIST",
+		13: "CLASSCONFLICT",
+		14: "IMPORTFILEERROR",
 	}
 	ErrorReason_value = map[string]int32{
 		"DB_NOTFOUND":                 0,
@@ -71,6 +75,8 @@ var (
 		"RECOVERFAILED":               10,
 		"GETSTUIDBYJXBID":             11,
 		"CLASSISEXIST":                12,
+		"CLASSCONFLICT":               13,
+		"IMPORTFILEERROR":             14,
 	}
 )
 
@@ -106,7 +112,7 @@ var File_classlist_v1_error_reason_proto protoreflect.FileDescriptor
 const file_classlist_v1_error_reason_proto_rawDesc = "" +
 	"\n" +
 	"\x1fclasslist/v1/error_reason.proto\x12\n" +
-	"classer.v1\x1a\x13errors/errors.proto*\x96\x02\n" +
+	"classer.v1\x1a\x13errors/errors.proto*\xbe\x02\n" +
 	"\vErrorReason\x12\x0f\n" +
 	"\vDB_NOTFOUND\x10\x00\x12\x0e\n" +
 	"\n" +
@@ -122,7 +128,9 @@ const file_classlist_v1_error_reason_proto_rawDesc = "" +
 	"\rRECOVERFAILED\x10\n" +
 	"\x12\x13\n" +
 	"\x0fGETSTUIDBYJXBID\x10\v\x12\x10\n" +
-	"\fCLASSISEXIST\x10\f\x1a\x04\xa0E\xf4\x03BHZFgithub.com/asynccnu/ccnubox-be/be-api/gen/proto/classlist/v1;classerv1b\x06proto3"
+	"\fCLASSISEXIST\x10\f\x12\x11\n" +
+	"\rCLASSCONFLICT\x10\r\x12\x13\n" +
+	"\x0fIMPORTFILEERROR\x10\x0e\x1a\x04\xa0E\xf4\x03BHZFgithub.com/asynccnu/ccnubox-be/be-api/gen/proto/classlist/v1;classerv1b\x06proto3"
 
 var (
 	file_classlist_v1_error_reason_proto_rawDescOnce sync.Once
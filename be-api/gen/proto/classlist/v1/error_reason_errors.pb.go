@@ -166,3 +166,27 @@ func IsClassisexist(err error) bool {
 func ErrorClassisexist(format string, args ...interface{}) *errors.Error {
 	return errors.New(500, ErrorReason_CLASSISEXIST.String(), fmt.Sprintf(format, args...))
 }
+
+func IsClassconflict(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_CLASSCONFLICT.String() && e.Code == 500
+}
+
+func ErrorClassconflict(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, ErrorReason_CLASSCONFLICT.String(), fmt.Sprintf(format, args...))
+}
+
+func IsImportfileerror(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_IMPORTFILEERROR.String() && e.Code == 500
+}
+
+func ErrorImportfileerror(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, ErrorReason_IMPORTFILEERROR.String(), fmt.Sprintf(format, args...))
+}
@@ -618,6 +618,254 @@ func (*CancelStandardResponse) Descriptor() ([]byte, []int) {
 	return file_elecprice_v1_elecprice_proto_rawDescGZIP(), []int{12}
 }
 
+type GetUsageHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	Granularity   string                 `protobuf:"bytes,2,opt,name=granularity,proto3" json:"granularity,omitempty"` // 统计粒度 day/week/month, 默认 day
+	Days          int64                  `protobuf:"varint,3,opt,name=days,proto3" json:"days,omitempty"`              // 统计最近多少天, 默认 30, 最多 366
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsageHistoryRequest) Reset() {
+	*x = GetUsageHistoryRequest{}
+	mi := &file_elecprice_v1_elecprice_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsageHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageHistoryRequest) ProtoMessage() {}
+
+func (x *GetUsageHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_elecprice_v1_elecprice_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetUsageHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_elecprice_v1_elecprice_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetUsageHistoryRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *GetUsageHistoryRequest) GetGranularity() string {
+	if x != nil {
+		return x.Granularity
+	}
+	return ""
+}
+
+func (x *GetUsageHistoryRequest) GetDays() int64 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+type UsagePoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`                                    // 统计周期的第一天, 2006-01-02
+	UseValue      float64                `protobuf:"fixed64,2,opt,name=use_value,json=useValue,proto3" json:"use_value,omitempty"`          // 用电量
+	UseMoney      float64                `protobuf:"fixed64,3,opt,name=use_money,json=useMoney,proto3" json:"use_money,omitempty"`          // 电费
+	RemainMoney   float64                `protobuf:"fixed64,4,opt,name=remain_money,json=remainMoney,proto3" json:"remain_money,omitempty"` // 周期内最后记录的剩余电费, 没有记录时为 -1
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UsagePoint) Reset() {
+	*x = UsagePoint{}
+	mi := &file_elecprice_v1_elecprice_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UsagePoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsagePoint) ProtoMessage() {}
+
+func (x *UsagePoint) ProtoReflect() protoreflect.Message {
+	mi := &file_elecprice_v1_elecprice_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsagePoint.ProtoReflect.Descriptor instead.
+func (*UsagePoint) Descriptor() ([]byte, []int) {
+	return file_elecprice_v1_elecprice_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UsagePoint) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *UsagePoint) GetUseValue() float64 {
+	if x != nil {
+		return x.UseValue
+	}
+	return 0
+}
+
+func (x *UsagePoint) GetUseMoney() float64 {
+	if x != nil {
+		return x.UseMoney
+	}
+	return 0
+}
+
+func (x *UsagePoint) GetRemainMoney() float64 {
+	if x != nil {
+		return x.RemainMoney
+	}
+	return 0
+}
+
+type UsageForecast struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RemainMoney   string                 `protobuf:"bytes,1,opt,name=remain_money,json=remainMoney,proto3" json:"remain_money,omitempty"`           // 当前剩余电费
+	AvgDailyMoney float64                `protobuf:"fixed64,2,opt,name=avg_daily_money,json=avgDailyMoney,proto3" json:"avg_daily_money,omitempty"` // 最近每天平均电费
+	DaysLeft      float64                `protobuf:"fixed64,3,opt,name=days_left,json=daysLeft,proto3" json:"days_left,omitempty"`                  // 预计还可使用的天数, 无法预测时为 -1
+	EmptyDate     string                 `protobuf:"bytes,4,opt,name=empty_date,json=emptyDate,proto3" json:"empty_date,omitempty"`                 // 预计用完的日期, 无法预测时为空
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UsageForecast) Reset() {
+	*x = UsageForecast{}
+	mi := &file_elecprice_v1_elecprice_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UsageForecast) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsageForecast) ProtoMessage() {}
+
+func (x *UsageForecast) ProtoReflect() protoreflect.Message {
+	mi := &file_elecprice_v1_elecprice_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsageForecast.ProtoReflect.Descriptor instead.
+func (*UsageForecast) Descriptor() ([]byte, []int) {
+	return file_elecprice_v1_elecprice_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UsageForecast) GetRemainMoney() string {
+	if x != nil {
+		return x.RemainMoney
+	}
+	return ""
+}
+
+func (x *UsageForecast) GetAvgDailyMoney() float64 {
+	if x != nil {
+		return x.AvgDailyMoney
+	}
+	return 0
+}
+
+func (x *UsageForecast) GetDaysLeft() float64 {
+	if x != nil {
+		return x.DaysLeft
+	}
+	return 0
+}
+
+func (x *UsageForecast) GetEmptyDate() string {
+	if x != nil {
+		return x.EmptyDate
+	}
+	return ""
+}
+
+type GetUsageHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Points        []*UsagePoint          `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"`
+	Forecast      *UsageForecast         `protobuf:"bytes,2,opt,name=forecast,proto3" json:"forecast,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsageHistoryResponse) Reset() {
+	*x = GetUsageHistoryResponse{}
+	mi := &file_elecprice_v1_elecprice_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsageHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageHistoryResponse) ProtoMessage() {}
+
+func (x *GetUsageHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_elecprice_v1_elecprice_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetUsageHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_elecprice_v1_elecprice_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetUsageHistoryResponse) GetPoints() []*UsagePoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+func (x *GetUsageHistoryResponse) GetForecast() *UsageForecast {
+	if x != nil {
+		return x.Forecast
+	}
+	return nil
+}
+
 type GetArchitectureResponse_Architecture struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
 	ArchitectureID   string                 `protobuf:"bytes,1,opt,name=ArchitectureID,proto3" json:"ArchitectureID,omitempty"`
@@ -630,7 +878,7 @@ type GetArchitectureResponse_Architecture struct {
 
 func (x *GetArchitectureResponse_Architecture) Reset() {
 	*x = GetArchitectureResponse_Architecture{}
-	mi := &file_elecprice_v1_elecprice_proto_msgTypes[13]
+	mi := &file_elecprice_v1_elecprice_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -642,7 +890,7 @@ func (x *GetArchitectureResponse_Architecture) String() string {
 func (*GetArchitectureResponse_Architecture) ProtoMessage() {}
 
 func (x *GetArchitectureResponse_Architecture) ProtoReflect() protoreflect.Message {
-	mi := &file_elecprice_v1_elecprice_proto_msgTypes[13]
+	mi := &file_elecprice_v1_elecprice_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -696,7 +944,7 @@ type GetRoomInfoResponse_Room struct {
 
 func (x *GetRoomInfoResponse_Room) Reset() {
 	*x = GetRoomInfoResponse_Room{}
-	mi := &file_elecprice_v1_elecprice_proto_msgTypes[14]
+	mi := &file_elecprice_v1_elecprice_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -708,7 +956,7 @@ func (x *GetRoomInfoResponse_Room) String() string {
 func (*GetRoomInfoResponse_Room) ProtoMessage() {}
 
 func (x *GetRoomInfoResponse_Room) ProtoReflect() protoreflect.Message {
-	mi := &file_elecprice_v1_elecprice_proto_msgTypes[14]
+	mi := &file_elecprice_v1_elecprice_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -749,7 +997,7 @@ type GetPriceResponse_Price struct {
 
 func (x *GetPriceResponse_Price) Reset() {
 	*x = GetPriceResponse_Price{}
-	mi := &file_elecprice_v1_elecprice_proto_msgTypes[15]
+	mi := &file_elecprice_v1_elecprice_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -761,7 +1009,7 @@ func (x *GetPriceResponse_Price) String() string {
 func (*GetPriceResponse_Price) ProtoMessage() {}
 
 func (x *GetPriceResponse_Price) ProtoReflect() protoreflect.Message {
-	mi := &file_elecprice_v1_elecprice_proto_msgTypes[15]
+	mi := &file_elecprice_v1_elecprice_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -843,14 +1091,34 @@ const file_elecprice_v1_elecprice_proto_rawDesc = "" +
 	"\x15CancelStandardRequest\x12\x1c\n" +
 	"\tstudentId\x18\x01 \x01(\tR\tstudentId\x12\x17\n" +
 	"\aroom_id\x18\x02 \x01(\tR\x06roomId\"\x18\n" +
-	"\x16CancelStandardResponse2\xa2\x04\n" +
+	"\x16CancelStandardResponse\"g\n" +
+	"\x16GetUsageHistoryRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12 \n" +
+	"\vgranularity\x18\x02 \x01(\tR\vgranularity\x12\x12\n" +
+	"\x04days\x18\x03 \x01(\x03R\x04days\"}\n" +
+	"\n" +
+	"UsagePoint\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x1b\n" +
+	"\tuse_value\x18\x02 \x01(\x01R\buseValue\x12\x1b\n" +
+	"\tuse_money\x18\x03 \x01(\x01R\buseMoney\x12!\n" +
+	"\fremain_money\x18\x04 \x01(\x01R\vremainMoney\"\x96\x01\n" +
+	"\rUsageForecast\x12!\n" +
+	"\fremain_money\x18\x01 \x01(\tR\vremainMoney\x12&\n" +
+	"\x0favg_daily_money\x18\x02 \x01(\x01R\ravgDailyMoney\x12\x1b\n" +
+	"\tdays_left\x18\x03 \x01(\x01R\bdaysLeft\x12\x1d\n" +
+	"\n" +
+	"empty_date\x18\x04 \x01(\tR\temptyDate\"\x84\x01\n" +
+	"\x17GetUsageHistoryResponse\x120\n" +
+	"\x06points\x18\x01 \x03(\v2\x18.elecprice.v1.UsagePointR\x06points\x127\n" +
+	"\bforecast\x18\x02 \x01(\v2\x1b.elecprice.v1.UsageForecastR\bforecast2\x82\x05\n" +
 	"\x10ElecpriceService\x12^\n" +
 	"\x0fGetArchitecture\x12$.elecprice.v1.GetArchitectureRequest\x1a%.elecprice.v1.GetArchitectureResponse\x12R\n" +
 	"\vGetRoomInfo\x12 .elecprice.v1.GetRoomInfoRequest\x1a!.elecprice.v1.GetRoomInfoResponse\x12I\n" +
 	"\bGetPrice\x12\x1d.elecprice.v1.GetPriceRequest\x1a\x1e.elecprice.v1.GetPriceResponse\x12R\n" +
 	"\vSetStandard\x12 .elecprice.v1.SetStandardRequest\x1a!.elecprice.v1.SetStandardResponse\x12^\n" +
 	"\x0fGetStandardList\x12$.elecprice.v1.GetStandardListRequest\x1a%.elecprice.v1.GetStandardListResponse\x12[\n" +
-	"\x0eCancelStandard\x12#.elecprice.v1.CancelStandardRequest\x1a$.elecprice.v1.CancelStandardResponseBJZHgithub.com/asynccnu/ccnubox-be/be-api/gen/proto/elecprice/v1;elecpricev1b\x06proto3"
+	"\x0eCancelStandard\x12#.elecprice.v1.CancelStandardRequest\x1a$.elecprice.v1.CancelStandardResponse\x12^\n" +
+	"\x0fGetUsageHistory\x12$.elecprice.v1.GetUsageHistoryRequest\x1a%.elecprice.v1.GetUsageHistoryResponseBJZHgithub.com/asynccnu/ccnubox-be/be-api/gen/proto/elecprice/v1;elecpricev1b\x06proto3"
 
 var (
 	file_elecprice_v1_elecprice_proto_rawDescOnce sync.Once
@@ -864,7 +1132,7 @@ func file_elecprice_v1_elecprice_proto_rawDescGZIP() []byte {
 	return file_elecprice_v1_elecprice_proto_rawDescData
 }
 
-var file_elecprice_v1_elecprice_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_elecprice_v1_elecprice_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_elecprice_v1_elecprice_proto_goTypes = []any{
 	(*GetArchitectureRequest)(nil),               // 0: elecprice.v1.GetArchitectureRequest
 	(*GetArchitectureResponse)(nil),              // 1: elecprice.v1.GetArchitectureResponse
@@ -879,33 +1147,41 @@ var file_elecprice_v1_elecprice_proto_goTypes = []any{
 	(*GetStandardListResponse)(nil),              // 10: elecprice.v1.GetStandardListResponse
 	(*CancelStandardRequest)(nil),                // 11: elecprice.v1.CancelStandardRequest
 	(*CancelStandardResponse)(nil),               // 12: elecprice.v1.CancelStandardResponse
-	(*GetArchitectureResponse_Architecture)(nil), // 13: elecprice.v1.GetArchitectureResponse.Architecture
-	(*GetRoomInfoResponse_Room)(nil),             // 14: elecprice.v1.GetRoomInfoResponse.Room
-	(*GetPriceResponse_Price)(nil),               // 15: elecprice.v1.GetPriceResponse.Price
+	(*GetUsageHistoryRequest)(nil),               // 13: elecprice.v1.GetUsageHistoryRequest
+	(*UsagePoint)(nil),                           // 14: elecprice.v1.UsagePoint
+	(*UsageForecast)(nil),                        // 15: elecprice.v1.UsageForecast
+	(*GetUsageHistoryResponse)(nil),              // 16: elecprice.v1.GetUsageHistoryResponse
+	(*GetArchitectureResponse_Architecture)(nil), // 17: elecprice.v1.GetArchitectureResponse.Architecture
+	(*GetRoomInfoResponse_Room)(nil),             // 18: elecprice.v1.GetRoomInfoResponse.Room
+	(*GetPriceResponse_Price)(nil),               // 19: elecprice.v1.GetPriceResponse.Price
 }
 var file_elecprice_v1_elecprice_proto_depIdxs = []int32{
-	13, // 0: elecprice.v1.GetArchitectureResponse.ArchitectureList:type_name -> elecprice.v1.GetArchitectureResponse.Architecture
-	14, // 1: elecprice.v1.GetRoomInfoResponse.RoomList:type_name -> elecprice.v1.GetRoomInfoResponse.Room
-	15, // 2: elecprice.v1.GetPriceResponse.price:type_name -> elecprice.v1.GetPriceResponse.Price
+	17, // 0: elecprice.v1.GetArchitectureResponse.ArchitectureList:type_name -> elecprice.v1.GetArchitectureResponse.Architecture
+	18, // 1: elecprice.v1.GetRoomInfoResponse.RoomList:type_name -> elecprice.v1.GetRoomInfoResponse.Room
+	19, // 2: elecprice.v1.GetPriceResponse.price:type_name -> elecprice.v1.GetPriceResponse.Price
 	6,  // 3: elecprice.v1.SetStandardRequest.standard:type_name -> elecprice.v1.Standard
 	6,  // 4: elecprice.v1.GetStandardListResponse.standards:type_name -> elecprice.v1.Standard
-	0,  // 5: elecprice.v1.ElecpriceService.GetArchitecture:input_type -> elecprice.v1.GetArchitectureRequest
-	2,  // 6: elecprice.v1.ElecpriceService.GetRoomInfo:input_type -> elecprice.v1.GetRoomInfoRequest
-	4,  // 7: elecprice.v1.ElecpriceService.GetPrice:input_type -> elecprice.v1.GetPriceRequest
-	7,  // 8: elecprice.v1.ElecpriceService.SetStandard:input_type -> elecprice.v1.SetStandardRequest
-	9,  // 9: elecprice.v1.ElecpriceService.GetStandardList:input_type -> elecprice.v1.GetStandardListRequest
-	11, // 10: elecprice.v1.ElecpriceService.CancelStandard:input_type -> elecprice.v1.CancelStandardRequest
-	1,  // 11: elecprice.v1.ElecpriceService.GetArchitecture:output_type -> elecprice.v1.GetArchitectureResponse
-	3,  // 12: elecprice.v1.ElecpriceService.GetRoomInfo:output_type -> elecprice.v1.GetRoomInfoResponse
-	5,  // 13: elecprice.v1.ElecpriceService.GetPrice:output_type -> elecprice.v1.GetPriceResponse
-	8,  // 14: elecprice.v1.ElecpriceService.SetStandard:output_type -> elecprice.v1.SetStandardResponse
-	10, // 15: elecprice.v1.ElecpriceService.GetStandardList:output_type -> elecprice.v1.GetStandardListResponse
-	12, // 16: elecprice.v1.ElecpriceService.CancelStandard:output_type -> elecprice.v1.CancelStandardResponse
-	11, // [11:17] is the sub-list for method output_type
-	5,  // [5:11] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	14, // 5: elecprice.v1.GetUsageHistoryResponse.points:type_name -> elecprice.v1.UsagePoint
+	15, // 6: elecprice.v1.GetUsageHistoryResponse.forecast:type_name -> elecprice.v1.UsageForecast
+	0,  // 7: elecprice.v1.ElecpriceService.GetArchitecture:input_type -> elecprice.v1.GetArchitectureRequest
+	2,  // 8: elecprice.v1.ElecpriceService.GetRoomInfo:input_type -> elecprice.v1.GetRoomInfoRequest
+	4,  // 9: elecprice.v1.ElecpriceService.GetPrice:input_type -> elecprice.v1.GetPriceRequest
+	7,  // 10: elecprice.v1.ElecpriceService.SetStandard:input_type -> elecprice.v1.SetStandardRequest
+	9,  // 11: elecprice.v1.ElecpriceService.GetStandardList:input_type -> elecprice.v1.GetStandardListRequest
+	11, // 12: elecprice.v1.ElecpriceService.CancelStandard:input_type -> elecprice.v1.CancelStandardRequest
+	13, // 13: elecprice.v1.ElecpriceService.GetUsageHistory:input_type -> elecprice.v1.GetUsageHistoryRequest
+	1,  // 14: elecprice.v1.ElecpriceService.GetArchitecture:output_type -> elecprice.v1.GetArchitectureResponse
+	3,  // 15: elecprice.v1.ElecpriceService.GetRoomInfo:output_type -> elecprice.v1.GetRoomInfoResponse
+	5,  // 16: elecprice.v1.ElecpriceService.GetPrice:output_type -> elecprice.v1.GetPriceResponse
+	8,  // 17: elecprice.v1.ElecpriceService.SetStandard:output_type -> elecprice.v1.SetStandardResponse
+	10, // 18: elecprice.v1.ElecpriceService.GetStandardList:output_type -> elecprice.v1.GetStandardListResponse
+	12, // 19: elecprice.v1.ElecpriceService.CancelStandard:output_type -> elecprice.v1.CancelStandardResponse
+	16, // 20: elecprice.v1.ElecpriceService.GetUsageHistory:output_type -> elecprice.v1.GetUsageHistoryResponse
+	14, // [14:21] is the sub-list for method output_type
+	7,  // [7:14] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_elecprice_v1_elecprice_proto_init() }
@@ -919,7 +1195,7 @@ func file_elecprice_v1_elecprice_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_elecprice_v1_elecprice_proto_rawDesc), len(file_elecprice_v1_elecprice_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
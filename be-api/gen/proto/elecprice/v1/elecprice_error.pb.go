@@ -28,6 +28,9 @@ const (
 	ErrorReason_INTERNET_ERROR    ErrorReason = 0
 	ErrorReason_FIND_CONFIG_ERROR ErrorReason = 1
 	ErrorReason_SAVE_CONFIG_ERROR ErrorReason = 2
+	ErrorReason_INVALID_REQUEST   ErrorReason = 3
+	ErrorReason_FIND_USAGE_ERROR  ErrorReason = 4
+	ErrorReason_SAVE_USAGE_ERROR  ErrorReason = 5
 )
 
 // Enum value maps for ErrorReason.
@@ -36,11 +39,17 @@ var (
 		0: "INTERNET_ERROR",
 		1: "FIND_CONFIG_ERROR",
 		2: "SAVE_CONFIG_ERROR",
+		3: "INVALID_REQUEST",
+		4: "FIND_USAGE_ERROR",
+		5: "SAVE_USAGE_ERROR",
 	}
 	ErrorReason_value = map[string]int32{
 		"INTERNET_ERROR":    0,
 		"FIND_CONFIG_ERROR": 1,
 		"SAVE_CONFIG_ERROR": 2,
+		"INVALID_REQUEST":   3,
+		"FIND_USAGE_ERROR":  4,
+		"SAVE_USAGE_ERROR":  5,
 	}
 )
 
@@ -75,11 +84,14 @@ var File_elecprice_v1_elecprice_error_proto protoreflect.FileDescriptor
 
 const file_elecprice_v1_elecprice_error_proto_rawDesc = "" +
 	"\n" +
-	"\"elecprice/v1/elecprice_error.proto\x12\felecprice.v1\x1a\x13errors/errors.proto*g\n" +
+	"\"elecprice/v1/elecprice_error.proto\x12\felecprice.v1\x1a\x13errors/errors.proto*\xba\x01\n" +
 	"\vErrorReason\x12\x18\n" +
 	"\x0eINTERNET_ERROR\x10\x00\x1a\x04\xa8E\xf5\x03\x12\x1b\n" +
 	"\x11FIND_CONFIG_ERROR\x10\x01\x1a\x04\xa8E\xf6\x03\x12\x1b\n" +
-	"\x11SAVE_CONFIG_ERROR\x10\x02\x1a\x04\xa8E\xf7\x03\x1a\x04\xa0E\xf4\x03BJZHgithub.com/asynccnu/ccnubox-be/be-api/gen/proto/elecprice/v1;elecpricev1b\x06proto3"
+	"\x11SAVE_CONFIG_ERROR\x10\x02\x1a\x04\xa8E\xf7\x03\x12\x19\n" +
+	"\x0fINVALID_REQUEST\x10\x03\x1a\x04\xa8E\x90\x03\x12\x1a\n" +
+	"\x10FIND_USAGE_ERROR\x10\x04\x1a\x04\xa8E\xf8\x03\x12\x1a\n" +
+	"\x10SAVE_USAGE_ERROR\x10\x05\x1a\x04\xa8E\xf9\x03\x1a\x04\xa0E\xf4\x03BJZHgithub.com/asynccnu/ccnubox-be/be-api/gen/proto/elecprice/v1;elecpricev1b\x06proto3"
 
 var (
 	file_elecprice_v1_elecprice_error_proto_rawDescOnce sync.Once
@@ -46,3 +46,39 @@ func IsSaveConfigError(err error) bool {
 func ErrorSaveConfigError(format string, args ...interface{}) *errors.Error {
 	return errors.New(503, ErrorReason_SAVE_CONFIG_ERROR.String(), fmt.Sprintf(format, args...))
 }
+
+func IsInvalidRequest(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_INVALID_REQUEST.String() && e.Code == 400
+}
+
+func ErrorInvalidRequest(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_INVALID_REQUEST.String(), fmt.Sprintf(format, args...))
+}
+
+func IsFindUsageError(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_FIND_USAGE_ERROR.String() && e.Code == 504
+}
+
+func ErrorFindUsageError(format string, args ...interface{}) *errors.Error {
+	return errors.New(504, ErrorReason_FIND_USAGE_ERROR.String(), fmt.Sprintf(format, args...))
+}
+
+func IsSaveUsageError(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_SAVE_USAGE_ERROR.String() && e.Code == 505
+}
+
+func ErrorSaveUsageError(format string, args ...interface{}) *errors.Error {
+	return errors.New(505, ErrorReason_SAVE_USAGE_ERROR.String(), fmt.Sprintf(format, args...))
+}
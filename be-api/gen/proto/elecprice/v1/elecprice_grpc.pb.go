@@ -25,6 +25,7 @@ const (
 	ElecpriceService_SetStandard_FullMethodName     = "/elecprice.v1.ElecpriceService/SetStandard"
 	ElecpriceService_GetStandardList_FullMethodName = "/elecprice.v1.ElecpriceService/GetStandardList"
 	ElecpriceService_CancelStandard_FullMethodName  = "/elecprice.v1.ElecpriceService/CancelStandard"
+	ElecpriceService_GetUsageHistory_FullMethodName = "/elecprice.v1.ElecpriceService/GetUsageHistory"
 )
 
 // ElecpriceServiceClient is the client API for ElecpriceService service.
@@ -39,6 +40,7 @@ type ElecpriceServiceClient interface {
 	SetStandard(ctx context.Context, in *SetStandardRequest, opts ...grpc.CallOption) (*SetStandardResponse, error)
 	GetStandardList(ctx context.Context, in *GetStandardListRequest, opts ...grpc.CallOption) (*GetStandardListResponse, error)
 	CancelStandard(ctx context.Context, in *CancelStandardRequest, opts ...grpc.CallOption) (*CancelStandardResponse, error)
+	GetUsageHistory(ctx context.Context, in *GetUsageHistoryRequest, opts ...grpc.CallOption) (*GetUsageHistoryResponse, error)
 }
 
 type elecpriceServiceClient struct {
@@ -109,6 +111,16 @@ func (c *elecpriceServiceClient) CancelStandard(ctx context.Context, in *CancelS
 	return out, nil
 }
 
+func (c *elecpriceServiceClient) GetUsageHistory(ctx context.Context, in *GetUsageHistoryRequest, opts ...grpc.CallOption) (*GetUsageHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUsageHistoryResponse)
+	err := c.cc.Invoke(ctx, ElecpriceService_GetUsageHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ElecpriceServiceServer is the server API for ElecpriceService service.
 // All implementations must embed UnimplementedElecpriceServiceServer
 // for forward compatibility.
@@ -121,6 +133,7 @@ type ElecpriceServiceServer interface {
 	SetStandard(context.Context, *SetStandardRequest) (*SetStandardResponse, error)
 	GetStandardList(context.Context, *GetStandardListRequest) (*GetStandardListResponse, error)
 	CancelStandard(context.Context, *CancelStandardRequest) (*CancelStandardResponse, error)
+	GetUsageHistory(context.Context, *GetUsageHistoryRequest) (*GetUsageHistoryResponse, error)
 	mustEmbedUnimplementedElecpriceServiceServer()
 }
 
@@ -149,6 +162,9 @@ func (UnimplementedElecpriceServiceServer) GetStandardList(context.Context, *Get
 func (UnimplementedElecpriceServiceServer) CancelStandard(context.Context, *CancelStandardRequest) (*CancelStandardResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelStandard not implemented")
 }
+func (UnimplementedElecpriceServiceServer) GetUsageHistory(context.Context, *GetUsageHistoryRequest) (*GetUsageHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsageHistory not implemented")
+}
 func (UnimplementedElecpriceServiceServer) mustEmbedUnimplementedElecpriceServiceServer() {}
 func (UnimplementedElecpriceServiceServer) testEmbeddedByValue()                          {}
 
@@ -278,6 +294,24 @@ func _ElecpriceService_CancelStandard_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ElecpriceService_GetUsageHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsageHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ElecpriceServiceServer).GetUsageHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ElecpriceService_GetUsageHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ElecpriceServiceServer).GetUsageHistory(ctx, req.(*GetUsageHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ElecpriceService_ServiceDesc is the grpc.ServiceDesc for ElecpriceService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -309,6 +343,10 @@ var ElecpriceService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelStandard",
 			Handler:    _ElecpriceService_CancelStandard_Handler,
 		},
+		{
+			MethodName: "GetUsageHistory",
+			Handler:    _ElecpriceService_GetUsageHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "elecprice/v1/elecprice.proto",
@@ -732,6 +732,7 @@ type AllowList struct {
 	Muxi          bool                   `protobuf:"varint,3,opt,name=muxi,proto3" json:"muxi,omitempty"`
 	Holiday       bool                   `protobuf:"varint,4,opt,name=holiday,proto3" json:"holiday,omitempty"`
 	Energy        bool                   `protobuf:"varint,5,opt,name=energy,proto3" json:"energy,omitempty"`
+	Library       bool                   `protobuf:"varint,6,opt,name=library,proto3" json:"library,omitempty"` //图书馆预约相关
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -801,6 +802,13 @@ func (x *AllowList) GetEnergy() bool {
 	return false
 }
 
+func (x *AllowList) GetLibrary() bool {
+	if x != nil {
+		return x.Library
+	}
+	return false
+}
+
 type RemoveFeedTokenReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	StudentId     string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
@@ -1346,13 +1354,14 @@ const file_feed_v1_feed_proto_rawDesc = "" +
 	"\x13GetFeedAllowListReq\x12\x1c\n" +
 	"\tstudentId\x18\x01 \x01(\tR\tstudentId\"H\n" +
 	"\x14GetFeedAllowListResp\x120\n" +
-	"\tallowList\x18\x01 \x01(\v2\x12.feed.v1.AllowListR\tallowList\"\x85\x01\n" +
+	"\tallowList\x18\x01 \x01(\v2\x12.feed.v1.AllowListR\tallowList\"\x9f\x01\n" +
 	"\tAllowList\x12\x1c\n" +
 	"\tstudentId\x18\x01 \x01(\tR\tstudentId\x12\x14\n" +
 	"\x05grade\x18\x02 \x01(\bR\x05grade\x12\x12\n" +
 	"\x04muxi\x18\x03 \x01(\bR\x04muxi\x12\x18\n" +
 	"\aholiday\x18\x04 \x01(\bR\aholiday\x12\x16\n" +
-	"\x06energy\x18\x05 \x01(\bR\x06energy\"H\n" +
+	"\x06energy\x18\x05 \x01(\bR\x06energy\x12\x18\n" +
+	"\alibrary\x18\x06 \x01(\bR\alibrary\"H\n" +
 	"\x12RemoveFeedTokenReq\x12\x1c\n" +
 	"\tstudentId\x18\x01 \x01(\tR\tstudentId\x12\x14\n" +
 	"\x05token\x18\x02 \x01(\tR\x05token\"\x15\n" +
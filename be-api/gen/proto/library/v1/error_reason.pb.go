@@ -25,19 +25,79 @@ const (
 type ErrorReason int32
 
 const (
-	ErrorReason_CCNULogin_Error ErrorReason = 0
-	ErrorReason_Crawler_Error   ErrorReason = 1
+	ErrorReason_CCNULogin_Error          ErrorReason = 0
+	ErrorReason_Crawler_Error            ErrorReason = 1
+	ErrorReason_Seat_Not_Found           ErrorReason = 2
+	ErrorReason_Favourite_Not_Found      ErrorReason = 3
+	ErrorReason_No_Available_Seat        ErrorReason = 4
+	ErrorReason_Invalid_Reserve_Intent   ErrorReason = 5
+	ErrorReason_Reserve_Intent_Not_Found ErrorReason = 6
+	ErrorReason_Invalid_Comment          ErrorReason = 7
+	ErrorReason_Comment_Not_Found        ErrorReason = 8
+	ErrorReason_Comment_Forbidden        ErrorReason = 9
+	ErrorReason_Comment_Rate_Limited     ErrorReason = 10
+	ErrorReason_Comment_Blocked          ErrorReason = 11
+	ErrorReason_Reservation_Not_Found    ErrorReason = 12
+	ErrorReason_Swap_Failed              ErrorReason = 13
+	ErrorReason_Invalid_Discussion_Query ErrorReason = 14
+	ErrorReason_Invalid_Study_Group      ErrorReason = 15
+	ErrorReason_Study_Group_Not_Found    ErrorReason = 16
+	ErrorReason_Invalid_Room             ErrorReason = 17
+	ErrorReason_Room_Not_Found           ErrorReason = 18
+	ErrorReason_Invalid_Usage_Query      ErrorReason = 19
+	ErrorReason_Swap_Double_Booked       ErrorReason = 20
+	ErrorReason_Invalid_Swap_Request     ErrorReason = 21
 )
 
 // Enum value maps for ErrorReason.
 var (
 	ErrorReason_name = map[int32]string{
-		0: "CCNULogin_Error",
-		1: "Crawler_Error",
+		0:  "CCNULogin_Error",
+		1:  "Crawler_Error",
+		2:  "Seat_Not_Found",
+		3:  "Favourite_Not_Found",
+		4:  "No_Available_Seat",
+		5:  "Invalid_Reserve_Intent",
+		6:  "Reserve_Intent_Not_Found",
+		7:  "Invalid_Comment",
+		8:  "Comment_Not_Found",
+		9:  "Comment_Forbidden",
+		10: "Comment_Rate_Limited",
+		11: "Comment_Blocked",
+		12: "Reservation_Not_Found",
+		13: "Swap_Failed",
+		14: "Invalid_Discussion_Query",
+		15: "Invalid_Study_Group",
+		16: "Study_Group_Not_Found",
+		17: "Invalid_Room",
+		18: "Room_Not_Found",
+		19: "Invalid_Usage_Query",
+		20: "Swap_Double_Booked",
+		21: "Invalid_Swap_Request",
 	}
 	ErrorReason_value = map[string]int32{
-		"CCNULogin_Error": 0,
-		"Crawler_Error":   1,
+		"CCNULogin_Error":          0,
+		"Crawler_Error":            1,
+		"Seat_Not_Found":           2,
+		"Favourite_Not_Found":      3,
+		"No_Available_Seat":        4,
+		"Invalid_Reserve_Intent":   5,
+		"Reserve_Intent_Not_Found": 6,
+		"Invalid_Comment":          7,
+		"Comment_Not_Found":        8,
+		"Comment_Forbidden":        9,
+		"Comment_Rate_Limited":     10,
+		"Comment_Blocked":          11,
+		"Reservation_Not_Found":    12,
+		"Swap_Failed":              13,
+		"Invalid_Discussion_Query": 14,
+		"Invalid_Study_Group":      15,
+		"Study_Group_Not_Found":    16,
+		"Invalid_Room":             17,
+		"Room_Not_Found":           18,
+		"Invalid_Usage_Query":      19,
+		"Swap_Double_Booked":       20,
+		"Invalid_Swap_Request":     21,
 	}
 )
 
@@ -73,10 +133,31 @@ var File_library_v1_error_reason_proto protoreflect.FileDescriptor
 const file_library_v1_error_reason_proto_rawDesc = "" +
 	"\n" +
 	"\x1dlibrary/v1/error_reason.proto\x12\n" +
-	"library.v1\x1a\x13errors/errors.proto*;\n" +
+	"library.v1\x1a\x13errors/errors.proto*\x9a\x04\n" +
 	"\vErrorReason\x12\x13\n" +
 	"\x0fCCNULogin_Error\x10\x00\x12\x11\n" +
-	"\rCrawler_Error\x10\x01\x1a\x04\xa0E\xf4\x03BFZDgithub.com/asynccnu/ccnubox-be/be-api/gen/proto/library/v1;libraryv1b\x06proto3"
+	"\rCrawler_Error\x10\x01\x12\x12\n" +
+	"\x0eSeat_Not_Found\x10\x02\x12\x17\n" +
+	"\x13Favourite_Not_Found\x10\x03\x12\x15\n" +
+	"\x11No_Available_Seat\x10\x04\x12\x1a\n" +
+	"\x16Invalid_Reserve_Intent\x10\x05\x12\x1c\n" +
+	"\x18Reserve_Intent_Not_Found\x10\x06\x12\x13\n" +
+	"\x0fInvalid_Comment\x10\a\x12\x15\n" +
+	"\x11Comment_Not_Found\x10\b\x12\x15\n" +
+	"\x11Comment_Forbidden\x10\t\x12\x18\n" +
+	"\x14Comment_Rate_Limited\x10\n" +
+	"\x12\x13\n" +
+	"\x0fComment_Blocked\x10\v\x12\x19\n" +
+	"\x15Reservation_Not_Found\x10\f\x12\x0f\n" +
+	"\vSwap_Failed\x10\r\x12\x1c\n" +
+	"\x18Invalid_Discussion_Query\x10\x0e\x12\x17\n" +
+	"\x13Invalid_Study_Group\x10\x0f\x12\x19\n" +
+	"\x15Study_Group_Not_Found\x10\x10\x12\x10\n" +
+	"\fInvalid_Room\x10\x11\x12\x12\n" +
+	"\x0eRoom_Not_Found\x10\x12\x12\x17\n" +
+	"\x13Invalid_Usage_Query\x10\x13\x12\x16\n" +
+	"\x12Swap_Double_Booked\x10\x14\x12\x18\n" +
+	"\x14Invalid_Swap_Request\x10\x15\x1a\x04\xa0E\xf4\x03BFZDgithub.com/asynccnu/ccnubox-be/be-api/gen/proto/library/v1;libraryv1b\x06proto3"
 
 var (
 	file_library_v1_error_reason_proto_rawDescOnce sync.Once
@@ -34,3 +34,243 @@ func IsCrawlerError(err error) bool {
 func ErrorCrawlerError(format string, args ...interface{}) *errors.Error {
 	return errors.New(500, ErrorReason_Crawler_Error.String(), fmt.Sprintf(format, args...))
 }
+
+func IsSeatNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_Seat_Not_Found.String() && e.Code == 500
+}
+
+func ErrorSeatNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, ErrorReason_Seat_Not_Found.String(), fmt.Sprintf(format, args...))
+}
+
+func IsFavouriteNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_Favourite_Not_Found.String() && e.Code == 500
+}
+
+func ErrorFavouriteNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, ErrorReason_Favourite_Not_Found.String(), fmt.Sprintf(format, args...))
+}
+
+func IsNoAvailableSeat(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_No_Available_Seat.String() && e.Code == 500
+}
+
+func ErrorNoAvailableSeat(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, ErrorReason_No_Available_Seat.String(), fmt.Sprintf(format, args...))
+}
+
+func IsInvalidReserveIntent(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_Invalid_Reserve_Intent.String() && e.Code == 500
+}
+
+func ErrorInvalidReserveIntent(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, ErrorReason_Invalid_Reserve_Intent.String(), fmt.Sprintf(format, args...))
+}
+
+func IsReserveIntentNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_Reserve_Intent_Not_Found.String() && e.Code == 500
+}
+
+func ErrorReserveIntentNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, ErrorReason_Reserve_Intent_Not_Found.String(), fmt.Sprintf(format, args...))
+}
+
+func IsInvalidComment(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_Invalid_Comment.String() && e.Code == 500
+}
+
+func ErrorInvalidComment(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, ErrorReason_Invalid_Comment.String(), fmt.Sprintf(format, args...))
+}
+
+func IsCommentNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_Comment_Not_Found.String() && e.Code == 500
+}
+
+func ErrorCommentNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, ErrorReason_Comment_Not_Found.String(), fmt.Sprintf(format, args...))
+}
+
+func IsCommentForbidden(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_Comment_Forbidden.String() && e.Code == 500
+}
+
+func ErrorCommentForbidden(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, ErrorReason_Comment_Forbidden.String(), fmt.Sprintf(format, args...))
+}
+
+func IsCommentRateLimited(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_Comment_Rate_Limited.String() && e.Code == 500
+}
+
+func ErrorCommentRateLimited(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, ErrorReason_Comment_Rate_Limited.String(), fmt.Sprintf(format, args...))
+}
+
+func IsCommentBlocked(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_Comment_Blocked.String() && e.Code == 500
+}
+
+func ErrorCommentBlocked(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, ErrorReason_Comment_Blocked.String(), fmt.Sprintf(format, args...))
+}
+
+func IsReservationNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_Reservation_Not_Found.String() && e.Code == 500
+}
+
+func ErrorReservationNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, ErrorReason_Reservation_Not_Found.String(), fmt.Sprintf(format, args...))
+}
+
+func IsSwapFailed(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_Swap_Failed.String() && e.Code == 500
+}
+
+func ErrorSwapFailed(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, ErrorReason_Swap_Failed.String(), fmt.Sprintf(format, args...))
+}
+
+func IsInvalidDiscussionQuery(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_Invalid_Discussion_Query.String() && e.Code == 500
+}
+
+func ErrorInvalidDiscussionQuery(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, ErrorReason_Invalid_Discussion_Query.String(), fmt.Sprintf(format, args...))
+}
+
+func IsInvalidStudyGroup(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_Invalid_Study_Group.String() && e.Code == 500
+}
+
+func ErrorInvalidStudyGroup(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, ErrorReason_Invalid_Study_Group.String(), fmt.Sprintf(format, args...))
+}
+
+func IsStudyGroupNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_Study_Group_Not_Found.String() && e.Code == 500
+}
+
+func ErrorStudyGroupNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, ErrorReason_Study_Group_Not_Found.String(), fmt.Sprintf(format, args...))
+}
+
+func IsInvalidRoom(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_Invalid_Room.String() && e.Code == 500
+}
+
+func ErrorInvalidRoom(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, ErrorReason_Invalid_Room.String(), fmt.Sprintf(format, args...))
+}
+
+func IsRoomNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_Room_Not_Found.String() && e.Code == 500
+}
+
+func ErrorRoomNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, ErrorReason_Room_Not_Found.String(), fmt.Sprintf(format, args...))
+}
+
+func IsInvalidUsageQuery(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_Invalid_Usage_Query.String() && e.Code == 500
+}
+
+func ErrorInvalidUsageQuery(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, ErrorReason_Invalid_Usage_Query.String(), fmt.Sprintf(format, args...))
+}
+
+func IsSwapDoubleBooked(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_Swap_Double_Booked.String() && e.Code == 500
+}
+
+func ErrorSwapDoubleBooked(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, ErrorReason_Swap_Double_Booked.String(), fmt.Sprintf(format, args...))
+}
+
+func IsInvalidSwapRequest(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_Invalid_Swap_Request.String() && e.Code == 500
+}
+
+func ErrorInvalidSwapRequest(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, ErrorReason_Invalid_Swap_Request.String(), fmt.Sprintf(format, args...))
+}
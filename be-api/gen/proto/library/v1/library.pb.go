@@ -1569,29 +1569,37 @@ func (x *ReserveDiscussionResponse) GetMessage() string {
 	return ""
 }
 
-// 取消预约
-type CancelReserveRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	StuId         string                 `protobuf:"bytes,2,opt,name=stu_id,json=stuId,proto3" json:"stu_id,omitempty"`
+// 在所有研讨间中查找某天可以预约的空闲时段
+type SearchDiscussionSlotsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	StuId string                 `protobuf:"bytes,1,opt,name=stu_id,json=stuId,proto3" json:"stu_id,omitempty"`
+	// 2006-01-02
+	Date string `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	// 需要的时长,单位分钟
+	Duration int32 `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	// 研讨间类别,为空时使用配置的全部类别
+	ClassIds []string `protobuf:"bytes,4,rep,name=class_ids,json=classIds,proto3" json:"class_ids,omitempty"`
+	// 只查找该时间范围内的时段 HH:MM,为空时使用研讨间的开放时间
+	From          string `protobuf:"bytes,5,opt,name=from,proto3" json:"from,omitempty"`
+	To            string `protobuf:"bytes,6,opt,name=to,proto3" json:"to,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelReserveRequest) Reset() {
-	*x = CancelReserveRequest{}
+func (x *SearchDiscussionSlotsRequest) Reset() {
+	*x = SearchDiscussionSlotsRequest{}
 	mi := &file_library_v1_library_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelReserveRequest) String() string {
+func (x *SearchDiscussionSlotsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelReserveRequest) ProtoMessage() {}
+func (*SearchDiscussionSlotsRequest) ProtoMessage() {}
 
-func (x *CancelReserveRequest) ProtoReflect() protoreflect.Message {
+func (x *SearchDiscussionSlotsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_library_v1_library_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1603,46 +1611,83 @@ func (x *CancelReserveRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelReserveRequest.ProtoReflect.Descriptor instead.
-func (*CancelReserveRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchDiscussionSlotsRequest.ProtoReflect.Descriptor instead.
+func (*SearchDiscussionSlotsRequest) Descriptor() ([]byte, []int) {
 	return file_library_v1_library_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *CancelReserveRequest) GetId() string {
+func (x *SearchDiscussionSlotsRequest) GetStuId() string {
 	if x != nil {
-		return x.Id
+		return x.StuId
 	}
 	return ""
 }
 
-func (x *CancelReserveRequest) GetStuId() string {
+func (x *SearchDiscussionSlotsRequest) GetDate() string {
 	if x != nil {
-		return x.StuId
+		return x.Date
 	}
 	return ""
 }
 
-type CancelReserveResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+func (x *SearchDiscussionSlotsRequest) GetDuration() int32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *SearchDiscussionSlotsRequest) GetClassIds() []string {
+	if x != nil {
+		return x.ClassIds
+	}
+	return nil
+}
+
+func (x *SearchDiscussionSlotsRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *SearchDiscussionSlotsRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+// 研讨间的一段空闲时间,长度不小于查询的时长
+type DiscussionSlot struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	LabId    string                 `protobuf:"bytes,1,opt,name=lab_id,json=labId,proto3" json:"lab_id,omitempty"`
+	LabName  string                 `protobuf:"bytes,2,opt,name=lab_name,json=labName,proto3" json:"lab_name,omitempty"`
+	KindId   string                 `protobuf:"bytes,3,opt,name=kind_id,json=kindId,proto3" json:"kind_id,omitempty"`
+	KindName string                 `protobuf:"bytes,4,opt,name=kind_name,json=kindName,proto3" json:"kind_name,omitempty"`
+	DevId    string                 `protobuf:"bytes,5,opt,name=dev_id,json=devId,proto3" json:"dev_id,omitempty"`
+	DevName  string                 `protobuf:"bytes,6,opt,name=dev_name,json=devName,proto3" json:"dev_name,omitempty"`
+	// 2006-01-02 15:04
+	Start         string `protobuf:"bytes,7,opt,name=start,proto3" json:"start,omitempty"`
+	End           string `protobuf:"bytes,8,opt,name=end,proto3" json:"end,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelReserveResponse) Reset() {
-	*x = CancelReserveResponse{}
+func (x *DiscussionSlot) Reset() {
+	*x = DiscussionSlot{}
 	mi := &file_library_v1_library_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelReserveResponse) String() string {
+func (x *DiscussionSlot) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelReserveResponse) ProtoMessage() {}
+func (*DiscussionSlot) ProtoMessage() {}
 
-func (x *CancelReserveResponse) ProtoReflect() protoreflect.Message {
+func (x *DiscussionSlot) ProtoReflect() protoreflect.Message {
 	mi := &file_library_v1_library_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1654,43 +1699,88 @@ func (x *CancelReserveResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelReserveResponse.ProtoReflect.Descriptor instead.
-func (*CancelReserveResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DiscussionSlot.ProtoReflect.Descriptor instead.
+func (*DiscussionSlot) Descriptor() ([]byte, []int) {
 	return file_library_v1_library_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *CancelReserveResponse) GetMessage() string {
+func (x *DiscussionSlot) GetLabId() string {
 	if x != nil {
-		return x.Message
+		return x.LabId
 	}
 	return ""
 }
 
-// 随机选座
-type ReserveSeatRandomlyRequest struct {
+func (x *DiscussionSlot) GetLabName() string {
+	if x != nil {
+		return x.LabName
+	}
+	return ""
+}
+
+func (x *DiscussionSlot) GetKindId() string {
+	if x != nil {
+		return x.KindId
+	}
+	return ""
+}
+
+func (x *DiscussionSlot) GetKindName() string {
+	if x != nil {
+		return x.KindName
+	}
+	return ""
+}
+
+func (x *DiscussionSlot) GetDevId() string {
+	if x != nil {
+		return x.DevId
+	}
+	return ""
+}
+
+func (x *DiscussionSlot) GetDevName() string {
+	if x != nil {
+		return x.DevName
+	}
+	return ""
+}
+
+func (x *DiscussionSlot) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *DiscussionSlot) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+type SearchDiscussionSlotsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Start         string                 `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
-	End           string                 `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
-	StuId         string                 `protobuf:"bytes,3,opt,name=stu_id,json=stuId,proto3" json:"stu_id,omitempty"`
-	RoomIds       []string               `protobuf:"bytes,4,rep,name=room_ids,json=roomIds,proto3" json:"room_ids,omitempty"`
+	Slots         []*DiscussionSlot      `protobuf:"bytes,1,rep,name=slots,proto3" json:"slots,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReserveSeatRandomlyRequest) Reset() {
-	*x = ReserveSeatRandomlyRequest{}
+func (x *SearchDiscussionSlotsResponse) Reset() {
+	*x = SearchDiscussionSlotsResponse{}
 	mi := &file_library_v1_library_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReserveSeatRandomlyRequest) String() string {
+func (x *SearchDiscussionSlotsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReserveSeatRandomlyRequest) ProtoMessage() {}
+func (*SearchDiscussionSlotsResponse) ProtoMessage() {}
 
-func (x *ReserveSeatRandomlyRequest) ProtoReflect() protoreflect.Message {
+func (x *SearchDiscussionSlotsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_library_v1_library_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1702,61 +1792,3781 @@ func (x *ReserveSeatRandomlyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReserveSeatRandomlyRequest.ProtoReflect.Descriptor instead.
-func (*ReserveSeatRandomlyRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchDiscussionSlotsResponse.ProtoReflect.Descriptor instead.
+func (*SearchDiscussionSlotsResponse) Descriptor() ([]byte, []int) {
 	return file_library_v1_library_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *ReserveSeatRandomlyRequest) GetStart() string {
+func (x *SearchDiscussionSlotsResponse) GetSlots() []*DiscussionSlot {
 	if x != nil {
-		return x.Start
+		return x.Slots
+	}
+	return nil
+}
+
+// 学习小组,保存常用的研讨间成员
+type GroupMember struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	StudentId string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// 预约系统中的账号 id,预约研讨间时使用
+	AccountId     string `protobuf:"bytes,3,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupMember) Reset() {
+	*x = GroupMember{}
+	mi := &file_library_v1_library_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupMember) ProtoMessage() {}
+
+func (x *GroupMember) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupMember.ProtoReflect.Descriptor instead.
+func (*GroupMember) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GroupMember) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
 	}
 	return ""
 }
 
-func (x *ReserveSeatRandomlyRequest) GetEnd() string {
+func (x *GroupMember) GetName() string {
 	if x != nil {
-		return x.End
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ReserveSeatRandomlyRequest) GetStuId() string {
+func (x *GroupMember) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+type StudyGroup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Members       []*GroupMember         `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StudyGroup) Reset() {
+	*x = StudyGroup{}
+	mi := &file_library_v1_library_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StudyGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudyGroup) ProtoMessage() {}
+
+func (x *StudyGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudyGroup.ProtoReflect.Descriptor instead.
+func (*StudyGroup) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *StudyGroup) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StudyGroup) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StudyGroup) GetMembers() []*GroupMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *StudyGroup) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type SaveStudyGroupRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	StuId string                 `protobuf:"bytes,1,opt,name=stu_id,json=stuId,proto3" json:"stu_id,omitempty"`
+	// 为 0 时新建
+	Id   uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// 成员学号,不包括创建者自己
+	MemberIds     []string `protobuf:"bytes,4,rep,name=member_ids,json=memberIds,proto3" json:"member_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveStudyGroupRequest) Reset() {
+	*x = SaveStudyGroupRequest{}
+	mi := &file_library_v1_library_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveStudyGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveStudyGroupRequest) ProtoMessage() {}
+
+func (x *SaveStudyGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveStudyGroupRequest.ProtoReflect.Descriptor instead.
+func (*SaveStudyGroupRequest) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SaveStudyGroupRequest) GetStuId() string {
 	if x != nil {
 		return x.StuId
 	}
 	return ""
 }
 
-func (x *ReserveSeatRandomlyRequest) GetRoomIds() []string {
+func (x *SaveStudyGroupRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SaveStudyGroupRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SaveStudyGroupRequest) GetMemberIds() []string {
+	if x != nil {
+		return x.MemberIds
+	}
+	return nil
+}
+
+type SaveStudyGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         *StudyGroup            `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveStudyGroupResponse) Reset() {
+	*x = SaveStudyGroupResponse{}
+	mi := &file_library_v1_library_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveStudyGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveStudyGroupResponse) ProtoMessage() {}
+
+func (x *SaveStudyGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveStudyGroupResponse.ProtoReflect.Descriptor instead.
+func (*SaveStudyGroupResponse) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SaveStudyGroupResponse) GetGroup() *StudyGroup {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+type DeleteStudyGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StuId         string                 `protobuf:"bytes,1,opt,name=stu_id,json=stuId,proto3" json:"stu_id,omitempty"`
+	Id            uint64                 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteStudyGroupRequest) Reset() {
+	*x = DeleteStudyGroupRequest{}
+	mi := &file_library_v1_library_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteStudyGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteStudyGroupRequest) ProtoMessage() {}
+
+func (x *DeleteStudyGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteStudyGroupRequest.ProtoReflect.Descriptor instead.
+func (*DeleteStudyGroupRequest) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *DeleteStudyGroupRequest) GetStuId() string {
+	if x != nil {
+		return x.StuId
+	}
+	return ""
+}
+
+func (x *DeleteStudyGroupRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListStudyGroupsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StuId         string                 `protobuf:"bytes,1,opt,name=stu_id,json=stuId,proto3" json:"stu_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStudyGroupsRequest) Reset() {
+	*x = ListStudyGroupsRequest{}
+	mi := &file_library_v1_library_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStudyGroupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStudyGroupsRequest) ProtoMessage() {}
+
+func (x *ListStudyGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStudyGroupsRequest.ProtoReflect.Descriptor instead.
+func (*ListStudyGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListStudyGroupsRequest) GetStuId() string {
+	if x != nil {
+		return x.StuId
+	}
+	return ""
+}
+
+type ListStudyGroupsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Groups        []*StudyGroup          `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStudyGroupsResponse) Reset() {
+	*x = ListStudyGroupsResponse{}
+	mi := &file_library_v1_library_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStudyGroupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStudyGroupsResponse) ProtoMessage() {}
+
+func (x *ListStudyGroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStudyGroupsResponse.ProtoReflect.Descriptor instead.
+func (*ListStudyGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListStudyGroupsResponse) GetGroups() []*StudyGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+// 使用学习小组的成员预约研讨间,成功后通过 be-feed 邀请成员
+type ReserveDiscussionForGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StuId         string                 `protobuf:"bytes,1,opt,name=stu_id,json=stuId,proto3" json:"stu_id,omitempty"`
+	GroupId       uint64                 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	DevId         string                 `protobuf:"bytes,3,opt,name=dev_id,json=devId,proto3" json:"dev_id,omitempty"`
+	LabId         string                 `protobuf:"bytes,4,opt,name=lab_id,json=labId,proto3" json:"lab_id,omitempty"`
+	KindId        string                 `protobuf:"bytes,5,opt,name=kind_id,json=kindId,proto3" json:"kind_id,omitempty"`
+	Title         string                 `protobuf:"bytes,6,opt,name=title,proto3" json:"title,omitempty"`
+	Start         string                 `protobuf:"bytes,7,opt,name=start,proto3" json:"start,omitempty"`
+	End           string                 `protobuf:"bytes,8,opt,name=end,proto3" json:"end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveDiscussionForGroupRequest) Reset() {
+	*x = ReserveDiscussionForGroupRequest{}
+	mi := &file_library_v1_library_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveDiscussionForGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveDiscussionForGroupRequest) ProtoMessage() {}
+
+func (x *ReserveDiscussionForGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveDiscussionForGroupRequest.ProtoReflect.Descriptor instead.
+func (*ReserveDiscussionForGroupRequest) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ReserveDiscussionForGroupRequest) GetStuId() string {
+	if x != nil {
+		return x.StuId
+	}
+	return ""
+}
+
+func (x *ReserveDiscussionForGroupRequest) GetGroupId() uint64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *ReserveDiscussionForGroupRequest) GetDevId() string {
+	if x != nil {
+		return x.DevId
+	}
+	return ""
+}
+
+func (x *ReserveDiscussionForGroupRequest) GetLabId() string {
+	if x != nil {
+		return x.LabId
+	}
+	return ""
+}
+
+func (x *ReserveDiscussionForGroupRequest) GetKindId() string {
+	if x != nil {
+		return x.KindId
+	}
+	return ""
+}
+
+func (x *ReserveDiscussionForGroupRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ReserveDiscussionForGroupRequest) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *ReserveDiscussionForGroupRequest) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+type ReserveDiscussionForGroupResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Message string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	// 成功发送邀请的成员数
+	Invited       int32 `protobuf:"varint,2,opt,name=invited,proto3" json:"invited,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveDiscussionForGroupResponse) Reset() {
+	*x = ReserveDiscussionForGroupResponse{}
+	mi := &file_library_v1_library_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveDiscussionForGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveDiscussionForGroupResponse) ProtoMessage() {}
+
+func (x *ReserveDiscussionForGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveDiscussionForGroupResponse.ProtoReflect.Descriptor instead.
+func (*ReserveDiscussionForGroupResponse) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ReserveDiscussionForGroupResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ReserveDiscussionForGroupResponse) GetInvited() int32 {
+	if x != nil {
+		return x.Invited
+	}
+	return 0
+}
+
+// 取消预约
+type CancelReserveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	StuId         string                 `protobuf:"bytes,2,opt,name=stu_id,json=stuId,proto3" json:"stu_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelReserveRequest) Reset() {
+	*x = CancelReserveRequest{}
+	mi := &file_library_v1_library_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelReserveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelReserveRequest) ProtoMessage() {}
+
+func (x *CancelReserveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelReserveRequest.ProtoReflect.Descriptor instead.
+func (*CancelReserveRequest) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CancelReserveRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CancelReserveRequest) GetStuId() string {
+	if x != nil {
+		return x.StuId
+	}
+	return ""
+}
+
+type CancelReserveResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelReserveResponse) Reset() {
+	*x = CancelReserveResponse{}
+	mi := &file_library_v1_library_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelReserveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelReserveResponse) ProtoMessage() {}
+
+func (x *CancelReserveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelReserveResponse.ProtoReflect.Descriptor instead.
+func (*CancelReserveResponse) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CancelReserveResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 随机选座
+type ReserveSeatRandomlyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Start         string                 `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End           string                 `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	StuId         string                 `protobuf:"bytes,3,opt,name=stu_id,json=stuId,proto3" json:"stu_id,omitempty"`
+	RoomIds       []string               `protobuf:"bytes,4,rep,name=room_ids,json=roomIds,proto3" json:"room_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveSeatRandomlyRequest) Reset() {
+	*x = ReserveSeatRandomlyRequest{}
+	mi := &file_library_v1_library_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveSeatRandomlyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveSeatRandomlyRequest) ProtoMessage() {}
+
+func (x *ReserveSeatRandomlyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveSeatRandomlyRequest.ProtoReflect.Descriptor instead.
+func (*ReserveSeatRandomlyRequest) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ReserveSeatRandomlyRequest) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *ReserveSeatRandomlyRequest) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *ReserveSeatRandomlyRequest) GetStuId() string {
+	if x != nil {
+		return x.StuId
+	}
+	return ""
+}
+
+func (x *ReserveSeatRandomlyRequest) GetRoomIds() []string {
+	if x != nil {
+		return x.RoomIds
+	}
+	return nil
+}
+
+type ReserveSeatRandomlyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveSeatRandomlyResponse) Reset() {
+	*x = ReserveSeatRandomlyResponse{}
+	mi := &file_library_v1_library_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveSeatRandomlyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveSeatRandomlyResponse) ProtoMessage() {}
+
+func (x *ReserveSeatRandomlyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveSeatRandomlyResponse.ProtoReflect.Descriptor instead.
+func (*ReserveSeatRandomlyResponse) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ReserveSeatRandomlyResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 换座: 先预约新座位,成功后再取消原预约,失败时保留原预约
+type SwapReservationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	StuId string                 `protobuf:"bytes,1,opt,name=stu_id,json=stuId,proto3" json:"stu_id,omitempty"`
+	// 原预约的 ID
+	OldId string `protobuf:"bytes,2,opt,name=old_id,json=oldId,proto3" json:"old_id,omitempty"`
+	DevId string `protobuf:"bytes,3,opt,name=dev_id,json=devId,proto3" json:"dev_id,omitempty"`
+	// 2006-01-02 15:04
+	Start         string `protobuf:"bytes,4,opt,name=start,proto3" json:"start,omitempty"`
+	End           string `protobuf:"bytes,5,opt,name=end,proto3" json:"end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SwapReservationRequest) Reset() {
+	*x = SwapReservationRequest{}
+	mi := &file_library_v1_library_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SwapReservationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapReservationRequest) ProtoMessage() {}
+
+func (x *SwapReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapReservationRequest.ProtoReflect.Descriptor instead.
+func (*SwapReservationRequest) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SwapReservationRequest) GetStuId() string {
+	if x != nil {
+		return x.StuId
+	}
+	return ""
+}
+
+func (x *SwapReservationRequest) GetOldId() string {
+	if x != nil {
+		return x.OldId
+	}
+	return ""
+}
+
+func (x *SwapReservationRequest) GetDevId() string {
+	if x != nil {
+		return x.DevId
+	}
+	return ""
+}
+
+func (x *SwapReservationRequest) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *SwapReservationRequest) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+type SwapReservationResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Message string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	// 新预约的 ID,未能查到时为空
+	NewId         string `protobuf:"bytes,2,opt,name=new_id,json=newId,proto3" json:"new_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SwapReservationResponse) Reset() {
+	*x = SwapReservationResponse{}
+	mi := &file_library_v1_library_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SwapReservationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapReservationResponse) ProtoMessage() {}
+
+func (x *SwapReservationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapReservationResponse.ProtoReflect.Descriptor instead.
+func (*SwapReservationResponse) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SwapReservationResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SwapReservationResponse) GetNewId() string {
+	if x != nil {
+		return x.NewId
+	}
+	return ""
+}
+
+// 评论
+type Comment struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SeatId    string                 `protobuf:"bytes,2,opt,name=seat_id,json=seatId,proto3" json:"seat_id,omitempty"`
+	Username  string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Content   string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	Rating    int64                  `protobuf:"varint,5,opt,name=rating,proto3" json:"rating,omitempty"`
+	CreatedAt string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// visible 正常展示, pending 被举报过多待审核, hidden 被管理员隐藏
+	Status        string `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	ReportCount   int64  `protobuf:"varint,8,opt,name=report_count,json=reportCount,proto3" json:"report_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Comment) Reset() {
+	*x = Comment{}
+	mi := &file_library_v1_library_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Comment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Comment) ProtoMessage() {}
+
+func (x *Comment) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
+func (*Comment) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *Comment) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Comment) GetSeatId() string {
+	if x != nil {
+		return x.SeatId
+	}
+	return ""
+}
+
+func (x *Comment) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Comment) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Comment) GetRating() int64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *Comment) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Comment) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Comment) GetReportCount() int64 {
+	if x != nil {
+		return x.ReportCount
+	}
+	return 0
+}
+
+type CreateCommentReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SeatId        string                 `protobuf:"bytes,1,opt,name=seat_id,json=seatId,proto3" json:"seat_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	Rating        int64                  `protobuf:"varint,4,opt,name=rating,proto3" json:"rating,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCommentReq) Reset() {
+	*x = CreateCommentReq{}
+	mi := &file_library_v1_library_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCommentReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCommentReq) ProtoMessage() {}
+
+func (x *CreateCommentReq) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCommentReq.ProtoReflect.Descriptor instead.
+func (*CreateCommentReq) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CreateCommentReq) GetSeatId() string {
+	if x != nil {
+		return x.SeatId
+	}
+	return ""
+}
+
+func (x *CreateCommentReq) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *CreateCommentReq) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *CreateCommentReq) GetRating() int64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+type GetCommentResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comment       []*Comment             `protobuf:"bytes,1,rep,name=Comment,proto3" json:"Comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCommentResp) Reset() {
+	*x = GetCommentResp{}
+	mi := &file_library_v1_library_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommentResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommentResp) ProtoMessage() {}
+
+func (x *GetCommentResp) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommentResp.ProtoReflect.Descriptor instead.
+func (*GetCommentResp) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetCommentResp) GetComment() []*Comment {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+// 只能删除自己的评论
+type DeleteCommentReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCommentReq) Reset() {
+	*x = DeleteCommentReq{}
+	mi := &file_library_v1_library_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCommentReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCommentReq) ProtoMessage() {}
+
+func (x *DeleteCommentReq) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCommentReq.ProtoReflect.Descriptor instead.
+func (*DeleteCommentReq) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *DeleteCommentReq) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeleteCommentReq) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type SeatRating struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SeatId        string                 `protobuf:"bytes,1,opt,name=seat_id,json=seatId,proto3" json:"seat_id,omitempty"`
+	Average       float64                `protobuf:"fixed64,2,opt,name=average,proto3" json:"average,omitempty"`
+	Count         int64                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeatRating) Reset() {
+	*x = SeatRating{}
+	mi := &file_library_v1_library_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeatRating) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatRating) ProtoMessage() {}
+
+func (x *SeatRating) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatRating.ProtoReflect.Descriptor instead.
+func (*SeatRating) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SeatRating) GetSeatId() string {
+	if x != nil {
+		return x.SeatId
+	}
+	return ""
+}
+
+func (x *SeatRating) GetAverage() float64 {
+	if x != nil {
+		return x.Average
+	}
+	return 0
+}
+
+func (x *SeatRating) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// 分页获取座位的评论,只返回正常展示的评论
+type ListCommentsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	SeatId string                 `protobuf:"bytes,1,opt,name=seat_id,json=seatId,proto3" json:"seat_id,omitempty"`
+	// 从 1 开始
+	Page          int32 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommentsRequest) Reset() {
+	*x = ListCommentsRequest{}
+	mi := &file_library_v1_library_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommentsRequest) ProtoMessage() {}
+
+func (x *ListCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommentsRequest.ProtoReflect.Descriptor instead.
+func (*ListCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListCommentsRequest) GetSeatId() string {
+	if x != nil {
+		return x.SeatId
+	}
+	return ""
+}
+
+func (x *ListCommentsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListCommentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListCommentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comments      []*Comment             `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Rating        *SeatRating            `protobuf:"bytes,3,opt,name=rating,proto3" json:"rating,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommentsResponse) Reset() {
+	*x = ListCommentsResponse{}
+	mi := &file_library_v1_library_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommentsResponse) ProtoMessage() {}
+
+func (x *ListCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommentsResponse.ProtoReflect.Descriptor instead.
+func (*ListCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ListCommentsResponse) GetComments() []*Comment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *ListCommentsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListCommentsResponse) GetRating() *SeatRating {
+	if x != nil {
+		return x.Rating
+	}
+	return nil
+}
+
+type GetSeatRatingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SeatIds       []string               `protobuf:"bytes,1,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSeatRatingsRequest) Reset() {
+	*x = GetSeatRatingsRequest{}
+	mi := &file_library_v1_library_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSeatRatingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSeatRatingsRequest) ProtoMessage() {}
+
+func (x *GetSeatRatingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSeatRatingsRequest.ProtoReflect.Descriptor instead.
+func (*GetSeatRatingsRequest) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetSeatRatingsRequest) GetSeatIds() []string {
+	if x != nil {
+		return x.SeatIds
+	}
+	return nil
+}
+
+type GetSeatRatingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ratings       []*SeatRating          `protobuf:"bytes,1,rep,name=ratings,proto3" json:"ratings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSeatRatingsResponse) Reset() {
+	*x = GetSeatRatingsResponse{}
+	mi := &file_library_v1_library_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSeatRatingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSeatRatingsResponse) ProtoMessage() {}
+
+func (x *GetSeatRatingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSeatRatingsResponse.ProtoReflect.Descriptor instead.
+func (*GetSeatRatingsResponse) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetSeatRatingsResponse) GetRatings() []*SeatRating {
+	if x != nil {
+		return x.Ratings
+	}
+	return nil
+}
+
+type ReportCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reporter      string                 `protobuf:"bytes,2,opt,name=reporter,proto3" json:"reporter,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportCommentRequest) Reset() {
+	*x = ReportCommentRequest{}
+	mi := &file_library_v1_library_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportCommentRequest) ProtoMessage() {}
+
+func (x *ReportCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportCommentRequest.ProtoReflect.Descriptor instead.
+func (*ReportCommentRequest) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ReportCommentRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReportCommentRequest) GetReporter() string {
+	if x != nil {
+		return x.Reporter
+	}
+	return ""
+}
+
+func (x *ReportCommentRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// 管理员审核队列,包括被举报的和待审核的评论
+type ListReportedCommentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReportedCommentsRequest) Reset() {
+	*x = ListReportedCommentsRequest{}
+	mi := &file_library_v1_library_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReportedCommentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReportedCommentsRequest) ProtoMessage() {}
+
+func (x *ListReportedCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReportedCommentsRequest.ProtoReflect.Descriptor instead.
+func (*ListReportedCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListReportedCommentsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListReportedCommentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListReportedCommentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comments      []*Comment             `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReportedCommentsResponse) Reset() {
+	*x = ListReportedCommentsResponse{}
+	mi := &file_library_v1_library_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReportedCommentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReportedCommentsResponse) ProtoMessage() {}
+
+func (x *ListReportedCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReportedCommentsResponse.ProtoReflect.Descriptor instead.
+func (*ListReportedCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListReportedCommentsResponse) GetComments() []*Comment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *ListReportedCommentsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type ModerateCommentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// hide 隐藏, restore 恢复展示并清空举报, delete 删除
+	Action        string `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerateCommentRequest) Reset() {
+	*x = ModerateCommentRequest{}
+	mi := &file_library_v1_library_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerateCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateCommentRequest) ProtoMessage() {}
+
+func (x *ModerateCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateCommentRequest.ProtoReflect.Descriptor instead.
+func (*ModerateCommentRequest) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ModerateCommentRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ModerateCommentRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+// 通用ID项
+type ID struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ID) Reset() {
+	*x = ID{}
+	mi := &file_library_v1_library_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ID) ProtoMessage() {}
+
+func (x *ID) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ID.ProtoReflect.Descriptor instead.
+func (*ID) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ID) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// 通用消息项
+type Resp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Resp) Reset() {
+	*x = Resp{}
+	mi := &file_library_v1_library_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Resp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Resp) ProtoMessage() {}
+
+func (x *Resp) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Resp.ProtoReflect.Descriptor instead.
+func (*Resp) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *Resp) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 收藏座位
+type FavouriteSeat struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	DevId      string                 `protobuf:"bytes,1,opt,name=dev_id,json=devId,proto3" json:"dev_id,omitempty"`
+	DevName    string                 `protobuf:"bytes,2,opt,name=dev_name,json=devName,proto3" json:"dev_name,omitempty"`
+	RoomId     string                 `protobuf:"bytes,3,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	RoomName   string                 `protobuf:"bytes,4,opt,name=room_name,json=roomName,proto3" json:"room_name,omitempty"`
+	LabName    string                 `protobuf:"bytes,5,opt,name=lab_name,json=labName,proto3" json:"lab_name,omitempty"`
+	CreateTime string                 `protobuf:"bytes,6,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// 查询时间段内是否空闲,来自座位缓存
+	IsAvailable   bool        `protobuf:"varint,7,opt,name=is_available,json=isAvailable,proto3" json:"is_available,omitempty"`
+	Ts            []*TimeSlot `protobuf:"bytes,8,rep,name=ts,proto3" json:"ts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FavouriteSeat) Reset() {
+	*x = FavouriteSeat{}
+	mi := &file_library_v1_library_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FavouriteSeat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FavouriteSeat) ProtoMessage() {}
+
+func (x *FavouriteSeat) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FavouriteSeat.ProtoReflect.Descriptor instead.
+func (*FavouriteSeat) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *FavouriteSeat) GetDevId() string {
+	if x != nil {
+		return x.DevId
+	}
+	return ""
+}
+
+func (x *FavouriteSeat) GetDevName() string {
+	if x != nil {
+		return x.DevName
+	}
+	return ""
+}
+
+func (x *FavouriteSeat) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *FavouriteSeat) GetRoomName() string {
+	if x != nil {
+		return x.RoomName
+	}
+	return ""
+}
+
+func (x *FavouriteSeat) GetLabName() string {
+	if x != nil {
+		return x.LabName
+	}
+	return ""
+}
+
+func (x *FavouriteSeat) GetCreateTime() string {
+	if x != nil {
+		return x.CreateTime
+	}
+	return ""
+}
+
+func (x *FavouriteSeat) GetIsAvailable() bool {
+	if x != nil {
+		return x.IsAvailable
+	}
+	return false
+}
+
+func (x *FavouriteSeat) GetTs() []*TimeSlot {
+	if x != nil {
+		return x.Ts
+	}
+	return nil
+}
+
+type AddFavouriteRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	StuId string                 `protobuf:"bytes,1,opt,name=stu_id,json=stuId,proto3" json:"stu_id,omitempty"`
+	DevId string                 `protobuf:"bytes,2,opt,name=dev_id,json=devId,proto3" json:"dev_id,omitempty"`
+	// 可选,为空时在全部房间中查找该座位
+	RoomId        string `protobuf:"bytes,3,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddFavouriteRequest) Reset() {
+	*x = AddFavouriteRequest{}
+	mi := &file_library_v1_library_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddFavouriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddFavouriteRequest) ProtoMessage() {}
+
+func (x *AddFavouriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddFavouriteRequest.ProtoReflect.Descriptor instead.
+func (*AddFavouriteRequest) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *AddFavouriteRequest) GetStuId() string {
+	if x != nil {
+		return x.StuId
+	}
+	return ""
+}
+
+func (x *AddFavouriteRequest) GetDevId() string {
+	if x != nil {
+		return x.DevId
+	}
+	return ""
+}
+
+func (x *AddFavouriteRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+type RemoveFavouriteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StuId         string                 `protobuf:"bytes,1,opt,name=stu_id,json=stuId,proto3" json:"stu_id,omitempty"`
+	DevId         string                 `protobuf:"bytes,2,opt,name=dev_id,json=devId,proto3" json:"dev_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFavouriteRequest) Reset() {
+	*x = RemoveFavouriteRequest{}
+	mi := &file_library_v1_library_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFavouriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFavouriteRequest) ProtoMessage() {}
+
+func (x *RemoveFavouriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFavouriteRequest.ProtoReflect.Descriptor instead.
+func (*RemoveFavouriteRequest) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *RemoveFavouriteRequest) GetStuId() string {
+	if x != nil {
+		return x.StuId
+	}
+	return ""
+}
+
+func (x *RemoveFavouriteRequest) GetDevId() string {
+	if x != nil {
+		return x.DevId
+	}
+	return ""
+}
+
+type ListFavouritesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	StuId string                 `protobuf:"bytes,1,opt,name=stu_id,json=stuId,proto3" json:"stu_id,omitempty"`
+	// 可选,格式 2006-01-02 15:04,为空时查询当前时刻是否空闲
+	Start         string `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End           string `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFavouritesRequest) Reset() {
+	*x = ListFavouritesRequest{}
+	mi := &file_library_v1_library_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFavouritesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFavouritesRequest) ProtoMessage() {}
+
+func (x *ListFavouritesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFavouritesRequest.ProtoReflect.Descriptor instead.
+func (*ListFavouritesRequest) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ListFavouritesRequest) GetStuId() string {
+	if x != nil {
+		return x.StuId
+	}
+	return ""
+}
+
+func (x *ListFavouritesRequest) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *ListFavouritesRequest) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+type ListFavouritesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Seats         []*FavouriteSeat       `protobuf:"bytes,1,rep,name=seats,proto3" json:"seats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFavouritesResponse) Reset() {
+	*x = ListFavouritesResponse{}
+	mi := &file_library_v1_library_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFavouritesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFavouritesResponse) ProtoMessage() {}
+
+func (x *ListFavouritesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFavouritesResponse.ProtoReflect.Descriptor instead.
+func (*ListFavouritesResponse) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ListFavouritesResponse) GetSeats() []*FavouriteSeat {
+	if x != nil {
+		return x.Seats
+	}
+	return nil
+}
+
+type ReserveFavouriteRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	StuId string                 `protobuf:"bytes,1,opt,name=stu_id,json=stuId,proto3" json:"stu_id,omitempty"`
+	Start string                 `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End   string                 `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	// 可选,指定收藏的座位,为空时按收藏顺序依次尝试
+	DevId string `protobuf:"bytes,4,opt,name=dev_id,json=devId,proto3" json:"dev_id,omitempty"`
+	// 收藏座位都被占用时,是否预约同一房间内最近的空闲座位
+	FallbackNeighbour bool `protobuf:"varint,5,opt,name=fallback_neighbour,json=fallbackNeighbour,proto3" json:"fallback_neighbour,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ReserveFavouriteRequest) Reset() {
+	*x = ReserveFavouriteRequest{}
+	mi := &file_library_v1_library_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveFavouriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveFavouriteRequest) ProtoMessage() {}
+
+func (x *ReserveFavouriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveFavouriteRequest.ProtoReflect.Descriptor instead.
+func (*ReserveFavouriteRequest) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ReserveFavouriteRequest) GetStuId() string {
+	if x != nil {
+		return x.StuId
+	}
+	return ""
+}
+
+func (x *ReserveFavouriteRequest) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *ReserveFavouriteRequest) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *ReserveFavouriteRequest) GetDevId() string {
+	if x != nil {
+		return x.DevId
+	}
+	return ""
+}
+
+func (x *ReserveFavouriteRequest) GetFallbackNeighbour() bool {
+	if x != nil {
+		return x.FallbackNeighbour
+	}
+	return false
+}
+
+type ReserveFavouriteResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Message string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	DevId   string                 `protobuf:"bytes,2,opt,name=dev_id,json=devId,proto3" json:"dev_id,omitempty"`
+	DevName string                 `protobuf:"bytes,3,opt,name=dev_name,json=devName,proto3" json:"dev_name,omitempty"`
+	RoomId  string                 `protobuf:"bytes,4,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	// 是否为收藏座位的邻座
+	IsNeighbour   bool `protobuf:"varint,5,opt,name=is_neighbour,json=isNeighbour,proto3" json:"is_neighbour,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveFavouriteResponse) Reset() {
+	*x = ReserveFavouriteResponse{}
+	mi := &file_library_v1_library_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveFavouriteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveFavouriteResponse) ProtoMessage() {}
+
+func (x *ReserveFavouriteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveFavouriteResponse.ProtoReflect.Descriptor instead.
+func (*ReserveFavouriteResponse) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ReserveFavouriteResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ReserveFavouriteResponse) GetDevId() string {
+	if x != nil {
+		return x.DevId
+	}
+	return ""
+}
+
+func (x *ReserveFavouriteResponse) GetDevName() string {
+	if x != nil {
+		return x.DevName
+	}
+	return ""
+}
+
+func (x *ReserveFavouriteResponse) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *ReserveFavouriteResponse) GetIsNeighbour() bool {
+	if x != nil {
+		return x.IsNeighbour
+	}
+	return false
+}
+
+// 自动预约意向,在图书馆开放预约的时刻自动执行
+type ReserveIntent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 1-7 对应周一到周日
+	Weekdays []int32 `protobuf:"varint,2,rep,packed,name=weekdays,proto3" json:"weekdays,omitempty"`
+	// HH:MM
+	Start string `protobuf:"bytes,3,opt,name=start,proto3" json:"start,omitempty"`
+	End   string `protobuf:"bytes,4,opt,name=end,proto3" json:"end,omitempty"`
+	// 优先房间,按优先级排序
+	RoomIds []string `protobuf:"bytes,5,rep,name=room_ids,json=roomIds,proto3" json:"room_ids,omitempty"`
+	// 优先座位 devID,按优先级排序
+	SeatIds       []string `protobuf:"bytes,6,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	Enabled       bool     `protobuf:"varint,7,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	LastRunDate   string   `protobuf:"bytes,8,opt,name=last_run_date,json=lastRunDate,proto3" json:"last_run_date,omitempty"`
+	LastResult    string   `protobuf:"bytes,9,opt,name=last_result,json=lastResult,proto3" json:"last_result,omitempty"`
+	LastSeat      string   `protobuf:"bytes,10,opt,name=last_seat,json=lastSeat,proto3" json:"last_seat,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveIntent) Reset() {
+	*x = ReserveIntent{}
+	mi := &file_library_v1_library_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveIntent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveIntent) ProtoMessage() {}
+
+func (x *ReserveIntent) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveIntent.ProtoReflect.Descriptor instead.
+func (*ReserveIntent) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ReserveIntent) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReserveIntent) GetWeekdays() []int32 {
+	if x != nil {
+		return x.Weekdays
+	}
+	return nil
+}
+
+func (x *ReserveIntent) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *ReserveIntent) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *ReserveIntent) GetRoomIds() []string {
+	if x != nil {
+		return x.RoomIds
+	}
+	return nil
+}
+
+func (x *ReserveIntent) GetSeatIds() []string {
+	if x != nil {
+		return x.SeatIds
+	}
+	return nil
+}
+
+func (x *ReserveIntent) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *ReserveIntent) GetLastRunDate() string {
+	if x != nil {
+		return x.LastRunDate
+	}
+	return ""
+}
+
+func (x *ReserveIntent) GetLastResult() string {
+	if x != nil {
+		return x.LastResult
+	}
+	return ""
+}
+
+func (x *ReserveIntent) GetLastSeat() string {
+	if x != nil {
+		return x.LastSeat
+	}
+	return ""
+}
+
+type SaveReserveIntentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	StuId string                 `protobuf:"bytes,1,opt,name=stu_id,json=stuId,proto3" json:"stu_id,omitempty"`
+	// id 为 0 时新建
+	Intent        *ReserveIntent `protobuf:"bytes,2,opt,name=intent,proto3" json:"intent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveReserveIntentRequest) Reset() {
+	*x = SaveReserveIntentRequest{}
+	mi := &file_library_v1_library_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveReserveIntentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveReserveIntentRequest) ProtoMessage() {}
+
+func (x *SaveReserveIntentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveReserveIntentRequest.ProtoReflect.Descriptor instead.
+func (*SaveReserveIntentRequest) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *SaveReserveIntentRequest) GetStuId() string {
+	if x != nil {
+		return x.StuId
+	}
+	return ""
+}
+
+func (x *SaveReserveIntentRequest) GetIntent() *ReserveIntent {
+	if x != nil {
+		return x.Intent
+	}
+	return nil
+}
+
+type SaveReserveIntentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Intent        *ReserveIntent         `protobuf:"bytes,1,opt,name=intent,proto3" json:"intent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveReserveIntentResponse) Reset() {
+	*x = SaveReserveIntentResponse{}
+	mi := &file_library_v1_library_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveReserveIntentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveReserveIntentResponse) ProtoMessage() {}
+
+func (x *SaveReserveIntentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveReserveIntentResponse.ProtoReflect.Descriptor instead.
+func (*SaveReserveIntentResponse) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *SaveReserveIntentResponse) GetIntent() *ReserveIntent {
+	if x != nil {
+		return x.Intent
+	}
+	return nil
+}
+
+type DeleteReserveIntentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StuId         string                 `protobuf:"bytes,1,opt,name=stu_id,json=stuId,proto3" json:"stu_id,omitempty"`
+	Id            uint64                 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteReserveIntentRequest) Reset() {
+	*x = DeleteReserveIntentRequest{}
+	mi := &file_library_v1_library_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteReserveIntentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReserveIntentRequest) ProtoMessage() {}
+
+func (x *DeleteReserveIntentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReserveIntentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteReserveIntentRequest) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *DeleteReserveIntentRequest) GetStuId() string {
+	if x != nil {
+		return x.StuId
+	}
+	return ""
+}
+
+func (x *DeleteReserveIntentRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListReserveIntentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StuId         string                 `protobuf:"bytes,1,opt,name=stu_id,json=stuId,proto3" json:"stu_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReserveIntentsRequest) Reset() {
+	*x = ListReserveIntentsRequest{}
+	mi := &file_library_v1_library_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReserveIntentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReserveIntentsRequest) ProtoMessage() {}
+
+func (x *ListReserveIntentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReserveIntentsRequest.ProtoReflect.Descriptor instead.
+func (*ListReserveIntentsRequest) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ListReserveIntentsRequest) GetStuId() string {
+	if x != nil {
+		return x.StuId
+	}
+	return ""
+}
+
+type ListReserveIntentsResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Intents []*ReserveIntent       `protobuf:"bytes,1,rep,name=intents,proto3" json:"intents,omitempty"`
+	// 每天开放预约的时刻 HH:MM
+	OpenTime string `protobuf:"bytes,2,opt,name=open_time,json=openTime,proto3" json:"open_time,omitempty"`
+	// 开放预约的日期距今天的天数
+	DaysAhead     int32 `protobuf:"varint,3,opt,name=days_ahead,json=daysAhead,proto3" json:"days_ahead,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReserveIntentsResponse) Reset() {
+	*x = ListReserveIntentsResponse{}
+	mi := &file_library_v1_library_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReserveIntentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReserveIntentsResponse) ProtoMessage() {}
+
+func (x *ListReserveIntentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReserveIntentsResponse.ProtoReflect.Descriptor instead.
+func (*ListReserveIntentsResponse) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ListReserveIntentsResponse) GetIntents() []*ReserveIntent {
+	if x != nil {
+		return x.Intents
+	}
+	return nil
+}
+
+func (x *ListReserveIntentsResponse) GetOpenTime() string {
+	if x != nil {
+		return x.OpenTime
+	}
+	return ""
+}
+
+func (x *ListReserveIntentsResponse) GetDaysAhead() int32 {
+	if x != nil {
+		return x.DaysAhead
+	}
+	return 0
+}
+
+// 座位占用统计
+type SeatStatistics struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Total int64                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	// 当前空闲且之后没有预约
+	Available int64 `protobuf:"varint,2,opt,name=available,proto3" json:"available,omitempty"`
+	// 当前空闲但之后有预约
+	Partial int64 `protobuf:"varint,3,opt,name=partial,proto3" json:"partial,omitempty"`
+	// 当前被占用
+	Busy int64 `protobuf:"varint,4,opt,name=busy,proto3" json:"busy,omitempty"`
+	// busy / total
+	UsageRate     float64 `protobuf:"fixed64,5,opt,name=usage_rate,json=usageRate,proto3" json:"usage_rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeatStatistics) Reset() {
+	*x = SeatStatistics{}
+	mi := &file_library_v1_library_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeatStatistics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatStatistics) ProtoMessage() {}
+
+func (x *SeatStatistics) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatStatistics.ProtoReflect.Descriptor instead.
+func (*SeatStatistics) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *SeatStatistics) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SeatStatistics) GetAvailable() int64 {
+	if x != nil {
+		return x.Available
+	}
+	return 0
+}
+
+func (x *SeatStatistics) GetPartial() int64 {
+	if x != nil {
+		return x.Partial
+	}
+	return 0
+}
+
+func (x *SeatStatistics) GetBusy() int64 {
+	if x != nil {
+		return x.Busy
+	}
+	return 0
+}
+
+func (x *SeatStatistics) GetUsageRate() float64 {
+	if x != nil {
+		return x.UsageRate
+	}
+	return 0
+}
+
+type RoomOccupancy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	RoomName      string                 `protobuf:"bytes,2,opt,name=room_name,json=roomName,proto3" json:"room_name,omitempty"`
+	LabName       string                 `protobuf:"bytes,3,opt,name=lab_name,json=labName,proto3" json:"lab_name,omitempty"`
+	Stat          *SeatStatistics        `protobuf:"bytes,4,opt,name=stat,proto3" json:"stat,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoomOccupancy) Reset() {
+	*x = RoomOccupancy{}
+	mi := &file_library_v1_library_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoomOccupancy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomOccupancy) ProtoMessage() {}
+
+func (x *RoomOccupancy) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomOccupancy.ProtoReflect.Descriptor instead.
+func (*RoomOccupancy) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *RoomOccupancy) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *RoomOccupancy) GetRoomName() string {
+	if x != nil {
+		return x.RoomName
+	}
+	return ""
+}
+
+func (x *RoomOccupancy) GetLabName() string {
+	if x != nil {
+		return x.LabName
+	}
+	return ""
+}
+
+func (x *RoomOccupancy) GetStat() *SeatStatistics {
+	if x != nil {
+		return x.Stat
+	}
+	return nil
+}
+
+type FloorOccupancy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LabName       string                 `protobuf:"bytes,1,opt,name=lab_name,json=labName,proto3" json:"lab_name,omitempty"`
+	Stat          *SeatStatistics        `protobuf:"bytes,2,opt,name=stat,proto3" json:"stat,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FloorOccupancy) Reset() {
+	*x = FloorOccupancy{}
+	mi := &file_library_v1_library_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FloorOccupancy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FloorOccupancy) ProtoMessage() {}
+
+func (x *FloorOccupancy) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FloorOccupancy.ProtoReflect.Descriptor instead.
+func (*FloorOccupancy) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *FloorOccupancy) GetLabName() string {
+	if x != nil {
+		return x.LabName
+	}
+	return ""
+}
+
+func (x *FloorOccupancy) GetStat() *SeatStatistics {
+	if x != nil {
+		return x.Stat
+	}
+	return nil
+}
+
+type GetOccupancyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	StuId string                 `protobuf:"bytes,1,opt,name=stu_id,json=stuId,proto3" json:"stu_id,omitempty"`
+	// 为空时统计全部房间
+	RoomIds       []string `protobuf:"bytes,2,rep,name=room_ids,json=roomIds,proto3" json:"room_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOccupancyRequest) Reset() {
+	*x = GetOccupancyRequest{}
+	mi := &file_library_v1_library_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOccupancyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOccupancyRequest) ProtoMessage() {}
+
+func (x *GetOccupancyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOccupancyRequest.ProtoReflect.Descriptor instead.
+func (*GetOccupancyRequest) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *GetOccupancyRequest) GetStuId() string {
+	if x != nil {
+		return x.StuId
+	}
+	return ""
+}
+
+func (x *GetOccupancyRequest) GetRoomIds() []string {
+	if x != nil {
+		return x.RoomIds
+	}
+	return nil
+}
+
+type GetOccupancyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rooms         []*RoomOccupancy       `protobuf:"bytes,1,rep,name=rooms,proto3" json:"rooms,omitempty"`
+	Floors        []*FloorOccupancy      `protobuf:"bytes,2,rep,name=floors,proto3" json:"floors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOccupancyResponse) Reset() {
+	*x = GetOccupancyResponse{}
+	mi := &file_library_v1_library_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOccupancyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOccupancyResponse) ProtoMessage() {}
+
+func (x *GetOccupancyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOccupancyResponse.ProtoReflect.Descriptor instead.
+func (*GetOccupancyResponse) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *GetOccupancyResponse) GetRooms() []*RoomOccupancy {
+	if x != nil {
+		return x.Rooms
+	}
+	return nil
+}
+
+func (x *GetOccupancyResponse) GetFloors() []*FloorOccupancy {
+	if x != nil {
+		return x.Floors
+	}
+	return nil
+}
+
+type GetOccupancyHeatmapRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 都为空时统计整个图书馆
+	RoomId  string `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	LabName string `protobuf:"bytes,2,opt,name=lab_name,json=labName,proto3" json:"lab_name,omitempty"`
+	// 统计最近几周,默认 4
+	Weeks         int32 `protobuf:"varint,3,opt,name=weeks,proto3" json:"weeks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOccupancyHeatmapRequest) Reset() {
+	*x = GetOccupancyHeatmapRequest{}
+	mi := &file_library_v1_library_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOccupancyHeatmapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOccupancyHeatmapRequest) ProtoMessage() {}
+
+func (x *GetOccupancyHeatmapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOccupancyHeatmapRequest.ProtoReflect.Descriptor instead.
+func (*GetOccupancyHeatmapRequest) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *GetOccupancyHeatmapRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *GetOccupancyHeatmapRequest) GetLabName() string {
+	if x != nil {
+		return x.LabName
+	}
+	return ""
+}
+
+func (x *GetOccupancyHeatmapRequest) GetWeeks() int32 {
+	if x != nil {
+		return x.Weeks
+	}
+	return 0
+}
+
+type HeatmapCell struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 1-7 对应周一到周日
+	Weekday int32 `protobuf:"varint,1,opt,name=weekday,proto3" json:"weekday,omitempty"`
+	// 0-23
+	Hour          int32   `protobuf:"varint,2,opt,name=hour,proto3" json:"hour,omitempty"`
+	UsageRate     float64 `protobuf:"fixed64,3,opt,name=usage_rate,json=usageRate,proto3" json:"usage_rate,omitempty"`
+	Samples       int64   `protobuf:"varint,4,opt,name=samples,proto3" json:"samples,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HeatmapCell) Reset() {
+	*x = HeatmapCell{}
+	mi := &file_library_v1_library_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HeatmapCell) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeatmapCell) ProtoMessage() {}
+
+func (x *HeatmapCell) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeatmapCell.ProtoReflect.Descriptor instead.
+func (*HeatmapCell) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *HeatmapCell) GetWeekday() int32 {
+	if x != nil {
+		return x.Weekday
+	}
+	return 0
+}
+
+func (x *HeatmapCell) GetHour() int32 {
+	if x != nil {
+		return x.Hour
+	}
+	return 0
+}
+
+func (x *HeatmapCell) GetUsageRate() float64 {
+	if x != nil {
+		return x.UsageRate
+	}
+	return 0
+}
+
+func (x *HeatmapCell) GetSamples() int64 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
+
+type GetOccupancyHeatmapResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cells         []*HeatmapCell         `protobuf:"bytes,1,rep,name=cells,proto3" json:"cells,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOccupancyHeatmapResponse) Reset() {
+	*x = GetOccupancyHeatmapResponse{}
+	mi := &file_library_v1_library_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOccupancyHeatmapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOccupancyHeatmapResponse) ProtoMessage() {}
+
+func (x *GetOccupancyHeatmapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOccupancyHeatmapResponse.ProtoReflect.Descriptor instead.
+func (*GetOccupancyHeatmapResponse) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GetOccupancyHeatmapResponse) GetCells() []*HeatmapCell {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
+type GetLeastBusyRoomRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	StuId string                 `protobuf:"bytes,1,opt,name=stu_id,json=stuId,proto3" json:"stu_id,omitempty"`
+	// 为空时在全部房间中选择
+	RoomIds       []string `protobuf:"bytes,2,rep,name=room_ids,json=roomIds,proto3" json:"room_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLeastBusyRoomRequest) Reset() {
+	*x = GetLeastBusyRoomRequest{}
+	mi := &file_library_v1_library_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLeastBusyRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeastBusyRoomRequest) ProtoMessage() {}
+
+func (x *GetLeastBusyRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeastBusyRoomRequest.ProtoReflect.Descriptor instead.
+func (*GetLeastBusyRoomRequest) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *GetLeastBusyRoomRequest) GetStuId() string {
+	if x != nil {
+		return x.StuId
+	}
+	return ""
+}
+
+func (x *GetLeastBusyRoomRequest) GetRoomIds() []string {
+	if x != nil {
+		return x.RoomIds
+	}
+	return nil
+}
+
+type GetLeastBusyRoomResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Room          *RoomOccupancy         `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLeastBusyRoomResponse) Reset() {
+	*x = GetLeastBusyRoomResponse{}
+	mi := &file_library_v1_library_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLeastBusyRoomResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeastBusyRoomResponse) ProtoMessage() {}
+
+func (x *GetLeastBusyRoomResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeastBusyRoomResponse.ProtoReflect.Descriptor instead.
+func (*GetLeastBusyRoomResponse) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *GetLeastBusyRoomResponse) GetRoom() *RoomOccupancy {
+	if x != nil {
+		return x.Room
+	}
+	return nil
+}
+
+// 房间目录,座位缓存刷新和默认的房间列表都来自这里
+type Room struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// 主馆图书馆一楼
+	LabName string `protobuf:"bytes,3,opt,name=lab_name,json=labName,proto3" json:"lab_name,omitempty"`
+	// 主馆、南湖分馆
+	Branch string `protobuf:"bytes,4,opt,name=branch,proto3" json:"branch,omitempty"`
+	Floor  int32  `protobuf:"varint,5,opt,name=floor,proto3" json:"floor,omitempty"`
+	// HH:MM
+	OpenTime  string `protobuf:"bytes,6,opt,name=open_time,json=openTime,proto3" json:"open_time,omitempty"`
+	CloseTime string `protobuf:"bytes,7,opt,name=close_time,json=closeTime,proto3" json:"close_time,omitempty"`
+	SeatCount int32  `protobuf:"varint,8,opt,name=seat_count,json=seatCount,proto3" json:"seat_count,omitempty"`
+	// 关闭的房间不会出现在默认房间列表中,也不会刷新座位缓存
+	Bookable      bool   `protobuf:"varint,9,opt,name=bookable,proto3" json:"bookable,omitempty"`
+	UpdatedAt     string `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Room) Reset() {
+	*x = Room{}
+	mi := &file_library_v1_library_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Room) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Room) ProtoMessage() {}
+
+func (x *Room) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Room.ProtoReflect.Descriptor instead.
+func (*Room) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *Room) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Room) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Room) GetLabName() string {
+	if x != nil {
+		return x.LabName
+	}
+	return ""
+}
+
+func (x *Room) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+func (x *Room) GetFloor() int32 {
+	if x != nil {
+		return x.Floor
+	}
+	return 0
+}
+
+func (x *Room) GetOpenTime() string {
+	if x != nil {
+		return x.OpenTime
+	}
+	return ""
+}
+
+func (x *Room) GetCloseTime() string {
+	if x != nil {
+		return x.CloseTime
+	}
+	return ""
+}
+
+func (x *Room) GetSeatCount() int32 {
+	if x != nil {
+		return x.SeatCount
+	}
+	return 0
+}
+
+func (x *Room) GetBookable() bool {
+	if x != nil {
+		return x.Bookable
+	}
+	return false
+}
+
+func (x *Room) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type ListRoomsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 是否包括已关闭的房间
+	IncludeClosed bool `protobuf:"varint,1,opt,name=include_closed,json=includeClosed,proto3" json:"include_closed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRoomsRequest) Reset() {
+	*x = ListRoomsRequest{}
+	mi := &file_library_v1_library_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRoomsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoomsRequest) ProtoMessage() {}
+
+func (x *ListRoomsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoomsRequest.ProtoReflect.Descriptor instead.
+func (*ListRoomsRequest) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ListRoomsRequest) GetIncludeClosed() bool {
+	if x != nil {
+		return x.IncludeClosed
+	}
+	return false
+}
+
+type ListRoomsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rooms         []*Room                `protobuf:"bytes,1,rep,name=rooms,proto3" json:"rooms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRoomsResponse) Reset() {
+	*x = ListRoomsResponse{}
+	mi := &file_library_v1_library_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRoomsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoomsResponse) ProtoMessage() {}
+
+func (x *ListRoomsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoomsResponse.ProtoReflect.Descriptor instead.
+func (*ListRoomsResponse) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ListRoomsResponse) GetRooms() []*Room {
+	if x != nil {
+		return x.Rooms
+	}
+	return nil
+}
+
+type SaveRoomRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Room          *Room                  `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveRoomRequest) Reset() {
+	*x = SaveRoomRequest{}
+	mi := &file_library_v1_library_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveRoomRequest) ProtoMessage() {}
+
+func (x *SaveRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveRoomRequest.ProtoReflect.Descriptor instead.
+func (*SaveRoomRequest) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *SaveRoomRequest) GetRoom() *Room {
+	if x != nil {
+		return x.Room
+	}
+	return nil
+}
+
+type SaveRoomResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Room          *Room                  `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveRoomResponse) Reset() {
+	*x = SaveRoomResponse{}
+	mi := &file_library_v1_library_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveRoomResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveRoomResponse) ProtoMessage() {}
+
+func (x *SaveRoomResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveRoomResponse.ProtoReflect.Descriptor instead.
+func (*SaveRoomResponse) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *SaveRoomResponse) GetRoom() *Room {
+	if x != nil {
+		return x.Room
+	}
+	return nil
+}
+
+type DeleteRoomRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRoomRequest) Reset() {
+	*x = DeleteRoomRequest{}
+	mi := &file_library_v1_library_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRoomRequest) ProtoMessage() {}
+
+func (x *DeleteRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRoomRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRoomRequest) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *DeleteRoomRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// 从图书馆系统发现新房间并更新已有房间的座位数
+type DiscoverRoomsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 用于访问图书馆系统的学号
+	StuId         string `protobuf:"bytes,1,opt,name=stu_id,json=stuId,proto3" json:"stu_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiscoverRoomsRequest) Reset() {
+	*x = DiscoverRoomsRequest{}
+	mi := &file_library_v1_library_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiscoverRoomsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscoverRoomsRequest) ProtoMessage() {}
+
+func (x *DiscoverRoomsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscoverRoomsRequest.ProtoReflect.Descriptor instead.
+func (*DiscoverRoomsRequest) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *DiscoverRoomsRequest) GetStuId() string {
+	if x != nil {
+		return x.StuId
+	}
+	return ""
+}
+
+type DiscoverRoomsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 新增的房间
+	Added []*Room `protobuf:"bytes,1,rep,name=added,proto3" json:"added,omitempty"`
+	// 图书馆系统中的房间总数
+	Total         int32 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiscoverRoomsResponse) Reset() {
+	*x = DiscoverRoomsResponse{}
+	mi := &file_library_v1_library_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiscoverRoomsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscoverRoomsResponse) ProtoMessage() {}
+
+func (x *DiscoverRoomsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscoverRoomsResponse.ProtoReflect.Descriptor instead.
+func (*DiscoverRoomsResponse) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *DiscoverRoomsResponse) GetAdded() []*Room {
+	if x != nil {
+		return x.Added
+	}
+	return nil
+}
+
+func (x *DiscoverRoomsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 个人使用统计,根据库中保存的历史记录计算,不会重新爬取
+type GetUsageAnalyticsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	StuId string                 `protobuf:"bytes,1,opt,name=stu_id,json=stuId,proto3" json:"stu_id,omitempty"`
+	// 统计最近几周,默认 8,最多 26
+	Weeks int32 `protobuf:"varint,2,opt,name=weeks,proto3" json:"weeks,omitempty"`
+	// 统计最近几个月,默认 6,最多 12
+	Months int32 `protobuf:"varint,3,opt,name=months,proto3" json:"months,omitempty"`
+	// 学期回顾的起止日期 2006-01-02,为空时使用当前学期
+	SemesterStart string `protobuf:"bytes,4,opt,name=semester_start,json=semesterStart,proto3" json:"semester_start,omitempty"`
+	SemesterEnd   string `protobuf:"bytes,5,opt,name=semester_end,json=semesterEnd,proto3" json:"semester_end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsageAnalyticsRequest) Reset() {
+	*x = GetUsageAnalyticsRequest{}
+	mi := &file_library_v1_library_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsageAnalyticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageAnalyticsRequest) ProtoMessage() {}
+
+func (x *GetUsageAnalyticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageAnalyticsRequest.ProtoReflect.Descriptor instead.
+func (*GetUsageAnalyticsRequest) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *GetUsageAnalyticsRequest) GetStuId() string {
+	if x != nil {
+		return x.StuId
+	}
+	return ""
+}
+
+func (x *GetUsageAnalyticsRequest) GetWeeks() int32 {
+	if x != nil {
+		return x.Weeks
+	}
+	return 0
+}
+
+func (x *GetUsageAnalyticsRequest) GetMonths() int32 {
+	if x != nil {
+		return x.Months
+	}
+	return 0
+}
+
+func (x *GetUsageAnalyticsRequest) GetSemesterStart() string {
+	if x != nil {
+		return x.SemesterStart
+	}
+	return ""
+}
+
+func (x *GetUsageAnalyticsRequest) GetSemesterEnd() string {
+	if x != nil {
+		return x.SemesterEnd
+	}
+	return ""
+}
+
+type UsagePeriod struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 2025-W36 或 2025-09
+	Period        string  `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
+	Hours         float64 `protobuf:"fixed64,2,opt,name=hours,proto3" json:"hours,omitempty"`
+	Sessions      int32   `protobuf:"varint,3,opt,name=sessions,proto3" json:"sessions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UsagePeriod) Reset() {
+	*x = UsagePeriod{}
+	mi := &file_library_v1_library_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UsagePeriod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsagePeriod) ProtoMessage() {}
+
+func (x *UsagePeriod) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsagePeriod.ProtoReflect.Descriptor instead.
+func (*UsagePeriod) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *UsagePeriod) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *UsagePeriod) GetHours() float64 {
+	if x != nil {
+		return x.Hours
+	}
+	return 0
+}
+
+func (x *UsagePeriod) GetSessions() int32 {
 	if x != nil {
-		return x.RoomIds
+		return x.Sessions
 	}
-	return nil
+	return 0
 }
 
-type ReserveSeatRandomlyResponse struct {
+type FavouriteRoom struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Room          string                 `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	Floor         string                 `protobuf:"bytes,2,opt,name=floor,proto3" json:"floor,omitempty"`
+	Hours         float64                `protobuf:"fixed64,3,opt,name=hours,proto3" json:"hours,omitempty"`
+	Sessions      int32                  `protobuf:"varint,4,opt,name=sessions,proto3" json:"sessions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReserveSeatRandomlyResponse) Reset() {
-	*x = ReserveSeatRandomlyResponse{}
-	mi := &file_library_v1_library_proto_msgTypes[28]
+func (x *FavouriteRoom) Reset() {
+	*x = FavouriteRoom{}
+	mi := &file_library_v1_library_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReserveSeatRandomlyResponse) String() string {
+func (x *FavouriteRoom) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReserveSeatRandomlyResponse) ProtoMessage() {}
+func (*FavouriteRoom) ProtoMessage() {}
 
-func (x *ReserveSeatRandomlyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_library_v1_library_proto_msgTypes[28]
+func (x *FavouriteRoom) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1767,46 +5577,68 @@ func (x *ReserveSeatRandomlyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReserveSeatRandomlyResponse.ProtoReflect.Descriptor instead.
-func (*ReserveSeatRandomlyResponse) Descriptor() ([]byte, []int) {
-	return file_library_v1_library_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use FavouriteRoom.ProtoReflect.Descriptor instead.
+func (*FavouriteRoom) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *ReserveSeatRandomlyResponse) GetMessage() string {
+func (x *FavouriteRoom) GetRoom() string {
 	if x != nil {
-		return x.Message
+		return x.Room
 	}
 	return ""
 }
 
-// 评论
-type Comment struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	SeatId        string                 `protobuf:"bytes,2,opt,name=seat_id,json=seatId,proto3" json:"seat_id,omitempty"`
-	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
-	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
-	Rating        int64                  `protobuf:"varint,5,opt,name=rating,proto3" json:"rating,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+func (x *FavouriteRoom) GetFloor() string {
+	if x != nil {
+		return x.Floor
+	}
+	return ""
+}
+
+func (x *FavouriteRoom) GetHours() float64 {
+	if x != nil {
+		return x.Hours
+	}
+	return 0
+}
+
+func (x *FavouriteRoom) GetSessions() int32 {
+	if x != nil {
+		return x.Sessions
+	}
+	return 0
+}
+
+type AttendanceStat struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	OnTime    int32                  `protobuf:"varint,1,opt,name=on_time,json=onTime,proto3" json:"on_time,omitempty"`
+	Late      int32                  `protobuf:"varint,2,opt,name=late,proto3" json:"late,omitempty"`
+	NoShow    int32                  `protobuf:"varint,3,opt,name=no_show,json=noShow,proto3" json:"no_show,omitempty"`
+	Cancelled int32                  `protobuf:"varint,4,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+	// 按签到、迟到、违约三类计算,不包括取消的预约
+	OnTimeRate    float64 `protobuf:"fixed64,5,opt,name=on_time_rate,json=onTimeRate,proto3" json:"on_time_rate,omitempty"`
+	LateRate      float64 `protobuf:"fixed64,6,opt,name=late_rate,json=lateRate,proto3" json:"late_rate,omitempty"`
+	NoShowRate    float64 `protobuf:"fixed64,7,opt,name=no_show_rate,json=noShowRate,proto3" json:"no_show_rate,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Comment) Reset() {
-	*x = Comment{}
-	mi := &file_library_v1_library_proto_msgTypes[29]
+func (x *AttendanceStat) Reset() {
+	*x = AttendanceStat{}
+	mi := &file_library_v1_library_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Comment) String() string {
+func (x *AttendanceStat) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Comment) ProtoMessage() {}
+func (*AttendanceStat) ProtoMessage() {}
 
-func (x *Comment) ProtoReflect() protoreflect.Message {
-	mi := &file_library_v1_library_proto_msgTypes[29]
+func (x *AttendanceStat) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1817,78 +5649,86 @@ func (x *Comment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
-func (*Comment) Descriptor() ([]byte, []int) {
-	return file_library_v1_library_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use AttendanceStat.ProtoReflect.Descriptor instead.
+func (*AttendanceStat) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *Comment) GetId() int64 {
+func (x *AttendanceStat) GetOnTime() int32 {
 	if x != nil {
-		return x.Id
+		return x.OnTime
 	}
 	return 0
 }
 
-func (x *Comment) GetSeatId() string {
+func (x *AttendanceStat) GetLate() int32 {
 	if x != nil {
-		return x.SeatId
+		return x.Late
 	}
-	return ""
+	return 0
 }
 
-func (x *Comment) GetUsername() string {
+func (x *AttendanceStat) GetNoShow() int32 {
 	if x != nil {
-		return x.Username
+		return x.NoShow
 	}
-	return ""
+	return 0
 }
 
-func (x *Comment) GetContent() string {
+func (x *AttendanceStat) GetCancelled() int32 {
 	if x != nil {
-		return x.Content
+		return x.Cancelled
 	}
-	return ""
+	return 0
 }
 
-func (x *Comment) GetRating() int64 {
+func (x *AttendanceStat) GetOnTimeRate() float64 {
 	if x != nil {
-		return x.Rating
+		return x.OnTimeRate
 	}
 	return 0
 }
 
-func (x *Comment) GetCreatedAt() string {
+func (x *AttendanceStat) GetLateRate() float64 {
 	if x != nil {
-		return x.CreatedAt
+		return x.LateRate
 	}
-	return ""
+	return 0
 }
 
-type CreateCommentReq struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SeatId        string                 `protobuf:"bytes,1,opt,name=seat_id,json=seatId,proto3" json:"seat_id,omitempty"`
-	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
-	Rating        int64                  `protobuf:"varint,4,opt,name=rating,proto3" json:"rating,omitempty"`
+func (x *AttendanceStat) GetNoShowRate() float64 {
+	if x != nil {
+		return x.NoShowRate
+	}
+	return 0
+}
+
+type StudyStreak struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 截至今天(或昨天)连续学习的天数
+	Current       int32  `protobuf:"varint,1,opt,name=current,proto3" json:"current,omitempty"`
+	Longest       int32  `protobuf:"varint,2,opt,name=longest,proto3" json:"longest,omitempty"`
+	LongestStart  string `protobuf:"bytes,3,opt,name=longest_start,json=longestStart,proto3" json:"longest_start,omitempty"`
+	LongestEnd    string `protobuf:"bytes,4,opt,name=longest_end,json=longestEnd,proto3" json:"longest_end,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateCommentReq) Reset() {
-	*x = CreateCommentReq{}
-	mi := &file_library_v1_library_proto_msgTypes[30]
+func (x *StudyStreak) Reset() {
+	*x = StudyStreak{}
+	mi := &file_library_v1_library_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCommentReq) String() string {
+func (x *StudyStreak) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCommentReq) ProtoMessage() {}
+func (*StudyStreak) ProtoMessage() {}
 
-func (x *CreateCommentReq) ProtoReflect() protoreflect.Message {
-	mi := &file_library_v1_library_proto_msgTypes[30]
+func (x *StudyStreak) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1899,61 +5739,72 @@ func (x *CreateCommentReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCommentReq.ProtoReflect.Descriptor instead.
-func (*CreateCommentReq) Descriptor() ([]byte, []int) {
-	return file_library_v1_library_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use StudyStreak.ProtoReflect.Descriptor instead.
+func (*StudyStreak) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *CreateCommentReq) GetSeatId() string {
+func (x *StudyStreak) GetCurrent() int32 {
 	if x != nil {
-		return x.SeatId
+		return x.Current
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateCommentReq) GetUsername() string {
+func (x *StudyStreak) GetLongest() int32 {
 	if x != nil {
-		return x.Username
+		return x.Longest
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateCommentReq) GetContent() string {
+func (x *StudyStreak) GetLongestStart() string {
 	if x != nil {
-		return x.Content
+		return x.LongestStart
 	}
 	return ""
 }
 
-func (x *CreateCommentReq) GetRating() int64 {
+func (x *StudyStreak) GetLongestEnd() string {
 	if x != nil {
-		return x.Rating
+		return x.LongestEnd
 	}
-	return 0
+	return ""
 }
 
-type GetCommentResp struct {
+type SemesterReview struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Comment       []*Comment             `protobuf:"bytes,1,rep,name=Comment,proto3" json:"Comment,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetCommentResp) Reset() {
-	*x = GetCommentResp{}
-	mi := &file_library_v1_library_proto_msgTypes[31]
+	SemesterStart string                 `protobuf:"bytes,1,opt,name=semester_start,json=semesterStart,proto3" json:"semester_start,omitempty"`
+	SemesterEnd   string                 `protobuf:"bytes,2,opt,name=semester_end,json=semesterEnd,proto3" json:"semester_end,omitempty"`
+	TotalHours    float64                `protobuf:"fixed64,3,opt,name=total_hours,json=totalHours,proto3" json:"total_hours,omitempty"`
+	Sessions      int32                  `protobuf:"varint,4,opt,name=sessions,proto3" json:"sessions,omitempty"`
+	StudyDays     int32                  `protobuf:"varint,5,opt,name=study_days,json=studyDays,proto3" json:"study_days,omitempty"`
+	TopRoom       string                 `protobuf:"bytes,6,opt,name=top_room,json=topRoom,proto3" json:"top_room,omitempty"`
+	// 1-7 对应周一到周日,没有记录时为 0
+	BusiestWeekday  int32           `protobuf:"varint,7,opt,name=busiest_weekday,json=busiestWeekday,proto3" json:"busiest_weekday,omitempty"`
+	BusiestDay      string          `protobuf:"bytes,8,opt,name=busiest_day,json=busiestDay,proto3" json:"busiest_day,omitempty"`
+	BusiestDayHours float64         `protobuf:"fixed64,9,opt,name=busiest_day_hours,json=busiestDayHours,proto3" json:"busiest_day_hours,omitempty"`
+	LongestStreak   int32           `protobuf:"varint,10,opt,name=longest_streak,json=longestStreak,proto3" json:"longest_streak,omitempty"`
+	Attendance      *AttendanceStat `protobuf:"bytes,11,opt,name=attendance,proto3" json:"attendance,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SemesterReview) Reset() {
+	*x = SemesterReview{}
+	mi := &file_library_v1_library_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCommentResp) String() string {
+func (x *SemesterReview) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCommentResp) ProtoMessage() {}
+func (*SemesterReview) ProtoMessage() {}
 
-func (x *GetCommentResp) ProtoReflect() protoreflect.Message {
-	mi := &file_library_v1_library_proto_msgTypes[31]
+func (x *SemesterReview) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1964,86 +5815,115 @@ func (x *GetCommentResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCommentResp.ProtoReflect.Descriptor instead.
-func (*GetCommentResp) Descriptor() ([]byte, []int) {
-	return file_library_v1_library_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use SemesterReview.ProtoReflect.Descriptor instead.
+func (*SemesterReview) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *GetCommentResp) GetComment() []*Comment {
+func (x *SemesterReview) GetSemesterStart() string {
 	if x != nil {
-		return x.Comment
+		return x.SemesterStart
 	}
-	return nil
+	return ""
 }
 
-// 通用ID项
-type ID struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *SemesterReview) GetSemesterEnd() string {
+	if x != nil {
+		return x.SemesterEnd
+	}
+	return ""
 }
 
-func (x *ID) Reset() {
-	*x = ID{}
-	mi := &file_library_v1_library_proto_msgTypes[32]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *SemesterReview) GetTotalHours() float64 {
+	if x != nil {
+		return x.TotalHours
+	}
+	return 0
 }
 
-func (x *ID) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SemesterReview) GetSessions() int32 {
+	if x != nil {
+		return x.Sessions
+	}
+	return 0
 }
 
-func (*ID) ProtoMessage() {}
+func (x *SemesterReview) GetStudyDays() int32 {
+	if x != nil {
+		return x.StudyDays
+	}
+	return 0
+}
 
-func (x *ID) ProtoReflect() protoreflect.Message {
-	mi := &file_library_v1_library_proto_msgTypes[32]
+func (x *SemesterReview) GetTopRoom() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.TopRoom
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ID.ProtoReflect.Descriptor instead.
-func (*ID) Descriptor() ([]byte, []int) {
-	return file_library_v1_library_proto_rawDescGZIP(), []int{32}
+func (x *SemesterReview) GetBusiestWeekday() int32 {
+	if x != nil {
+		return x.BusiestWeekday
+	}
+	return 0
 }
 
-func (x *ID) GetId() int64 {
+func (x *SemesterReview) GetBusiestDay() string {
 	if x != nil {
-		return x.Id
+		return x.BusiestDay
+	}
+	return ""
+}
+
+func (x *SemesterReview) GetBusiestDayHours() float64 {
+	if x != nil {
+		return x.BusiestDayHours
 	}
 	return 0
 }
 
-// 通用消息项
-type Resp struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *SemesterReview) GetLongestStreak() int32 {
+	if x != nil {
+		return x.LongestStreak
+	}
+	return 0
 }
 
-func (x *Resp) Reset() {
-	*x = Resp{}
-	mi := &file_library_v1_library_proto_msgTypes[33]
+func (x *SemesterReview) GetAttendance() *AttendanceStat {
+	if x != nil {
+		return x.Attendance
+	}
+	return nil
+}
+
+type GetUsageAnalyticsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Weekly         []*UsagePeriod         `protobuf:"bytes,1,rep,name=weekly,proto3" json:"weekly,omitempty"`
+	Monthly        []*UsagePeriod         `protobuf:"bytes,2,rep,name=monthly,proto3" json:"monthly,omitempty"`
+	FavouriteRooms []*FavouriteRoom       `protobuf:"bytes,3,rep,name=favourite_rooms,json=favouriteRooms,proto3" json:"favourite_rooms,omitempty"`
+	Attendance     *AttendanceStat        `protobuf:"bytes,4,opt,name=attendance,proto3" json:"attendance,omitempty"`
+	Streak         *StudyStreak           `protobuf:"bytes,5,opt,name=streak,proto3" json:"streak,omitempty"`
+	Review         *SemesterReview        `protobuf:"bytes,6,opt,name=review,proto3" json:"review,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetUsageAnalyticsResponse) Reset() {
+	*x = GetUsageAnalyticsResponse{}
+	mi := &file_library_v1_library_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Resp) String() string {
+func (x *GetUsageAnalyticsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Resp) ProtoMessage() {}
+func (*GetUsageAnalyticsResponse) ProtoMessage() {}
 
-func (x *Resp) ProtoReflect() protoreflect.Message {
-	mi := &file_library_v1_library_proto_msgTypes[33]
+func (x *GetUsageAnalyticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_library_v1_library_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2054,16 +5934,51 @@ func (x *Resp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Resp.ProtoReflect.Descriptor instead.
-func (*Resp) Descriptor() ([]byte, []int) {
-	return file_library_v1_library_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use GetUsageAnalyticsResponse.ProtoReflect.Descriptor instead.
+func (*GetUsageAnalyticsResponse) Descriptor() ([]byte, []int) {
+	return file_library_v1_library_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *Resp) GetMessage() string {
+func (x *GetUsageAnalyticsResponse) GetWeekly() []*UsagePeriod {
 	if x != nil {
-		return x.Message
+		return x.Weekly
 	}
-	return ""
+	return nil
+}
+
+func (x *GetUsageAnalyticsResponse) GetMonthly() []*UsagePeriod {
+	if x != nil {
+		return x.Monthly
+	}
+	return nil
+}
+
+func (x *GetUsageAnalyticsResponse) GetFavouriteRooms() []*FavouriteRoom {
+	if x != nil {
+		return x.FavouriteRooms
+	}
+	return nil
+}
+
+func (x *GetUsageAnalyticsResponse) GetAttendance() *AttendanceStat {
+	if x != nil {
+		return x.Attendance
+	}
+	return nil
+}
+
+func (x *GetUsageAnalyticsResponse) GetStreak() *StudyStreak {
+	if x != nil {
+		return x.Streak
+	}
+	return nil
+}
+
+func (x *GetUsageAnalyticsResponse) GetReview() *SemesterReview {
+	if x != nil {
+		return x.Review
+	}
+	return nil
 }
 
 var File_library_v1_library_proto protoreflect.FileDescriptor
@@ -2182,7 +6097,65 @@ const file_library_v1_library_proto_rawDesc = "" +
 	"\x04list\x18\a \x03(\tR\x04list\x12\x15\n" +
 	"\x06stu_id\x18\b \x01(\tR\x05stuId\"5\n" +
 	"\x19ReserveDiscussionResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\"=\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\xa6\x01\n" +
+	"\x1cSearchDiscussionSlotsRequest\x12\x15\n" +
+	"\x06stu_id\x18\x01 \x01(\tR\x05stuId\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12\x1a\n" +
+	"\bduration\x18\x03 \x01(\x05R\bduration\x12\x1b\n" +
+	"\tclass_ids\x18\x04 \x03(\tR\bclassIds\x12\x12\n" +
+	"\x04from\x18\x05 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x06 \x01(\tR\x02to\"\xd2\x01\n" +
+	"\x0eDiscussionSlot\x12\x15\n" +
+	"\x06lab_id\x18\x01 \x01(\tR\x05labId\x12\x19\n" +
+	"\blab_name\x18\x02 \x01(\tR\alabName\x12\x17\n" +
+	"\akind_id\x18\x03 \x01(\tR\x06kindId\x12\x1b\n" +
+	"\tkind_name\x18\x04 \x01(\tR\bkindName\x12\x15\n" +
+	"\x06dev_id\x18\x05 \x01(\tR\x05devId\x12\x19\n" +
+	"\bdev_name\x18\x06 \x01(\tR\adevName\x12\x14\n" +
+	"\x05start\x18\a \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\b \x01(\tR\x03end\"Q\n" +
+	"\x1dSearchDiscussionSlotsResponse\x120\n" +
+	"\x05slots\x18\x01 \x03(\v2\x1a.library.v1.DiscussionSlotR\x05slots\"_\n" +
+	"\vGroupMember\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x01 \x01(\tR\tstudentId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x03 \x01(\tR\taccountId\"\x82\x01\n" +
+	"\n" +
+	"StudyGroup\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x121\n" +
+	"\amembers\x18\x03 \x03(\v2\x17.library.v1.GroupMemberR\amembers\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\tR\tcreatedAt\"q\n" +
+	"\x15SaveStudyGroupRequest\x12\x15\n" +
+	"\x06stu_id\x18\x01 \x01(\tR\x05stuId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x04R\x02id\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"member_ids\x18\x04 \x03(\tR\tmemberIds\"F\n" +
+	"\x16SaveStudyGroupResponse\x12,\n" +
+	"\x05group\x18\x01 \x01(\v2\x16.library.v1.StudyGroupR\x05group\"@\n" +
+	"\x17DeleteStudyGroupRequest\x12\x15\n" +
+	"\x06stu_id\x18\x01 \x01(\tR\x05stuId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x04R\x02id\"/\n" +
+	"\x16ListStudyGroupsRequest\x12\x15\n" +
+	"\x06stu_id\x18\x01 \x01(\tR\x05stuId\"I\n" +
+	"\x17ListStudyGroupsResponse\x12.\n" +
+	"\x06groups\x18\x01 \x03(\v2\x16.library.v1.StudyGroupR\x06groups\"\xd9\x01\n" +
+	" ReserveDiscussionForGroupRequest\x12\x15\n" +
+	"\x06stu_id\x18\x01 \x01(\tR\x05stuId\x12\x19\n" +
+	"\bgroup_id\x18\x02 \x01(\x04R\agroupId\x12\x15\n" +
+	"\x06dev_id\x18\x03 \x01(\tR\x05devId\x12\x15\n" +
+	"\x06lab_id\x18\x04 \x01(\tR\x05labId\x12\x17\n" +
+	"\akind_id\x18\x05 \x01(\tR\x06kindId\x12\x14\n" +
+	"\x05title\x18\x06 \x01(\tR\x05title\x12\x14\n" +
+	"\x05start\x18\a \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\b \x01(\tR\x03end\"W\n" +
+	"!ReserveDiscussionForGroupResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x18\n" +
+	"\ainvited\x18\x02 \x01(\x05R\ainvited\"=\n" +
 	"\x14CancelReserveRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x15\n" +
 	"\x06stu_id\x18\x02 \x01(\tR\x05stuId\"1\n" +
@@ -2194,7 +6167,16 @@ const file_library_v1_library_proto_rawDesc = "" +
 	"\x06stu_id\x18\x03 \x01(\tR\x05stuId\x12\x19\n" +
 	"\broom_ids\x18\x04 \x03(\tR\aroomIds\"7\n" +
 	"\x1bReserveSeatRandomlyResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\"\x9f\x01\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\x85\x01\n" +
+	"\x16SwapReservationRequest\x12\x15\n" +
+	"\x06stu_id\x18\x01 \x01(\tR\x05stuId\x12\x15\n" +
+	"\x06old_id\x18\x02 \x01(\tR\x05oldId\x12\x15\n" +
+	"\x06dev_id\x18\x03 \x01(\tR\x05devId\x12\x14\n" +
+	"\x05start\x18\x04 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\x05 \x01(\tR\x03end\"J\n" +
+	"\x17SwapReservationResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x15\n" +
+	"\x06new_id\x18\x02 \x01(\tR\x05newId\"\xda\x01\n" +
 	"\aComment\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
 	"\aseat_id\x18\x02 \x01(\tR\x06seatId\x12\x1a\n" +
@@ -2202,18 +6184,242 @@ const file_library_v1_library_proto_rawDesc = "" +
 	"\acontent\x18\x04 \x01(\tR\acontent\x12\x16\n" +
 	"\x06rating\x18\x05 \x01(\x03R\x06rating\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\x06 \x01(\tR\tcreatedAt\"y\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12!\n" +
+	"\freport_count\x18\b \x01(\x03R\vreportCount\"y\n" +
 	"\x10CreateCommentReq\x12\x17\n" +
 	"\aseat_id\x18\x01 \x01(\tR\x06seatId\x12\x1a\n" +
 	"\busername\x18\x02 \x01(\tR\busername\x12\x18\n" +
 	"\acontent\x18\x03 \x01(\tR\acontent\x12\x16\n" +
 	"\x06rating\x18\x04 \x01(\x03R\x06rating\"?\n" +
 	"\x0eGetCommentResp\x12-\n" +
-	"\aComment\x18\x01 \x03(\v2\x13.library.v1.CommentR\aComment\"\x14\n" +
+	"\aComment\x18\x01 \x03(\v2\x13.library.v1.CommentR\aComment\">\n" +
+	"\x10DeleteCommentReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\"U\n" +
+	"\n" +
+	"SeatRating\x12\x17\n" +
+	"\aseat_id\x18\x01 \x01(\tR\x06seatId\x12\x18\n" +
+	"\aaverage\x18\x02 \x01(\x01R\aaverage\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x03R\x05count\"_\n" +
+	"\x13ListCommentsRequest\x12\x17\n" +
+	"\aseat_id\x18\x01 \x01(\tR\x06seatId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"\x8d\x01\n" +
+	"\x14ListCommentsResponse\x12/\n" +
+	"\bcomments\x18\x01 \x03(\v2\x13.library.v1.CommentR\bcomments\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\x12.\n" +
+	"\x06rating\x18\x03 \x01(\v2\x16.library.v1.SeatRatingR\x06rating\"2\n" +
+	"\x15GetSeatRatingsRequest\x12\x19\n" +
+	"\bseat_ids\x18\x01 \x03(\tR\aseatIds\"J\n" +
+	"\x16GetSeatRatingsResponse\x120\n" +
+	"\aratings\x18\x01 \x03(\v2\x16.library.v1.SeatRatingR\aratings\"Z\n" +
+	"\x14ReportCommentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1a\n" +
+	"\breporter\x18\x02 \x01(\tR\breporter\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"N\n" +
+	"\x1bListReportedCommentsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"e\n" +
+	"\x1cListReportedCommentsResponse\x12/\n" +
+	"\bcomments\x18\x01 \x03(\v2\x13.library.v1.CommentR\bcomments\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"@\n" +
+	"\x16ModerateCommentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\"\x14\n" +
 	"\x02ID\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\" \n" +
 	"\x04Resp\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage2\x8b\b\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\xfc\x01\n" +
+	"\rFavouriteSeat\x12\x15\n" +
+	"\x06dev_id\x18\x01 \x01(\tR\x05devId\x12\x19\n" +
+	"\bdev_name\x18\x02 \x01(\tR\adevName\x12\x17\n" +
+	"\aroom_id\x18\x03 \x01(\tR\x06roomId\x12\x1b\n" +
+	"\troom_name\x18\x04 \x01(\tR\broomName\x12\x19\n" +
+	"\blab_name\x18\x05 \x01(\tR\alabName\x12\x1f\n" +
+	"\vcreate_time\x18\x06 \x01(\tR\n" +
+	"createTime\x12!\n" +
+	"\fis_available\x18\a \x01(\bR\visAvailable\x12$\n" +
+	"\x02ts\x18\b \x03(\v2\x14.library.v1.TimeSlotR\x02ts\"\\\n" +
+	"\x13AddFavouriteRequest\x12\x15\n" +
+	"\x06stu_id\x18\x01 \x01(\tR\x05stuId\x12\x15\n" +
+	"\x06dev_id\x18\x02 \x01(\tR\x05devId\x12\x17\n" +
+	"\aroom_id\x18\x03 \x01(\tR\x06roomId\"F\n" +
+	"\x16RemoveFavouriteRequest\x12\x15\n" +
+	"\x06stu_id\x18\x01 \x01(\tR\x05stuId\x12\x15\n" +
+	"\x06dev_id\x18\x02 \x01(\tR\x05devId\"V\n" +
+	"\x15ListFavouritesRequest\x12\x15\n" +
+	"\x06stu_id\x18\x01 \x01(\tR\x05stuId\x12\x14\n" +
+	"\x05start\x18\x02 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\x03 \x01(\tR\x03end\"I\n" +
+	"\x16ListFavouritesResponse\x12/\n" +
+	"\x05seats\x18\x01 \x03(\v2\x19.library.v1.FavouriteSeatR\x05seats\"\x9e\x01\n" +
+	"\x17ReserveFavouriteRequest\x12\x15\n" +
+	"\x06stu_id\x18\x01 \x01(\tR\x05stuId\x12\x14\n" +
+	"\x05start\x18\x02 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\x03 \x01(\tR\x03end\x12\x15\n" +
+	"\x06dev_id\x18\x04 \x01(\tR\x05devId\x12-\n" +
+	"\x12fallback_neighbour\x18\x05 \x01(\bR\x11fallbackNeighbour\"\xa2\x01\n" +
+	"\x18ReserveFavouriteResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x15\n" +
+	"\x06dev_id\x18\x02 \x01(\tR\x05devId\x12\x19\n" +
+	"\bdev_name\x18\x03 \x01(\tR\adevName\x12\x17\n" +
+	"\aroom_id\x18\x04 \x01(\tR\x06roomId\x12!\n" +
+	"\fis_neighbour\x18\x05 \x01(\bR\visNeighbour\"\x95\x02\n" +
+	"\rReserveIntent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1a\n" +
+	"\bweekdays\x18\x02 \x03(\x05R\bweekdays\x12\x14\n" +
+	"\x05start\x18\x03 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\x04 \x01(\tR\x03end\x12\x19\n" +
+	"\broom_ids\x18\x05 \x03(\tR\aroomIds\x12\x19\n" +
+	"\bseat_ids\x18\x06 \x03(\tR\aseatIds\x12\x18\n" +
+	"\aenabled\x18\a \x01(\bR\aenabled\x12\"\n" +
+	"\rlast_run_date\x18\b \x01(\tR\vlastRunDate\x12\x1f\n" +
+	"\vlast_result\x18\t \x01(\tR\n" +
+	"lastResult\x12\x1b\n" +
+	"\tlast_seat\x18\n" +
+	" \x01(\tR\blastSeat\"d\n" +
+	"\x18SaveReserveIntentRequest\x12\x15\n" +
+	"\x06stu_id\x18\x01 \x01(\tR\x05stuId\x121\n" +
+	"\x06intent\x18\x02 \x01(\v2\x19.library.v1.ReserveIntentR\x06intent\"N\n" +
+	"\x19SaveReserveIntentResponse\x121\n" +
+	"\x06intent\x18\x01 \x01(\v2\x19.library.v1.ReserveIntentR\x06intent\"C\n" +
+	"\x1aDeleteReserveIntentRequest\x12\x15\n" +
+	"\x06stu_id\x18\x01 \x01(\tR\x05stuId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x04R\x02id\"2\n" +
+	"\x19ListReserveIntentsRequest\x12\x15\n" +
+	"\x06stu_id\x18\x01 \x01(\tR\x05stuId\"\x8d\x01\n" +
+	"\x1aListReserveIntentsResponse\x123\n" +
+	"\aintents\x18\x01 \x03(\v2\x19.library.v1.ReserveIntentR\aintents\x12\x1b\n" +
+	"\topen_time\x18\x02 \x01(\tR\bopenTime\x12\x1d\n" +
+	"\n" +
+	"days_ahead\x18\x03 \x01(\x05R\tdaysAhead\"\x91\x01\n" +
+	"\x0eSeatStatistics\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x03R\x05total\x12\x1c\n" +
+	"\tavailable\x18\x02 \x01(\x03R\tavailable\x12\x18\n" +
+	"\apartial\x18\x03 \x01(\x03R\apartial\x12\x12\n" +
+	"\x04busy\x18\x04 \x01(\x03R\x04busy\x12\x1d\n" +
+	"\n" +
+	"usage_rate\x18\x05 \x01(\x01R\tusageRate\"\x90\x01\n" +
+	"\rRoomOccupancy\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12\x1b\n" +
+	"\troom_name\x18\x02 \x01(\tR\broomName\x12\x19\n" +
+	"\blab_name\x18\x03 \x01(\tR\alabName\x12.\n" +
+	"\x04stat\x18\x04 \x01(\v2\x1a.library.v1.SeatStatisticsR\x04stat\"[\n" +
+	"\x0eFloorOccupancy\x12\x19\n" +
+	"\blab_name\x18\x01 \x01(\tR\alabName\x12.\n" +
+	"\x04stat\x18\x02 \x01(\v2\x1a.library.v1.SeatStatisticsR\x04stat\"G\n" +
+	"\x13GetOccupancyRequest\x12\x15\n" +
+	"\x06stu_id\x18\x01 \x01(\tR\x05stuId\x12\x19\n" +
+	"\broom_ids\x18\x02 \x03(\tR\aroomIds\"{\n" +
+	"\x14GetOccupancyResponse\x12/\n" +
+	"\x05rooms\x18\x01 \x03(\v2\x19.library.v1.RoomOccupancyR\x05rooms\x122\n" +
+	"\x06floors\x18\x02 \x03(\v2\x1a.library.v1.FloorOccupancyR\x06floors\"f\n" +
+	"\x1aGetOccupancyHeatmapRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12\x19\n" +
+	"\blab_name\x18\x02 \x01(\tR\alabName\x12\x14\n" +
+	"\x05weeks\x18\x03 \x01(\x05R\x05weeks\"t\n" +
+	"\vHeatmapCell\x12\x18\n" +
+	"\aweekday\x18\x01 \x01(\x05R\aweekday\x12\x12\n" +
+	"\x04hour\x18\x02 \x01(\x05R\x04hour\x12\x1d\n" +
+	"\n" +
+	"usage_rate\x18\x03 \x01(\x01R\tusageRate\x12\x18\n" +
+	"\asamples\x18\x04 \x01(\x03R\asamples\"L\n" +
+	"\x1bGetOccupancyHeatmapResponse\x12-\n" +
+	"\x05cells\x18\x01 \x03(\v2\x17.library.v1.HeatmapCellR\x05cells\"K\n" +
+	"\x17GetLeastBusyRoomRequest\x12\x15\n" +
+	"\x06stu_id\x18\x01 \x01(\tR\x05stuId\x12\x19\n" +
+	"\broom_ids\x18\x02 \x03(\tR\aroomIds\"I\n" +
+	"\x18GetLeastBusyRoomResponse\x12-\n" +
+	"\x04room\x18\x01 \x01(\v2\x19.library.v1.RoomOccupancyR\x04room\"\x89\x02\n" +
+	"\x04Room\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x19\n" +
+	"\blab_name\x18\x03 \x01(\tR\alabName\x12\x16\n" +
+	"\x06branch\x18\x04 \x01(\tR\x06branch\x12\x14\n" +
+	"\x05floor\x18\x05 \x01(\x05R\x05floor\x12\x1b\n" +
+	"\topen_time\x18\x06 \x01(\tR\bopenTime\x12\x1d\n" +
+	"\n" +
+	"close_time\x18\a \x01(\tR\tcloseTime\x12\x1d\n" +
+	"\n" +
+	"seat_count\x18\b \x01(\x05R\tseatCount\x12\x1a\n" +
+	"\bbookable\x18\t \x01(\bR\bbookable\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\tR\tupdatedAt\"9\n" +
+	"\x10ListRoomsRequest\x12%\n" +
+	"\x0einclude_closed\x18\x01 \x01(\bR\rincludeClosed\";\n" +
+	"\x11ListRoomsResponse\x12&\n" +
+	"\x05rooms\x18\x01 \x03(\v2\x10.library.v1.RoomR\x05rooms\"7\n" +
+	"\x0fSaveRoomRequest\x12$\n" +
+	"\x04room\x18\x01 \x01(\v2\x10.library.v1.RoomR\x04room\"8\n" +
+	"\x10SaveRoomResponse\x12$\n" +
+	"\x04room\x18\x01 \x01(\v2\x10.library.v1.RoomR\x04room\"#\n" +
+	"\x11DeleteRoomRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"-\n" +
+	"\x14DiscoverRoomsRequest\x12\x15\n" +
+	"\x06stu_id\x18\x01 \x01(\tR\x05stuId\"U\n" +
+	"\x15DiscoverRoomsResponse\x12&\n" +
+	"\x05added\x18\x01 \x03(\v2\x10.library.v1.RoomR\x05added\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\xa9\x01\n" +
+	"\x18GetUsageAnalyticsRequest\x12\x15\n" +
+	"\x06stu_id\x18\x01 \x01(\tR\x05stuId\x12\x14\n" +
+	"\x05weeks\x18\x02 \x01(\x05R\x05weeks\x12\x16\n" +
+	"\x06months\x18\x03 \x01(\x05R\x06months\x12%\n" +
+	"\x0esemester_start\x18\x04 \x01(\tR\rsemesterStart\x12!\n" +
+	"\fsemester_end\x18\x05 \x01(\tR\vsemesterEnd\"W\n" +
+	"\vUsagePeriod\x12\x16\n" +
+	"\x06period\x18\x01 \x01(\tR\x06period\x12\x14\n" +
+	"\x05hours\x18\x02 \x01(\x01R\x05hours\x12\x1a\n" +
+	"\bsessions\x18\x03 \x01(\x05R\bsessions\"k\n" +
+	"\rFavouriteRoom\x12\x12\n" +
+	"\x04room\x18\x01 \x01(\tR\x04room\x12\x14\n" +
+	"\x05floor\x18\x02 \x01(\tR\x05floor\x12\x14\n" +
+	"\x05hours\x18\x03 \x01(\x01R\x05hours\x12\x1a\n" +
+	"\bsessions\x18\x04 \x01(\x05R\bsessions\"\xd5\x01\n" +
+	"\x0eAttendanceStat\x12\x17\n" +
+	"\aon_time\x18\x01 \x01(\x05R\x06onTime\x12\x12\n" +
+	"\x04late\x18\x02 \x01(\x05R\x04late\x12\x17\n" +
+	"\ano_show\x18\x03 \x01(\x05R\x06noShow\x12\x1c\n" +
+	"\tcancelled\x18\x04 \x01(\x05R\tcancelled\x12 \n" +
+	"\fon_time_rate\x18\x05 \x01(\x01R\n" +
+	"onTimeRate\x12\x1b\n" +
+	"\tlate_rate\x18\x06 \x01(\x01R\blateRate\x12 \n" +
+	"\fno_show_rate\x18\a \x01(\x01R\n" +
+	"noShowRate\"\x87\x01\n" +
+	"\vStudyStreak\x12\x18\n" +
+	"\acurrent\x18\x01 \x01(\x05R\acurrent\x12\x18\n" +
+	"\alongest\x18\x02 \x01(\x05R\alongest\x12#\n" +
+	"\rlongest_start\x18\x03 \x01(\tR\flongestStart\x12\x1f\n" +
+	"\vlongest_end\x18\x04 \x01(\tR\n" +
+	"longestEnd\"\xaa\x03\n" +
+	"\x0eSemesterReview\x12%\n" +
+	"\x0esemester_start\x18\x01 \x01(\tR\rsemesterStart\x12!\n" +
+	"\fsemester_end\x18\x02 \x01(\tR\vsemesterEnd\x12\x1f\n" +
+	"\vtotal_hours\x18\x03 \x01(\x01R\n" +
+	"totalHours\x12\x1a\n" +
+	"\bsessions\x18\x04 \x01(\x05R\bsessions\x12\x1d\n" +
+	"\n" +
+	"study_days\x18\x05 \x01(\x05R\tstudyDays\x12\x19\n" +
+	"\btop_room\x18\x06 \x01(\tR\atopRoom\x12'\n" +
+	"\x0fbusiest_weekday\x18\a \x01(\x05R\x0ebusiestWeekday\x12\x1f\n" +
+	"\vbusiest_day\x18\b \x01(\tR\n" +
+	"busiestDay\x12*\n" +
+	"\x11busiest_day_hours\x18\t \x01(\x01R\x0fbusiestDayHours\x12%\n" +
+	"\x0elongest_streak\x18\n" +
+	" \x01(\x05R\rlongestStreak\x12:\n" +
+	"\n" +
+	"attendance\x18\v \x01(\v2\x1a.library.v1.AttendanceStatR\n" +
+	"attendance\"\xe4\x02\n" +
+	"\x19GetUsageAnalyticsResponse\x12/\n" +
+	"\x06weekly\x18\x01 \x03(\v2\x17.library.v1.UsagePeriodR\x06weekly\x121\n" +
+	"\amonthly\x18\x02 \x03(\v2\x17.library.v1.UsagePeriodR\amonthly\x12B\n" +
+	"\x0ffavourite_rooms\x18\x03 \x03(\v2\x19.library.v1.FavouriteRoomR\x0efavouriteRooms\x12:\n" +
+	"\n" +
+	"attendance\x18\x04 \x01(\v2\x1a.library.v1.AttendanceStatR\n" +
+	"attendance\x12/\n" +
+	"\x06streak\x18\x05 \x01(\v2\x17.library.v1.StudyStreakR\x06streak\x122\n" +
+	"\x06review\x18\x06 \x01(\v2\x1a.library.v1.SemesterReviewR\x06review2\x80\x1a\n" +
 	"\aLibrary\x12B\n" +
 	"\aGetSeat\x12\x1a.library.v1.GetSeatRequest\x1a\x1b.library.v1.GetSeatResponse\x12N\n" +
 	"\vReserveSeat\x12\x1e.library.v1.ReserveSeatRequest\x1a\x1f.library.v1.ReserveSeatResponse\x12T\n" +
@@ -2224,12 +6430,39 @@ const file_library_v1_library_proto_rawDesc = "" +
 	"\rGetDiscussion\x12 .library.v1.GetDiscussionRequest\x1a!.library.v1.GetDiscussionResponse\x12K\n" +
 	"\n" +
 	"SearchUser\x12\x1d.library.v1.SearchUserRequest\x1a\x1e.library.v1.SearchUserResponse\x12`\n" +
-	"\x11ReserveDiscussion\x12$.library.v1.ReserveDiscussionRequest\x1a%.library.v1.ReserveDiscussionResponse\x12T\n" +
+	"\x11ReserveDiscussion\x12$.library.v1.ReserveDiscussionRequest\x1a%.library.v1.ReserveDiscussionResponse\x12l\n" +
+	"\x15SearchDiscussionSlots\x12(.library.v1.SearchDiscussionSlotsRequest\x1a).library.v1.SearchDiscussionSlotsResponse\x12W\n" +
+	"\x0eSaveStudyGroup\x12!.library.v1.SaveStudyGroupRequest\x1a\".library.v1.SaveStudyGroupResponse\x12I\n" +
+	"\x10DeleteStudyGroup\x12#.library.v1.DeleteStudyGroupRequest\x1a\x10.library.v1.Resp\x12Z\n" +
+	"\x0fListStudyGroups\x12\".library.v1.ListStudyGroupsRequest\x1a#.library.v1.ListStudyGroupsResponse\x12x\n" +
+	"\x19ReserveDiscussionForGroup\x12,.library.v1.ReserveDiscussionForGroupRequest\x1a-.library.v1.ReserveDiscussionForGroupResponse\x12T\n" +
 	"\rCancelReserve\x12 .library.v1.CancelReserveRequest\x1a!.library.v1.CancelReserveResponse\x12f\n" +
-	"\x13ReserveSeatRandomly\x12&.library.v1.ReserveSeatRandomlyRequest\x1a'.library.v1.ReserveSeatRandomlyResponse\x12?\n" +
+	"\x13ReserveSeatRandomly\x12&.library.v1.ReserveSeatRandomlyRequest\x1a'.library.v1.ReserveSeatRandomlyResponse\x12Z\n" +
+	"\x0fSwapReservation\x12\".library.v1.SwapReservationRequest\x1a#.library.v1.SwapReservationResponse\x12?\n" +
 	"\rCreateComment\x12\x1c.library.v1.CreateCommentReq\x1a\x10.library.v1.Resp\x129\n" +
-	"\vGetComments\x12\x0e.library.v1.ID\x1a\x1a.library.v1.GetCommentResp\x121\n" +
-	"\rDeleteComment\x12\x0e.library.v1.ID\x1a\x10.library.v1.RespBFZDgithub.com/asynccnu/ccnubox-be/be-api/gen/proto/library/v1;libraryv1b\x06proto3"
+	"\vGetComments\x12\x0e.library.v1.ID\x1a\x1a.library.v1.GetCommentResp\x12?\n" +
+	"\rDeleteComment\x12\x1c.library.v1.DeleteCommentReq\x1a\x10.library.v1.Resp\x12Q\n" +
+	"\fListComments\x12\x1f.library.v1.ListCommentsRequest\x1a .library.v1.ListCommentsResponse\x12W\n" +
+	"\x0eGetSeatRatings\x12!.library.v1.GetSeatRatingsRequest\x1a\".library.v1.GetSeatRatingsResponse\x12C\n" +
+	"\rReportComment\x12 .library.v1.ReportCommentRequest\x1a\x10.library.v1.Resp\x12i\n" +
+	"\x14ListReportedComments\x12'.library.v1.ListReportedCommentsRequest\x1a(.library.v1.ListReportedCommentsResponse\x12G\n" +
+	"\x0fModerateComment\x12\".library.v1.ModerateCommentRequest\x1a\x10.library.v1.Resp\x12A\n" +
+	"\fAddFavourite\x12\x1f.library.v1.AddFavouriteRequest\x1a\x10.library.v1.Resp\x12G\n" +
+	"\x0fRemoveFavourite\x12\".library.v1.RemoveFavouriteRequest\x1a\x10.library.v1.Resp\x12W\n" +
+	"\x0eListFavourites\x12!.library.v1.ListFavouritesRequest\x1a\".library.v1.ListFavouritesResponse\x12]\n" +
+	"\x10ReserveFavourite\x12#.library.v1.ReserveFavouriteRequest\x1a$.library.v1.ReserveFavouriteResponse\x12`\n" +
+	"\x11SaveReserveIntent\x12$.library.v1.SaveReserveIntentRequest\x1a%.library.v1.SaveReserveIntentResponse\x12O\n" +
+	"\x13DeleteReserveIntent\x12&.library.v1.DeleteReserveIntentRequest\x1a\x10.library.v1.Resp\x12c\n" +
+	"\x12ListReserveIntents\x12%.library.v1.ListReserveIntentsRequest\x1a&.library.v1.ListReserveIntentsResponse\x12Q\n" +
+	"\fGetOccupancy\x12\x1f.library.v1.GetOccupancyRequest\x1a .library.v1.GetOccupancyResponse\x12f\n" +
+	"\x13GetOccupancyHeatmap\x12&.library.v1.GetOccupancyHeatmapRequest\x1a'.library.v1.GetOccupancyHeatmapResponse\x12]\n" +
+	"\x10GetLeastBusyRoom\x12#.library.v1.GetLeastBusyRoomRequest\x1a$.library.v1.GetLeastBusyRoomResponse\x12H\n" +
+	"\tListRooms\x12\x1c.library.v1.ListRoomsRequest\x1a\x1d.library.v1.ListRoomsResponse\x12E\n" +
+	"\bSaveRoom\x12\x1b.library.v1.SaveRoomRequest\x1a\x1c.library.v1.SaveRoomResponse\x12=\n" +
+	"\n" +
+	"DeleteRoom\x12\x1d.library.v1.DeleteRoomRequest\x1a\x10.library.v1.Resp\x12T\n" +
+	"\rDiscoverRooms\x12 .library.v1.DiscoverRoomsRequest\x1a!.library.v1.DiscoverRoomsResponse\x12`\n" +
+	"\x11GetUsageAnalytics\x12$.library.v1.GetUsageAnalyticsRequest\x1a%.library.v1.GetUsageAnalyticsResponseBFZDgithub.com/asynccnu/ccnubox-be/be-api/gen/proto/library/v1;libraryv1b\x06proto3"
 
 var (
 	file_library_v1_library_proto_rawDescOnce sync.Once
@@ -2243,42 +6476,104 @@ func file_library_v1_library_proto_rawDescGZIP() []byte {
 	return file_library_v1_library_proto_rawDescData
 }
 
-var file_library_v1_library_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
+var file_library_v1_library_proto_msgTypes = make([]protoimpl.MessageInfo, 96)
 var file_library_v1_library_proto_goTypes = []any{
-	(*GetSeatRequest)(nil),              // 0: library.v1.GetSeatRequest
-	(*GetSeatResponse)(nil),             // 1: library.v1.GetSeatResponse
-	(*RoomSeat)(nil),                    // 2: library.v1.RoomSeat
-	(*Seat)(nil),                        // 3: library.v1.Seat
-	(*TimeSlot)(nil),                    // 4: library.v1.TimeSlot
-	(*ReserveSeatRequest)(nil),          // 5: library.v1.ReserveSeatRequest
-	(*ReserveSeatResponse)(nil),         // 6: library.v1.ReserveSeatResponse
-	(*GetSeatRecordRequest)(nil),        // 7: library.v1.GetSeatRecordRequest
-	(*GetSeatRecordResponse)(nil),       // 8: library.v1.GetSeatRecordResponse
-	(*Record)(nil),                      // 9: library.v1.Record
-	(*GetHistoryRequest)(nil),           // 10: library.v1.GetHistoryRequest
-	(*GetHistoryResponse)(nil),          // 11: library.v1.GetHistoryResponse
-	(*History)(nil),                     // 12: library.v1.History
-	(*GetCreditPointRequest)(nil),       // 13: library.v1.GetCreditPointRequest
-	(*GetCreditPointResponse)(nil),      // 14: library.v1.GetCreditPointResponse
-	(*CreditSummary)(nil),               // 15: library.v1.CreditSummary
-	(*CreditRecord)(nil),                // 16: library.v1.CreditRecord
-	(*GetDiscussionRequest)(nil),        // 17: library.v1.GetDiscussionRequest
-	(*GetDiscussionResponse)(nil),       // 18: library.v1.GetDiscussionResponse
-	(*Discussion)(nil),                  // 19: library.v1.Discussion
-	(*DiscussionTS)(nil),                // 20: library.v1.DiscussionTS
-	(*SearchUserRequest)(nil),           // 21: library.v1.SearchUserRequest
-	(*SearchUserResponse)(nil),          // 22: library.v1.SearchUserResponse
-	(*ReserveDiscussionRequest)(nil),    // 23: library.v1.ReserveDiscussionRequest
-	(*ReserveDiscussionResponse)(nil),   // 24: library.v1.ReserveDiscussionResponse
-	(*CancelReserveRequest)(nil),        // 25: library.v1.CancelReserveRequest
-	(*CancelReserveResponse)(nil),       // 26: library.v1.CancelReserveResponse
-	(*ReserveSeatRandomlyRequest)(nil),  // 27: library.v1.ReserveSeatRandomlyRequest
-	(*ReserveSeatRandomlyResponse)(nil), // 28: library.v1.ReserveSeatRandomlyResponse
-	(*Comment)(nil),                     // 29: library.v1.Comment
-	(*CreateCommentReq)(nil),            // 30: library.v1.CreateCommentReq
-	(*GetCommentResp)(nil),              // 31: library.v1.GetCommentResp
-	(*ID)(nil),                          // 32: library.v1.ID
-	(*Resp)(nil),                        // 33: library.v1.Resp
+	(*GetSeatRequest)(nil),                    // 0: library.v1.GetSeatRequest
+	(*GetSeatResponse)(nil),                   // 1: library.v1.GetSeatResponse
+	(*RoomSeat)(nil),                          // 2: library.v1.RoomSeat
+	(*Seat)(nil),                              // 3: library.v1.Seat
+	(*TimeSlot)(nil),                          // 4: library.v1.TimeSlot
+	(*ReserveSeatRequest)(nil),                // 5: library.v1.ReserveSeatRequest
+	(*ReserveSeatResponse)(nil),               // 6: library.v1.ReserveSeatResponse
+	(*GetSeatRecordRequest)(nil),              // 7: library.v1.GetSeatRecordRequest
+	(*GetSeatRecordResponse)(nil),             // 8: library.v1.GetSeatRecordResponse
+	(*Record)(nil),                            // 9: library.v1.Record
+	(*GetHistoryRequest)(nil),                 // 10: library.v1.GetHistoryRequest
+	(*GetHistoryResponse)(nil),                // 11: library.v1.GetHistoryResponse
+	(*History)(nil),                           // 12: library.v1.History
+	(*GetCreditPointRequest)(nil),             // 13: library.v1.GetCreditPointRequest
+	(*GetCreditPointResponse)(nil),            // 14: library.v1.GetCreditPointResponse
+	(*CreditSummary)(nil),                     // 15: library.v1.CreditSummary
+	(*CreditRecord)(nil),                      // 16: library.v1.CreditRecord
+	(*GetDiscussionRequest)(nil),              // 17: library.v1.GetDiscussionRequest
+	(*GetDiscussionResponse)(nil),             // 18: library.v1.GetDiscussionResponse
+	(*Discussion)(nil),                        // 19: library.v1.Discussion
+	(*DiscussionTS)(nil),                      // 20: library.v1.DiscussionTS
+	(*SearchUserRequest)(nil),                 // 21: library.v1.SearchUserRequest
+	(*SearchUserResponse)(nil),                // 22: library.v1.SearchUserResponse
+	(*ReserveDiscussionRequest)(nil),          // 23: library.v1.ReserveDiscussionRequest
+	(*ReserveDiscussionResponse)(nil),         // 24: library.v1.ReserveDiscussionResponse
+	(*SearchDiscussionSlotsRequest)(nil),      // 25: library.v1.SearchDiscussionSlotsRequest
+	(*DiscussionSlot)(nil),                    // 26: library.v1.DiscussionSlot
+	(*SearchDiscussionSlotsResponse)(nil),     // 27: library.v1.SearchDiscussionSlotsResponse
+	(*GroupMember)(nil),                       // 28: library.v1.GroupMember
+	(*StudyGroup)(nil),                        // 29: library.v1.StudyGroup
+	(*SaveStudyGroupRequest)(nil),             // 30: library.v1.SaveStudyGroupRequest
+	(*SaveStudyGroupResponse)(nil),            // 31: library.v1.SaveStudyGroupResponse
+	(*DeleteStudyGroupRequest)(nil),           // 32: library.v1.DeleteStudyGroupRequest
+	(*ListStudyGroupsRequest)(nil),            // 33: library.v1.ListStudyGroupsRequest
+	(*ListStudyGroupsResponse)(nil),           // 34: library.v1.ListStudyGroupsResponse
+	(*ReserveDiscussionForGroupRequest)(nil),  // 35: library.v1.ReserveDiscussionForGroupRequest
+	(*ReserveDiscussionForGroupResponse)(nil), // 36: library.v1.ReserveDiscussionForGroupResponse
+	(*CancelReserveRequest)(nil),              // 37: library.v1.CancelReserveRequest
+	(*CancelReserveResponse)(nil),             // 38: library.v1.CancelReserveResponse
+	(*ReserveSeatRandomlyRequest)(nil),        // 39: library.v1.ReserveSeatRandomlyRequest
+	(*ReserveSeatRandomlyResponse)(nil),       // 40: library.v1.ReserveSeatRandomlyResponse
+	(*SwapReservationRequest)(nil),            // 41: library.v1.SwapReservationRequest
+	(*SwapReservationResponse)(nil),           // 42: library.v1.SwapReservationResponse
+	(*Comment)(nil),                           // 43: library.v1.Comment
+	(*CreateCommentReq)(nil),                  // 44: library.v1.CreateCommentReq
+	(*GetCommentResp)(nil),                    // 45: library.v1.GetCommentResp
+	(*DeleteCommentReq)(nil),                  // 46: library.v1.DeleteCommentReq
+	(*SeatRating)(nil),                        // 47: library.v1.SeatRating
+	(*ListCommentsRequest)(nil),               // 48: library.v1.ListCommentsRequest
+	(*ListCommentsResponse)(nil),              // 49: library.v1.ListCommentsResponse
+	(*GetSeatRatingsRequest)(nil),             // 50: library.v1.GetSeatRatingsRequest
+	(*GetSeatRatingsResponse)(nil),            // 51: library.v1.GetSeatRatingsResponse
+	(*ReportCommentRequest)(nil),              // 52: library.v1.ReportCommentRequest
+	(*ListReportedCommentsRequest)(nil),       // 53: library.v1.ListReportedCommentsRequest
+	(*ListReportedCommentsResponse)(nil),      // 54: library.v1.ListReportedCommentsResponse
+	(*ModerateCommentRequest)(nil),            // 55: library.v1.ModerateCommentRequest
+	(*ID)(nil),                                // 56: library.v1.ID
+	(*Resp)(nil),                              // 57: library.v1.Resp
+	(*FavouriteSeat)(nil),                     // 58: library.v1.FavouriteSeat
+	(*AddFavouriteRequest)(nil),               // 59: library.v1.AddFavouriteRequest
+	(*RemoveFavouriteRequest)(nil),            // 60: library.v1.RemoveFavouriteRequest
+	(*ListFavouritesRequest)(nil),             // 61: library.v1.ListFavouritesRequest
+	(*ListFavouritesResponse)(nil),            // 62: library.v1.ListFavouritesResponse
+	(*ReserveFavouriteRequest)(nil),           // 63: library.v1.ReserveFavouriteRequest
+	(*ReserveFavouriteResponse)(nil),          // 64: library.v1.ReserveFavouriteResponse
+	(*ReserveIntent)(nil),                     // 65: library.v1.ReserveIntent
+	(*SaveReserveIntentRequest)(nil),          // 66: library.v1.SaveReserveIntentRequest
+	(*SaveReserveIntentResponse)(nil),         // 67: library.v1.SaveReserveIntentResponse
+	(*DeleteReserveIntentRequest)(nil),        // 68: library.v1.DeleteReserveIntentRequest
+	(*ListReserveIntentsRequest)(nil),         // 69: library.v1.ListReserveIntentsRequest
+	(*ListReserveIntentsResponse)(nil),        // 70: library.v1.ListReserveIntentsResponse
+	(*SeatStatistics)(nil),                    // 71: library.v1.SeatStatistics
+	(*RoomOccupancy)(nil),                     // 72: library.v1.RoomOccupancy
+	(*FloorOccupancy)(nil),                    // 73: library.v1.FloorOccupancy
+	(*GetOccupancyRequest)(nil),               // 74: library.v1.GetOccupancyRequest
+	(*GetOccupancyResponse)(nil),              // 75: library.v1.GetOccupancyResponse
+	(*GetOccupancyHeatmapRequest)(nil),        // 76: library.v1.GetOccupancyHeatmapRequest
+	(*HeatmapCell)(nil),                       // 77: library.v1.HeatmapCell
+	(*GetOccupancyHeatmapResponse)(nil),       // 78: library.v1.GetOccupancyHeatmapResponse
+	(*GetLeastBusyRoomRequest)(nil),           // 79: library.v1.GetLeastBusyRoomRequest
+	(*GetLeastBusyRoomResponse)(nil),          // 80: library.v1.GetLeastBusyRoomResponse
+	(*Room)(nil),                              // 81: library.v1.Room
+	(*ListRoomsRequest)(nil),                  // 82: library.v1.ListRoomsRequest
+	(*ListRoomsResponse)(nil),                 // 83: library.v1.ListRoomsResponse
+	(*SaveRoomRequest)(nil),                   // 84: library.v1.SaveRoomRequest
+	(*SaveRoomResponse)(nil),                  // 85: library.v1.SaveRoomResponse
+	(*DeleteRoomRequest)(nil),                 // 86: library.v1.DeleteRoomRequest
+	(*DiscoverRoomsRequest)(nil),              // 87: library.v1.DiscoverRoomsRequest
+	(*DiscoverRoomsResponse)(nil),             // 88: library.v1.DiscoverRoomsResponse
+	(*GetUsageAnalyticsRequest)(nil),          // 89: library.v1.GetUsageAnalyticsRequest
+	(*UsagePeriod)(nil),                       // 90: library.v1.UsagePeriod
+	(*FavouriteRoom)(nil),                     // 91: library.v1.FavouriteRoom
+	(*AttendanceStat)(nil),                    // 92: library.v1.AttendanceStat
+	(*StudyStreak)(nil),                       // 93: library.v1.StudyStreak
+	(*SemesterReview)(nil),                    // 94: library.v1.SemesterReview
+	(*GetUsageAnalyticsResponse)(nil),         // 95: library.v1.GetUsageAnalyticsResponse
 }
 var file_library_v1_library_proto_depIdxs = []int32{
 	2,  // 0: library.v1.GetSeatResponse.room_seats:type_name -> library.v1.RoomSeat
@@ -2290,38 +6585,120 @@ var file_library_v1_library_proto_depIdxs = []int32{
 	16, // 6: library.v1.GetCreditPointResponse.credit_record:type_name -> library.v1.CreditRecord
 	19, // 7: library.v1.GetDiscussionResponse.discussions:type_name -> library.v1.Discussion
 	20, // 8: library.v1.Discussion.TS:type_name -> library.v1.DiscussionTS
-	29, // 9: library.v1.GetCommentResp.Comment:type_name -> library.v1.Comment
-	0,  // 10: library.v1.Library.GetSeat:input_type -> library.v1.GetSeatRequest
-	5,  // 11: library.v1.Library.ReserveSeat:input_type -> library.v1.ReserveSeatRequest
-	7,  // 12: library.v1.Library.GetSeatRecord:input_type -> library.v1.GetSeatRecordRequest
-	10, // 13: library.v1.Library.GetHistory:input_type -> library.v1.GetHistoryRequest
-	13, // 14: library.v1.Library.GetCreditPoint:input_type -> library.v1.GetCreditPointRequest
-	17, // 15: library.v1.Library.GetDiscussion:input_type -> library.v1.GetDiscussionRequest
-	21, // 16: library.v1.Library.SearchUser:input_type -> library.v1.SearchUserRequest
-	23, // 17: library.v1.Library.ReserveDiscussion:input_type -> library.v1.ReserveDiscussionRequest
-	25, // 18: library.v1.Library.CancelReserve:input_type -> library.v1.CancelReserveRequest
-	27, // 19: library.v1.Library.ReserveSeatRandomly:input_type -> library.v1.ReserveSeatRandomlyRequest
-	30, // 20: library.v1.Library.CreateComment:input_type -> library.v1.CreateCommentReq
-	32, // 21: library.v1.Library.GetComments:input_type -> library.v1.ID
-	32, // 22: library.v1.Library.DeleteComment:input_type -> library.v1.ID
-	1,  // 23: library.v1.Library.GetSeat:output_type -> library.v1.GetSeatResponse
-	6,  // 24: library.v1.Library.ReserveSeat:output_type -> library.v1.ReserveSeatResponse
-	8,  // 25: library.v1.Library.GetSeatRecord:output_type -> library.v1.GetSeatRecordResponse
-	11, // 26: library.v1.Library.GetHistory:output_type -> library.v1.GetHistoryResponse
-	14, // 27: library.v1.Library.GetCreditPoint:output_type -> library.v1.GetCreditPointResponse
-	18, // 28: library.v1.Library.GetDiscussion:output_type -> library.v1.GetDiscussionResponse
-	22, // 29: library.v1.Library.SearchUser:output_type -> library.v1.SearchUserResponse
-	24, // 30: library.v1.Library.ReserveDiscussion:output_type -> library.v1.ReserveDiscussionResponse
-	26, // 31: library.v1.Library.CancelReserve:output_type -> library.v1.CancelReserveResponse
-	28, // 32: library.v1.Library.ReserveSeatRandomly:output_type -> library.v1.ReserveSeatRandomlyResponse
-	33, // 33: library.v1.Library.CreateComment:output_type -> library.v1.Resp
-	31, // 34: library.v1.Library.GetComments:output_type -> library.v1.GetCommentResp
-	33, // 35: library.v1.Library.DeleteComment:output_type -> library.v1.Resp
-	23, // [23:36] is the sub-list for method output_type
-	10, // [10:23] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	26, // 9: library.v1.SearchDiscussionSlotsResponse.slots:type_name -> library.v1.DiscussionSlot
+	28, // 10: library.v1.StudyGroup.members:type_name -> library.v1.GroupMember
+	29, // 11: library.v1.SaveStudyGroupResponse.group:type_name -> library.v1.StudyGroup
+	29, // 12: library.v1.ListStudyGroupsResponse.groups:type_name -> library.v1.StudyGroup
+	43, // 13: library.v1.GetCommentResp.Comment:type_name -> library.v1.Comment
+	43, // 14: library.v1.ListCommentsResponse.comments:type_name -> library.v1.Comment
+	47, // 15: library.v1.ListCommentsResponse.rating:type_name -> library.v1.SeatRating
+	47, // 16: library.v1.GetSeatRatingsResponse.ratings:type_name -> library.v1.SeatRating
+	43, // 17: library.v1.ListReportedCommentsResponse.comments:type_name -> library.v1.Comment
+	4,  // 18: library.v1.FavouriteSeat.ts:type_name -> library.v1.TimeSlot
+	58, // 19: library.v1.ListFavouritesResponse.seats:type_name -> library.v1.FavouriteSeat
+	65, // 20: library.v1.SaveReserveIntentRequest.intent:type_name -> library.v1.ReserveIntent
+	65, // 21: library.v1.SaveReserveIntentResponse.intent:type_name -> library.v1.ReserveIntent
+	65, // 22: library.v1.ListReserveIntentsResponse.intents:type_name -> library.v1.ReserveIntent
+	71, // 23: library.v1.RoomOccupancy.stat:type_name -> library.v1.SeatStatistics
+	71, // 24: library.v1.FloorOccupancy.stat:type_name -> library.v1.SeatStatistics
+	72, // 25: library.v1.GetOccupancyResponse.rooms:type_name -> library.v1.RoomOccupancy
+	73, // 26: library.v1.GetOccupancyResponse.floors:type_name -> library.v1.FloorOccupancy
+	77, // 27: library.v1.GetOccupancyHeatmapResponse.cells:type_name -> library.v1.HeatmapCell
+	72, // 28: library.v1.GetLeastBusyRoomResponse.room:type_name -> library.v1.RoomOccupancy
+	81, // 29: library.v1.ListRoomsResponse.rooms:type_name -> library.v1.Room
+	81, // 30: library.v1.SaveRoomRequest.room:type_name -> library.v1.Room
+	81, // 31: library.v1.SaveRoomResponse.room:type_name -> library.v1.Room
+	81, // 32: library.v1.DiscoverRoomsResponse.added:type_name -> library.v1.Room
+	92, // 33: library.v1.SemesterReview.attendance:type_name -> library.v1.AttendanceStat
+	90, // 34: library.v1.GetUsageAnalyticsResponse.weekly:type_name -> library.v1.UsagePeriod
+	90, // 35: library.v1.GetUsageAnalyticsResponse.monthly:type_name -> library.v1.UsagePeriod
+	91, // 36: library.v1.GetUsageAnalyticsResponse.favourite_rooms:type_name -> library.v1.FavouriteRoom
+	92, // 37: library.v1.GetUsageAnalyticsResponse.attendance:type_name -> library.v1.AttendanceStat
+	93, // 38: library.v1.GetUsageAnalyticsResponse.streak:type_name -> library.v1.StudyStreak
+	94, // 39: library.v1.GetUsageAnalyticsResponse.review:type_name -> library.v1.SemesterReview
+	0,  // 40: library.v1.Library.GetSeat:input_type -> library.v1.GetSeatRequest
+	5,  // 41: library.v1.Library.ReserveSeat:input_type -> library.v1.ReserveSeatRequest
+	7,  // 42: library.v1.Library.GetSeatRecord:input_type -> library.v1.GetSeatRecordRequest
+	10, // 43: library.v1.Library.GetHistory:input_type -> library.v1.GetHistoryRequest
+	13, // 44: library.v1.Library.GetCreditPoint:input_type -> library.v1.GetCreditPointRequest
+	17, // 45: library.v1.Library.GetDiscussion:input_type -> library.v1.GetDiscussionRequest
+	21, // 46: library.v1.Library.SearchUser:input_type -> library.v1.SearchUserRequest
+	23, // 47: library.v1.Library.ReserveDiscussion:input_type -> library.v1.ReserveDiscussionRequest
+	25, // 48: library.v1.Library.SearchDiscussionSlots:input_type -> library.v1.SearchDiscussionSlotsRequest
+	30, // 49: library.v1.Library.SaveStudyGroup:input_type -> library.v1.SaveStudyGroupRequest
+	32, // 50: library.v1.Library.DeleteStudyGroup:input_type -> library.v1.DeleteStudyGroupRequest
+	33, // 51: library.v1.Library.ListStudyGroups:input_type -> library.v1.ListStudyGroupsRequest
+	35, // 52: library.v1.Library.ReserveDiscussionForGroup:input_type -> library.v1.ReserveDiscussionForGroupRequest
+	37, // 53: library.v1.Library.CancelReserve:input_type -> library.v1.CancelReserveRequest
+	39, // 54: library.v1.Library.ReserveSeatRandomly:input_type -> library.v1.ReserveSeatRandomlyRequest
+	41, // 55: library.v1.Library.SwapReservation:input_type -> library.v1.SwapReservationRequest
+	44, // 56: library.v1.Library.CreateComment:input_type -> library.v1.CreateCommentReq
+	56, // 57: library.v1.Library.GetComments:input_type -> library.v1.ID
+	46, // 58: library.v1.Library.DeleteComment:input_type -> library.v1.DeleteCommentReq
+	48, // 59: library.v1.Library.ListComments:input_type -> library.v1.ListCommentsRequest
+	50, // 60: library.v1.Library.GetSeatRatings:input_type -> library.v1.GetSeatRatingsRequest
+	52, // 61: library.v1.Library.ReportComment:input_type -> library.v1.ReportCommentRequest
+	53, // 62: library.v1.Library.ListReportedComments:input_type -> library.v1.ListReportedCommentsRequest
+	55, // 63: library.v1.Library.ModerateComment:input_type -> library.v1.ModerateCommentRequest
+	59, // 64: library.v1.Library.AddFavourite:input_type -> library.v1.AddFavouriteRequest
+	60, // 65: library.v1.Library.RemoveFavourite:input_type -> library.v1.RemoveFavouriteRequest
+	61, // 66: library.v1.Library.ListFavourites:input_type -> library.v1.ListFavouritesRequest
+	63, // 67: library.v1.Library.ReserveFavourite:input_type -> library.v1.ReserveFavouriteRequest
+	66, // 68: library.v1.Library.SaveReserveIntent:input_type -> library.v1.SaveReserveIntentRequest
+	68, // 69: library.v1.Library.DeleteReserveIntent:input_type -> library.v1.DeleteReserveIntentRequest
+	69, // 70: library.v1.Library.ListReserveIntents:input_type -> library.v1.ListReserveIntentsRequest
+	74, // 71: library.v1.Library.GetOccupancy:input_type -> library.v1.GetOccupancyRequest
+	76, // 72: library.v1.Library.GetOccupancyHeatmap:input_type -> library.v1.GetOccupancyHeatmapRequest
+	79, // 73: library.v1.Library.GetLeastBusyRoom:input_type -> library.v1.GetLeastBusyRoomRequest
+	82, // 74: library.v1.Library.ListRooms:input_type -> library.v1.ListRoomsRequest
+	84, // 75: library.v1.Library.SaveRoom:input_type -> library.v1.SaveRoomRequest
+	86, // 76: library.v1.Library.DeleteRoom:input_type -> library.v1.DeleteRoomRequest
+	87, // 77: library.v1.Library.DiscoverRooms:input_type -> library.v1.DiscoverRoomsRequest
+	89, // 78: library.v1.Library.GetUsageAnalytics:input_type -> library.v1.GetUsageAnalyticsRequest
+	1,  // 79: library.v1.Library.GetSeat:output_type -> library.v1.GetSeatResponse
+	6,  // 80: library.v1.Library.ReserveSeat:output_type -> library.v1.ReserveSeatResponse
+	8,  // 81: library.v1.Library.GetSeatRecord:output_type -> library.v1.GetSeatRecordResponse
+	11, // 82: library.v1.Library.GetHistory:output_type -> library.v1.GetHistoryResponse
+	14, // 83: library.v1.Library.GetCreditPoint:output_type -> library.v1.GetCreditPointResponse
+	18, // 84: library.v1.Library.GetDiscussion:output_type -> library.v1.GetDiscussionResponse
+	22, // 85: library.v1.Library.SearchUser:output_type -> library.v1.SearchUserResponse
+	24, // 86: library.v1.Library.ReserveDiscussion:output_type -> library.v1.ReserveDiscussionResponse
+	27, // 87: library.v1.Library.SearchDiscussionSlots:output_type -> library.v1.SearchDiscussionSlotsResponse
+	31, // 88: library.v1.Library.SaveStudyGroup:output_type -> library.v1.SaveStudyGroupResponse
+	57, // 89: library.v1.Library.DeleteStudyGroup:output_type -> library.v1.Resp
+	34, // 90: library.v1.Library.ListStudyGroups:output_type -> library.v1.ListStudyGroupsResponse
+	36, // 91: library.v1.Library.ReserveDiscussionForGroup:output_type -> library.v1.ReserveDiscussionForGroupResponse
+	38, // 92: library.v1.Library.CancelReserve:output_type -> library.v1.CancelReserveResponse
+	40, // 93: library.v1.Library.ReserveSeatRandomly:output_type -> library.v1.ReserveSeatRandomlyResponse
+	42, // 94: library.v1.Library.SwapReservation:output_type -> library.v1.SwapReservationResponse
+	57, // 95: library.v1.Library.CreateComment:output_type -> library.v1.Resp
+	45, // 96: library.v1.Library.GetComments:output_type -> library.v1.GetCommentResp
+	57, // 97: library.v1.Library.DeleteComment:output_type -> library.v1.Resp
+	49, // 98: library.v1.Library.ListComments:output_type -> library.v1.ListCommentsResponse
+	51, // 99: library.v1.Library.GetSeatRatings:output_type -> library.v1.GetSeatRatingsResponse
+	57, // 100: library.v1.Library.ReportComment:output_type -> library.v1.Resp
+	54, // 101: library.v1.Library.ListReportedComments:output_type -> library.v1.ListReportedCommentsResponse
+	57, // 102: library.v1.Library.ModerateComment:output_type -> library.v1.Resp
+	57, // 103: library.v1.Library.AddFavourite:output_type -> library.v1.Resp
+	57, // 104: library.v1.Library.RemoveFavourite:output_type -> library.v1.Resp
+	62, // 105: library.v1.Library.ListFavourites:output_type -> library.v1.ListFavouritesResponse
+	64, // 106: library.v1.Library.ReserveFavourite:output_type -> library.v1.ReserveFavouriteResponse
+	67, // 107: library.v1.Library.SaveReserveIntent:output_type -> library.v1.SaveReserveIntentResponse
+	57, // 108: library.v1.Library.DeleteReserveIntent:output_type -> library.v1.Resp
+	70, // 109: library.v1.Library.ListReserveIntents:output_type -> library.v1.ListReserveIntentsResponse
+	75, // 110: library.v1.Library.GetOccupancy:output_type -> library.v1.GetOccupancyResponse
+	78, // 111: library.v1.Library.GetOccupancyHeatmap:output_type -> library.v1.GetOccupancyHeatmapResponse
+	80, // 112: library.v1.Library.GetLeastBusyRoom:output_type -> library.v1.GetLeastBusyRoomResponse
+	83, // 113: library.v1.Library.ListRooms:output_type -> library.v1.ListRoomsResponse
+	85, // 114: library.v1.Library.SaveRoom:output_type -> library.v1.SaveRoomResponse
+	57, // 115: library.v1.Library.DeleteRoom:output_type -> library.v1.Resp
+	88, // 116: library.v1.Library.DiscoverRooms:output_type -> library.v1.DiscoverRoomsResponse
+	95, // 117: library.v1.Library.GetUsageAnalytics:output_type -> library.v1.GetUsageAnalyticsResponse
+	79, // [79:118] is the sub-list for method output_type
+	40, // [40:79] is the sub-list for method input_type
+	40, // [40:40] is the sub-list for extension type_name
+	40, // [40:40] is the sub-list for extension extendee
+	0,  // [0:40] is the sub-list for field type_name
 }
 
 func init() { file_library_v1_library_proto_init() }
@@ -2335,7 +6712,7 @@ func file_library_v1_library_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_library_v1_library_proto_rawDesc), len(file_library_v1_library_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   34,
+			NumMessages:   96,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,19 +19,45 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Library_GetSeat_FullMethodName             = "/library.v1.Library/GetSeat"
-	Library_ReserveSeat_FullMethodName         = "/library.v1.Library/ReserveSeat"
-	Library_GetSeatRecord_FullMethodName       = "/library.v1.Library/GetSeatRecord"
-	Library_GetHistory_FullMethodName          = "/library.v1.Library/GetHistory"
-	Library_GetCreditPoint_FullMethodName      = "/library.v1.Library/GetCreditPoint"
-	Library_GetDiscussion_FullMethodName       = "/library.v1.Library/GetDiscussion"
-	Library_SearchUser_FullMethodName          = "/library.v1.Library/SearchUser"
-	Library_ReserveDiscussion_FullMethodName   = "/library.v1.Library/ReserveDiscussion"
-	Library_CancelReserve_FullMethodName       = "/library.v1.Library/CancelReserve"
-	Library_ReserveSeatRandomly_FullMethodName = "/library.v1.Library/ReserveSeatRandomly"
-	Library_CreateComment_FullMethodName       = "/library.v1.Library/CreateComment"
-	Library_GetComments_FullMethodName         = "/library.v1.Library/GetComments"
-	Library_DeleteComment_FullMethodName       = "/library.v1.Library/DeleteComment"
+	Library_GetSeat_FullMethodName                   = "/library.v1.Library/GetSeat"
+	Library_ReserveSeat_FullMethodName               = "/library.v1.Library/ReserveSeat"
+	Library_GetSeatRecord_FullMethodName             = "/library.v1.Library/GetSeatRecord"
+	Library_GetHistory_FullMethodName                = "/library.v1.Library/GetHistory"
+	Library_GetCreditPoint_FullMethodName            = "/library.v1.Library/GetCreditPoint"
+	Library_GetDiscussion_FullMethodName             = "/library.v1.Library/GetDiscussion"
+	Library_SearchUser_FullMethodName                = "/library.v1.Library/SearchUser"
+	Library_ReserveDiscussion_FullMethodName         = "/library.v1.Library/ReserveDiscussion"
+	Library_SearchDiscussionSlots_FullMethodName     = "/library.v1.Library/SearchDiscussionSlots"
+	Library_SaveStudyGroup_FullMethodName            = "/library.v1.Library/SaveStudyGroup"
+	Library_DeleteStudyGroup_FullMethodName          = "/library.v1.Library/DeleteStudyGroup"
+	Library_ListStudyGroups_FullMethodName           = "/library.v1.Library/ListStudyGroups"
+	Library_ReserveDiscussionForGroup_FullMethodName = "/library.v1.Library/ReserveDiscussionForGroup"
+	Library_CancelReserve_FullMethodName             = "/library.v1.Library/CancelReserve"
+	Library_ReserveSeatRandomly_FullMethodName       = "/library.v1.Library/ReserveSeatRandomly"
+	Library_SwapReservation_FullMethodName           = "/library.v1.Library/SwapReservation"
+	Library_CreateComment_FullMethodName             = "/library.v1.Library/CreateComment"
+	Library_GetComments_FullMethodName               = "/library.v1.Library/GetComments"
+	Library_DeleteComment_FullMethodName             = "/library.v1.Library/DeleteComment"
+	Library_ListComments_FullMethodName              = "/library.v1.Library/ListComments"
+	Library_GetSeatRatings_FullMethodName            = "/library.v1.Library/GetSeatRatings"
+	Library_ReportComment_FullMethodName             = "/library.v1.Library/ReportComment"
+	Library_ListReportedComments_FullMethodName      = "/library.v1.Library/ListReportedComments"
+	Library_ModerateComment_FullMethodName           = "/library.v1.Library/ModerateComment"
+	Library_AddFavourite_FullMethodName              = "/library.v1.Library/AddFavourite"
+	Library_RemoveFavourite_FullMethodName           = "/library.v1.Library/RemoveFavourite"
+	Library_ListFavourites_FullMethodName            = "/library.v1.Library/ListFavourites"
+	Library_ReserveFavourite_FullMethodName          = "/library.v1.Library/ReserveFavourite"
+	Library_SaveReserveIntent_FullMethodName         = "/library.v1.Library/SaveReserveIntent"
+	Library_DeleteReserveIntent_FullMethodName       = "/library.v1.Library/DeleteReserveIntent"
+	Library_ListReserveIntents_FullMethodName        = "/library.v1.Library/ListReserveIntents"
+	Library_GetOccupancy_FullMethodName              = "/library.v1.Library/GetOccupancy"
+	Library_GetOccupancyHeatmap_FullMethodName       = "/library.v1.Library/GetOccupancyHeatmap"
+	Library_GetLeastBusyRoom_FullMethodName          = "/library.v1.Library/GetLeastBusyRoom"
+	Library_ListRooms_FullMethodName                 = "/library.v1.Library/ListRooms"
+	Library_SaveRoom_FullMethodName                  = "/library.v1.Library/SaveRoom"
+	Library_DeleteRoom_FullMethodName                = "/library.v1.Library/DeleteRoom"
+	Library_DiscoverRooms_FullMethodName             = "/library.v1.Library/DiscoverRooms"
+	Library_GetUsageAnalytics_FullMethodName         = "/library.v1.Library/GetUsageAnalytics"
 )
 
 // LibraryClient is the client API for Library service.
@@ -46,11 +72,37 @@ type LibraryClient interface {
 	GetDiscussion(ctx context.Context, in *GetDiscussionRequest, opts ...grpc.CallOption) (*GetDiscussionResponse, error)
 	SearchUser(ctx context.Context, in *SearchUserRequest, opts ...grpc.CallOption) (*SearchUserResponse, error)
 	ReserveDiscussion(ctx context.Context, in *ReserveDiscussionRequest, opts ...grpc.CallOption) (*ReserveDiscussionResponse, error)
+	SearchDiscussionSlots(ctx context.Context, in *SearchDiscussionSlotsRequest, opts ...grpc.CallOption) (*SearchDiscussionSlotsResponse, error)
+	SaveStudyGroup(ctx context.Context, in *SaveStudyGroupRequest, opts ...grpc.CallOption) (*SaveStudyGroupResponse, error)
+	DeleteStudyGroup(ctx context.Context, in *DeleteStudyGroupRequest, opts ...grpc.CallOption) (*Resp, error)
+	ListStudyGroups(ctx context.Context, in *ListStudyGroupsRequest, opts ...grpc.CallOption) (*ListStudyGroupsResponse, error)
+	ReserveDiscussionForGroup(ctx context.Context, in *ReserveDiscussionForGroupRequest, opts ...grpc.CallOption) (*ReserveDiscussionForGroupResponse, error)
 	CancelReserve(ctx context.Context, in *CancelReserveRequest, opts ...grpc.CallOption) (*CancelReserveResponse, error)
 	ReserveSeatRandomly(ctx context.Context, in *ReserveSeatRandomlyRequest, opts ...grpc.CallOption) (*ReserveSeatRandomlyResponse, error)
+	SwapReservation(ctx context.Context, in *SwapReservationRequest, opts ...grpc.CallOption) (*SwapReservationResponse, error)
 	CreateComment(ctx context.Context, in *CreateCommentReq, opts ...grpc.CallOption) (*Resp, error)
 	GetComments(ctx context.Context, in *ID, opts ...grpc.CallOption) (*GetCommentResp, error)
-	DeleteComment(ctx context.Context, in *ID, opts ...grpc.CallOption) (*Resp, error)
+	DeleteComment(ctx context.Context, in *DeleteCommentReq, opts ...grpc.CallOption) (*Resp, error)
+	ListComments(ctx context.Context, in *ListCommentsRequest, opts ...grpc.CallOption) (*ListCommentsResponse, error)
+	GetSeatRatings(ctx context.Context, in *GetSeatRatingsRequest, opts ...grpc.CallOption) (*GetSeatRatingsResponse, error)
+	ReportComment(ctx context.Context, in *ReportCommentRequest, opts ...grpc.CallOption) (*Resp, error)
+	ListReportedComments(ctx context.Context, in *ListReportedCommentsRequest, opts ...grpc.CallOption) (*ListReportedCommentsResponse, error)
+	ModerateComment(ctx context.Context, in *ModerateCommentRequest, opts ...grpc.CallOption) (*Resp, error)
+	AddFavourite(ctx context.Context, in *AddFavouriteRequest, opts ...grpc.CallOption) (*Resp, error)
+	RemoveFavourite(ctx context.Context, in *RemoveFavouriteRequest, opts ...grpc.CallOption) (*Resp, error)
+	ListFavourites(ctx context.Context, in *ListFavouritesRequest, opts ...grpc.CallOption) (*ListFavouritesResponse, error)
+	ReserveFavourite(ctx context.Context, in *ReserveFavouriteRequest, opts ...grpc.CallOption) (*ReserveFavouriteResponse, error)
+	SaveReserveIntent(ctx context.Context, in *SaveReserveIntentRequest, opts ...grpc.CallOption) (*SaveReserveIntentResponse, error)
+	DeleteReserveIntent(ctx context.Context, in *DeleteReserveIntentRequest, opts ...grpc.CallOption) (*Resp, error)
+	ListReserveIntents(ctx context.Context, in *ListReserveIntentsRequest, opts ...grpc.CallOption) (*ListReserveIntentsResponse, error)
+	GetOccupancy(ctx context.Context, in *GetOccupancyRequest, opts ...grpc.CallOption) (*GetOccupancyResponse, error)
+	GetOccupancyHeatmap(ctx context.Context, in *GetOccupancyHeatmapRequest, opts ...grpc.CallOption) (*GetOccupancyHeatmapResponse, error)
+	GetLeastBusyRoom(ctx context.Context, in *GetLeastBusyRoomRequest, opts ...grpc.CallOption) (*GetLeastBusyRoomResponse, error)
+	ListRooms(ctx context.Context, in *ListRoomsRequest, opts ...grpc.CallOption) (*ListRoomsResponse, error)
+	SaveRoom(ctx context.Context, in *SaveRoomRequest, opts ...grpc.CallOption) (*SaveRoomResponse, error)
+	DeleteRoom(ctx context.Context, in *DeleteRoomRequest, opts ...grpc.CallOption) (*Resp, error)
+	DiscoverRooms(ctx context.Context, in *DiscoverRoomsRequest, opts ...grpc.CallOption) (*DiscoverRoomsResponse, error)
+	GetUsageAnalytics(ctx context.Context, in *GetUsageAnalyticsRequest, opts ...grpc.CallOption) (*GetUsageAnalyticsResponse, error)
 }
 
 type libraryClient struct {
@@ -141,6 +193,56 @@ func (c *libraryClient) ReserveDiscussion(ctx context.Context, in *ReserveDiscus
 	return out, nil
 }
 
+func (c *libraryClient) SearchDiscussionSlots(ctx context.Context, in *SearchDiscussionSlotsRequest, opts ...grpc.CallOption) (*SearchDiscussionSlotsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchDiscussionSlotsResponse)
+	err := c.cc.Invoke(ctx, Library_SearchDiscussionSlots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *libraryClient) SaveStudyGroup(ctx context.Context, in *SaveStudyGroupRequest, opts ...grpc.CallOption) (*SaveStudyGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaveStudyGroupResponse)
+	err := c.cc.Invoke(ctx, Library_SaveStudyGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *libraryClient) DeleteStudyGroup(ctx context.Context, in *DeleteStudyGroupRequest, opts ...grpc.CallOption) (*Resp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Resp)
+	err := c.cc.Invoke(ctx, Library_DeleteStudyGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *libraryClient) ListStudyGroups(ctx context.Context, in *ListStudyGroupsRequest, opts ...grpc.CallOption) (*ListStudyGroupsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStudyGroupsResponse)
+	err := c.cc.Invoke(ctx, Library_ListStudyGroups_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *libraryClient) ReserveDiscussionForGroup(ctx context.Context, in *ReserveDiscussionForGroupRequest, opts ...grpc.CallOption) (*ReserveDiscussionForGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReserveDiscussionForGroupResponse)
+	err := c.cc.Invoke(ctx, Library_ReserveDiscussionForGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *libraryClient) CancelReserve(ctx context.Context, in *CancelReserveRequest, opts ...grpc.CallOption) (*CancelReserveResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CancelReserveResponse)
@@ -161,6 +263,16 @@ func (c *libraryClient) ReserveSeatRandomly(ctx context.Context, in *ReserveSeat
 	return out, nil
 }
 
+func (c *libraryClient) SwapReservation(ctx context.Context, in *SwapReservationRequest, opts ...grpc.CallOption) (*SwapReservationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SwapReservationResponse)
+	err := c.cc.Invoke(ctx, Library_SwapReservation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *libraryClient) CreateComment(ctx context.Context, in *CreateCommentReq, opts ...grpc.CallOption) (*Resp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Resp)
@@ -181,7 +293,7 @@ func (c *libraryClient) GetComments(ctx context.Context, in *ID, opts ...grpc.Ca
 	return out, nil
 }
 
-func (c *libraryClient) DeleteComment(ctx context.Context, in *ID, opts ...grpc.CallOption) (*Resp, error) {
+func (c *libraryClient) DeleteComment(ctx context.Context, in *DeleteCommentReq, opts ...grpc.CallOption) (*Resp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Resp)
 	err := c.cc.Invoke(ctx, Library_DeleteComment_FullMethodName, in, out, cOpts...)
@@ -191,6 +303,206 @@ func (c *libraryClient) DeleteComment(ctx context.Context, in *ID, opts ...grpc.
 	return out, nil
 }
 
+func (c *libraryClient) ListComments(ctx context.Context, in *ListCommentsRequest, opts ...grpc.CallOption) (*ListCommentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCommentsResponse)
+	err := c.cc.Invoke(ctx, Library_ListComments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *libraryClient) GetSeatRatings(ctx context.Context, in *GetSeatRatingsRequest, opts ...grpc.CallOption) (*GetSeatRatingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSeatRatingsResponse)
+	err := c.cc.Invoke(ctx, Library_GetSeatRatings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *libraryClient) ReportComment(ctx context.Context, in *ReportCommentRequest, opts ...grpc.CallOption) (*Resp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Resp)
+	err := c.cc.Invoke(ctx, Library_ReportComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *libraryClient) ListReportedComments(ctx context.Context, in *ListReportedCommentsRequest, opts ...grpc.CallOption) (*ListReportedCommentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReportedCommentsResponse)
+	err := c.cc.Invoke(ctx, Library_ListReportedComments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *libraryClient) ModerateComment(ctx context.Context, in *ModerateCommentRequest, opts ...grpc.CallOption) (*Resp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Resp)
+	err := c.cc.Invoke(ctx, Library_ModerateComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *libraryClient) AddFavourite(ctx context.Context, in *AddFavouriteRequest, opts ...grpc.CallOption) (*Resp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Resp)
+	err := c.cc.Invoke(ctx, Library_AddFavourite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *libraryClient) RemoveFavourite(ctx context.Context, in *RemoveFavouriteRequest, opts ...grpc.CallOption) (*Resp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Resp)
+	err := c.cc.Invoke(ctx, Library_RemoveFavourite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *libraryClient) ListFavourites(ctx context.Context, in *ListFavouritesRequest, opts ...grpc.CallOption) (*ListFavouritesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFavouritesResponse)
+	err := c.cc.Invoke(ctx, Library_ListFavourites_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *libraryClient) ReserveFavourite(ctx context.Context, in *ReserveFavouriteRequest, opts ...grpc.CallOption) (*ReserveFavouriteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReserveFavouriteResponse)
+	err := c.cc.Invoke(ctx, Library_ReserveFavourite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *libraryClient) SaveReserveIntent(ctx context.Context, in *SaveReserveIntentRequest, opts ...grpc.CallOption) (*SaveReserveIntentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaveReserveIntentResponse)
+	err := c.cc.Invoke(ctx, Library_SaveReserveIntent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *libraryClient) DeleteReserveIntent(ctx context.Context, in *DeleteReserveIntentRequest, opts ...grpc.CallOption) (*Resp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Resp)
+	err := c.cc.Invoke(ctx, Library_DeleteReserveIntent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *libraryClient) ListReserveIntents(ctx context.Context, in *ListReserveIntentsRequest, opts ...grpc.CallOption) (*ListReserveIntentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReserveIntentsResponse)
+	err := c.cc.Invoke(ctx, Library_ListReserveIntents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *libraryClient) GetOccupancy(ctx context.Context, in *GetOccupancyRequest, opts ...grpc.CallOption) (*GetOccupancyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOccupancyResponse)
+	err := c.cc.Invoke(ctx, Library_GetOccupancy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *libraryClient) GetOccupancyHeatmap(ctx context.Context, in *GetOccupancyHeatmapRequest, opts ...grpc.CallOption) (*GetOccupancyHeatmapResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOccupancyHeatmapResponse)
+	err := c.cc.Invoke(ctx, Library_GetOccupancyHeatmap_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *libraryClient) GetLeastBusyRoom(ctx context.Context, in *GetLeastBusyRoomRequest, opts ...grpc.CallOption) (*GetLeastBusyRoomResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLeastBusyRoomResponse)
+	err := c.cc.Invoke(ctx, Library_GetLeastBusyRoom_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *libraryClient) ListRooms(ctx context.Context, in *ListRoomsRequest, opts ...grpc.CallOption) (*ListRoomsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRoomsResponse)
+	err := c.cc.Invoke(ctx, Library_ListRooms_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *libraryClient) SaveRoom(ctx context.Context, in *SaveRoomRequest, opts ...grpc.CallOption) (*SaveRoomResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaveRoomResponse)
+	err := c.cc.Invoke(ctx, Library_SaveRoom_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *libraryClient) DeleteRoom(ctx context.Context, in *DeleteRoomRequest, opts ...grpc.CallOption) (*Resp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Resp)
+	err := c.cc.Invoke(ctx, Library_DeleteRoom_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *libraryClient) DiscoverRooms(ctx context.Context, in *DiscoverRoomsRequest, opts ...grpc.CallOption) (*DiscoverRoomsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiscoverRoomsResponse)
+	err := c.cc.Invoke(ctx, Library_DiscoverRooms_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *libraryClient) GetUsageAnalytics(ctx context.Context, in *GetUsageAnalyticsRequest, opts ...grpc.CallOption) (*GetUsageAnalyticsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUsageAnalyticsResponse)
+	err := c.cc.Invoke(ctx, Library_GetUsageAnalytics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LibraryServer is the server API for Library service.
 // All implementations must embed UnimplementedLibraryServer
 // for forward compatibility.
@@ -203,11 +515,37 @@ type LibraryServer interface {
 	GetDiscussion(context.Context, *GetDiscussionRequest) (*GetDiscussionResponse, error)
 	SearchUser(context.Context, *SearchUserRequest) (*SearchUserResponse, error)
 	ReserveDiscussion(context.Context, *ReserveDiscussionRequest) (*ReserveDiscussionResponse, error)
+	SearchDiscussionSlots(context.Context, *SearchDiscussionSlotsRequest) (*SearchDiscussionSlotsResponse, error)
+	SaveStudyGroup(context.Context, *SaveStudyGroupRequest) (*SaveStudyGroupResponse, error)
+	DeleteStudyGroup(context.Context, *DeleteStudyGroupRequest) (*Resp, error)
+	ListStudyGroups(context.Context, *ListStudyGroupsRequest) (*ListStudyGroupsResponse, error)
+	ReserveDiscussionForGroup(context.Context, *ReserveDiscussionForGroupRequest) (*ReserveDiscussionForGroupResponse, error)
 	CancelReserve(context.Context, *CancelReserveRequest) (*CancelReserveResponse, error)
 	ReserveSeatRandomly(context.Context, *ReserveSeatRandomlyRequest) (*ReserveSeatRandomlyResponse, error)
+	SwapReservation(context.Context, *SwapReservationRequest) (*SwapReservationResponse, error)
 	CreateComment(context.Context, *CreateCommentReq) (*Resp, error)
 	GetComments(context.Context, *ID) (*GetCommentResp, error)
-	DeleteComment(context.Context, *ID) (*Resp, error)
+	DeleteComment(context.Context, *DeleteCommentReq) (*Resp, error)
+	ListComments(context.Context, *ListCommentsRequest) (*ListCommentsResponse, error)
+	GetSeatRatings(context.Context, *GetSeatRatingsRequest) (*GetSeatRatingsResponse, error)
+	ReportComment(context.Context, *ReportCommentRequest) (*Resp, error)
+	ListReportedComments(context.Context, *ListReportedCommentsRequest) (*ListReportedCommentsResponse, error)
+	ModerateComment(context.Context, *ModerateCommentRequest) (*Resp, error)
+	AddFavourite(context.Context, *AddFavouriteRequest) (*Resp, error)
+	RemoveFavourite(context.Context, *RemoveFavouriteRequest) (*Resp, error)
+	ListFavourites(context.Context, *ListFavouritesRequest) (*ListFavouritesResponse, error)
+	ReserveFavourite(context.Context, *ReserveFavouriteRequest) (*ReserveFavouriteResponse, error)
+	SaveReserveIntent(context.Context, *SaveReserveIntentRequest) (*SaveReserveIntentResponse, error)
+	DeleteReserveIntent(context.Context, *DeleteReserveIntentRequest) (*Resp, error)
+	ListReserveIntents(context.Context, *ListReserveIntentsRequest) (*ListReserveIntentsResponse, error)
+	GetOccupancy(context.Context, *GetOccupancyRequest) (*GetOccupancyResponse, error)
+	GetOccupancyHeatmap(context.Context, *GetOccupancyHeatmapRequest) (*GetOccupancyHeatmapResponse, error)
+	GetLeastBusyRoom(context.Context, *GetLeastBusyRoomRequest) (*GetLeastBusyRoomResponse, error)
+	ListRooms(context.Context, *ListRoomsRequest) (*ListRoomsResponse, error)
+	SaveRoom(context.Context, *SaveRoomRequest) (*SaveRoomResponse, error)
+	DeleteRoom(context.Context, *DeleteRoomRequest) (*Resp, error)
+	DiscoverRooms(context.Context, *DiscoverRoomsRequest) (*DiscoverRoomsResponse, error)
+	GetUsageAnalytics(context.Context, *GetUsageAnalyticsRequest) (*GetUsageAnalyticsResponse, error)
 	mustEmbedUnimplementedLibraryServer()
 }
 
@@ -242,21 +580,99 @@ func (UnimplementedLibraryServer) SearchUser(context.Context, *SearchUserRequest
 func (UnimplementedLibraryServer) ReserveDiscussion(context.Context, *ReserveDiscussionRequest) (*ReserveDiscussionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReserveDiscussion not implemented")
 }
+func (UnimplementedLibraryServer) SearchDiscussionSlots(context.Context, *SearchDiscussionSlotsRequest) (*SearchDiscussionSlotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchDiscussionSlots not implemented")
+}
+func (UnimplementedLibraryServer) SaveStudyGroup(context.Context, *SaveStudyGroupRequest) (*SaveStudyGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveStudyGroup not implemented")
+}
+func (UnimplementedLibraryServer) DeleteStudyGroup(context.Context, *DeleteStudyGroupRequest) (*Resp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteStudyGroup not implemented")
+}
+func (UnimplementedLibraryServer) ListStudyGroups(context.Context, *ListStudyGroupsRequest) (*ListStudyGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStudyGroups not implemented")
+}
+func (UnimplementedLibraryServer) ReserveDiscussionForGroup(context.Context, *ReserveDiscussionForGroupRequest) (*ReserveDiscussionForGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveDiscussionForGroup not implemented")
+}
 func (UnimplementedLibraryServer) CancelReserve(context.Context, *CancelReserveRequest) (*CancelReserveResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelReserve not implemented")
 }
 func (UnimplementedLibraryServer) ReserveSeatRandomly(context.Context, *ReserveSeatRandomlyRequest) (*ReserveSeatRandomlyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReserveSeatRandomly not implemented")
 }
+func (UnimplementedLibraryServer) SwapReservation(context.Context, *SwapReservationRequest) (*SwapReservationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapReservation not implemented")
+}
 func (UnimplementedLibraryServer) CreateComment(context.Context, *CreateCommentReq) (*Resp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateComment not implemented")
 }
 func (UnimplementedLibraryServer) GetComments(context.Context, *ID) (*GetCommentResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetComments not implemented")
 }
-func (UnimplementedLibraryServer) DeleteComment(context.Context, *ID) (*Resp, error) {
+func (UnimplementedLibraryServer) DeleteComment(context.Context, *DeleteCommentReq) (*Resp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteComment not implemented")
 }
+func (UnimplementedLibraryServer) ListComments(context.Context, *ListCommentsRequest) (*ListCommentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListComments not implemented")
+}
+func (UnimplementedLibraryServer) GetSeatRatings(context.Context, *GetSeatRatingsRequest) (*GetSeatRatingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSeatRatings not implemented")
+}
+func (UnimplementedLibraryServer) ReportComment(context.Context, *ReportCommentRequest) (*Resp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportComment not implemented")
+}
+func (UnimplementedLibraryServer) ListReportedComments(context.Context, *ListReportedCommentsRequest) (*ListReportedCommentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReportedComments not implemented")
+}
+func (UnimplementedLibraryServer) ModerateComment(context.Context, *ModerateCommentRequest) (*Resp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModerateComment not implemented")
+}
+func (UnimplementedLibraryServer) AddFavourite(context.Context, *AddFavouriteRequest) (*Resp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddFavourite not implemented")
+}
+func (UnimplementedLibraryServer) RemoveFavourite(context.Context, *RemoveFavouriteRequest) (*Resp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFavourite not implemented")
+}
+func (UnimplementedLibraryServer) ListFavourites(context.Context, *ListFavouritesRequest) (*ListFavouritesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFavourites not implemented")
+}
+func (UnimplementedLibraryServer) ReserveFavourite(context.Context, *ReserveFavouriteRequest) (*ReserveFavouriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveFavourite not implemented")
+}
+func (UnimplementedLibraryServer) SaveReserveIntent(context.Context, *SaveReserveIntentRequest) (*SaveReserveIntentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveReserveIntent not implemented")
+}
+func (UnimplementedLibraryServer) DeleteReserveIntent(context.Context, *DeleteReserveIntentRequest) (*Resp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteReserveIntent not implemented")
+}
+func (UnimplementedLibraryServer) ListReserveIntents(context.Context, *ListReserveIntentsRequest) (*ListReserveIntentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReserveIntents not implemented")
+}
+func (UnimplementedLibraryServer) GetOccupancy(context.Context, *GetOccupancyRequest) (*GetOccupancyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOccupancy not implemented")
+}
+func (UnimplementedLibraryServer) GetOccupancyHeatmap(context.Context, *GetOccupancyHeatmapRequest) (*GetOccupancyHeatmapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOccupancyHeatmap not implemented")
+}
+func (UnimplementedLibraryServer) GetLeastBusyRoom(context.Context, *GetLeastBusyRoomRequest) (*GetLeastBusyRoomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLeastBusyRoom not implemented")
+}
+func (UnimplementedLibraryServer) ListRooms(context.Context, *ListRoomsRequest) (*ListRoomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRooms not implemented")
+}
+func (UnimplementedLibraryServer) SaveRoom(context.Context, *SaveRoomRequest) (*SaveRoomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveRoom not implemented")
+}
+func (UnimplementedLibraryServer) DeleteRoom(context.Context, *DeleteRoomRequest) (*Resp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRoom not implemented")
+}
+func (UnimplementedLibraryServer) DiscoverRooms(context.Context, *DiscoverRoomsRequest) (*DiscoverRoomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiscoverRooms not implemented")
+}
+func (UnimplementedLibraryServer) GetUsageAnalytics(context.Context, *GetUsageAnalyticsRequest) (*GetUsageAnalyticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsageAnalytics not implemented")
+}
 func (UnimplementedLibraryServer) mustEmbedUnimplementedLibraryServer() {}
 func (UnimplementedLibraryServer) testEmbeddedByValue()                 {}
 
@@ -422,113 +838,581 @@ func _Library_ReserveDiscussion_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Library_CancelReserve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CancelReserveRequest)
+func _Library_SearchDiscussionSlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchDiscussionSlotsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LibraryServer).CancelReserve(ctx, in)
+		return srv.(LibraryServer).SearchDiscussionSlots(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Library_CancelReserve_FullMethodName,
+		FullMethod: Library_SearchDiscussionSlots_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LibraryServer).CancelReserve(ctx, req.(*CancelReserveRequest))
+		return srv.(LibraryServer).SearchDiscussionSlots(ctx, req.(*SearchDiscussionSlotsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Library_ReserveSeatRandomly_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReserveSeatRandomlyRequest)
+func _Library_SaveStudyGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveStudyGroupRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LibraryServer).ReserveSeatRandomly(ctx, in)
+		return srv.(LibraryServer).SaveStudyGroup(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Library_ReserveSeatRandomly_FullMethodName,
+		FullMethod: Library_SaveStudyGroup_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LibraryServer).ReserveSeatRandomly(ctx, req.(*ReserveSeatRandomlyRequest))
+		return srv.(LibraryServer).SaveStudyGroup(ctx, req.(*SaveStudyGroupRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Library_CreateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateCommentReq)
+func _Library_DeleteStudyGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteStudyGroupRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LibraryServer).CreateComment(ctx, in)
+		return srv.(LibraryServer).DeleteStudyGroup(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Library_CreateComment_FullMethodName,
+		FullMethod: Library_DeleteStudyGroup_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LibraryServer).CreateComment(ctx, req.(*CreateCommentReq))
+		return srv.(LibraryServer).DeleteStudyGroup(ctx, req.(*DeleteStudyGroupRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Library_GetComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ID)
+func _Library_ListStudyGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStudyGroupsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LibraryServer).GetComments(ctx, in)
+		return srv.(LibraryServer).ListStudyGroups(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Library_GetComments_FullMethodName,
+		FullMethod: Library_ListStudyGroups_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LibraryServer).GetComments(ctx, req.(*ID))
+		return srv.(LibraryServer).ListStudyGroups(ctx, req.(*ListStudyGroupsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Library_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ID)
+func _Library_ReserveDiscussionForGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveDiscussionForGroupRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LibraryServer).DeleteComment(ctx, in)
+		return srv.(LibraryServer).ReserveDiscussionForGroup(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Library_DeleteComment_FullMethodName,
+		FullMethod: Library_ReserveDiscussionForGroup_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LibraryServer).DeleteComment(ctx, req.(*ID))
+		return srv.(LibraryServer).ReserveDiscussionForGroup(ctx, req.(*ReserveDiscussionForGroupRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// Library_ServiceDesc is the grpc.ServiceDesc for Library service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var Library_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "library.v1.Library",
-	HandlerType: (*LibraryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetSeat",
-			Handler:    _Library_GetSeat_Handler,
-		},
-		{
-			MethodName: "ReserveSeat",
-			Handler:    _Library_ReserveSeat_Handler,
-		},
-		{
-			MethodName: "GetSeatRecord",
+func _Library_CancelReserve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelReserveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServer).CancelReserve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Library_CancelReserve_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServer).CancelReserve(ctx, req.(*CancelReserveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Library_ReserveSeatRandomly_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveSeatRandomlyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServer).ReserveSeatRandomly(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Library_ReserveSeatRandomly_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServer).ReserveSeatRandomly(ctx, req.(*ReserveSeatRandomlyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Library_SwapReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SwapReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServer).SwapReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Library_SwapReservation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServer).SwapReservation(ctx, req.(*SwapReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Library_CreateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCommentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServer).CreateComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Library_CreateComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServer).CreateComment(ctx, req.(*CreateCommentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Library_GetComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServer).GetComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Library_GetComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServer).GetComments(ctx, req.(*ID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Library_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCommentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServer).DeleteComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Library_DeleteComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServer).DeleteComment(ctx, req.(*DeleteCommentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Library_ListComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServer).ListComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Library_ListComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServer).ListComments(ctx, req.(*ListCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Library_GetSeatRatings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSeatRatingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServer).GetSeatRatings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Library_GetSeatRatings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServer).GetSeatRatings(ctx, req.(*GetSeatRatingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Library_ReportComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServer).ReportComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Library_ReportComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServer).ReportComment(ctx, req.(*ReportCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Library_ListReportedComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReportedCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServer).ListReportedComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Library_ListReportedComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServer).ListReportedComments(ctx, req.(*ListReportedCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Library_ModerateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServer).ModerateComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Library_ModerateComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServer).ModerateComment(ctx, req.(*ModerateCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Library_AddFavourite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddFavouriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServer).AddFavourite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Library_AddFavourite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServer).AddFavourite(ctx, req.(*AddFavouriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Library_RemoveFavourite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFavouriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServer).RemoveFavourite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Library_RemoveFavourite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServer).RemoveFavourite(ctx, req.(*RemoveFavouriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Library_ListFavourites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFavouritesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServer).ListFavourites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Library_ListFavourites_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServer).ListFavourites(ctx, req.(*ListFavouritesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Library_ReserveFavourite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveFavouriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServer).ReserveFavourite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Library_ReserveFavourite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServer).ReserveFavourite(ctx, req.(*ReserveFavouriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Library_SaveReserveIntent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveReserveIntentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServer).SaveReserveIntent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Library_SaveReserveIntent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServer).SaveReserveIntent(ctx, req.(*SaveReserveIntentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Library_DeleteReserveIntent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReserveIntentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServer).DeleteReserveIntent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Library_DeleteReserveIntent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServer).DeleteReserveIntent(ctx, req.(*DeleteReserveIntentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Library_ListReserveIntents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReserveIntentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServer).ListReserveIntents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Library_ListReserveIntents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServer).ListReserveIntents(ctx, req.(*ListReserveIntentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Library_GetOccupancy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOccupancyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServer).GetOccupancy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Library_GetOccupancy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServer).GetOccupancy(ctx, req.(*GetOccupancyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Library_GetOccupancyHeatmap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOccupancyHeatmapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServer).GetOccupancyHeatmap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Library_GetOccupancyHeatmap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServer).GetOccupancyHeatmap(ctx, req.(*GetOccupancyHeatmapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Library_GetLeastBusyRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLeastBusyRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServer).GetLeastBusyRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Library_GetLeastBusyRoom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServer).GetLeastBusyRoom(ctx, req.(*GetLeastBusyRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Library_ListRooms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRoomsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServer).ListRooms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Library_ListRooms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServer).ListRooms(ctx, req.(*ListRoomsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Library_SaveRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServer).SaveRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Library_SaveRoom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServer).SaveRoom(ctx, req.(*SaveRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Library_DeleteRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServer).DeleteRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Library_DeleteRoom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServer).DeleteRoom(ctx, req.(*DeleteRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Library_DiscoverRooms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiscoverRoomsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServer).DiscoverRooms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Library_DiscoverRooms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServer).DiscoverRooms(ctx, req.(*DiscoverRoomsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Library_GetUsageAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsageAnalyticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServer).GetUsageAnalytics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Library_GetUsageAnalytics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServer).GetUsageAnalytics(ctx, req.(*GetUsageAnalyticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Library_ServiceDesc is the grpc.ServiceDesc for Library service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Library_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "library.v1.Library",
+	HandlerType: (*LibraryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSeat",
+			Handler:    _Library_GetSeat_Handler,
+		},
+		{
+			MethodName: "ReserveSeat",
+			Handler:    _Library_ReserveSeat_Handler,
+		},
+		{
+			MethodName: "GetSeatRecord",
 			Handler:    _Library_GetSeatRecord_Handler,
 		},
 		{
@@ -551,6 +1435,26 @@ var Library_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReserveDiscussion",
 			Handler:    _Library_ReserveDiscussion_Handler,
 		},
+		{
+			MethodName: "SearchDiscussionSlots",
+			Handler:    _Library_SearchDiscussionSlots_Handler,
+		},
+		{
+			MethodName: "SaveStudyGroup",
+			Handler:    _Library_SaveStudyGroup_Handler,
+		},
+		{
+			MethodName: "DeleteStudyGroup",
+			Handler:    _Library_DeleteStudyGroup_Handler,
+		},
+		{
+			MethodName: "ListStudyGroups",
+			Handler:    _Library_ListStudyGroups_Handler,
+		},
+		{
+			MethodName: "ReserveDiscussionForGroup",
+			Handler:    _Library_ReserveDiscussionForGroup_Handler,
+		},
 		{
 			MethodName: "CancelReserve",
 			Handler:    _Library_CancelReserve_Handler,
@@ -559,6 +1463,10 @@ var Library_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReserveSeatRandomly",
 			Handler:    _Library_ReserveSeatRandomly_Handler,
 		},
+		{
+			MethodName: "SwapReservation",
+			Handler:    _Library_SwapReservation_Handler,
+		},
 		{
 			MethodName: "CreateComment",
 			Handler:    _Library_CreateComment_Handler,
@@ -571,6 +1479,86 @@ var Library_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteComment",
 			Handler:    _Library_DeleteComment_Handler,
 		},
+		{
+			MethodName: "ListComments",
+			Handler:    _Library_ListComments_Handler,
+		},
+		{
+			MethodName: "GetSeatRatings",
+			Handler:    _Library_GetSeatRatings_Handler,
+		},
+		{
+			MethodName: "ReportComment",
+			Handler:    _Library_ReportComment_Handler,
+		},
+		{
+			MethodName: "ListReportedComments",
+			Handler:    _Library_ListReportedComments_Handler,
+		},
+		{
+			MethodName: "ModerateComment",
+			Handler:    _Library_ModerateComment_Handler,
+		},
+		{
+			MethodName: "AddFavourite",
+			Handler:    _Library_AddFavourite_Handler,
+		},
+		{
+			MethodName: "RemoveFavourite",
+			Handler:    _Library_RemoveFavourite_Handler,
+		},
+		{
+			MethodName: "ListFavourites",
+			Handler:    _Library_ListFavourites_Handler,
+		},
+		{
+			MethodName: "ReserveFavourite",
+			Handler:    _Library_ReserveFavourite_Handler,
+		},
+		{
+			MethodName: "SaveReserveIntent",
+			Handler:    _Library_SaveReserveIntent_Handler,
+		},
+		{
+			MethodName: "DeleteReserveIntent",
+			Handler:    _Library_DeleteReserveIntent_Handler,
+		},
+		{
+			MethodName: "ListReserveIntents",
+			Handler:    _Library_ListReserveIntents_Handler,
+		},
+		{
+			MethodName: "GetOccupancy",
+			Handler:    _Library_GetOccupancy_Handler,
+		},
+		{
+			MethodName: "GetOccupancyHeatmap",
+			Handler:    _Library_GetOccupancyHeatmap_Handler,
+		},
+		{
+			MethodName: "GetLeastBusyRoom",
+			Handler:    _Library_GetLeastBusyRoom_Handler,
+		},
+		{
+			MethodName: "ListRooms",
+			Handler:    _Library_ListRooms_Handler,
+		},
+		{
+			MethodName: "SaveRoom",
+			Handler:    _Library_SaveRoom_Handler,
+		},
+		{
+			MethodName: "DeleteRoom",
+			Handler:    _Library_DeleteRoom_Handler,
+		},
+		{
+			MethodName: "DiscoverRooms",
+			Handler:    _Library_DiscoverRooms_Handler,
+		},
+		{
+			MethodName: "GetUsageAnalytics",
+			Handler:    _Library_GetUsageAnalytics_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "library/v1/library.proto",
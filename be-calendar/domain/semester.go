@@ -0,0 +1,37 @@
+package domain
+
+// Holiday 学期中的节假日,日期的格式为"2006-01-02"
+type Holiday struct {
+	Name      string
+	StartDate string
+	EndDate   string // 包含这一天
+}
+
+// MakeupDay 调休,在Date这一天按照ReplaceDate那一天的课表上课
+type MakeupDay struct {
+	Name        string
+	Date        string
+	ReplaceDate string
+}
+
+// Semester 校历中的一个学期
+type Semester struct {
+	Year       string // "2024" 代表"2024-2025学年"
+	Semester   string // "1"、"2"、"3"
+	StartDate  string // 正式上学的第一天,这一周为第一周
+	EndDate    string // 学期的最后一天
+	Holidays   []Holiday
+	MakeupDays []MakeupDay
+}
+
+// SemesterDay 某一天在校历中的信息
+type SemesterDay struct {
+	Semester    Semester // 在假期中时为假期前的学期
+	InSemester  bool
+	Week        int64 // 不在学期内时为0
+	Weekday     int64 // 按星期几的课表上课,1-7
+	IsHoliday   bool
+	HolidayName string
+	IsMakeup    bool
+	MakeupName  string
+}
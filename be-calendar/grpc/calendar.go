@@ -10,11 +10,12 @@ import (
 
 type CalendarServiceServer struct {
 	calendarv1.UnimplementedCalendarServiceServer
-	svc service.CalendarService
+	svc         service.CalendarService
+	semesterSvc service.SemesterService
 }
 
-func NewCalendarServiceServer(svc service.CalendarService) *CalendarServiceServer {
-	return &CalendarServiceServer{svc: svc}
+func NewCalendarServiceServer(svc service.CalendarService, semesterSvc service.SemesterService) *CalendarServiceServer {
+	return &CalendarServiceServer{svc: svc, semesterSvc: semesterSvc}
 }
 
 func (c *CalendarServiceServer) GetCalendars(ctx context.Context, request *calendarv1.GetCalendarsRequest) (*calendarv1.GetCalendarsResponse, error) {
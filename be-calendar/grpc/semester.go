@@ -0,0 +1,95 @@
+package grpc
+
+import (
+	"context"
+	calendarv1 "github.com/asynccnu/ccnubox-be/be-api/gen/proto/calendar/v1"
+	"github.com/asynccnu/ccnubox-be/be-calendar/domain"
+)
+
+func (c *CalendarServiceServer) GetSemesters(ctx context.Context, request *calendarv1.GetSemestersRequest) (*calendarv1.GetSemestersResponse, error) {
+	semesters, err := c.semesterSvc.GetSemesters(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*calendarv1.Semester, 0, len(semesters))
+	for _, s := range semesters {
+		res = append(res, convSemesterToGRPC(s))
+	}
+	return &calendarv1.GetSemestersResponse{Semesters: res}, nil
+}
+
+func (c *CalendarServiceServer) SaveSemester(ctx context.Context, request *calendarv1.SaveSemesterRequest) (*calendarv1.SaveSemesterResponse, error) {
+	s := request.GetSemester()
+	semester := &domain.Semester{
+		Year:      s.GetYear(),
+		Semester:  s.GetSemester(),
+		StartDate: s.GetStartDate(),
+		EndDate:   s.GetEndDate(),
+	}
+	for _, h := range s.GetHolidays() {
+		semester.Holidays = append(semester.Holidays, domain.Holiday{
+			Name:      h.GetName(),
+			StartDate: h.GetStartDate(),
+			EndDate:   h.GetEndDate(),
+		})
+	}
+	for _, m := range s.GetMakeupDays() {
+		semester.MakeupDays = append(semester.MakeupDays, domain.MakeupDay{
+			Name:        m.GetName(),
+			Date:        m.GetDate(),
+			ReplaceDate: m.GetReplaceDate(),
+		})
+	}
+	if err := c.semesterSvc.SaveSemester(ctx, semester); err != nil {
+		return nil, err
+	}
+	return &calendarv1.SaveSemesterResponse{}, nil
+}
+
+func (c *CalendarServiceServer) DelSemester(ctx context.Context, request *calendarv1.DelSemesterRequest) (*calendarv1.DelSemesterResponse, error) {
+	if err := c.semesterSvc.DelSemester(ctx, request.GetYear(), request.GetSemester()); err != nil {
+		return nil, err
+	}
+	return &calendarv1.DelSemesterResponse{}, nil
+}
+
+func (c *CalendarServiceServer) GetSemesterDay(ctx context.Context, request *calendarv1.GetSemesterDayRequest) (*calendarv1.GetSemesterDayResponse, error) {
+	day, err := c.semesterSvc.GetSemesterDay(ctx, request.GetDate())
+	if err != nil {
+		return nil, err
+	}
+	return &calendarv1.GetSemesterDayResponse{
+		Semester:    convSemesterToGRPC(day.Semester),
+		InSemester:  day.InSemester,
+		Week:        day.Week,
+		Weekday:     day.Weekday,
+		IsHoliday:   day.IsHoliday,
+		HolidayName: day.HolidayName,
+		IsMakeup:    day.IsMakeup,
+		MakeupName:  day.MakeupName,
+	}, nil
+}
+
+func convSemesterToGRPC(s domain.Semester) *calendarv1.Semester {
+	res := &calendarv1.Semester{
+		Year:      s.Year,
+		Semester:  s.Semester,
+		StartDate: s.StartDate,
+		EndDate:   s.EndDate,
+	}
+	for _, h := range s.Holidays {
+		res.Holidays = append(res.Holidays, &calendarv1.Holiday{
+			Name:      h.Name,
+			StartDate: h.StartDate,
+			EndDate:   h.EndDate,
+		})
+	}
+	for _, m := range s.MakeupDays {
+		res.MakeupDays = append(res.MakeupDays, &calendarv1.MakeupDay{
+			Name:        m.Name,
+			Date:        m.Date,
+			ReplaceDate: m.ReplaceDate,
+		})
+	}
+	return res
+}
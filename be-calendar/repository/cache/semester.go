@@ -0,0 +1,50 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/asynccnu/ccnubox-be/be-calendar/domain"
+	"github.com/redis/go-redis/v9"
+)
+
+// SemesterCache 缓存校历中的所有学期
+type SemesterCache interface {
+	GetSemesters(ctx context.Context) ([]domain.Semester, error)
+	SetSemesters(ctx context.Context, semesters []domain.Semester) error
+	ClearSemesterCache(ctx context.Context) error
+}
+
+type RedisSemesterCache struct {
+	cmd redis.Cmdable
+}
+
+// NewRedisSemesterCache 创建一个基于 Redis 的 SemesterCache 实现
+func NewRedisSemesterCache(cmd redis.Cmdable) SemesterCache {
+	return &RedisSemesterCache{cmd: cmd}
+}
+
+func (cache *RedisSemesterCache) GetSemesters(ctx context.Context) ([]domain.Semester, error) {
+	data, err := cache.cmd.Get(ctx, cache.getKey()).Bytes()
+	if err != nil {
+		return nil, err
+	}
+	var st []domain.Semester
+	err = json.Unmarshal(data, &st)
+	return st, err
+}
+
+func (cache *RedisSemesterCache) SetSemesters(ctx context.Context, semesters []domain.Semester) error {
+	data, err := json.Marshal(semesters)
+	if err != nil {
+		return err
+	}
+	return cache.cmd.Set(ctx, cache.getKey(), data, 0).Err() // 永不过期,修改时清除
+}
+
+func (cache *RedisSemesterCache) ClearSemesterCache(ctx context.Context) error {
+	return cache.cmd.Del(ctx, cache.getKey()).Err()
+}
+
+func (cache *RedisSemesterCache) getKey() string {
+	return "ccnubox:semesters"
+}
@@ -6,5 +6,5 @@ import (
 )
 
 func InitTables(db *gorm.DB) error {
-	return db.AutoMigrate(&model.Calendar{})
+	return db.AutoMigrate(&model.Calendar{}, &model.Semester{})
 }
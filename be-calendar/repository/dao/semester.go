@@ -0,0 +1,46 @@
+package dao
+
+import (
+	"context"
+	"github.com/asynccnu/ccnubox-be/be-calendar/repository/model"
+	"gorm.io/gorm"
+)
+
+// SemesterDAO 校历中学期的存取
+type SemesterDAO interface {
+	GetSemester(ctx context.Context, year, semester string) (*model.Semester, error)
+	GetSemesters(ctx context.Context) ([]model.Semester, error)
+	SaveSemester(ctx context.Context, semester *model.Semester) error
+	DelSemester(ctx context.Context, year, semester string) error
+}
+
+type semesterDAO struct {
+	gorm *gorm.DB
+}
+
+// NewMysqlSemesterDAO 创建一个基于 MySQL 的 SemesterDAO 实现
+func NewMysqlSemesterDAO(db *gorm.DB) SemesterDAO {
+	return &semesterDAO{gorm: db}
+}
+
+func (dao *semesterDAO) GetSemester(ctx context.Context, year, semester string) (*model.Semester, error) {
+	var s model.Semester
+	err := dao.gorm.WithContext(ctx).Where("year=? AND semester=?", year, semester).First(&s).Error
+	return &s, err
+}
+
+// GetSemesters 按开学时间排序
+func (dao *semesterDAO) GetSemesters(ctx context.Context) ([]model.Semester, error) {
+	var s []model.Semester
+	err := dao.gorm.WithContext(ctx).Order("start_date").Find(&s).Error
+	return s, err
+}
+
+func (dao *semesterDAO) SaveSemester(ctx context.Context, semester *model.Semester) error {
+	return dao.gorm.WithContext(ctx).Save(semester).Error
+}
+
+// DelSemester 直接删除,避免软删除的记录占用唯一索引
+func (dao *semesterDAO) DelSemester(ctx context.Context, year, semester string) error {
+	return dao.gorm.WithContext(ctx).Unscoped().Where("year=? AND semester=?", year, semester).Delete(&model.Semester{}).Error
+}
@@ -0,0 +1,25 @@
+package model
+
+import "gorm.io/gorm"
+
+type Holiday struct {
+	Name      string `json:"name"`
+	StartDate string `json:"start_date"`
+	EndDate   string `json:"end_date"`
+}
+
+type MakeupDay struct {
+	Name        string `json:"name"`
+	Date        string `json:"date"`
+	ReplaceDate string `json:"replace_date"`
+}
+
+type Semester struct {
+	Year       string      `gorm:"column:year;size:8;uniqueIndex:idx_year_semester"`
+	Semester   string      `gorm:"column:semester;size:4;uniqueIndex:idx_year_semester"`
+	StartDate  string      `gorm:"column:start_date;size:10"`
+	EndDate    string      `gorm:"column:end_date;size:10"`
+	Holidays   []Holiday   `gorm:"column:holidays;type:text;serializer:json"`
+	MakeupDays []MakeupDay `gorm:"column:makeup_days;type:text;serializer:json"`
+	gorm.Model
+}
@@ -0,0 +1,292 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	calendarv1 "github.com/asynccnu/ccnubox-be/be-api/gen/proto/calendar/v1"
+	"github.com/asynccnu/ccnubox-be/be-calendar/domain"
+	"github.com/asynccnu/ccnubox-be/be-calendar/pkg/errorx"
+	"github.com/asynccnu/ccnubox-be/be-calendar/pkg/logger"
+	"github.com/asynccnu/ccnubox-be/be-calendar/repository/cache"
+	"github.com/asynccnu/ccnubox-be/be-calendar/repository/dao"
+	"github.com/asynccnu/ccnubox-be/be-calendar/repository/model"
+	"gorm.io/gorm"
+)
+
+const dateLayout = "2006-01-02"
+
+// 校历中的日期都是北京时间
+var cst = time.FixedZone("CST", 8*60*60)
+
+// SemesterService 校历,其他服务通过它获取学期和当前周,不再各自计算
+type SemesterService interface {
+	GetSemesters(ctx context.Context) ([]domain.Semester, error)
+	SaveSemester(ctx context.Context, semester *domain.Semester) error
+	DelSemester(ctx context.Context, year, semester string) error
+	// GetSemesterDay 查询某一天在校历中的信息,date为空时查询今天
+	GetSemesterDay(ctx context.Context, date string) (domain.SemesterDay, error)
+}
+
+var (
+	GET_SEMESTER_ERROR = func(err error) error {
+		return errorx.New(calendarv1.ErrorGetSemesterError("获取学期失败"), "dao", err)
+	}
+
+	DEL_SEMESTER_ERROR = func(err error) error {
+		return errorx.New(calendarv1.ErrorDelSemesterError("删除学期失败"), "dao", err)
+	}
+
+	SAVE_SEMESTER_ERROR = func(err error) error {
+		return errorx.New(calendarv1.ErrorSaveSemesterError("保存学期失败"), "dao", err)
+	}
+
+	INVALID_SEMESTER_ERROR = func(err error) error {
+		return errorx.New(calendarv1.ErrorInvalidSemesterError("学期信息不合法: %v", err), "param", err)
+	}
+
+	SEMESTER_NOT_FOUND = func(err error) error {
+		return errorx.New(calendarv1.ErrorSemesterNotFound("校历中没有对应的学期"), "dao", err)
+	}
+)
+
+type CachedSemesterService struct {
+	dao   dao.SemesterDAO
+	cache cache.SemesterCache
+	l     logger.Logger
+}
+
+func NewCachedSemesterService(dao dao.SemesterDAO, cache cache.SemesterCache, l logger.Logger) SemesterService {
+	return &CachedSemesterService{dao: dao, cache: cache, l: l}
+}
+
+func (s *CachedSemesterService) GetSemesters(ctx context.Context) ([]domain.Semester, error) {
+	res, err := s.cache.GetSemesters(ctx)
+	if err == nil {
+		return res, nil
+	}
+
+	semesters, err := s.dao.GetSemesters(ctx)
+	if err != nil {
+		return nil, GET_SEMESTER_ERROR(err)
+	}
+	res = convSemesterModelsToDomains(semesters)
+
+	if err := s.cache.SetSemesters(ctx, res); err != nil {
+		s.l.Error("回写资源失败", logger.Error(err))
+	}
+	return res, nil
+}
+
+func (s *CachedSemesterService) SaveSemester(ctx context.Context, semester *domain.Semester) error {
+	if err := validateSemester(semester); err != nil {
+		return INVALID_SEMESTER_ERROR(err)
+	}
+
+	m, err := s.dao.GetSemester(ctx, semester.Year, semester.Semester)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return SAVE_SEMESTER_ERROR(err)
+	}
+	m.Year = semester.Year
+	m.Semester = semester.Semester
+	m.StartDate = semester.StartDate
+	m.EndDate = semester.EndDate
+	m.Holidays = convHolidaysToModels(semester.Holidays)
+	m.MakeupDays = convMakeupDaysToModels(semester.MakeupDays)
+	if err := s.dao.SaveSemester(ctx, m); err != nil {
+		return SAVE_SEMESTER_ERROR(err)
+	}
+
+	// 校历修改后需要立即生效,同步清除缓存
+	if err := s.cache.ClearSemesterCache(ctx); err != nil {
+		s.l.Error("清除缓存失败", logger.Error(err))
+	}
+	return nil
+}
+
+func (s *CachedSemesterService) DelSemester(ctx context.Context, year, semester string) error {
+	if err := s.dao.DelSemester(ctx, year, semester); err != nil {
+		return DEL_SEMESTER_ERROR(err)
+	}
+	if err := s.cache.ClearSemesterCache(ctx); err != nil {
+		s.l.Error("清除缓存失败", logger.Error(err))
+	}
+	return nil
+}
+
+func (s *CachedSemesterService) GetSemesterDay(ctx context.Context, date string) (domain.SemesterDay, error) {
+	day := time.Now().In(cst)
+	if date != "" {
+		var err error
+		day, err = time.ParseInLocation(dateLayout, date, cst)
+		if err != nil {
+			return domain.SemesterDay{}, INVALID_SEMESTER_ERROR(err)
+		}
+	}
+
+	semesters, err := s.GetSemesters(ctx)
+	if err != nil {
+		return domain.SemesterDay{}, err
+	}
+	res, ok := findSemesterDay(semesters, day)
+	if !ok {
+		return domain.SemesterDay{}, SEMESTER_NOT_FOUND(fmt.Errorf("no semester starts before %s", day.Format(dateLayout)))
+	}
+	return res, nil
+}
+
+// findSemesterDay 找到day所在的学期,在假期中时使用假期前的学期
+func findSemesterDay(semesters []domain.Semester, day time.Time) (domain.SemesterDay, bool) {
+	day = truncateDay(day)
+
+	var (
+		res   domain.SemesterDay
+		found bool
+		start time.Time
+	)
+	for _, semester := range semesters {
+		st, err := time.ParseInLocation(dateLayout, semester.StartDate, cst)
+		if err != nil || st.After(day) {
+			continue
+		}
+		if !found || st.After(start) {
+			res.Semester, start, found = semester, st, true
+		}
+	}
+	if !found {
+		return res, false
+	}
+
+	res.Weekday = weekday(day)
+	for _, h := range res.Semester.Holidays {
+		if inRange(day, h.StartDate, h.EndDate) {
+			res.IsHoliday, res.HolidayName = true, h.Name
+			break
+		}
+	}
+
+	// 调休的那一天按照被替换那一天的周次和星期上课
+	classDay := day
+	for _, m := range res.Semester.MakeupDays {
+		if m.Date != day.Format(dateLayout) {
+			continue
+		}
+		replace, err := time.ParseInLocation(dateLayout, m.ReplaceDate, cst)
+		if err != nil {
+			continue
+		}
+		classDay = replace
+		res.IsMakeup, res.MakeupName = true, m.Name
+		res.IsHoliday, res.HolidayName = false, ""
+		res.Weekday = weekday(replace)
+		break
+	}
+
+	if !inRange(day, res.Semester.StartDate, res.Semester.EndDate) {
+		return res, true
+	}
+	res.InSemester = true
+	// 开学的那一周为第一周,即使开学那天不是星期一
+	firstMonday := start.AddDate(0, 0, 1-int(weekday(start)))
+	res.Week = int64(classDay.Sub(firstMonday).Hours()/24)/7 + 1
+	return res, true
+}
+
+func validateSemester(s *domain.Semester) error {
+	if y, err := strconv.Atoi(s.Year); err != nil || y < 2000 || y > 9999 {
+		return fmt.Errorf("year %q should be like 2024", s.Year)
+	}
+	if s.Semester != "1" && s.Semester != "2" && s.Semester != "3" {
+		return fmt.Errorf("semester %q should be 1, 2 or 3", s.Semester)
+	}
+	if err := checkRange(s.StartDate, s.EndDate); err != nil {
+		return err
+	}
+	for _, h := range s.Holidays {
+		if err := checkRange(h.StartDate, h.EndDate); err != nil {
+			return fmt.Errorf("holiday %s: %w", h.Name, err)
+		}
+	}
+	for _, m := range s.MakeupDays {
+		if _, err := time.Parse(dateLayout, m.Date); err != nil {
+			return fmt.Errorf("makeup day %s: %w", m.Name, err)
+		}
+		if _, err := time.Parse(dateLayout, m.ReplaceDate); err != nil {
+			return fmt.Errorf("makeup day %s: %w", m.Name, err)
+		}
+	}
+	return nil
+}
+
+func checkRange(startDate, endDate string) error {
+	start, err := time.Parse(dateLayout, startDate)
+	if err != nil {
+		return err
+	}
+	end, err := time.Parse(dateLayout, endDate)
+	if err != nil {
+		return err
+	}
+	if end.Before(start) {
+		return fmt.Errorf("end date %s is before start date %s", endDate, startDate)
+	}
+	return nil
+}
+
+// inRange 判断day是否在[startDate,endDate]中
+func inRange(day time.Time, startDate, endDate string) bool {
+	d := day.Format(dateLayout)
+	return d >= startDate && d <= endDate
+}
+
+func truncateDay(t time.Time) time.Time {
+	t = t.In(cst)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, cst)
+}
+
+// weekday 星期一为1,星期日为7
+func weekday(t time.Time) int64 {
+	if t.Weekday() == time.Sunday {
+		return 7
+	}
+	return int64(t.Weekday())
+}
+
+func convSemesterModelsToDomains(semesters []model.Semester) []domain.Semester {
+	res := make([]domain.Semester, 0, len(semesters))
+	for _, s := range semesters {
+		d := domain.Semester{
+			Year:      s.Year,
+			Semester:  s.Semester,
+			StartDate: s.StartDate,
+			EndDate:   s.EndDate,
+		}
+		for _, h := range s.Holidays {
+			d.Holidays = append(d.Holidays, domain.Holiday{Name: h.Name, StartDate: h.StartDate, EndDate: h.EndDate})
+		}
+		for _, m := range s.MakeupDays {
+			d.MakeupDays = append(d.MakeupDays, domain.MakeupDay{Name: m.Name, Date: m.Date, ReplaceDate: m.ReplaceDate})
+		}
+		res = append(res, d)
+	}
+	return res
+}
+
+func convHolidaysToModels(holidays []domain.Holiday) []model.Holiday {
+	res := make([]model.Holiday, 0, len(holidays))
+	for _, h := range holidays {
+		res = append(res, model.Holiday{Name: h.Name, StartDate: h.StartDate, EndDate: h.EndDate})
+	}
+	return res
+}
+
+func convMakeupDaysToModels(makeupDays []domain.MakeupDay) []model.MakeupDay {
+	res := make([]model.MakeupDay, 0, len(makeupDays))
+	for _, m := range makeupDays {
+		res = append(res, model.MakeupDay{Name: m.Name, Date: m.Date, ReplaceDate: m.ReplaceDate})
+	}
+	return res
+}
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/asynccnu/ccnubox-be/be-calendar/domain"
+)
+
+func TestFindSemesterDay(t *testing.T) {
+	semesters := []domain.Semester{
+		{Year: "2024", Semester: "1", StartDate: "2024-09-02", EndDate: "2025-01-17"},
+		{
+			Year: "2024", Semester: "2", StartDate: "2025-02-17", EndDate: "2025-07-04",
+			Holidays:   []domain.Holiday{{Name: "劳动节", StartDate: "2025-05-01", EndDate: "2025-05-05"}},
+			MakeupDays: []domain.MakeupDay{{Name: "劳动节调休", Date: "2025-04-27", ReplaceDate: "2025-05-05"}},
+		},
+	}
+
+	tests := []struct {
+		date       string
+		ok         bool
+		semester   string
+		inSemester bool
+		week       int64
+		weekday    int64
+		holiday    bool
+		makeup     bool
+	}{
+		{date: "2024-08-01", ok: false},
+		{date: "2024-09-02", ok: true, semester: "1", inSemester: true, week: 1, weekday: 1},
+		{date: "2024-09-08", ok: true, semester: "1", inSemester: true, week: 1, weekday: 7},
+		{date: "2024-09-09", ok: true, semester: "1", inSemester: true, week: 2, weekday: 1},
+		// 寒假中使用假期前的学期
+		{date: "2025-02-01", ok: true, semester: "1", inSemester: false, week: 0, weekday: 6},
+		{date: "2025-02-19", ok: true, semester: "2", inSemester: true, week: 1, weekday: 3},
+		{date: "2025-05-02", ok: true, semester: "2", inSemester: true, week: 11, weekday: 5, holiday: true},
+		// 周日调休,上第12周星期一的课
+		{date: "2025-04-27", ok: true, semester: "2", inSemester: true, week: 12, weekday: 1, makeup: true},
+		{date: "2025-07-05", ok: true, semester: "2", inSemester: false, week: 0, weekday: 6},
+	}
+	for _, tt := range tests {
+		day, _ := time.ParseInLocation(dateLayout, tt.date, cst)
+		got, ok := findSemesterDay(semesters, day.Add(15*time.Hour))
+		if ok != tt.ok {
+			t.Fatalf("%s: ok = %v, want %v", tt.date, ok, tt.ok)
+		}
+		if !ok {
+			continue
+		}
+		if got.Semester.Semester != tt.semester || got.InSemester != tt.inSemester || got.Week != tt.week ||
+			got.Weekday != tt.weekday || got.IsHoliday != tt.holiday || got.IsMakeup != tt.makeup {
+			t.Errorf("%s: got %+v", tt.date, got)
+		}
+	}
+}
+
+func TestValidateSemester(t *testing.T) {
+	valid := domain.Semester{Year: "2024", Semester: "1", StartDate: "2024-09-02", EndDate: "2025-01-17"}
+	if err := validateSemester(&valid); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	invalid := []domain.Semester{
+		{Year: "24", Semester: "1", StartDate: "2024-09-02", EndDate: "2025-01-17"},
+		{Year: "2024", Semester: "4", StartDate: "2024-09-02", EndDate: "2025-01-17"},
+		{Year: "2024", Semester: "1", StartDate: "2025-01-17", EndDate: "2024-09-02"},
+		{Year: "2024", Semester: "1", StartDate: "2024-9-2", EndDate: "2025-01-17"},
+		{Year: "2024", Semester: "1", StartDate: "2024-09-02", EndDate: "2025-01-17",
+			Holidays: []domain.Holiday{{Name: "国庆节", StartDate: "2024-10-07", EndDate: "2024-10-01"}}},
+		{Year: "2024", Semester: "1", StartDate: "2024-09-02", EndDate: "2025-01-17",
+			MakeupDays: []domain.MakeupDay{{Name: "国庆节调休", Date: "2024-09-29"}}},
+	}
+	for _, s := range invalid {
+		if err := validateSemester(&s); err == nil {
+			t.Errorf("expected error for %+v", s)
+		}
+	}
+}
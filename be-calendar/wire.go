@@ -26,8 +26,11 @@ func InitApp() App {
 		ioc.InitGRPCxKratosServer,
 		grpc.NewCalendarServiceServer,
 		service.NewCachedCalendarService,
+		service.NewCachedSemesterService,
 		cache.NewRedisCalendarCache,
+		cache.NewRedisSemesterCache,
 		dao.NewMysqlCalendarDAO,
+		dao.NewMysqlSemesterDAO,
 		cron.NewHolidayController,
 		cron.NewCalendarController,
 		cron.NewCron,
@@ -24,7 +24,10 @@ func InitApp() App {
 	cmdable := ioc.InitRedis()
 	calendarCache := cache.NewRedisCalendarCache(cmdable)
 	calendarService := service.NewCachedCalendarService(calendarDAO, calendarCache, logger)
-	calendarServiceServer := grpc.NewCalendarServiceServer(calendarService)
+	semesterDAO := dao.NewMysqlSemesterDAO(db)
+	semesterCache := cache.NewRedisSemesterCache(cmdable)
+	semesterService := service.NewCachedSemesterService(semesterDAO, semesterCache, logger)
+	calendarServiceServer := grpc.NewCalendarServiceServer(calendarService, semesterService)
 	client := ioc.InitEtcdClient()
 	server := ioc.InitGRPCxKratosServer(calendarServiceServer, client, logger)
 	qiniuClient := ioc.InitQiniu()
@@ -42,7 +42,7 @@ func NewApp(app *kratos.App, task *timedTask.Task) *APP {
 	return &APP{app: app, task: task}
 }
 func init() {
-	prometheus.MustRegister(metrics.Counter, metrics.Summary)
+	prometheus.MustRegister(metrics.Counter, metrics.Summary, metrics.ClassSyncEvents, metrics.ClassSyncDrift)
 	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
 }
 
@@ -96,7 +96,7 @@ func main() {
 	defer cleanup()
 
 	// 启动定时任务
-	svc.task.RegisterAddClassInfosToESTask()
+	svc.task.RegisterSyncClassInfoTask()
 	svc.task.RegisterClearClassInfoTask()
 	svc.task.RegisterCrawFreeClassroomTask(bc.GetProxyStuId())
 	svc.task.Start()
@@ -29,15 +29,18 @@ func wireApp(*conf.Server, *conf.Data, *conf.Registry, log.Logger) (*APP, func()
 		client.ProviderSet,
 		timedTask.ProviderSet,
 		lock.ProviderSet,
-		wire.Bind(new(biz.EsProxy), new(*data.ClassData)),
+		wire.Bind(new(biz.EsProxy), new(data.ClassIndex)),
 		wire.Bind(new(biz.ClassListService), new(*client.ClassListService)),
-		wire.Bind(new(biz.FreeClassRoomData), new(*data.FreeClassroomData)),
-		wire.Bind(new(biz.ClassData), new(*data.ClassData)),
+		wire.Bind(new(biz.FreeClassRoomData), new(data.ClassroomIndex)),
+		wire.Bind(new(biz.ClassData), new(data.ClassIndex)),
 		wire.Bind(new(biz.CookieClient), new(*client.CookieSvc)),
 		wire.Bind(new(biz.Cache), new(*data.Cache)),
+		wire.Bind(new(biz.ClassEventSource), new(*data.ClassEventConsumer)),
 		wire.Bind(new(service.ClassInfoProxy), new(*biz.ClassServiceUserCase)),
-		wire.Bind(new(service.FreeClassRoomSaver), new(*biz.FreeClassroomBiz)),
+		wire.Bind(new(biz.SelectionUploadStore), new(*data.SelectionUploadStore)),
+		wire.Bind(new(service.SelectionUploadManager), new(*biz.SelectionUploadBiz)),
 		wire.Bind(new(service.FreeClassroomSearcher), new(*biz.FreeClassroomBiz)),
+		wire.Bind(new(service.ClassroomDetailer), new(*biz.FreeClassroomBiz)),
 		NewApp,
 		newApp))
 }
@@ -27,14 +27,11 @@ import (
 
 // wireApp init kratos application.
 func wireApp(confServer *conf.Server, confData *conf.Data, confRegistry *conf.Registry, logger log.Logger) (*APP, func(), error) {
-	elasticClient, err := data.NewEsClient(confData)
-	if err != nil {
-		return nil, nil, err
-	}
-	classData, cleanup, err := data.NewClassData(elasticClient)
+	indexes, cleanup, err := data.NewIndexes(confData)
 	if err != nil {
 		return nil, nil, err
 	}
+	classIndex := indexes.Class
 	etcdRegistry := registry.NewRegistrarServer(confRegistry)
 	classListService, err := client.NewClassListService(etcdRegistry)
 	if err != nil {
@@ -44,23 +41,34 @@ func wireApp(confServer *conf.Server, confData *conf.Data, confRegistry *conf.Re
 	redisClient := data.NewRedisClient(confData)
 	builder := lock.NewRedisLockBuilder(redisClient)
 	cache := data.NewCache(redisClient)
-	classServiceUserCase := biz.NewClassServiceUserCase(classData, classListService, builder, cache)
+	classEventConsumer, cleanup2 := data.NewClassEventConsumer(confData)
+	classServiceUserCase := biz.NewClassServiceUserCase(classIndex, classListService, builder, cache, classEventConsumer)
 	classServiceService := service.NewClassServiceService(classServiceUserCase)
-	freeClassroomData := data.NewFreeClassroomData(elasticClient)
+	classroomIndex := indexes.Classroom
 	cookieSvc, err := client.NewCookieSvc(etcdRegistry)
 	if err != nil {
+		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
-	freeClassroomBiz := biz.NewFreeClassroomBiz(classData, freeClassroomData, cookieSvc, builder, cache)
-	freeClassroomSvc := service.NewFreeClassroomSvc(freeClassroomBiz)
+	freeClassroomBiz := biz.NewFreeClassroomBiz(classIndex, classroomIndex, cookieSvc, builder, cache)
+	freeClassroomSvc := service.NewFreeClassroomSvc(freeClassroomBiz, freeClassroomBiz)
 	grpcServer := server.NewGRPCServer(confServer, classServiceService, freeClassroomSvc, logger)
-	selectionUploader := service.NewSelectionUploader(freeClassroomBiz)
+	selectionUploadStore := data.NewSelectionUploadStore(redisClient)
+	selectionUploadBiz := biz.NewSelectionUploadBiz(selectionUploadStore, classroomIndex, builder)
+	selectionUploader := service.NewSelectionUploader(confServer, selectionUploadBiz)
 	httpServer := server.NewHTTPServer(confServer, selectionUploader)
 	app := newApp(logger, grpcServer, httpServer, etcdRegistry)
-	task := timedTask.NewTask(classServiceUserCase, freeClassroomBiz, classListService)
+	calendarService, err := client.NewCalendarService(etcdRegistry)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	task := timedTask.NewTask(classServiceUserCase, freeClassroomBiz, calendarService)
 	mainAPP := NewApp(app, task)
 	return mainAPP, func() {
+		cleanup2()
 		cleanup()
 	}, nil
 }
@@ -41,7 +41,11 @@ func initCS() *ClassServiceUserCase {
 	return cs
 }
 
-func TestClassSerivceUserCase_AddClassInfosToES(t *testing.T) {
+func TestClassSerivceUserCase_ReconcileClassInfos(t *testing.T) {
 	cs := initCS()
-	cs.AddClassInfosToES(context.Background(), "2024", "1")
+	report, err := cs.ReconcileClassInfos(context.Background(), "2024", "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Logf("%+v", report)
 }
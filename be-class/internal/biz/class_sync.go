@@ -0,0 +1,142 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	clog "github.com/asynccnu/ccnubox-be/be-class/internal/log"
+	"github.com/asynccnu/ccnubox-be/be-class/internal/metrics"
+	"github.com/asynccnu/ccnubox-be/be-class/internal/model"
+)
+
+// ClassEventSource 课程信息变更事件的来源
+type ClassEventSource interface {
+	Consume(f func(ctx context.Context, event model.ClassEvent) error) error
+}
+
+const (
+	// 对账时从be-classlist拉取全部课程的起始游标
+	classListStartCursor = "1949-10-01T00:00:00.000000"
+	// 对账时每批写入索引的课程数
+	reconcileBatchSize = 500
+)
+
+// SyncClassEvents 持续消费课程信息变更事件并写入索引,阻塞直到事件源关闭
+func (c *ClassServiceUserCase) SyncClassEvents() error {
+	return c.events.Consume(c.ApplyClassEvent)
+}
+
+// ApplyClassEvent 将一个课程信息变更事件应用到索引
+func (c *ClassServiceUserCase) ApplyClassEvent(ctx context.Context, event model.ClassEvent) error {
+	var err error
+	switch event.Type {
+	case model.ClassEventUpsert:
+		if event.Class == nil {
+			err = fmt.Errorf("upsert event[%s] without class", event.ID)
+			break
+		}
+		err = c.es.AddClassInfo(ctx, *event.Class)
+	case model.ClassEventDelete:
+		err = c.es.DeleteClassInfo(ctx, event.ID)
+	default:
+		err = fmt.Errorf("unknown class event type: %s", event.Type)
+	}
+
+	result := "ok"
+	if err != nil {
+		result = "failed"
+	}
+	metrics.ClassSyncEvents.WithLabelValues(event.Type, result).Inc()
+	return err
+}
+
+// ReconcileClassInfos 比较be-classlist和索引中某个学期的课程,只修复不一致的部分
+// 多个实例同时执行时只有拿到锁的会执行
+func (c *ClassServiceUserCase) ReconcileClassInfos(ctx context.Context, xnm, xqm string) (model.ClassSyncReport, error) {
+	var report model.ClassSyncReport
+
+	lockKey := fmt.Sprintf("reconcile_class_info_%v_%v", xnm, xqm)
+	locker := c.lockBuilder.BuildWithExpire(lockKey, 30*time.Minute)
+	if err := locker.Lock(); err != nil {
+		clog.LogPrinter.Infof("the lock is not get, maybe other instance is doing this job")
+		return report, nil
+	}
+	defer func() {
+		if ok, err := locker.Unlock(); !ok || err != nil {
+			clog.LogPrinter.Errorf("unlock %v failed: %v", lockKey, err)
+		}
+	}()
+
+	source := make(map[string]model.ClassInfo)
+	cursor := classListStartCursor
+	for {
+		classInfos, lastTime, err := c.cs.GetAllSchoolClassInfos(ctx, xnm, xqm, cursor)
+		if err != nil {
+			return report, err
+		}
+		if len(classInfos) == 0 {
+			break
+		}
+		for _, info := range classInfos {
+			source[info.ID] = info
+		}
+		cursor = lastTime
+	}
+	report.Source = len(source)
+
+	var (
+		toAdd    []model.ClassInfo
+		toDelete []string
+		indexed  = make(map[string]struct{})
+	)
+	err := c.es.ScanClassInfos(ctx, xnm, xqm, func(classInfos []model.ClassInfo) error {
+		for _, info := range classInfos {
+			indexed[info.ID] = struct{}{}
+			want, ok := source[info.ID]
+			switch {
+			case !ok:
+				report.Extra++
+				toDelete = append(toDelete, info.ID)
+			case want != info:
+				report.Changed++
+				toAdd = append(toAdd, want)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return report, err
+	}
+	report.Indexed = len(indexed)
+	for id, info := range source {
+		if _, ok := indexed[id]; !ok {
+			report.Missing++
+			toAdd = append(toAdd, info)
+		}
+	}
+
+	metrics.ClassSyncDrift.WithLabelValues("missing").Set(float64(report.Missing))
+	metrics.ClassSyncDrift.WithLabelValues("changed").Set(float64(report.Changed))
+	metrics.ClassSyncDrift.WithLabelValues("extra").Set(float64(report.Extra))
+	clog.LogPrinter.Infof("reconcile class_info[xnm:%v xqm:%v]: %+v", xnm, xqm, report)
+
+	for i := 0; i < len(toAdd); i += reconcileBatchSize {
+		if err := c.es.AddClassInfo(ctx, toAdd[i:min(i+reconcileBatchSize, len(toAdd))]...); err != nil {
+			return report, err
+		}
+	}
+	// be-classlist一门课都没有返回时更可能是出了问题,不删除索引中的数据
+	if report.Source == 0 {
+		if len(toDelete) > 0 {
+			clog.LogPrinter.Warnf("request other service but get 0 classes, skip deleting %d classes", len(toDelete))
+		}
+		return report, nil
+	}
+	for i := 0; i < len(toDelete); i += reconcileBatchSize {
+		if err := c.es.DeleteClassInfo(ctx, toDelete[i:min(i+reconcileBatchSize, len(toDelete))]...); err != nil {
+			return report, err
+		}
+	}
+	return report, nil
+}
@@ -0,0 +1,107 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1 "github.com/asynccnu/ccnubox-be/be-api/gen/proto/classlist/v1"
+	"github.com/asynccnu/ccnubox-be/be-class/internal/conf"
+	"github.com/asynccnu/ccnubox-be/be-class/internal/data"
+	"github.com/asynccnu/ccnubox-be/be-class/internal/lock"
+	"github.com/asynccnu/ccnubox-be/be-class/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeClassList struct {
+	classInfos []model.ClassInfo
+}
+
+// GetAllSchoolClassInfos 每页两条,游标为已经返回的条数
+func (f fakeClassList) GetAllSchoolClassInfos(ctx context.Context, xnm, xqm, cursor string) ([]model.ClassInfo, string, error) {
+	from := 0
+	if cursor != classListStartCursor {
+		from = int(cursor[0] - '0')
+	}
+	to := min(from+2, len(f.classInfos))
+	if from >= to {
+		return nil, "", nil
+	}
+	return f.classInfos[from:to], string(rune('0' + to)), nil
+}
+
+func (f fakeClassList) AddClassInfoToClassListService(ctx context.Context, req *v1.AddClassRequest) (*v1.AddClassResponse, error) {
+	return nil, nil
+}
+
+func (f fakeClassList) GetStuClassTimes(ctx context.Context, stuID, xnm, xqm string) ([]model.CTime, error) {
+	return nil, nil
+}
+
+type fakeLocker struct{}
+
+func (fakeLocker) Lock() error           { return nil }
+func (fakeLocker) Unlock() (bool, error) { return true, nil }
+
+type fakeLockBuilder struct{}
+
+func (fakeLockBuilder) Build(name string) lock.Locker { return fakeLocker{} }
+func (fakeLockBuilder) BuildWithExpire(name string, expire time.Duration) lock.Locker {
+	return fakeLocker{}
+}
+
+func TestClassServiceUserCase_ReconcileClassInfos(t *testing.T) {
+	ctx := context.Background()
+	idx, _, err := data.NewEmbeddedIndex(&conf.Data_Embedded{})
+	require.NoError(t, err)
+
+	source := []model.ClassInfo{
+		{ID: "a", Classname: "高等数学", Year: "2024", Semester: "1"},
+		{ID: "b", Classname: "线性代数", Year: "2024", Semester: "1"},
+		{ID: "c", Classname: "大学物理", Year: "2024", Semester: "1"},
+	}
+	changed := source[1]
+	changed.Teacher = "李四"
+	require.NoError(t, idx.AddClassInfo(ctx,
+		source[0],
+		changed,
+		model.ClassInfo{ID: "d", Classname: "已经不存在的课", Year: "2024", Semester: "1"},
+	))
+
+	uc := NewClassServiceUserCase(idx, fakeClassList{classInfos: source}, fakeLockBuilder{}, nil, nil)
+	report, err := uc.ReconcileClassInfos(ctx, "2024", "1")
+	require.NoError(t, err)
+	assert.Equal(t, model.ClassSyncReport{Source: 3, Indexed: 3, Missing: 1, Changed: 1, Extra: 1}, report)
+
+	var indexed []model.ClassInfo
+	require.NoError(t, idx.ScanClassInfos(ctx, "2024", "1", func(infos []model.ClassInfo) error {
+		indexed = append(indexed, infos...)
+		return nil
+	}))
+	assert.ElementsMatch(t, source, indexed)
+
+	// 已经一致时不需要修复
+	report, err = uc.ReconcileClassInfos(ctx, "2024", "1")
+	require.NoError(t, err)
+	assert.Equal(t, 0, report.Drift())
+}
+
+func TestClassServiceUserCase_ApplyClassEvent(t *testing.T) {
+	ctx := context.Background()
+	idx, _, err := data.NewEmbeddedIndex(&conf.Data_Embedded{})
+	require.NoError(t, err)
+	uc := NewClassServiceUserCase(idx, fakeClassList{}, fakeLockBuilder{}, nil, nil)
+
+	info := model.ClassInfo{ID: "a", Classname: "高等数学", Year: "2024", Semester: "1"}
+	require.NoError(t, uc.ApplyClassEvent(ctx, model.ClassEvent{Type: model.ClassEventUpsert, ID: "a", Class: &info}))
+	_, total, _ := idx.GetBatchClassInfos(ctx, "2024", "1", 1, 10)
+	assert.Equal(t, 1, total)
+
+	require.NoError(t, uc.ApplyClassEvent(ctx, model.ClassEvent{Type: model.ClassEventDelete, ID: "a"}))
+	_, total, _ = idx.GetBatchClassInfos(ctx, "2024", "1", 1, 10)
+	assert.Equal(t, 0, total)
+
+	assert.Error(t, uc.ApplyClassEvent(ctx, model.ClassEvent{Type: model.ClassEventUpsert, ID: "a"}))
+	assert.Error(t, uc.ApplyClassEvent(ctx, model.ClassEvent{Type: "unknown"}))
+}
@@ -0,0 +1,121 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	clog "github.com/asynccnu/ccnubox-be/be-class/internal/log"
+	"github.com/asynccnu/ccnubox-be/be-class/internal/model"
+	"github.com/asynccnu/ccnubox-be/be-class/internal/service"
+)
+
+// 一个教室一周的课程不会太多,一次取完
+const classroomSchedulePageSize = 200
+
+// GetClassroomSchedule 获取某个教室某一周每天每节的占用情况
+// 占用信息来自选课手册和课程信息,能对应上课程的节次会带上课程信息
+func (f *FreeClassroomBiz) GetClassroomSchedule(ctx context.Context, year, semester, classroom string, week int) (service.ClassroomSchedule, error) {
+	var schedule service.ClassroomSchedule
+
+	cts, err := f.freeClassRoomData.GetClassroomOccupancy(ctx, year, semester, classroom, week)
+	if err != nil {
+		clog.LogPrinter.Errorf("failed to get occupancy of classroom[%s]: %v", classroom, err)
+		return schedule, err
+	}
+
+	// 课程信息只是用来补充说明的,拿不到时只返回占用情况
+	res, err := f.classData.SearchClassInfo(ctx, model.ClassSearchQuery{
+		Year:        year,
+		Semester:    semester,
+		Week:        week,
+		WherePrefix: classroom,
+		Page:        1,
+		PageSize:    classroomSchedulePageSize,
+		Sort:        model.SortTime,
+	})
+	if err != nil {
+		clog.LogPrinter.Warnf("failed to search classes in classroom[%s]: %v", classroom, err)
+	}
+
+	schedule.Meta, err = f.freeClassRoomData.GetClassroomMeta(ctx, classroom)
+	if err != nil {
+		clog.LogPrinter.Warnf("failed to get meta of classroom[%s]: %v", classroom, err)
+	}
+
+	schedule.Slots = buildClassroomSlots(classroom, cts, res.ClassInfos)
+	return schedule, nil
+}
+
+func buildClassroomSlots(classroom string, cts []model.CTime, classInfos []model.ClassInfo) []service.ClassroomSlot {
+	var slots = make(map[[2]int]*service.ClassroomSlot)
+	slotOf := func(day, section int) *service.ClassroomSlot {
+		key := [2]int{day, section}
+		if slots[key] == nil {
+			slots[key] = &service.ClassroomSlot{Day: day, Section: section}
+		}
+		return slots[key]
+	}
+
+	for _, ct := range cts {
+		for _, section := range ct.Sections {
+			slotOf(ct.Day, section)
+		}
+	}
+	for _, info := range classInfos {
+		// WherePrefix是前缀匹配,"n101"也会匹配到"n1011"
+		if !strings.EqualFold(info.Where, classroom) {
+			continue
+		}
+		start, end, ok := parseClassWhen(info.ClassWhen)
+		if !ok {
+			continue
+		}
+		for section := start; section <= end; section++ {
+			slot := slotOf(int(info.Day), section)
+			slot.Classes = append(slot.Classes, info)
+		}
+	}
+
+	var res = make([]service.ClassroomSlot, 0, len(slots))
+	for _, slot := range slots {
+		res = append(res, *slot)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Day != res[j].Day {
+			return res[i].Day < res[j].Day
+		}
+		return res[i].Section < res[j].Section
+	})
+	return res
+}
+
+// parseClassWhen 解析"1-2"或"5"这样的节次
+func parseClassWhen(classWhen string) (start, end int, ok bool) {
+	switch n, _ := fmt.Sscanf(classWhen, "%d-%d", &start, &end); n {
+	case 1:
+		return start, start, true
+	case 2:
+		return start, end, start <= end
+	}
+	return 0, 0, false
+}
+
+// SaveClassroomMeta 保存管理员维护的教室信息,会覆盖已有的
+func (f *FreeClassroomBiz) SaveClassroomMeta(ctx context.Context, meta model.ClassroomMeta) error {
+	var equipment = make([]string, 0, len(meta.Equipment))
+	for _, e := range meta.Equipment {
+		if e = strings.TrimSpace(e); e != "" {
+			equipment = append(equipment, e)
+		}
+	}
+	meta.Equipment = equipment
+	meta.UpdatedAt = time.Now().Unix()
+	return f.freeClassRoomData.SaveClassroomMeta(ctx, meta)
+}
+
+func (f *FreeClassroomBiz) DeleteClassroomMeta(ctx context.Context, classroom string) error {
+	return f.freeClassRoomData.DeleteClassroomMeta(ctx, classroom)
+}
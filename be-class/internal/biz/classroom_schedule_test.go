@@ -0,0 +1,49 @@
+package biz
+
+import (
+	"testing"
+
+	"github.com/asynccnu/ccnubox-be/be-class/internal/model"
+	"github.com/asynccnu/ccnubox-be/be-class/internal/service"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildClassroomSlots(t *testing.T) {
+	cts := []model.CTime{
+		{Weeks: []int{1, 2}, Day: 2, Sections: []int{3, 4}},
+		{Weeks: []int{1}, Day: 1, Sections: []int{5}},
+	}
+	calculus := model.ClassInfo{ID: "c1", Classname: "高等数学", Where: "N101", Day: 2, ClassWhen: "3-4"}
+	classInfos := []model.ClassInfo{
+		calculus,
+		{ID: "c2", Classname: "大学英语", Where: "n1011", Day: 3, ClassWhen: "1-2"},
+		{ID: "c3", Classname: "体育", Where: "n101", Day: 3, ClassWhen: "未知"},
+	}
+
+	slots := buildClassroomSlots("n101", cts, classInfos)
+	assert.Equal(t, []service.ClassroomSlot{
+		{Day: 1, Section: 5},
+		{Day: 2, Section: 3, Classes: []model.ClassInfo{calculus}},
+		{Day: 2, Section: 4, Classes: []model.ClassInfo{calculus}},
+	}, slots)
+}
+
+func TestParseClassWhen(t *testing.T) {
+	tests := []struct {
+		classWhen  string
+		start, end int
+		ok         bool
+	}{
+		{"1-2", 1, 2, true},
+		{"5", 5, 5, true},
+		{"4-3", 4, 3, false},
+		{"", 0, 0, false},
+	}
+	for _, tt := range tests {
+		start, end, ok := parseClassWhen(tt.classWhen)
+		assert.Equal(t, tt.ok, ok, tt.classWhen)
+		if ok {
+			assert.Equal(t, [2]int{tt.start, tt.end}, [2]int{start, end}, tt.classWhen)
+		}
+	}
+}
@@ -6,12 +6,14 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/asynccnu/ccnubox-be/be-class/internal/lock"
 	clog "github.com/asynccnu/ccnubox-be/be-class/internal/log"
 	"github.com/asynccnu/ccnubox-be/be-class/internal/model"
+	"github.com/asynccnu/ccnubox-be/be-class/internal/pkg/tool"
 	"github.com/asynccnu/ccnubox-be/be-class/internal/service"
 	"github.com/valyala/fastjson"
 )
@@ -26,13 +28,23 @@ const (
 
 type FreeClassRoomData interface {
 	AddClassroomOccupancy(ctx context.Context, year, semester string, cwtPairs ...model.CTWPair) error
+	AddUploadedOccupancy(ctx context.Context, year, semester, version string, cwtPairs ...model.CTWPair) error
+	ClearUploadedOccupancy(ctx context.Context, year, semester, keep string) error
+	DeleteUploadedOccupancy(ctx context.Context, year, semester, version string) error
+	ClearLegacyOccupancy(ctx context.Context, year, semester string) error
 	ClearClassroomOccupancy(ctx context.Context, year, semester string) error
 	GetAllClassroom(ctx context.Context, wherePrefix string) ([]string, error)
 	QueryAvailableClassrooms(ctx context.Context, year, semester string, week, day, section int, wherePrefix string) (map[string]bool, error)
+	CountOccupiedSections(ctx context.Context, year, semester string, week, day int, wherePrefix string) (map[string]int, error)
+	GetClassroomOccupancy(ctx context.Context, year, semester, where string, week int) ([]model.CTime, error)
+	SaveClassroomMeta(ctx context.Context, meta model.ClassroomMeta) error
+	DeleteClassroomMeta(ctx context.Context, where string) error
+	GetClassroomMeta(ctx context.Context, where string) (*model.ClassroomMeta, error)
 }
 
 type ClassData interface {
 	GetBatchClassInfos(ctx context.Context, year, semester string, page, pageSize int) ([]model.ClassInfo, int, error)
+	SearchClassInfo(ctx context.Context, q model.ClassSearchQuery) (model.ClassSearchResult, error)
 }
 
 type CookieClient interface {
@@ -76,9 +88,11 @@ func (f *FreeClassroomBiz) ClearClassroomOccupancyFromES(ctx context.Context, ye
 }
 
 // SaveFreeClassRoomFromLocal 保存空教室信息从本地ES
+// 所有的课程都已经重新写入时,顺便清除旧的上传接口留下的没有来源的占用信息
 func (f *FreeClassroomBiz) SaveFreeClassRoomFromLocal(ctx context.Context, year, semester string) error {
 	const pageSize = 500 // 每批获取500条
 	page := 1
+	complete := true
 	var tasks []string
 
 	defer func() {
@@ -103,6 +117,8 @@ func (f *FreeClassroomBiz) SaveFreeClassRoomFromLocal(ctx context.Context, year,
 		lockErr := locker.Lock()
 		if lockErr != nil {
 			clog.LogPrinter.Infof("Error don't get lock %v: %v", lockKey, lockErr)
+			// 其他实例正在写入这一批,不能确定它已经写完
+			complete = false
 			// 判断是否已经获取完所有数据
 			if page*pageSize >= total {
 				break
@@ -195,6 +211,11 @@ func (f *FreeClassroomBiz) SaveFreeClassRoomFromLocal(ctx context.Context, year,
 		}
 		page++
 	}
+	if complete {
+		if err := f.freeClassRoomData.ClearLegacyOccupancy(ctx, year, semester); err != nil {
+			clog.LogPrinter.Warnf("failed to clear legacy classroom occupancy of %s-%s: %v", year, semester, err)
+		}
+	}
 	return nil
 }
 
@@ -214,11 +235,19 @@ func (f *FreeClassroomBiz) SaveFreeClassRoomInfo(ctx context.Context, year, seme
 	return nil
 }
 
-func (f *FreeClassroomBiz) SearchAvailableClassroom(ctx context.Context, year, semester, stuID string, week, day int, sections []int, wherePrefix string) ([]service.AvailableClassroomStat, error) {
+// SearchAvailableClassroom 查询空闲教室,wherePrefix为空时按loc中的校区、教学楼和楼层查询
+func (f *FreeClassroomBiz) SearchAvailableClassroom(ctx context.Context, year, semester, stuID string, week, day int, sections []int, wherePrefix string, loc model.ClassroomLocation) ([]service.AvailableClassroomStat, error) {
 	var (
 		classroomStats = make(map[string][]bool)
 		err            error
 	)
+	if wherePrefix == "" {
+		wherePrefix = loc.Prefix()
+	}
+	campus := loc.Campus
+	if campus == 0 {
+		campus = tool.CampusOf(wherePrefix)
+	}
 
 	//先获取全部的教室
 	classroomSet, err := f.freeClassRoomData.GetAllClassroom(ctx, wherePrefix)
@@ -226,7 +255,7 @@ func (f *FreeClassroomBiz) SearchAvailableClassroom(ctx context.Context, year, s
 		return nil, err
 	}
 	//从教务系统中爬取
-	freeClassroomMp, err := f.getFreeClassrooms(ctx, year, semester, stuID, week, day, sections, wherePrefix)
+	freeClassroomMp, err := f.getFreeClassrooms(ctx, year, semester, stuID, week, day, sections, campus, wherePrefix)
 	if err == nil {
 		//如果爬取成功，则使用爬取的数据
 		for _, classroom := range classroomSet {
@@ -243,14 +272,79 @@ func (f *FreeClassroomBiz) SearchAvailableClassroom(ctx context.Context, year, s
 				}
 			}
 		}
-		return toSerializableClassroomStats(classroomStats), nil
+		return toSerializableClassroomStats(filterClassroomStats(classroomStats, loc)), nil
 	}
 	//爬取失败就使用本地数据
 	classroomStats, err = f.queryAvailableClassroomFromLocal(ctx, year, semester, week, day, sections, wherePrefix)
 	if err != nil {
 		return nil, err
 	}
-	return toSerializableClassroomStats(classroomStats), nil
+	return toSerializableClassroomStats(filterClassroomStats(classroomStats, loc)), nil
+}
+
+// filterClassroomStats 地点前缀只能粗略地筛选,按位置查询时再精确地筛选一次
+func filterClassroomStats(classroomStats map[string][]bool, loc model.ClassroomLocation) map[string][]bool {
+	if loc == (model.ClassroomLocation{}) {
+		return classroomStats
+	}
+	for classroom := range classroomStats {
+		if l, ok := tool.ParseClassroom(classroom); !ok || !loc.Contains(l) {
+			delete(classroomStats, classroom)
+		}
+	}
+	return classroomStats
+}
+
+// GetClassroomCatalog 从所有的教室推出校区、教学楼和楼层
+func (f *FreeClassroomBiz) GetClassroomCatalog(ctx context.Context) ([]model.Campus, error) {
+	classrooms, err := f.freeClassRoomData.GetAllClassroom(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	floors := make(map[int]map[string]map[int]struct{})
+	for _, classroom := range classrooms {
+		loc, ok := tool.ParseClassroom(classroom)
+		if !ok {
+			continue
+		}
+		if floors[loc.Campus] == nil {
+			floors[loc.Campus] = make(map[string]map[int]struct{})
+		}
+		if floors[loc.Campus][loc.Building] == nil {
+			floors[loc.Campus][loc.Building] = make(map[int]struct{})
+		}
+		floors[loc.Campus][loc.Building][loc.Floor] = struct{}{}
+	}
+
+	campuses := make([]model.Campus, 0, len(floors))
+	for id, buildings := range floors {
+		campus := model.Campus{ID: id, Name: tool.CampusName(id)}
+		for building, fs := range buildings {
+			b := model.Building{ID: building, Name: tool.BuildingName(building)}
+			for floor := range fs {
+				b.Floors = append(b.Floors, floor)
+			}
+			sort.Ints(b.Floors)
+			campus.Buildings = append(campus.Buildings, b)
+		}
+		sort.Slice(campus.Buildings, func(i, j int) bool {
+			return lessBuilding(campus.Buildings[i].ID, campus.Buildings[j].ID)
+		})
+		campuses = append(campuses, campus)
+	}
+	sort.Slice(campuses, func(i, j int) bool {
+		return campuses[i].ID < campuses[j].ID
+	})
+	return campuses, nil
+}
+
+// lessBuilding 按楼号的数值排序,"3"在"10"之前
+func lessBuilding(a, b string) bool {
+	if len(a) != len(b) {
+		return len(a) < len(b)
+	}
+	return a < b
 }
 
 func toSerializableClassroomStats(classroomStats map[string][]bool) []service.AvailableClassroomStat {
@@ -292,13 +386,9 @@ func (f *FreeClassroomBiz) queryAvailableClassroomFromLocal(ctx context.Context,
 }
 
 // 返回每一节课的空闲教室
-func (f *FreeClassroomBiz) getFreeClassrooms(ctx context.Context, year, semester, stuID string, week, day int, sections []int, wherePrefix string) (map[int][]string, error) {
+func (f *FreeClassroomBiz) getFreeClassrooms(ctx context.Context, year, semester, stuID string, week, day int, sections []int, campus int, wherePrefix string) (map[int][]string, error) {
 	var freeClassroomMp = make(map[int][]string, len(sections))
 
-	var campus = 1
-	if wherePrefix[0] == 'n' {
-		campus = 2
-	}
 	preYear := strings.Split(year, "-")[0]
 
 	// 先从缓存拿数据
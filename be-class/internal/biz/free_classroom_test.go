@@ -4,6 +4,12 @@ import (
 	"context"
 	"net/http"
 	"testing"
+
+	"github.com/asynccnu/ccnubox-be/be-class/internal/conf"
+	"github.com/asynccnu/ccnubox-be/be-class/internal/data"
+	"github.com/asynccnu/ccnubox-be/be-class/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type MockCookieClient struct {
@@ -19,9 +25,43 @@ func TestFreeClassroomBiz_crawFreeClassroom(t *testing.T) {
 		cookieCli: cli,
 		httpCli:   &http.Client{},
 	}
-	res, err := fcb.getFreeClassrooms(context.Background(), "2024", "2", "testID", 6, 2, []int{1, 2}, "71")
+	res, err := fcb.getFreeClassrooms(context.Background(), "2024", "2", "testID", 6, 2, []int{1, 2}, 1, "71")
 	if err != nil {
 		t.Fatal(err)
 	}
 	t.Log(res)
 }
+
+func TestFreeClassroomBiz_GetClassroomCatalog(t *testing.T) {
+	idx, _, err := data.NewEmbeddedIndex(&conf.Data_Embedded{Classroom: "../../configs/classrooms.json"})
+	require.NoError(t, err)
+	fcb := &FreeClassroomBiz{freeClassRoomData: idx}
+
+	campuses, err := fcb.GetClassroomCatalog(context.Background())
+	require.NoError(t, err)
+	require.Len(t, campuses, 2)
+
+	assert.Equal(t, model.CampusMain, campuses[0].ID)
+	var buildings []string
+	for _, b := range campuses[0].Buildings {
+		buildings = append(buildings, b.ID)
+	}
+	assert.Equal(t, []string{"3", "7", "8", "9", "10"}, buildings)
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 6, 7, 8}, campuses[0].Buildings[4].Floors)
+
+	assert.Equal(t, model.CampusNanhu, campuses[1].ID)
+	require.Len(t, campuses[1].Buildings, 1)
+	assert.Equal(t, "南湖综合楼", campuses[1].Buildings[0].Name)
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, campuses[1].Buildings[0].Floors)
+}
+
+func TestFilterClassroomStats(t *testing.T) {
+	stats := map[string][]bool{"7101": nil, "7201": nil, "n101": nil, "72": nil}
+	filterClassroomStats(stats, model.ClassroomLocation{Building: "7", Floor: 2})
+	assert.Len(t, stats, 1)
+	assert.Contains(t, stats, "7201")
+
+	stats = map[string][]bool{"7101": nil, "n101": nil}
+	filterClassroomStats(stats, model.ClassroomLocation{})
+	assert.Len(t, stats, 2)
+}
@@ -0,0 +1,139 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	clog "github.com/asynccnu/ccnubox-be/be-class/internal/log"
+	"github.com/asynccnu/ccnubox-be/be-class/internal/model"
+	"github.com/asynccnu/ccnubox-be/be-class/internal/pkg/tool"
+	"github.com/asynccnu/ccnubox-be/be-class/internal/service"
+)
+
+// 推荐分数中各项的权重,加起来为1
+const (
+	weightFreeBlock = 0.35 //连续空闲的时长
+	weightWait      = 0.15 //需要等多久才空出来
+	weightQuiet     = 0.30 //这一天教室里的课少不少
+	weightNearby    = 0.20 //离用户近不近
+)
+
+// RecommendQuietClassroom 推荐从q.StartSection开始能连续空闲q.Duration节的教室
+// 按连续空闲的时长、当天历史上的占用情况和离用户的距离排序
+func (f *FreeClassroomBiz) RecommendQuietClassroom(ctx context.Context, q service.QuietClassroomQuery) ([]service.QuietClassroom, error) {
+	var sections []int
+	for s := q.StartSection; s <= service.MaxSection; s++ {
+		sections = append(sections, s)
+	}
+	stats, err := f.SearchAvailableClassroom(ctx, q.Year, q.Semester, q.StuID, q.Week, q.Day, sections, q.WherePrefix, model.ClassroomLocation{})
+	if err != nil {
+		clog.LogPrinter.Errorf("failed to search available classroom for recommendation: %v", err)
+		return nil, err
+	}
+
+	// 占用数据只是用来排序的,拿不到也照样推荐
+	occupied, err := f.freeClassRoomData.CountOccupiedSections(ctx, q.Year, q.Semester, q.Week, q.Day, q.WherePrefix)
+	if err != nil {
+		clog.LogPrinter.Warnf("failed to count occupied sections, recommend without it: %v", err)
+		occupied = nil
+	}
+	return rankQuietClassrooms(q, sections, stats, occupied), nil
+}
+
+// rankQuietClassrooms occupied为nil时表示没有历史占用数据
+func rankQuietClassrooms(q service.QuietClassroomQuery, sections []int, stats []service.AvailableClassroomStat, occupied map[string]int) []service.QuietClassroom {
+	var res []service.QuietClassroom
+	for _, stat := range stats {
+		start, length := freeBlock(stat.AvailableStat, q.Duration)
+		if length == 0 {
+			continue
+		}
+		c := service.QuietClassroom{
+			Classroom:        stat.Classroom,
+			FreeStart:        sections[start],
+			FreeEnd:          sections[start+length-1],
+			OccupiedSections: -1,
+		}
+
+		blockScore := float64(length) / float64(len(sections))
+		waitScore := 1 - float64(start)/float64(len(sections))
+		c.Reasons = append(c.Reasons, fmt.Sprintf("第%d-%d节连续空闲", c.FreeStart, c.FreeEnd))
+		if start > 0 {
+			c.Reasons = append(c.Reasons, fmt.Sprintf("第%d节才空出来", c.FreeStart))
+		}
+
+		quietScore := 0.5
+		if occupied != nil {
+			c.OccupiedSections = occupied[stat.Classroom]
+			quietScore = 1 - float64(min(c.OccupiedSections, service.MaxSection))/service.MaxSection
+			if c.OccupiedSections == 0 {
+				c.Reasons = append(c.Reasons, "这一天没有课")
+			} else {
+				c.Reasons = append(c.Reasons, fmt.Sprintf("这一天有%d节课", c.OccupiedSections))
+			}
+		}
+
+		nearbyScore, reason := nearby(stat.Classroom, q.Location)
+		if reason != "" {
+			c.Reasons = append(c.Reasons, reason)
+		}
+
+		score := weightFreeBlock*blockScore + weightWait*waitScore + weightQuiet*quietScore + weightNearby*nearbyScore
+		c.Score = math.Round(score*1000) / 1000
+		res = append(res, c)
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Score != res[j].Score {
+			return res[i].Score > res[j].Score
+		}
+		return res[i].Classroom < res[j].Classroom
+	})
+	if len(res) > q.Limit {
+		res = res[:q.Limit]
+	}
+	return res
+}
+
+// freeBlock 返回能满足duration的连续空闲时段在stat中的起点和长度
+// 从第一节就空闲的时段优先,否则取最长的,没有满足的时段时长度为0
+func freeBlock(stat []bool, duration int) (start, length int) {
+	bestStart, bestLen := 0, 0
+	for i := 0; i < len(stat); {
+		if !stat[i] {
+			i++
+			continue
+		}
+		j := i
+		for j < len(stat) && stat[j] {
+			j++
+		}
+		if j-i >= duration {
+			if i == 0 {
+				return 0, j
+			}
+			if j-i > bestLen {
+				bestStart, bestLen = i, j-i
+			}
+		}
+		i = j
+	}
+	return bestStart, bestLen
+}
+
+// nearby 根据用户所在的位置给教室的距离打分
+// 同一栋楼的同一层(比如"7205"和"7203","n101"和"n105")时认为最近
+func nearby(classroom, location string) (float64, string) {
+	building := tool.BuildingOf(location)
+	if building == "" || building != tool.BuildingOf(classroom) {
+		return 0, ""
+	}
+	location, classroom = strings.ToLower(location), strings.ToLower(classroom)
+	if len(location) > len(building) && len(classroom) > len(building) && location[len(building)] == classroom[len(building)] {
+		return 1, "离你很近"
+	}
+	return 0.6, "和你在同一栋楼"
+}
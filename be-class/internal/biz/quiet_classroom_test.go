@@ -0,0 +1,59 @@
+package biz
+
+import (
+	"testing"
+
+	"github.com/asynccnu/ccnubox-be/be-class/internal/service"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFreeBlock(t *testing.T) {
+	tests := []struct {
+		stat       []bool
+		duration   int
+		start, len int
+	}{
+		{[]bool{true, true, false, true, true, true}, 2, 0, 2},
+		{[]bool{true, false, true, true, true, false}, 2, 2, 3},
+		{[]bool{false, true, true, false, true, true, true}, 2, 4, 3},
+		{[]bool{true, false, true, false}, 2, 0, 0},
+		{nil, 1, 0, 0},
+	}
+	for _, tt := range tests {
+		start, length := freeBlock(tt.stat, tt.duration)
+		assert.Equal(t, tt.start, start, tt.stat)
+		assert.Equal(t, tt.len, length, tt.stat)
+	}
+}
+
+func TestRankQuietClassrooms(t *testing.T) {
+	q := service.QuietClassroomQuery{StartSection: 3, Duration: 2, Location: "n1203", Limit: 3}
+	sections := []int{3, 4, 5, 6}
+	stats := []service.AvailableClassroomStat{
+		{Classroom: "n1201", AvailableStat: []bool{true, true, true, true}},
+		{Classroom: "n1101", AvailableStat: []bool{true, true, true, true}},
+		{Classroom: "n2101", AvailableStat: []bool{true, true, true, true}},
+		{Classroom: "n1202", AvailableStat: []bool{false, false, true, true}},
+		{Classroom: "n1204", AvailableStat: []bool{true, false, true, false}},
+	}
+	occupied := map[string]int{"n1201": 6, "n1101": 0, "n1202": 2}
+
+	res := rankQuietClassrooms(q, sections, stats, occupied)
+	var got []string
+	for _, c := range res {
+		got = append(got, c.Classroom)
+	}
+	// n1204没有连续两节的空闲,不推荐;n1101当天没有课,排在离得近但课多的n1201前面
+	assert.Equal(t, []string{"n1101", "n1201", "n2101"}, got)
+	assert.Equal(t, 3, res[0].FreeStart)
+	assert.Equal(t, 6, res[0].FreeEnd)
+	assert.Equal(t, []string{"第3-6节连续空闲", "这一天没有课", "和你在同一栋楼"}, res[0].Reasons)
+	assert.Contains(t, res[1].Reasons, "离你很近")
+
+	res = rankQuietClassrooms(q, sections, stats, nil)
+	for _, c := range res {
+		assert.Equal(t, -1, c.OccupiedSections)
+	}
+	// 没有占用数据时只看时长和距离
+	assert.Equal(t, "n1201", res[0].Classroom)
+}
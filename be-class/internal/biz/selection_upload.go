@@ -0,0 +1,474 @@
+package biz
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/asynccnu/ccnubox-be/be-class/internal/lock"
+	clog "github.com/asynccnu/ccnubox-be/be-class/internal/log"
+	"github.com/asynccnu/ccnubox-be/be-class/internal/model"
+	"github.com/asynccnu/ccnubox-be/be-class/internal/service"
+	"github.com/xuri/excelize/v2"
+)
+
+const (
+	// 每个学期保留的上传版本数,更早的会被删除
+	maxUploadVersions = 10
+	// 任务中最多保留的错误行数
+	maxUploadRowErrors = 100
+	// 每解析多少行更新一次进度
+	uploadProgressStep = 1000
+	// 每批写入的占用信息数
+	uploadSaveBatchSize = 1000
+)
+
+// SelectionUploadStore 保存上传任务的进度和每次上传解析出的占用信息
+type SelectionUploadStore interface {
+	SaveJob(ctx context.Context, job model.UploadJob) error
+	GetJob(ctx context.Context, id string) (model.UploadJob, error)
+	SaveUpload(ctx context.Context, upload model.SelectionUpload, cwtPairs []model.CTWPair) error
+	ListUploads(ctx context.Context, year, semester string) ([]model.SelectionUpload, error)
+	GetUploadPairs(ctx context.Context, year, semester, version string) ([]model.CTWPair, error)
+	DeleteUpload(ctx context.Context, year, semester, version string) error
+	SetActiveUpload(ctx context.Context, year, semester, version string) error
+	GetActiveUpload(ctx context.Context, year, semester string) (string, error)
+}
+
+// SelectionUploadBiz 处理选课手册的上传,解析在后台进行,通过任务id查询进度
+type SelectionUploadBiz struct {
+	store       SelectionUploadStore
+	data        FreeClassRoomData
+	lockBuilder lock.Builder
+}
+
+func NewSelectionUploadBiz(store SelectionUploadStore, data FreeClassRoomData, lockBuilder lock.Builder) *SelectionUploadBiz {
+	return &SelectionUploadBiz{
+		store:       store,
+		data:        data,
+		lockBuilder: lockBuilder,
+	}
+}
+
+// StartUpload 创建上传任务并在后台解析,立即返回任务
+func (s *SelectionUploadBiz) StartUpload(ctx context.Context, req service.UploadReq, filename string, file []byte) (model.UploadJob, error) {
+	job, err := s.newUploadJob(ctx, req, filename)
+	if err != nil {
+		return job, err
+	}
+	go s.runUpload(context.Background(), job, req.Sheets, file)
+	return job, nil
+}
+
+func (s *SelectionUploadBiz) newUploadJob(ctx context.Context, req service.UploadReq, filename string) (model.UploadJob, error) {
+	now := time.Now()
+	job := model.UploadJob{
+		ID:        newUploadJobID(now),
+		Year:      req.Year,
+		Semester:  req.Semester,
+		Filename:  filename,
+		DryRun:    req.DryRun,
+		Status:    model.UploadJobPending,
+		Errors:    make([]model.UploadRowError, 0),
+		CreatedAt: now.Unix(),
+		UpdatedAt: now.Unix(),
+	}
+	if err := s.store.SaveJob(ctx, job); err != nil {
+		clog.LogPrinter.Errorf("failed to save upload job[%s]: %v", job.ID, err)
+		return job, err
+	}
+	return job, nil
+}
+
+func (s *SelectionUploadBiz) GetUploadJob(ctx context.Context, id string) (model.UploadJob, error) {
+	return s.store.GetJob(ctx, id)
+}
+
+// ListUploads 获取某个学期的所有上传版本,按时间从新到旧
+func (s *SelectionUploadBiz) ListUploads(ctx context.Context, year, semester string) (service.UploadHistory, error) {
+	var history service.UploadHistory
+	uploads, err := s.store.ListUploads(ctx, year, semester)
+	if err != nil {
+		return history, err
+	}
+	sort.Slice(uploads, func(i, j int) bool {
+		return uploads[i].Version > uploads[j].Version
+	})
+	history.Uploads = uploads
+	history.Active, err = s.store.GetActiveUpload(ctx, year, semester)
+	return history, err
+}
+
+// RollbackUpload 用某个版本的占用信息替换当前生效的
+func (s *SelectionUploadBiz) RollbackUpload(ctx context.Context, year, semester, version string) error {
+	cwtPairs, err := s.store.GetUploadPairs(ctx, year, semester, version)
+	if err != nil {
+		return err
+	}
+	return s.activate(ctx, year, semester, version, cwtPairs)
+}
+
+func (s *SelectionUploadBiz) runUpload(ctx context.Context, job model.UploadJob, sheets map[string]model.ColumnMapping, file []byte) {
+	fail := func(format string, args ...interface{}) {
+		job.Status = model.UploadJobFailed
+		job.Message = fmt.Sprintf(format, args...)
+		clog.LogPrinter.Errorf("upload job[%s] failed: %s", job.ID, job.Message)
+		s.saveJob(ctx, &job)
+	}
+
+	job.Status = model.UploadJobParsing
+	s.saveJob(ctx, &job)
+
+	f, err := excelize.OpenReader(bytes.NewReader(file))
+	if err != nil {
+		fail("failed to open file: %v", err)
+		return
+	}
+	defer f.Close()
+
+	cwtPairs, err := s.parseSheets(ctx, f, sheets, &job)
+	if err != nil {
+		fail("%v", err)
+		return
+	}
+	if job.DryRun {
+		job.Status = model.UploadJobFinished
+		s.saveJob(ctx, &job)
+		return
+	}
+	if len(cwtPairs) == 0 {
+		fail("no classroom occupancy parsed from the file")
+		return
+	}
+
+	job.Status = model.UploadJobSaving
+	s.saveJob(ctx, &job)
+
+	upload := model.SelectionUpload{
+		Version:   job.ID,
+		Year:      job.Year,
+		Semester:  job.Semester,
+		Filename:  job.Filename,
+		Pairs:     len(cwtPairs),
+		CreatedAt: job.CreatedAt,
+	}
+	if err := s.store.SaveUpload(ctx, upload, cwtPairs); err != nil {
+		fail("failed to save upload: %v", err)
+		return
+	}
+	if err := s.activate(ctx, job.Year, job.Semester, upload.Version, cwtPairs); err != nil {
+		fail("failed to save classroom occupancy: %v", err)
+		return
+	}
+	s.trimUploads(ctx, job.Year, job.Semester)
+
+	job.Status = model.UploadJobFinished
+	job.Version = upload.Version
+	s.saveJob(ctx, &job)
+}
+
+// parseSheets 解析所有sheet,无法解析的行记录到job中,不影响其他行
+func (s *SelectionUploadBiz) parseSheets(ctx context.Context, f *excelize.File, sheets map[string]model.ColumnMapping, job *model.UploadJob) ([]model.CTWPair, error) {
+	var names = make([]string, 0, len(sheets))
+	var rowsOf = make(map[string][][]string, len(sheets))
+	for name := range sheets {
+		rows, err := f.GetRows(name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read sheet %s: %w", name, err)
+		}
+		names = append(names, name)
+		rowsOf[name] = rows
+		if len(rows) > 0 {
+			job.TotalRows += len(rows) - 1
+		}
+	}
+	sort.Strings(names)
+	s.saveJob(ctx, job)
+
+	var ctwPairs []model.CTWPair
+	for _, name := range names {
+		rows := rowsOf[name]
+		if len(rows) == 0 {
+			continue
+		}
+		timeIdx, whereIdx, err := resolveColumns(rows[0], sheets[name])
+		if err != nil {
+			return nil, fmt.Errorf("sheet %s: %w", name, err)
+		}
+		// 第一行是表头
+		for i := 1; i < len(rows); i++ {
+			pairs, rowErr := parseRow(rows[i], timeIdx, whereIdx)
+			if rowErr != "" {
+				job.ErrorCount++
+				if len(job.Errors) < maxUploadRowErrors {
+					job.Errors = append(job.Errors, model.UploadRowError{
+						Sheet:  name,
+						Row:    i + 1,
+						Value:  cell(rows[i], timeIdx),
+						Reason: rowErr,
+					})
+				}
+			}
+			ctwPairs = append(ctwPairs, pairs...)
+			job.ParsedRows++
+			job.Pairs = len(ctwPairs)
+			if job.ParsedRows%uploadProgressStep == 0 {
+				s.saveJob(ctx, job)
+			}
+		}
+	}
+	return ctwPairs, nil
+}
+
+// resolveColumns 找到上课时间和上课地点所在的列
+func resolveColumns(header []string, mapping model.ColumnMapping) (timeIdx, whereIdx int, err error) {
+	find := func(name string, idx uint) (int, error) {
+		if name == "" {
+			return int(idx), nil
+		}
+		for i, h := range header {
+			if strings.TrimSpace(h) == name {
+				return i, nil
+			}
+		}
+		return 0, fmt.Errorf("column %q not found in header", name)
+	}
+	if timeIdx, err = find(mapping.ClassTimeHeader, mapping.ClassTimeIdx); err != nil {
+		return 0, 0, err
+	}
+	if whereIdx, err = find(mapping.ClassWhereHeader, mapping.ClassWhereIdx); err != nil {
+		return 0, 0, err
+	}
+	return timeIdx, whereIdx, nil
+}
+
+func cell(row []string, idx int) string {
+	if idx < len(row) {
+		return strings.TrimSpace(row[idx])
+	}
+	return ""
+}
+
+// parseRow 解析一行的上课时间和地点,多个时间和多个地点两两组合
+// 没有安排上课时间的课程(比如网课)不占用教室,直接跳过
+func parseRow(row []string, timeIdx, whereIdx int) ([]model.CTWPair, string) {
+	timeVal, whereVal := cell(row, timeIdx), cell(row, whereIdx)
+	if timeVal == "" {
+		return nil, ""
+	}
+	if whereVal == "" {
+		return nil, "上课地点为空"
+	}
+	ctimes, err := parseTime(timeVal)
+	if err != nil {
+		return nil, err.Error()
+	}
+
+	var ctwPairs []model.CTWPair
+	for _, ct := range ctimes {
+		for _, where := range strings.Split(whereVal, ";") {
+			if where = strings.TrimSpace(where); where == "" {
+				continue
+			}
+			ctwPairs = append(ctwPairs, model.CTWPair{
+				CT:    ct,
+				Where: where,
+			})
+		}
+	}
+	return ctwPairs, ""
+}
+
+var weekdays = map[string]int{
+	"一": 1,
+	"二": 2,
+	"三": 3,
+	"四": 4,
+	"五": 5,
+	"六": 6,
+	"日": 7,
+	"天": 7,
+}
+
+// parseTime 解析选课手册中的上课时间,格式不对时返回错误
+// 看几种典型的时间格式
+// 星期四第3-4节{4-19周}
+// 星期一第1-2节{4-18周(双)};星期二第7-8节{4-19周}
+// 星期一第5-8节{4-6周(双),7-8周};星期二第5-8节{4-6周(双),7-8周};星期四第1-4节{4-6周(双),7-8周};星期五第1-4节{4-6周(双),7-8周}
+// 星期一第9-10节{5-17周(单)};星期二第1-2节{4-19周}
+func parseTime(val string) ([]model.CTime, error) {
+	uniteTimes := strings.Split(val, ";")
+	res := make([]model.CTime, 0, len(uniteTimes))
+	for _, uniteTime := range uniteTimes {
+		uniteTime = strings.TrimSpace(uniteTime)
+		if uniteTime == "" {
+			continue
+		}
+		var tt model.CTime
+
+		lbrace, rbrace := strings.Index(uniteTime, "{"), strings.LastIndex(uniteTime, "}")
+		if lbrace == -1 || rbrace < lbrace {
+			return nil, fmt.Errorf("缺少周数: %s", uniteTime)
+		}
+		dayAndSection := uniteTime[:lbrace]      //代表 "星期一第1-2节" 这样的部分
+		weeksStr := uniteTime[lbrace+1 : rbrace] //代表 4-19周 这个部分
+
+		//获取星期几和第几节
+		index := strings.Index(dayAndSection, "第")
+		if index == -1 {
+			return nil, fmt.Errorf("缺少节次: %s", uniteTime)
+		}
+		day, ok := weekdays[strings.TrimPrefix(dayAndSection[:index], "星期")]
+		if !ok {
+			return nil, fmt.Errorf("无法识别星期: %s", uniteTime)
+		}
+		tt.Day = day
+
+		var secStart, secEnd int
+		switch n, _ := fmt.Sscanf(dayAndSection[index:], "第%d-%d节", &secStart, &secEnd); n {
+		case 1:
+			secEnd = secStart
+		case 2:
+		default:
+			return nil, fmt.Errorf("无法识别节次: %s", uniteTime)
+		}
+		if secStart < 1 || secStart > secEnd {
+			return nil, fmt.Errorf("无法识别节次: %s", uniteTime)
+		}
+		for i := secStart; i <= secEnd; i++ {
+			tt.Sections = append(tt.Sections, i)
+		}
+
+		//开始获取周数
+		for _, weekStr := range strings.Split(weeksStr, ",") {
+			weeks, err := parseWeeks(weekStr)
+			if err != nil {
+				return nil, fmt.Errorf("%v: %s", err, uniteTime)
+			}
+			tt.Weeks = append(tt.Weeks, weeks...)
+		}
+		res = append(res, tt)
+	}
+	if len(res) == 0 {
+		return nil, fmt.Errorf("无法识别上课时间: %s", val)
+	}
+	return res, nil
+}
+
+// parseWeeks 解析"4-19周","4-18周(双)","5周"这样的周数
+func parseWeeks(weekStr string) ([]int, error) {
+	weekStr = strings.TrimSpace(weekStr)
+	var pattern int // 1代表单周,2代表双周,0代表没有
+	switch {
+	case strings.HasSuffix(weekStr, "(单)"):
+		pattern = 1
+	case strings.HasSuffix(weekStr, "(双)"):
+		pattern = 2
+	}
+	if index := strings.Index(weekStr, "("); index != -1 {
+		weekStr = weekStr[:index]
+	}
+
+	var weekStart, weekEnd int
+	switch n, _ := fmt.Sscanf(weekStr, "%d-%d周", &weekStart, &weekEnd); n {
+	case 1:
+		weekEnd = weekStart
+	case 2:
+	default:
+		return nil, fmt.Errorf("无法识别周数")
+	}
+	if weekStart < 1 || weekStart > weekEnd {
+		return nil, fmt.Errorf("无法识别周数")
+	}
+
+	var weeks []int
+	for i := weekStart; i <= weekEnd; i++ {
+		if pattern == 0 || (pattern == 1 && i%2 == 1) || (pattern == 2 && i%2 == 0) {
+			weeks = append(weeks, i)
+		}
+	}
+	return weeks, nil
+}
+
+// activate 让某个版本的占用信息生效,同一学期同时只能有一个版本在写入
+// 先写入新版本,切换生效的版本之后再删除其他版本,写入失败时只删除新版本,原来生效的版本不受影响
+func (s *SelectionUploadBiz) activate(ctx context.Context, year, semester, version string, cwtPairs []model.CTWPair) error {
+	lockKey := fmt.Sprintf("selection_upload_%v_%v", year, semester)
+	locker := s.lockBuilder.BuildWithExpire(lockKey, 10*time.Minute)
+	if err := locker.Lock(); err != nil {
+		return fmt.Errorf("another upload of %s-%s is being saved: %w", year, semester, err)
+	}
+	defer func() {
+		if ok, err := locker.Unlock(); !ok || err != nil {
+			clog.LogPrinter.Errorf("unlock %v failed: %v", lockKey, err)
+		}
+	}()
+
+	active, err := s.store.GetActiveUpload(ctx, year, semester)
+	if err != nil {
+		return err
+	}
+	// 回滚到当前生效的版本时写入失败也不能删除它
+	discard := func() {
+		if version == active {
+			return
+		}
+		if err := s.data.DeleteUploadedOccupancy(ctx, year, semester, version); err != nil {
+			clog.LogPrinter.Errorf("failed to delete upload[%s] of %s-%s: %v", version, year, semester, err)
+		}
+	}
+
+	for i := 0; i < len(cwtPairs); i += uploadSaveBatchSize {
+		if err := s.data.AddUploadedOccupancy(ctx, year, semester, version, cwtPairs[i:min(i+uploadSaveBatchSize, len(cwtPairs))]...); err != nil {
+			discard()
+			return err
+		}
+	}
+	if err := s.store.SetActiveUpload(ctx, year, semester, version); err != nil {
+		discard()
+		return err
+	}
+	// 删除失败时其他版本的占用会留到下一次切换,只会让教室显示为占用
+	if err := s.data.ClearUploadedOccupancy(ctx, year, semester, version); err != nil {
+		clog.LogPrinter.Warnf("failed to clear other uploads of %s-%s: %v", year, semester, err)
+	}
+	clog.LogPrinter.Infof("activate selection upload[%s] of %s-%s with %d classroom occupancy", version, year, semester, len(cwtPairs))
+	return nil
+}
+
+// trimUploads 只保留最近的maxUploadVersions个版本,生效中的版本不删除
+func (s *SelectionUploadBiz) trimUploads(ctx context.Context, year, semester string) {
+	history, err := s.ListUploads(ctx, year, semester)
+	if err != nil {
+		clog.LogPrinter.Warnf("failed to list uploads of %s-%s: %v", year, semester, err)
+		return
+	}
+	for i := maxUploadVersions; i < len(history.Uploads); i++ {
+		version := history.Uploads[i].Version
+		if version == history.Active {
+			continue
+		}
+		if err := s.store.DeleteUpload(ctx, year, semester, version); err != nil {
+			clog.LogPrinter.Warnf("failed to delete upload[%s] of %s-%s: %v", version, year, semester, err)
+		}
+	}
+}
+
+func (s *SelectionUploadBiz) saveJob(ctx context.Context, job *model.UploadJob) {
+	job.UpdatedAt = time.Now().Unix()
+	if err := s.store.SaveJob(ctx, *job); err != nil {
+		clog.LogPrinter.Errorf("failed to save upload job[%s]: %v", job.ID, err)
+	}
+}
+
+// newUploadJobID 以时间开头,按字符串排序即按创建时间排序
+func newUploadJobID(now time.Time) string {
+	b := make([]byte, 4)
+	_, _ = rand.Read(b)
+	return now.Format("20060102150405.000000") + "-" + hex.EncodeToString(b)
+}
@@ -0,0 +1,256 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/asynccnu/ccnubox-be/be-class/internal/conf"
+	"github.com/asynccnu/ccnubox-be/be-class/internal/data"
+	"github.com/asynccnu/ccnubox-be/be-class/internal/errcode"
+	"github.com/asynccnu/ccnubox-be/be-class/internal/model"
+	"github.com/asynccnu/ccnubox-be/be-class/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xuri/excelize/v2"
+)
+
+type fakeUploadStore struct {
+	jobs    map[string]model.UploadJob
+	uploads map[string]model.SelectionUpload
+	pairs   map[string][]model.CTWPair
+	active  string
+}
+
+func newFakeUploadStore() *fakeUploadStore {
+	return &fakeUploadStore{
+		jobs:    make(map[string]model.UploadJob),
+		uploads: make(map[string]model.SelectionUpload),
+		pairs:   make(map[string][]model.CTWPair),
+	}
+}
+
+func (f *fakeUploadStore) SaveJob(ctx context.Context, job model.UploadJob) error {
+	f.jobs[job.ID] = job
+	return nil
+}
+
+func (f *fakeUploadStore) GetJob(ctx context.Context, id string) (model.UploadJob, error) {
+	job, ok := f.jobs[id]
+	if !ok {
+		return job, errcode.Err_UploadJobNotFound
+	}
+	return job, nil
+}
+
+func (f *fakeUploadStore) SaveUpload(ctx context.Context, upload model.SelectionUpload, cwtPairs []model.CTWPair) error {
+	f.uploads[upload.Version] = upload
+	f.pairs[upload.Version] = cwtPairs
+	return nil
+}
+
+func (f *fakeUploadStore) ListUploads(ctx context.Context, year, semester string) ([]model.SelectionUpload, error) {
+	var uploads []model.SelectionUpload
+	for _, u := range f.uploads {
+		uploads = append(uploads, u)
+	}
+	return uploads, nil
+}
+
+func (f *fakeUploadStore) GetUploadPairs(ctx context.Context, year, semester, version string) ([]model.CTWPair, error) {
+	pairs, ok := f.pairs[version]
+	if !ok {
+		return nil, errcode.Err_UploadVersionNotFound
+	}
+	return pairs, nil
+}
+
+func (f *fakeUploadStore) DeleteUpload(ctx context.Context, year, semester, version string) error {
+	delete(f.uploads, version)
+	delete(f.pairs, version)
+	return nil
+}
+
+func (f *fakeUploadStore) SetActiveUpload(ctx context.Context, year, semester, version string) error {
+	f.active = version
+	return nil
+}
+
+func (f *fakeUploadStore) GetActiveUpload(ctx context.Context, year, semester string) (string, error) {
+	return f.active, nil
+}
+
+// newSelectionFile 生成一个选课手册,第一行是表头
+func newSelectionFile(t *testing.T, rows [][]string) []byte {
+	f := excelize.NewFile()
+	defer f.Close()
+	_, err := f.NewSheet("2024级")
+	require.NoError(t, err)
+	for i, row := range rows {
+		cellName, err := excelize.CoordinatesToCellName(1, i+1)
+		require.NoError(t, err)
+		require.NoError(t, f.SetSheetRow("2024级", cellName, &row))
+	}
+	buf, err := f.WriteToBuffer()
+	require.NoError(t, err)
+	return buf.Bytes()
+}
+
+func TestParseTime(t *testing.T) {
+	cts, err := parseTime("星期一第1-2节{4-8周(双)};星期二第7节{4-5周,7周}")
+	require.NoError(t, err)
+	assert.Equal(t, []model.CTime{
+		{Day: 1, Sections: []int{1, 2}, Weeks: []int{4, 6, 8}},
+		{Day: 2, Sections: []int{7}, Weeks: []int{4, 5, 7}},
+	}, cts)
+
+	cts, err = parseTime("星期五第9-10节{5-9周(单)}")
+	require.NoError(t, err)
+	assert.Equal(t, []int{5, 7, 9}, cts[0].Weeks)
+
+	for _, val := range []string{"星期一第1-2节", "星期八第1-2节{1-2周}", "星期一{1-2周}", "星期一第1-2节{周}", "线上"} {
+		_, err := parseTime(val)
+		assert.Error(t, err, val)
+	}
+}
+
+func TestResolveColumns(t *testing.T) {
+	header := []string{"课程名称", "教师", "上课时间", "教学地点"}
+	timeIdx, whereIdx, err := resolveColumns(header, model.ColumnMapping{ClassTimeHeader: "上课时间", ClassWhereHeader: "教学地点"})
+	require.NoError(t, err)
+	assert.Equal(t, [2]int{2, 3}, [2]int{timeIdx, whereIdx})
+
+	timeIdx, whereIdx, err = resolveColumns(header, model.ColumnMapping{ClassTimeIdx: 2, ClassWhereIdx: 3})
+	require.NoError(t, err)
+	assert.Equal(t, [2]int{2, 3}, [2]int{timeIdx, whereIdx})
+
+	_, _, err = resolveColumns(header, model.ColumnMapping{ClassTimeHeader: "时间"})
+	assert.Error(t, err)
+}
+
+func TestSelectionUploadBiz(t *testing.T) {
+	ctx := context.Background()
+	idx, _, err := data.NewEmbeddedIndex(&conf.Data_Embedded{})
+	require.NoError(t, err)
+	store := newFakeUploadStore()
+	s := NewSelectionUploadBiz(store, idx, fakeLockBuilder{})
+	sheets := map[string]model.ColumnMapping{"2024级": {ClassTimeHeader: "上课时间", ClassWhereHeader: "教学地点"}}
+
+	// 课程信息推出的占用不受上传的影响
+	require.NoError(t, idx.AddClassroomOccupancy(ctx, "2024", "1", model.CTWPair{
+		CT:    model.CTime{Weeks: []int{1}, Day: 3, Sections: []int{1}},
+		Where: "n201",
+	}))
+
+	run := func(req service.UploadReq, rows [][]string) model.UploadJob {
+		// 在测试中同步执行,不在后台解析
+		job, err := s.newUploadJob(ctx, req, "test.xlsx")
+		require.NoError(t, err)
+		s.runUpload(ctx, job, req.Sheets, newSelectionFile(t, rows))
+		job, err = s.GetUploadJob(ctx, job.ID)
+		require.NoError(t, err)
+		return job
+	}
+
+	rows := [][]string{
+		{"课程名称", "上课时间", "教学地点"},
+		{"高等数学", "星期一第1-2节{1-2周}", "n101"},
+		{"网课", "", ""},
+		{"大学英语", "星期二第3节{1周}", "n101;n102"},
+		{"坏数据", "星期八第1节{1周}", "n103"},
+	}
+
+	job := run(service.UploadReq{Year: "2024", Semester: "1", Sheets: sheets, DryRun: true}, rows)
+	assert.Equal(t, model.UploadJobFinished, job.Status)
+	assert.Equal(t, 4, job.TotalRows)
+	assert.Equal(t, 4, job.ParsedRows)
+	assert.Equal(t, 3, job.Pairs)
+	assert.Equal(t, 1, job.ErrorCount)
+	assert.Equal(t, 5, job.Errors[0].Row)
+	assert.Empty(t, job.Version)
+	// 试运行不写入
+	counts, _ := idx.CountOccupiedSections(ctx, "2024", "1", 1, 1, "n1")
+	assert.Empty(t, counts)
+
+	first := run(service.UploadReq{Year: "2024", Semester: "1", Sheets: sheets}, rows)
+	assert.Equal(t, model.UploadJobFinished, first.Status)
+	assert.Equal(t, first.ID, first.Version)
+	counts, _ = idx.CountOccupiedSections(ctx, "2024", "1", 1, 1, "n1")
+	assert.Equal(t, map[string]int{"n101": 2}, counts)
+
+	second := run(service.UploadReq{Year: "2024", Semester: "1", Sheets: sheets}, [][]string{
+		{"课程名称", "上课时间", "教学地点"},
+		{"线性代数", "星期一第5节{1周}", "n102"},
+	})
+	assert.Equal(t, model.UploadJobFinished, second.Status)
+	counts, _ = idx.CountOccupiedSections(ctx, "2024", "1", 1, 1, "n1")
+	assert.Equal(t, map[string]int{"n102": 1}, counts)
+
+	history, err := s.ListUploads(ctx, "2024", "1")
+	require.NoError(t, err)
+	assert.Equal(t, second.Version, history.Active)
+	require.Len(t, history.Uploads, 2)
+	assert.Equal(t, second.Version, history.Uploads[0].Version)
+
+	require.NoError(t, s.RollbackUpload(ctx, "2024", "1", first.Version))
+	counts, _ = idx.CountOccupiedSections(ctx, "2024", "1", 1, 1, "n1")
+	assert.Equal(t, map[string]int{"n101": 2}, counts)
+	counts, _ = idx.CountOccupiedSections(ctx, "2024", "1", 1, 3, "n2")
+	assert.Equal(t, map[string]int{"n201": 1}, counts)
+	assert.Equal(t, first.Version, store.active)
+
+	assert.ErrorIs(t, s.RollbackUpload(ctx, "2024", "1", "unknown"), errcode.Err_UploadVersionNotFound)
+
+	failed := run(service.UploadReq{Year: "2024", Semester: "1", Sheets: map[string]model.ColumnMapping{"2024级": {ClassTimeHeader: "时间"}}}, rows)
+	assert.Equal(t, model.UploadJobFailed, failed.Status)
+	assert.NotEmpty(t, failed.Message)
+}
+
+// failingOccupancy 写入第fail批选课手册的占用时失败
+type failingOccupancy struct {
+	*data.EmbeddedIndex
+	fail, batches int
+}
+
+func (f *failingOccupancy) AddUploadedOccupancy(ctx context.Context, year, semester, version string, cwtPairs ...model.CTWPair) error {
+	f.batches++
+	if f.batches == f.fail {
+		return errors.New("es unavailable")
+	}
+	return f.EmbeddedIndex.AddUploadedOccupancy(ctx, year, semester, version, cwtPairs...)
+}
+
+func TestSelectionUploadBiz_ActivateFailureKeepsActiveVersion(t *testing.T) {
+	ctx := context.Background()
+	idx, _, err := data.NewEmbeddedIndex(&conf.Data_Embedded{})
+	require.NoError(t, err)
+	store := newFakeUploadStore()
+	occupancy := &failingOccupancy{EmbeddedIndex: idx}
+	s := NewSelectionUploadBiz(store, occupancy, fakeLockBuilder{})
+
+	require.NoError(t, s.activate(ctx, "2024", "1", "v1", []model.CTWPair{
+		{CT: model.CTime{Weeks: []int{1}, Day: 1, Sections: []int{1}}, Where: "n101"},
+	}))
+
+	// 第二批写入失败,已经写入的第一批被删除,v1仍然生效
+	occupancy.batches, occupancy.fail = 0, 2
+	pairs := make([]model.CTWPair, uploadSaveBatchSize+1)
+	for i := range pairs {
+		pairs[i] = model.CTWPair{CT: model.CTime{Weeks: []int{1}, Day: 1, Sections: []int{i%12 + 1}}, Where: fmt.Sprintf("n2%02d", i%50)}
+	}
+	assert.Error(t, s.activate(ctx, "2024", "1", "v2", pairs))
+	assert.Equal(t, "v1", store.active)
+	counts, err := idx.CountOccupiedSections(ctx, "2024", "1", 1, 1, "n")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"n101": 1}, counts)
+
+	// 回滚到生效中的版本失败时也不删除它
+	occupancy.batches, occupancy.fail = 0, 1
+	assert.Error(t, s.activate(ctx, "2024", "1", "v1", []model.CTWPair{
+		{CT: model.CTime{Weeks: []int{1}, Day: 1, Sections: []int{1}}, Where: "n101"},
+	}))
+	counts, err = idx.CountOccupiedSections(ctx, "2024", "1", 1, 1, "n")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"n101": 1}, counts)
+}
@@ -0,0 +1,53 @@
+package client
+
+import (
+	"context"
+	calendar "github.com/asynccnu/ccnubox-be/be-api/gen/proto/calendar/v1"
+	clog "github.com/asynccnu/ccnubox-be/be-class/internal/log"
+	"github.com/asynccnu/ccnubox-be/be-class/internal/model"
+	"github.com/go-kratos/kratos/contrib/registry/etcd/v2"
+	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/middleware/tracing"
+	"github.com/go-kratos/kratos/v2/transport/grpc"
+)
+
+const CALENDARSERVICE = "discovery:///calendar"
+
+// CalendarService 从be-calendar的校历中获取学期和周次
+type CalendarService struct {
+	cs calendar.CalendarServiceClient
+}
+
+func NewCalendarService(r *etcd.Registry) (*CalendarService, error) {
+	conn, err := grpc.DialInsecure(
+		context.Background(),
+		grpc.WithEndpoint(CALENDARSERVICE),
+		grpc.WithDiscovery(r),
+		grpc.WithMiddleware(
+			tracing.Client(),
+			recovery.Recovery(),
+		),
+	)
+	if err != nil {
+		clog.LogPrinter.Errorw("kind", "grpc-client", "reason", "GRPC_CLIENT_INIT_ERROR", "err", err)
+		return nil, err
+	}
+	return &CalendarService{cs: calendar.NewCalendarServiceClient(conn)}, nil
+}
+
+// GetSemesterDay 查询date(形如"2006-01-02")在校历中的信息,date为空时查询今天
+func (c *CalendarService) GetSemesterDay(ctx context.Context, date string) (model.SemesterDay, error) {
+	resp, err := c.cs.GetSemesterDay(ctx, &calendar.GetSemesterDayRequest{Date: date})
+	if err != nil {
+		clog.LogPrinter.Errorf("send request for service[%v] to get semester day[%v] failed: %v", CALENDARSERVICE, date, err)
+		return model.SemesterDay{}, err
+	}
+	return model.SemesterDay{
+		Year:       resp.GetSemester().GetYear(),
+		Semester:   resp.GetSemester().GetSemester(),
+		InSemester: resp.GetInSemester(),
+		Week:       int(resp.GetWeek()),
+		Weekday:    int(resp.GetWeekday()),
+		IsHoliday:  resp.GetIsHoliday(),
+	}, nil
+}
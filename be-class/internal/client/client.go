@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"fmt"
 	classlist "github.com/asynccnu/ccnubox-be/be-api/gen/proto/classlist/v1"
 	user "github.com/asynccnu/ccnubox-be/be-api/gen/proto/user/v1"
 	"github.com/asynccnu/ccnubox-be/be-class/internal/errcode"
@@ -16,7 +17,7 @@ import (
 
 const CLASSLISTSERVICE = "discovery:///be-classlist"
 
-var ProviderSet = wire.NewSet(NewClassListService, NewCookieSvc)
+var ProviderSet = wire.NewSet(NewClassListService, NewCalendarService, NewCookieSvc)
 
 type ClassListService struct {
 	cs classlist.ClasserClient
@@ -64,6 +65,7 @@ func (c *ClassListService) GetAllSchoolClassInfos(ctx context.Context, xnm, xqm,
 			Weeks:        info.Weeks,
 			Semester:     info.Semester,
 			Year:         info.Year,
+			Nature:       info.Nature,
 		}
 		classInfos = append(classInfos, classInfo)
 	}
@@ -80,12 +82,41 @@ func (c *ClassListService) AddClassInfoToClassListService(ctx context.Context, r
 
 }
 
-func (c *ClassListService) GetSchoolDay(ctx context.Context) (string, error) {
-	resp, err := c.cs.GetSchoolDay(ctx, &classlist.GetSchoolDayReq{})
+// GetStuClassTimes 获取学生课表中所有课程的上课时间
+func (c *ClassListService) GetStuClassTimes(ctx context.Context, stuID, xnm, xqm string) ([]model.CTime, error) {
+	resp, err := c.cs.GetClass(ctx, &classlist.GetClassRequest{
+		StuId:    stuID,
+		Year:     xnm,
+		Semester: xqm,
+	})
 	if err != nil {
-		return "", err
+		clog.LogPrinter.Errorf("send request for service[%v] to get classes[stu_id:%v xnm:%v xqm:%v] failed: %v", CLASSLISTSERVICE, stuID, xnm, xqm, err)
+		return nil, err
+	}
+	var times = make([]model.CTime, 0, len(resp.Classes))
+	for _, class := range resp.Classes {
+		info := class.GetInfo()
+		if info == nil {
+			continue
+		}
+		var secStart, secEnd int
+		if n, _ := fmt.Sscanf(info.ClassWhen, "%d-%d", &secStart, &secEnd); n == 0 {
+			continue
+		} else if n == 1 {
+			secEnd = secStart
+		}
+		ct := model.CTime{Day: int(info.Day)}
+		for i := secStart; i <= secEnd; i++ {
+			ct.Sections = append(ct.Sections, i)
+		}
+		for i := 1; i <= 30; i++ {
+			if info.Weeks&(1<<(i-1)) != 0 {
+				ct.Weeks = append(ct.Weeks, i)
+			}
+		}
+		times = append(times, ct)
 	}
-	return resp.SchoolTime, nil
+	return times, nil
 }
 
 type CookieSvc struct {
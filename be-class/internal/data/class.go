@@ -1,9 +1,14 @@
 package data
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"net/http"
+	"text/template"
 
 	"github.com/asynccnu/ccnubox-be/be-class/internal/errcode"
 	clog "github.com/asynccnu/ccnubox-be/be-class/internal/log"
@@ -12,7 +17,8 @@ import (
 	"github.com/olivere/elastic/v7"
 )
 
-const classMapping = `{
+// classMappingTmpl 课程索引的mapping,Pinyin为true时使用analysis-pinyin插件增加拼音子字段
+var classMappingTmpl = template.Must(template.New("class_mapping").Parse(`{
   "settings": {
     "number_of_shards": 3,
     "number_of_replicas": 1,
@@ -25,7 +31,15 @@ const classMapping = `{
         "edge_ngram_analyzer": {
           "tokenizer": "edge_ngram_tk",
           "filter": ["lowercase"]
-        }
+        },
+        "keyword_lowercase_analyzer": {
+          "tokenizer": "keyword",
+          "filter": ["lowercase"]
+        }{{if .Pinyin}},
+        "pinyin_analyzer": {
+          "tokenizer": "pinyin_tk",
+          "filter": ["lowercase", "pinyin_edge_ngram"]
+        }{{end}}
       },
       "tokenizer": {
         "ngram_tk": {
@@ -39,8 +53,27 @@ const classMapping = `{
           "min_gram": 1,
           "max_gram": 25,
           "token_chars": ["letter", "digit"]
+        }{{if .Pinyin}},
+        "pinyin_tk": {
+          "type": "pinyin",
+          "keep_first_letter": true,
+          "keep_separate_first_letter": false,
+          "keep_full_pinyin": false,
+          "keep_joined_full_pinyin": true,
+          "keep_none_chinese_in_joined_full_pinyin": true,
+          "keep_original": false,
+          "limit_first_letter_length": 16,
+          "lowercase": true,
+          "remove_duplicated_term": true
+        }{{end}}
+      }{{if .Pinyin}},
+      "filter": {
+        "pinyin_edge_ngram": {
+          "type": "edge_ngram",
+          "min_gram": 1,
+          "max_gram": 30
         }
-      }
+      }{{end}}
     }
   },
   "mappings": {
@@ -50,41 +83,113 @@ const classMapping = `{
       "teacher": {
         "type": "text",
         "analyzer": "ngram_analyzer",
+        "fields": {
+          "keyword": { "type": "keyword" },
+          "prefix": {
+            "type": "text",
+            "analyzer": "edge_ngram_analyzer",
+            "search_analyzer": "keyword_lowercase_analyzer"
+          }{{if .Pinyin}},
+          "pinyin": {
+            "type": "text",
+            "analyzer": "pinyin_analyzer",
+            "search_analyzer": "keyword_lowercase_analyzer"
+          }{{end}}
+        }
+      },
+      "where": {
+        "type": "text",
+        "fields": {
+          "keyword": { "type": "keyword" }
+        }
+      },
+      "building": { "type": "keyword" },
+      "class_when": {
+        "type": "text",
         "fields": {
           "keyword": { "type": "keyword" }
         }
       },
-      "where": { "type": "text" },
-      "class_when": { "type": "text" },
+      "section_start": { "type": "integer" },
+      "section_end": { "type": "integer" },
       "week_duration": { "type": "text" },
       "classname": {
         "type": "text",
         "analyzer": "ngram_analyzer",
         "fields": {
-          "keyword": { "type": "keyword" }, 
-          "standard": { 
+          "keyword": { "type": "keyword" },
+          "standard": {
+            "type": "text",
+            "analyzer": "standard"
+          },
+          "prefix": {
+            "type": "text",
+            "analyzer": "edge_ngram_analyzer",
+            "search_analyzer": "keyword_lowercase_analyzer"
+          }{{if .Pinyin}},
+          "pinyin": {
             "type": "text",
-            "analyzer": "standard" 
-          }
+            "analyzer": "pinyin_analyzer",
+            "search_analyzer": "keyword_lowercase_analyzer"
+          }{{end}}
+        }
+      },
+      "aliases": {
+        "type": "text",
+        "analyzer": "ngram_analyzer",
+        "fields": {
+          "prefix": {
+            "type": "text",
+            "analyzer": "edge_ngram_analyzer",
+            "search_analyzer": "keyword_lowercase_analyzer"
+          }{{if .Pinyin}},
+          "pinyin": {
+            "type": "text",
+            "analyzer": "pinyin_analyzer",
+            "search_analyzer": "keyword_lowercase_analyzer"
+          }{{end}}
         }
       },
       "credit": { "type": "float" },
       "weeks": { "type": "integer" },
+      "week_list": { "type": "integer" },
+      "nature": { "type": "keyword" },
       "semester": { "type": "keyword" },
       "year": { "type": "keyword" }
     }
   }
 }
-`
+`))
 
+func classMapping(pinyin bool) string {
+	var buf bytes.Buffer
+	_ = classMappingTmpl.Execute(&buf, struct{ Pinyin bool }{pinyin})
+	return buf.String()
+}
+
+// classIndexName 是别名,实际的索引带有mapping的版本号
 const classIndexName = "ccnubox-class_info"
 
+// classIndexVersion 课程索引mapping的版本,修改classMappingTmpl时需要加一,启动时会迁移到新版本
+const classIndexVersion = 3
+
+// classIndexVersionName 当前版本的索引名,是否使用拼音插件的mapping不同,分开命名
+func classIndexVersionName(pinyin bool) string {
+	name := fmt.Sprintf("%s-v%d", classIndexName, classIndexVersion)
+	if pinyin {
+		name += "-pinyin"
+	}
+	return name
+}
+
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewClassData, NewEsClient, NewFreeClassroomData, NewRedisClient, NewCache)
+var ProviderSet = wire.NewSet(NewIndexes, wire.FieldsOf(new(*Indexes), "Class", "Classroom"), NewRedisClient, NewCache, NewClassEventConsumer, NewSelectionUploadStore)
 
 // ClassData .
 type ClassData struct {
-	cli *elastic.Client
+	cli         *elastic.Client
+	pinyin      bool            //es是否安装了拼音插件,没有时不使用拼音子字段
+	facetFields map[string]bool //可以聚合的统计字段
 }
 
 // NewClassData .
@@ -93,7 +198,9 @@ func NewClassData(cli *elastic.Client) (*ClassData, func(), error) {
 		clog.LogPrinter.Info("closing the data resources")
 	}
 	return &ClassData{
-		cli: cli,
+		cli:         cli,
+		pinyin:      hasPinyinPlugin(context.Background(), cli),
+		facetFields: facetFields(context.Background(), cli),
 	}, cleanup, nil
 }
 
@@ -109,7 +216,7 @@ func (d ClassData) AddClassInfo(ctx context.Context, classInfos ...model.ClassIn
 		req := elastic.NewBulkIndexRequest().
 			Index(classIndexName).
 			Id(classInfo.ID).
-			Doc(classInfo)
+			Doc(newClassDoc(classInfo))
 		bulkRequest = bulkRequest.Add(req)
 	}
 
@@ -154,44 +261,6 @@ func (d ClassData) ClearClassInfo(ctx context.Context, xnm, xqm string) {
 	clog.LogPrinter.Infof("Deleted %d documents", deleteResponse.Deleted)
 }
 
-func (d ClassData) SearchClassInfo(ctx context.Context, keyWords string, xnm, xqm string, page, pageSize int) ([]model.ClassInfo, error) {
-	var classInfos = make([]model.ClassInfo, 0)
-	offset := (page - 1) * pageSize
-	searchResult, err := d.cli.Search().
-		Index(classIndexName).
-		Query(
-			elastic.NewBoolQuery().
-				Should(
-					// 改为 MatchQuery 支持任意片段匹配（"算机"可匹配"计算机科学"）
-					elastic.NewMatchPhraseQuery("classname", keyWords).Boost(2),
-					elastic.NewMatchPhraseQuery("teacher", keyWords).Boost(1.5),
-				).
-				MinimumShouldMatch("1").
-				Filter(
-					elastic.NewTermQuery("year", xnm),
-					elastic.NewTermQuery("semester", xqm),
-				),
-		).
-		From(offset).
-		Size(pageSize + 1).
-		Do(ctx)
-
-	if err != nil {
-		clog.LogPrinter.Errorf("es: failed to search class_info[keywords:%v xnm:%v xqm:%v]: %v", keyWords, xnm, xqm, err)
-		return nil, errcode.Err_EsSearchClassInfo
-	}
-
-	for _, hit := range searchResult.Hits.Hits {
-		var classInfo model.ClassInfo
-		if err := json.Unmarshal(hit.Source, &classInfo); err != nil {
-			clog.LogPrinter.Errorf("json unmarshal %v failed: %v", hit.Source, err)
-			continue
-		}
-		classInfos = append(classInfos, classInfo)
-	}
-	return classInfos, nil
-}
-
 func (d ClassData) GetBatchClassInfos(ctx context.Context, year, semester string, page, pageSize int) ([]model.ClassInfo, int, error) {
 	var classInfos = make([]model.ClassInfo, 0)
 	searchResult, err := d.cli.Search().
@@ -222,3 +291,70 @@ func (d ClassData) GetBatchClassInfos(ctx context.Context, year, semester string
 	}
 	return classInfos, int(total), nil
 }
+
+// DeleteClassInfo 按课程ID删除,不存在的课程忽略
+func (d ClassData) DeleteClassInfo(ctx context.Context, ids ...string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	bulkRequest := d.cli.Bulk()
+	for _, id := range ids {
+		bulkRequest = bulkRequest.Add(elastic.NewBulkDeleteRequest().Index(classIndexName).Id(id))
+	}
+
+	bulkResponse, err := bulkRequest.Do(ctx)
+	if err != nil {
+		clog.LogPrinter.Errorf("es: failed to bulk delete class_info: %v", err)
+		return fmt.Errorf("%w: %v", errcode.Err_EsDeleteClassInfo, err)
+	}
+
+	failed := 0
+	for _, item := range bulkResponse.Failed() {
+		if item.Status == http.StatusNotFound {
+			continue
+		}
+		failed++
+		clog.LogPrinter.Errorf("es: failed to delete class_info[%s]: %v", item.Id, item.Error)
+	}
+	if failed > 0 {
+		return errcode.Err_EsDeleteClassInfo
+	}
+	return nil
+}
+
+// ScanClassInfos 用scroll遍历某个学期的所有课程,每批调用一次f
+func (d ClassData) ScanClassInfos(ctx context.Context, xnm, xqm string, f func([]model.ClassInfo) error) error {
+	scroll := d.cli.Scroll(classIndexName).
+		Query(elastic.NewBoolQuery().Filter(
+			elastic.NewTermQuery("year", xnm),
+			elastic.NewTermQuery("semester", xqm),
+		)).
+		Size(1000)
+	defer func() {
+		_ = scroll.Clear(context.Background())
+	}()
+
+	for {
+		res, err := scroll.Do(ctx)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			clog.LogPrinter.Errorf("es: failed to scroll class_info[xnm:%v xqm:%v]: %v", xnm, xqm, err)
+			return errcode.Err_EsSearchClassInfo
+		}
+		classInfos := make([]model.ClassInfo, 0, len(res.Hits.Hits))
+		for _, hit := range res.Hits.Hits {
+			var classInfo model.ClassInfo
+			if err := json.Unmarshal(hit.Source, &classInfo); err != nil {
+				clog.LogPrinter.Errorf("json unmarshal %v failed: %v", hit.Source, err)
+				continue
+			}
+			classInfos = append(classInfos, classInfo)
+		}
+		if err := f(classInfos); err != nil {
+			return err
+		}
+	}
+}
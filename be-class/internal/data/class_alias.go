@@ -0,0 +1,44 @@
+package data
+
+import "strings"
+
+// classAbbreviations 课程名称中的片段 -> 学生常用的简称
+// 简称会作为课程的别名存入es,配合拼音子字段可以用"gs"搜到"高等数学"
+var classAbbreviations = map[string][]string{
+	"高等数学":      {"高数"},
+	"线性代数":      {"线代"},
+	"概率论与数理统计":  {"概率论", "概统"},
+	"大学物理":      {"大物"},
+	"大学英语":      {"大英"},
+	"大学计算机":     {"大计"},
+	"数据结构":      {"数构"},
+	"计算机网络":     {"计网"},
+	"操作系统":      {"操统"},
+	"马克思主义基本原理": {"马原"},
+	"毛泽东思想和中国特色社会主义理论体系概论": {"毛概"},
+	"习近平新时代中国特色社会主义思想概论":   {"习概"},
+	"思想道德与法治":     {"思修"},
+	"思想道德修养与法律基础": {"思修"},
+	"中国近现代史纲要":    {"史纲", "近代史"},
+	"形势与政策":       {"形策"},
+	"大学生心理健康":     {"心理健康"},
+	"军事理论":        {"军理"},
+}
+
+// classAliases 返回课程名称对应的简称
+func classAliases(classname string) []string {
+	var aliases []string
+	seen := make(map[string]bool)
+	for full, abbrs := range classAbbreviations {
+		if !strings.Contains(classname, full) {
+			continue
+		}
+		for _, abbr := range abbrs {
+			if !seen[abbr] {
+				seen[abbr] = true
+				aliases = append(aliases, abbr)
+			}
+		}
+	}
+	return aliases
+}
@@ -0,0 +1,105 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/asynccnu/ccnubox-be/be-class/internal/conf"
+	clog "github.com/asynccnu/ccnubox-be/be-class/internal/log"
+	"github.com/asynccnu/ccnubox-be/be-class/internal/model"
+)
+
+const (
+	classEventTopic   = "be-classlist-class-event" //由be-classlist发送
+	classEventGroupID = "be-class-class-sync"
+	// 处理失败时的重试次数,仍然失败就跳过,由定时对账修复
+	classEventMaxAttempts = 3
+)
+
+// ClassEventConsumer 消费be-classlist发送的课程信息变更事件
+type ClassEventConsumer struct {
+	brokers []string
+	ctx     context.Context
+	cancel  context.CancelFunc
+}
+
+func NewClassEventConsumer(c *conf.Data) (*ClassEventConsumer, func()) {
+	ctx, cancel := context.WithCancel(context.Background())
+	ec := &ClassEventConsumer{
+		brokers: c.GetKafka().GetBrokers(),
+		ctx:     ctx,
+		cancel:  cancel,
+	}
+	return ec, ec.Close
+}
+
+// Consume 阻塞地消费事件,直到Close被调用
+// 没有配置kafka时直接返回
+func (c *ClassEventConsumer) Consume(f func(ctx context.Context, event model.ClassEvent) error) error {
+	if len(c.brokers) == 0 {
+		clog.LogPrinter.Warn("kafka is not configured, class events will not be consumed")
+		return nil
+	}
+
+	cfg := sarama.NewConfig()
+	// 第一次启动时从最新的位置开始,之前的变更由对账补上
+	cfg.Consumer.Offsets.Initial = sarama.OffsetNewest
+	cfg.Consumer.Group.Session.Timeout = 10 * time.Second
+	cfg.Consumer.Group.Heartbeat.Interval = 3 * time.Second
+
+	cg, err := sarama.NewConsumerGroup(c.brokers, classEventGroupID, cfg)
+	if err != nil {
+		clog.LogPrinter.Errorf("kafka consumer connect failed: %v", err)
+		return err
+	}
+	defer cg.Close()
+
+	handler := classEventHandler{f: f}
+	for {
+		if err := cg.Consume(c.ctx, []string{classEventTopic}, handler); err != nil {
+			return err
+		}
+		if c.ctx.Err() != nil {
+			return nil
+		}
+	}
+}
+
+func (c *ClassEventConsumer) Close() {
+	c.cancel()
+}
+
+type classEventHandler struct {
+	f func(ctx context.Context, event model.ClassEvent) error
+}
+
+func (h classEventHandler) Setup(sarama.ConsumerGroupSession) error { return nil }
+
+func (h classEventHandler) Cleanup(sarama.ConsumerGroupSession) error { return nil }
+
+func (h classEventHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	for msg := range claim.Messages() {
+		var event model.ClassEvent
+		if err := json.Unmarshal(msg.Value, &event); err != nil {
+			// 消息格式不对,重试也没有用
+			clog.LogPrinter.Errorf("unmarshal class event[partition:%d offset:%d] failed: %v", msg.Partition, msg.Offset, err)
+			session.MarkMessage(msg, "")
+			continue
+		}
+
+		var err error
+		for attempt := 1; attempt <= classEventMaxAttempts; attempt++ {
+			if err = h.f(session.Context(), event); err == nil {
+				break
+			}
+			time.Sleep(time.Duration(attempt) * 200 * time.Millisecond)
+		}
+		if err != nil {
+			clog.LogPrinter.Errorf("handle class event[%s %s] failed after %d attempts: %v", event.Type, event.ID, classEventMaxAttempts, err)
+		}
+		session.MarkMessage(msg, "")
+	}
+	return nil
+}
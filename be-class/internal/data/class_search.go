@@ -0,0 +1,416 @@
+package data
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/asynccnu/ccnubox-be/be-class/internal/errcode"
+	clog "github.com/asynccnu/ccnubox-be/be-class/internal/log"
+	"github.com/asynccnu/ccnubox-be/be-class/internal/model"
+	"github.com/asynccnu/ccnubox-be/be-class/internal/pkg/tool"
+	"github.com/olivere/elastic/v7"
+)
+
+// classDoc 是存入es的课程文档,在课程信息的基础上附带了用于筛选和统计的字段
+type classDoc struct {
+	model.ClassInfo
+	Building     string   `json:"building"`      //教学楼,由上课地点推出
+	SectionStart int      `json:"section_start"` //开始节次
+	SectionEnd   int      `json:"section_end"`   //结束节次
+	WeekList     []int    `json:"week_list"`     //有课的周
+	Aliases      []string `json:"aliases"`       //课程的常用简称
+}
+
+func newClassDoc(info model.ClassInfo) classDoc {
+	doc := classDoc{
+		ClassInfo: info,
+		Building:  tool.BuildingOf(info.Where),
+		Aliases:   classAliases(info.Classname),
+	}
+	if n, _ := fmt.Sscanf(info.ClassWhen, "%d-%d", &doc.SectionStart, &doc.SectionEnd); n == 1 {
+		doc.SectionEnd = doc.SectionStart
+	}
+	for i := 1; i <= 30; i++ {
+		if info.Weeks&(1<<(i-1)) != 0 {
+			doc.WeekList = append(doc.WeekList, i)
+		}
+	}
+	return doc
+}
+
+// rebuildClassDoc 迁移课程索引时重新计算教学楼等字段
+func rebuildClassDoc(source json.RawMessage) (interface{}, error) {
+	var info model.ClassInfo
+	if err := json.Unmarshal(source, &info); err != nil {
+		return nil, err
+	}
+	return newClassDoc(info), nil
+}
+
+func (d ClassData) SearchClassInfo(ctx context.Context, q model.ClassSearchQuery) (model.ClassSearchResult, error) {
+	var res = model.ClassSearchResult{ClassInfos: make([]model.ClassInfo, 0)}
+
+	search := d.cli.Search().
+		Index(classIndexName).
+		Query(buildSearchQuery(q, d.pinyin)).
+		SortBy(searchSorters(q.Sort)...).
+		// 多取一条,返回的结果数量大于page_size时代表还有下一页
+		Size(q.PageSize + 1).
+		TrackTotalHits(true)
+
+	if q.Cursor != "" {
+		after, err := decodeCursor(q.Cursor)
+		if err != nil {
+			return res, fmt.Errorf("%w: invalid cursor", errcode.Err_EsSearchClassInfo)
+		}
+		search = search.SearchAfter(after...)
+	} else {
+		search = search.From((q.Page - 1) * q.PageSize)
+	}
+
+	if q.WithFacets {
+		for field, agg := range facetAggregations(d.facetFields) {
+			search = search.Aggregation(field, agg)
+		}
+	}
+
+	searchResult, err := search.Do(ctx)
+	if err != nil {
+		clog.LogPrinter.Errorf("es: failed to search class_info[%+v]: %v", q, err)
+		return res, errcode.Err_EsSearchClassInfo
+	}
+
+	hits := searchResult.Hits.Hits
+	for _, hit := range hits {
+		var classInfo model.ClassInfo
+		if err := json.Unmarshal(hit.Source, &classInfo); err != nil {
+			clog.LogPrinter.Errorf("json unmarshal %v failed: %v", hit.Source, err)
+			continue
+		}
+		res.ClassInfos = append(res.ClassInfos, classInfo)
+	}
+	// 游标指向本页的最后一条,多取的那条会出现在下一页的开头,和按页码翻页的行为一致
+	if len(hits) > q.PageSize && q.PageSize > 0 {
+		res.NextCursor = encodeCursor(hits[q.PageSize-1].Sort)
+	}
+	res.Total = searchResult.TotalHits()
+
+	if q.WithFacets {
+		res.Facets = parseFacets(searchResult.Aggregations)
+	}
+	return res, nil
+}
+
+func buildSearchQuery(q model.ClassSearchQuery, pinyin bool) elastic.Query {
+	query := elastic.NewBoolQuery().
+		Filter(
+			elastic.NewTermQuery("year", q.Year),
+			elastic.NewTermQuery("semester", q.Semester),
+		)
+
+	if q.KeyWords != "" {
+		query = query.Should(keywordQueries(q.KeyWords, pinyin)...).MinimumShouldMatch("1")
+	}
+
+	if len(q.Days) > 0 {
+		query = query.Filter(elastic.NewTermsQuery("day", int64sToInterfaces(q.Days)...))
+	}
+	if q.SectionStart > 0 {
+		query = query.Filter(elastic.NewRangeQuery("section_start").Gte(q.SectionStart))
+	}
+	if q.SectionEnd > 0 {
+		query = query.Filter(elastic.NewRangeQuery("section_end").Lte(q.SectionEnd))
+	}
+	if q.Week > 0 {
+		query = query.Filter(elastic.NewTermQuery("week_list", q.Week))
+	}
+	if q.WherePrefix != "" {
+		query = query.Filter(elastic.NewPrefixQuery("where.keyword", q.WherePrefix).CaseInsensitive(true))
+	}
+	if q.MinCredit != nil || q.MaxCredit != nil {
+		credit := elastic.NewRangeQuery("credit")
+		if q.MinCredit != nil {
+			credit = credit.Gte(*q.MinCredit)
+		}
+		if q.MaxCredit != nil {
+			credit = credit.Lte(*q.MaxCredit)
+		}
+		query = query.Filter(credit)
+	}
+	if len(q.Natures) > 0 {
+		natures := make([]interface{}, 0, len(q.Natures))
+		for _, n := range q.Natures {
+			natures = append(natures, n)
+		}
+		query = query.Filter(elastic.NewTermsQuery("nature", natures...))
+	}
+
+	// 排除和已有课程同一天、节次有重叠且至少有一周重合的课程
+	for _, busy := range q.Busy {
+		if len(busy.Sections) == 0 || len(busy.Weeks) == 0 {
+			continue
+		}
+		minSec, maxSec := busy.Sections[0], busy.Sections[0]
+		for _, s := range busy.Sections {
+			minSec, maxSec = min(minSec, s), max(maxSec, s)
+		}
+		weeks := make([]interface{}, 0, len(busy.Weeks))
+		for _, w := range busy.Weeks {
+			weeks = append(weeks, w)
+		}
+		query = query.MustNot(elastic.NewBoolQuery().Filter(
+			elastic.NewTermQuery("day", busy.Day),
+			elastic.NewRangeQuery("section_start").Lte(maxSec),
+			elastic.NewRangeQuery("section_end").Gte(minSec),
+			elastic.NewTermsQuery("week_list", weeks...),
+		))
+	}
+	return query
+}
+
+// keywordQueries 关键词的匹配方式,按匹配的可信程度给予不同的权重:
+// 完全一致 > 连续片段 > 简称 > 拼音 > 容错匹配
+func keywordQueries(keyWords string, pinyin bool) []elastic.Query {
+	queries := []elastic.Query{
+		elastic.NewTermQuery("classname.keyword", keyWords).Boost(10),
+		elastic.NewTermQuery("teacher.keyword", keyWords).Boost(8),
+		// 连续片段("算机"可匹配"计算机科学")
+		elastic.NewMatchPhraseQuery("classname", keyWords).Boost(4),
+		elastic.NewMatchPhraseQuery("teacher", keyWords).Boost(3),
+		elastic.NewMatchPhraseQuery("aliases", keyWords).Boost(3),
+		// 容错:中文按字切分后允许少量字不匹配,英文单词允许拼写错误
+		elastic.NewMatchQuery("classname", keyWords).MinimumShouldMatch("75%").Boost(1),
+		elastic.NewMatchQuery("classname.standard", keyWords).Fuzziness("AUTO").PrefixLength(1).Boost(1),
+	}
+	if pinyin {
+		queries = append(queries,
+			// 拼音的全拼或首字母前缀,全拼允许少量拼写错误
+			elastic.NewMatchQuery("classname.pinyin", keyWords).Fuzziness("AUTO").PrefixLength(1).Boost(2),
+			elastic.NewMatchQuery("aliases.pinyin", keyWords).Boost(2),
+			elastic.NewMatchQuery("teacher.pinyin", keyWords).Fuzziness("AUTO").PrefixLength(1).Boost(1.5),
+		)
+	}
+	return queries
+}
+
+// searchSorters 最后都按id排序,保证search_after翻页时顺序稳定
+func searchSorters(sort int) []elastic.Sorter {
+	var sorters []elastic.Sorter
+	switch sort {
+	case model.SortCreditDesc:
+		sorters = append(sorters, elastic.NewFieldSort("credit").Desc())
+	case model.SortCreditAsc:
+		sorters = append(sorters, elastic.NewFieldSort("credit").Asc())
+	case model.SortTime:
+		sorters = append(sorters, elastic.NewFieldSort("day").Asc(), elastic.NewFieldSort("section_start").Asc())
+	default:
+		sorters = append(sorters, elastic.NewScoreSort())
+	}
+	return append(sorters, elastic.NewFieldSort("id").Asc())
+}
+
+// facetFieldOf 统计项对应的es字段
+var facetFieldOf = map[string]string{
+	model.FacetDay:       "day",
+	model.FacetBuilding:  "building",
+	model.FacetNature:    "nature",
+	model.FacetCredit:    "credit",
+	model.FacetClassWhen: "class_when.keyword",
+	model.FacetWeek:      "week_list",
+}
+
+// facetAggregations 只统计可以聚合的字段,text类型的字段聚合会让整个查询失败
+func facetAggregations(aggregatable map[string]bool) map[string]elastic.Aggregation {
+	aggs := map[string]*elastic.TermsAggregation{
+		model.FacetDay:       elastic.NewTermsAggregation().Size(7).OrderByKeyAsc(),
+		model.FacetBuilding:  elastic.NewTermsAggregation().Size(50),
+		model.FacetNature:    elastic.NewTermsAggregation().Size(50),
+		model.FacetCredit:    elastic.NewTermsAggregation().Size(20).OrderByKeyAsc(),
+		model.FacetClassWhen: elastic.NewTermsAggregation().Size(50),
+		model.FacetWeek:      elastic.NewTermsAggregation().Size(30).OrderByKeyAsc(),
+	}
+	res := make(map[string]elastic.Aggregation, len(aggs))
+	for facet, agg := range aggs {
+		field := facetFieldOf[facet]
+		if aggregatable[field] {
+			res[facet] = agg.Field(field)
+		}
+	}
+	return res
+}
+
+// facetFields 查询课程索引中可以聚合的统计字段
+func facetFields(ctx context.Context, cli *elastic.Client) map[string]bool {
+	fields := make([]string, 0, len(facetFieldOf))
+	for _, field := range facetFieldOf {
+		fields = append(fields, field)
+	}
+	resp, err := cli.GetFieldMapping().Index(classIndexName).Field(fields...).Do(ctx)
+	if err != nil {
+		clog.LogPrinter.Warnf("es: failed to get field mapping of %s, facets are disabled: %v", classIndexName, err)
+		return nil
+	}
+	b, err := json.Marshal(resp)
+	if err != nil {
+		return nil
+	}
+	res := aggregatableFields(b)
+	for _, field := range fields {
+		if !res[field] {
+			clog.LogPrinter.Warnf("es: field %s of %s is not aggregatable, its facet is disabled", field, classIndexName)
+		}
+	}
+	return res
+}
+
+// aggregatableFields 解析_mapping/field的结果,所有索引中都不是text类型的字段才可以聚合
+func aggregatableFields(fieldMapping []byte) map[string]bool {
+	var indices map[string]struct {
+		Mappings map[string]struct {
+			Mapping map[string]struct {
+				Type string `json:"type"`
+			} `json:"mapping"`
+		} `json:"mappings"`
+	}
+	if err := json.Unmarshal(fieldMapping, &indices); err != nil {
+		return nil
+	}
+
+	res := make(map[string]bool)
+	bad := make(map[string]bool)
+	for _, index := range indices {
+		for field, m := range index.Mappings {
+			for _, leaf := range m.Mapping {
+				switch leaf.Type {
+				case "", "text":
+					bad[field] = true
+				default:
+					res[field] = true
+				}
+			}
+		}
+	}
+	for field := range bad {
+		delete(res, field)
+	}
+	return res
+}
+
+func parseFacets(aggs elastic.Aggregations) []model.Facet {
+	facets := make([]model.Facet, 0, len(model.Facets))
+	for _, field := range model.Facets {
+		terms, ok := aggs.Terms(field)
+		if !ok {
+			continue
+		}
+		facet := model.Facet{Field: field, Buckets: make([]model.FacetBucket, 0, len(terms.Buckets))}
+		for _, b := range terms.Buckets {
+			value := fmt.Sprint(b.Key)
+			if value == "" {
+				continue
+			}
+			facet.Buckets = append(facet.Buckets, model.FacetBucket{Value: value, Count: b.DocCount})
+		}
+		facets = append(facets, facet)
+	}
+	return facets
+}
+
+func encodeCursor(sortValues []interface{}) string {
+	b, err := json.Marshal(sortValues)
+	if err != nil {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeCursor(cursor string) ([]interface{}, error) {
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, err
+	}
+	var sortValues []interface{}
+	if err := json.Unmarshal(b, &sortValues); err != nil {
+		return nil, err
+	}
+	if len(sortValues) == 0 {
+		return nil, fmt.Errorf("empty cursor")
+	}
+	return sortValues, nil
+}
+
+func int64sToInterfaces(vals []int64) []interface{} {
+	res := make([]interface{}, 0, len(vals))
+	for _, v := range vals {
+		res = append(res, v)
+	}
+	return res
+}
+
+// SuggestClassInfo 根据输入的前缀(汉字或拼音)联想课程名称和教师,按包含的课程数排序
+func (d ClassData) SuggestClassInfo(ctx context.Context, prefix, xnm, xqm string, size int) ([]model.Suggestion, error) {
+	classnameQuery := elastic.NewBoolQuery().Should(
+		elastic.NewMatchQuery("classname.prefix", prefix),
+		elastic.NewMatchQuery("aliases.prefix", prefix),
+	)
+	teacherQuery := elastic.NewBoolQuery().Should(
+		elastic.NewMatchQuery("teacher.prefix", prefix),
+	)
+	if d.pinyin {
+		classnameQuery = classnameQuery.Should(
+			elastic.NewMatchQuery("classname.pinyin", prefix),
+			elastic.NewMatchQuery("aliases.pinyin", prefix),
+		)
+		teacherQuery = teacherQuery.Should(elastic.NewMatchQuery("teacher.pinyin", prefix))
+	}
+
+	searchResult, err := d.cli.Search().
+		Index(classIndexName).
+		Query(elastic.NewBoolQuery().
+			Filter(
+				elastic.NewTermQuery("year", xnm),
+				elastic.NewTermQuery("semester", xqm),
+			).
+			Should(classnameQuery, teacherQuery).
+			MinimumShouldMatch("1"),
+		).
+		Aggregation(model.SuggestClassname, elastic.NewFilterAggregation().Filter(classnameQuery).
+			SubAggregation("terms", elastic.NewTermsAggregation().Field("classname.keyword").Size(size))).
+		Aggregation(model.SuggestTeacher, elastic.NewFilterAggregation().Filter(teacherQuery).
+			SubAggregation("terms", elastic.NewTermsAggregation().Field("teacher.keyword").Size(size))).
+		Size(0).
+		Do(ctx)
+	if err != nil {
+		clog.LogPrinter.Errorf("es: failed to suggest class_info[prefix:%v xnm:%v xqm:%v]: %v", prefix, xnm, xqm, err)
+		return nil, errcode.Err_EsSearchClassInfo
+	}
+
+	var suggestions []model.Suggestion
+	for _, typ := range []string{model.SuggestClassname, model.SuggestTeacher} {
+		filtered, ok := searchResult.Aggregations.Filter(typ)
+		if !ok {
+			continue
+		}
+		terms, ok := filtered.Aggregations.Terms("terms")
+		if !ok {
+			continue
+		}
+		for _, b := range terms.Buckets {
+			text := fmt.Sprint(b.Key)
+			if text == "" {
+				continue
+			}
+			suggestions = append(suggestions, model.Suggestion{Text: text, Type: typ, Count: b.DocCount})
+		}
+	}
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		return suggestions[i].Count > suggestions[j].Count
+	})
+	if len(suggestions) > size {
+		suggestions = suggestions[:size]
+	}
+	return suggestions, nil
+}
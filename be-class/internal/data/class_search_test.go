@@ -0,0 +1,107 @@
+package data
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/asynccnu/ccnubox-be/be-class/internal/model"
+	"github.com/olivere/elastic/v7"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewClassDoc(t *testing.T) {
+	doc := newClassDoc(model.ClassInfo{ClassWhen: "3-4", Weeks: 0b10101, Where: "n201"})
+	assert.Equal(t, 3, doc.SectionStart)
+	assert.Equal(t, 4, doc.SectionEnd)
+	assert.Equal(t, []int{1, 3, 5}, doc.WeekList)
+	assert.Equal(t, "n", doc.Building)
+
+	doc = newClassDoc(model.ClassInfo{ClassWhen: "5"})
+	assert.Equal(t, 5, doc.SectionStart)
+	assert.Equal(t, 5, doc.SectionEnd)
+}
+
+func TestCursor(t *testing.T) {
+	cursor := encodeCursor([]interface{}{1.5, "Class:xxx"})
+	vals, err := decodeCursor(cursor)
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{1.5, "Class:xxx"}, vals)
+
+	_, err = decodeCursor("not a cursor")
+	assert.Error(t, err)
+}
+
+func TestBuildSearchQuery_FreeTime(t *testing.T) {
+	q := buildSearchQuery(model.ClassSearchQuery{
+		Year:     "2024",
+		Semester: "1",
+		Busy: []model.CTime{
+			{Day: 1, Sections: []int{3, 4}, Weeks: []int{1, 2}},
+			{Day: 2, Sections: []int{1}},
+		},
+	}, false)
+	src, err := q.Source()
+	assert.NoError(t, err)
+	b, _ := json.Marshal(src)
+
+	var parsed struct {
+		Bool struct {
+			MustNot json.RawMessage `json:"must_not"`
+			Should  json.RawMessage `json:"should"`
+		} `json:"bool"`
+	}
+	assert.NoError(t, json.Unmarshal(b, &parsed))
+	// 没有周次的时间段被忽略,只剩一个排除条件
+	assert.NotContains(t, string(parsed.Bool.MustNot), `"day":2`)
+	assert.Contains(t, string(parsed.Bool.MustNot), `"week_list":[1,2]`)
+	// 没有关键词时不需要匹配
+	assert.Empty(t, parsed.Bool.Should)
+}
+
+func TestKeywordQueries(t *testing.T) {
+	fields := func(pinyin bool) string {
+		src, err := elastic.NewBoolQuery().Should(keywordQueries("gs", pinyin)...).Source()
+		assert.NoError(t, err)
+		b, _ := json.Marshal(src)
+		return string(b)
+	}
+	assert.NotContains(t, fields(false), "pinyin")
+	assert.Contains(t, fields(true), "classname.pinyin")
+	assert.Contains(t, fields(true), "aliases.pinyin")
+}
+
+func TestClassAliases(t *testing.T) {
+	assert.Equal(t, []string{"高数"}, classAliases("高等数学A(一)"))
+	assert.ElementsMatch(t, []string{"史纲", "近代史"}, classAliases("中国近现代史纲要"))
+	assert.Empty(t, classAliases("编译原理"))
+}
+
+func TestClassMapping(t *testing.T) {
+	for _, pinyin := range []bool{true, false} {
+		var m map[string]interface{}
+		assert.NoError(t, json.Unmarshal([]byte(classMapping(pinyin)), &m), "pinyin=%v", pinyin)
+		assert.Equal(t, pinyin, strings.Contains(classMapping(pinyin), "pinyin_tk"))
+	}
+}
+
+func TestFacetAggregations_SkipTextFields(t *testing.T) {
+	// 升级前动态mapping的索引,building和nature是text
+	old := []byte(`{"ccnubox-class_info":{"mappings":{
+		"building":{"full_name":"building","mapping":{"building":{"type":"text","fields":{"keyword":{"type":"keyword"}}}}},
+		"nature":{"full_name":"nature","mapping":{"nature":{"type":"text"}}},
+		"day":{"full_name":"day","mapping":{"day":{"type":"long"}}},
+		"class_when.keyword":{"full_name":"class_when.keyword","mapping":{"keyword":{"type":"keyword"}}}
+	}}}`)
+	fields := aggregatableFields(old)
+	assert.Equal(t, map[string]bool{"day": true, "class_when.keyword": true}, fields)
+
+	aggs := facetAggregations(fields)
+	assert.Contains(t, aggs, model.FacetDay)
+	assert.Contains(t, aggs, model.FacetClassWhen)
+	assert.NotContains(t, aggs, model.FacetBuilding)
+	assert.NotContains(t, aggs, model.FacetNature)
+
+	// 获取mapping失败时不统计
+	assert.Empty(t, facetAggregations(nil))
+}
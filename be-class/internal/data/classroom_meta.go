@@ -0,0 +1,105 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	clog "github.com/asynccnu/ccnubox-be/be-class/internal/log"
+	"github.com/asynccnu/ccnubox-be/be-class/internal/model"
+	"github.com/olivere/elastic/v7"
+)
+
+const (
+	// classroomMetaIndex 由管理员维护,重启时总是保留
+	classroomMetaIndex   = "ccnubox-classroom_meta"
+	classroomMetaMapping = `{
+	"mappings": {
+		"properties": {
+			"where": { "type": "keyword" },
+			"capacity": { "type": "integer" },
+			"equipment": { "type": "keyword" },
+			"note": { "type": "text" },
+			"updated_at": { "type": "long" }
+		}
+	}
+}`
+)
+
+func (f *FreeClassroomData) SaveClassroomMeta(ctx context.Context, meta model.ClassroomMeta) error {
+	_, err := f.cli.Index().
+		Index(classroomMetaIndex).
+		Id(meta.Where).
+		BodyJson(meta).
+		Do(ctx)
+	if err != nil {
+		clog.LogPrinter.Errorf("es: failed to save classroom_meta[%s]: %v", meta.Where, err)
+		return err
+	}
+	return nil
+}
+
+func (f *FreeClassroomData) DeleteClassroomMeta(ctx context.Context, where string) error {
+	_, err := f.cli.Delete().
+		Index(classroomMetaIndex).
+		Id(where).
+		Do(ctx)
+	if err != nil && !elastic.IsNotFound(err) {
+		clog.LogPrinter.Errorf("es: failed to delete classroom_meta[%s]: %v", where, err)
+		return err
+	}
+	return nil
+}
+
+// GetClassroomMeta 没有维护该教室的信息时返回nil
+func (f *FreeClassroomData) GetClassroomMeta(ctx context.Context, where string) (*model.ClassroomMeta, error) {
+	res, err := f.cli.Get().
+		Index(classroomMetaIndex).
+		Id(where).
+		Do(ctx)
+	if elastic.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var meta model.ClassroomMeta
+	if err := json.Unmarshal(res.Source, &meta); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal classroom_meta[%s]: %w", where, err)
+	}
+	return &meta, nil
+}
+
+// GetClassroomOccupancy 获取某个教室在某一周的所有占用时间
+func (f *FreeClassroomData) GetClassroomOccupancy(ctx context.Context, year, semester, where string, week int) ([]model.CTime, error) {
+	boolQuery := elastic.NewBoolQuery().
+		Must(
+			elastic.NewTermQuery("year", year),
+			elastic.NewTermQuery("semester", semester),
+			elastic.NewTermQuery("where", where),
+			elastic.NewTermQuery("weeks", week),
+		)
+	searchResult, err := f.cli.Search().
+		Index(freeClassroomIndex).
+		Query(boolQuery).
+		Size(1000).
+		Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var cts = make([]model.CTime, 0, len(searchResult.Hits.Hits))
+	for _, hit := range searchResult.Hits.Hits {
+		var doc struct {
+			Weeks    []int `json:"weeks"`
+			Day      int   `json:"day"`
+			Sections []int `json:"sections"`
+		}
+		if err := json.Unmarshal(hit.Source, &doc); err != nil {
+			clog.LogPrinter.Errorf("es: failed to unmarshal classroom_occupancy[%s]: %v", hit.Id, err)
+			continue
+		}
+		cts = append(cts, model.CTime{Weeks: doc.Weeks, Day: doc.Day, Sections: doc.Sections})
+	}
+	return cts, nil
+}
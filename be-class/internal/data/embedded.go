@@ -0,0 +1,726 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/asynccnu/ccnubox-be/be-class/internal/conf"
+	"github.com/asynccnu/ccnubox-be/be-class/internal/errcode"
+	clog "github.com/asynccnu/ccnubox-be/be-class/internal/log"
+	"github.com/asynccnu/ccnubox-be/be-class/internal/model"
+)
+
+// EmbeddedIndex 进程内的课程和空闲教室索引,不依赖es,用于本地开发、测试和小规模部署
+// 匹配规则尽量与es的实现保持一致,但不支持拼音
+type EmbeddedIndex struct {
+	mu          sync.RWMutex
+	path        string
+	classes     map[string]classDoc
+	occupancies map[string]classroomOccupancy
+	metas       map[string]model.ClassroomMeta
+	classrooms  []string
+}
+
+type classroomOccupancy struct {
+	Year      string `json:"year"`
+	Semester  string `json:"semester"`
+	Where     string `json:"where"`
+	Weeks     []int  `json:"weeks"`
+	Day       int    `json:"day"`
+	Sections  []int  `json:"sections"`
+	Upload    string `json:"upload,omitempty"`     //来自选课手册的哪个版本
+	FromClass bool   `json:"from_class,omitempty"` //由课程信息推出
+}
+
+// embeddedSnapshot 持久化到文件中的内容
+type embeddedSnapshot struct {
+	Classes     []model.ClassInfo     `json:"classes"`
+	Occupancies []classroomOccupancy  `json:"occupancies"`
+	Metas       []model.ClassroomMeta `json:"metas"`
+}
+
+// NewEmbeddedIndex path不为空时启动时从文件加载,关闭时写回文件
+func NewEmbeddedIndex(c *conf.Data_Embedded) (*EmbeddedIndex, func(), error) {
+	idx := &EmbeddedIndex{
+		path:        c.GetPath(),
+		classes:     make(map[string]classDoc),
+		occupancies: make(map[string]classroomOccupancy),
+		metas:       make(map[string]model.ClassroomMeta),
+	}
+
+	if c.GetClassroom() != "" {
+		classrooms, err := loadClassrooms(c.GetClassroom())
+		if err != nil {
+			clog.LogPrinter.Errorf("embedded: failed to load classrooms: %v", err)
+			return nil, nil, err
+		}
+		idx.classrooms = classrooms
+		sort.Strings(idx.classrooms)
+	}
+
+	if err := idx.load(); err != nil {
+		clog.LogPrinter.Errorf("embedded: failed to load index from %s: %v", idx.path, err)
+		return nil, nil, err
+	}
+
+	cleanup := func() {
+		if err := idx.save(); err != nil {
+			clog.LogPrinter.Errorf("embedded: failed to save index to %s: %v", idx.path, err)
+		}
+	}
+	return idx, cleanup, nil
+}
+
+func (e *EmbeddedIndex) load() error {
+	if e.path == "" {
+		return nil
+	}
+	b, err := os.ReadFile(e.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	var snapshot embeddedSnapshot
+	if err := json.Unmarshal(b, &snapshot); err != nil {
+		return err
+	}
+	for _, info := range snapshot.Classes {
+		e.classes[info.ID] = newClassDoc(info)
+	}
+	for _, o := range snapshot.Occupancies {
+		e.occupancies[occupancyID(o)] = o
+	}
+	for _, meta := range snapshot.Metas {
+		e.metas[meta.Where] = meta
+	}
+	clog.LogPrinter.Infof("embedded: loaded %d class_info and %d classroom_occupancy records", len(e.classes), len(e.occupancies))
+	return nil
+}
+
+func (e *EmbeddedIndex) save() error {
+	if e.path == "" {
+		return nil
+	}
+	e.mu.RLock()
+	snapshot := embeddedSnapshot{
+		Classes:     make([]model.ClassInfo, 0, len(e.classes)),
+		Occupancies: make([]classroomOccupancy, 0, len(e.occupancies)),
+		Metas:       make([]model.ClassroomMeta, 0, len(e.metas)),
+	}
+	for _, doc := range e.classes {
+		snapshot.Classes = append(snapshot.Classes, doc.ClassInfo)
+	}
+	for _, o := range e.occupancies {
+		snapshot.Occupancies = append(snapshot.Occupancies, o)
+	}
+	for _, meta := range e.metas {
+		snapshot.Metas = append(snapshot.Metas, meta)
+	}
+	e.mu.RUnlock()
+
+	b, err := json.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+	// 先写临时文件再替换,避免写到一半时退出导致文件损坏
+	tmp, err := os.CreateTemp(filepath.Dir(e.path), filepath.Base(e.path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), e.path)
+}
+
+func (e *EmbeddedIndex) AddClassInfo(ctx context.Context, classInfos ...model.ClassInfo) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for _, info := range classInfos {
+		e.classes[info.ID] = newClassDoc(info)
+	}
+	return nil
+}
+
+// ClearClassInfo 删除除了 year=xnm 和 semester=xqm 之外的所有数据
+func (e *EmbeddedIndex) ClearClassInfo(ctx context.Context, xnm, xqm string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	deleted := 0
+	for id, doc := range e.classes {
+		if doc.Year != xnm || doc.Semester != xqm {
+			delete(e.classes, id)
+			deleted++
+		}
+	}
+	clog.LogPrinter.Infof("Deleted %d documents", deleted)
+}
+
+func (e *EmbeddedIndex) GetBatchClassInfos(ctx context.Context, year, semester string, page, pageSize int) ([]model.ClassInfo, int, error) {
+	e.mu.RLock()
+	var docs []classDoc
+	for _, doc := range e.classes {
+		if doc.Year == year && doc.Semester == semester {
+			docs = append(docs, doc)
+		}
+	}
+	e.mu.RUnlock()
+
+	sort.Slice(docs, func(i, j int) bool { return docs[i].ID < docs[j].ID })
+
+	var classInfos = make([]model.ClassInfo, 0)
+	from := max(page-1, 0) * pageSize
+	for i := from; i < len(docs) && i < from+pageSize; i++ {
+		classInfos = append(classInfos, docs[i].ClassInfo)
+	}
+	return classInfos, len(docs), nil
+}
+
+// DeleteClassInfo 按课程ID删除,不存在的课程忽略
+func (e *EmbeddedIndex) DeleteClassInfo(ctx context.Context, ids ...string) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for _, id := range ids {
+		delete(e.classes, id)
+	}
+	return nil
+}
+
+// ScanClassInfos 按课程ID的顺序遍历某个学期的所有课程,每批调用一次f
+func (e *EmbeddedIndex) ScanClassInfos(ctx context.Context, xnm, xqm string, f func([]model.ClassInfo) error) error {
+	const batchSize = 1000
+	for page := 1; ; page++ {
+		classInfos, total, err := e.GetBatchClassInfos(ctx, xnm, xqm, page, batchSize)
+		if err != nil {
+			return err
+		}
+		if len(classInfos) > 0 {
+			if err := f(classInfos); err != nil {
+				return err
+			}
+		}
+		if page*batchSize >= total {
+			return nil
+		}
+	}
+}
+
+type embeddedHit struct {
+	doc   classDoc
+	score float64
+	sort  []interface{}
+}
+
+func (e *EmbeddedIndex) SearchClassInfo(ctx context.Context, q model.ClassSearchQuery) (model.ClassSearchResult, error) {
+	var res = model.ClassSearchResult{ClassInfos: make([]model.ClassInfo, 0)}
+
+	var after []interface{}
+	if q.Cursor != "" {
+		var err error
+		after, err = decodeCursor(q.Cursor)
+		if err != nil || !validEmbeddedCursor(q.Sort, after) {
+			return res, fmt.Errorf("%w: invalid cursor", errcode.Err_EsSearchClassInfo)
+		}
+	}
+
+	keyWords := strings.ToLower(strings.TrimSpace(q.KeyWords))
+	e.mu.RLock()
+	var hits []embeddedHit
+	for _, doc := range e.classes {
+		if !matchSearchFilter(doc, q) {
+			continue
+		}
+		var score float64
+		if keyWords != "" {
+			if score = keywordScore(doc, keyWords); score == 0 {
+				continue
+			}
+		}
+		hits = append(hits, embeddedHit{doc: doc, score: score, sort: embeddedSortValues(q.Sort, doc, score)})
+	}
+	e.mu.RUnlock()
+
+	desc := sortDescending(q.Sort)
+	sort.Slice(hits, func(i, j int) bool {
+		return compareSortValues(hits[i].sort, hits[j].sort, desc) < 0
+	})
+
+	start := max(q.Page-1, 0) * q.PageSize
+	if after != nil {
+		start = sort.Search(len(hits), func(i int) bool {
+			return compareSortValues(hits[i].sort, after, desc) > 0
+		})
+	}
+	end := min(start+q.PageSize, len(hits))
+	for i := start; i < end; i++ {
+		res.ClassInfos = append(res.ClassInfos, hits[i].doc.ClassInfo)
+	}
+	if end < len(hits) && q.PageSize > 0 {
+		res.NextCursor = encodeCursor(hits[end-1].sort)
+	}
+	res.Total = int64(len(hits))
+
+	if q.WithFacets {
+		res.Facets = embeddedFacets(hits)
+	}
+	return res, nil
+}
+
+func matchSearchFilter(doc classDoc, q model.ClassSearchQuery) bool {
+	if doc.Year != q.Year || doc.Semester != q.Semester {
+		return false
+	}
+	if len(q.Days) > 0 && !slices.Contains(q.Days, doc.Day) {
+		return false
+	}
+	if q.SectionStart > 0 && doc.SectionStart < q.SectionStart {
+		return false
+	}
+	if q.SectionEnd > 0 && doc.SectionEnd > q.SectionEnd {
+		return false
+	}
+	if q.Week > 0 && !slices.Contains(doc.WeekList, q.Week) {
+		return false
+	}
+	if q.WherePrefix != "" && !strings.HasPrefix(strings.ToLower(doc.Where), strings.ToLower(q.WherePrefix)) {
+		return false
+	}
+	if q.MinCredit != nil && doc.Credit < *q.MinCredit {
+		return false
+	}
+	if q.MaxCredit != nil && doc.Credit > *q.MaxCredit {
+		return false
+	}
+	if len(q.Natures) > 0 && !slices.Contains(q.Natures, doc.Nature) {
+		return false
+	}
+	for _, busy := range q.Busy {
+		if conflictsWith(doc, busy) {
+			return false
+		}
+	}
+	return true
+}
+
+// conflictsWith 同一天、节次有重叠且至少有一周重合
+func conflictsWith(doc classDoc, busy model.CTime) bool {
+	if len(busy.Sections) == 0 || len(busy.Weeks) == 0 || int64(busy.Day) != doc.Day {
+		return false
+	}
+	minSec, maxSec := slices.Min(busy.Sections), slices.Max(busy.Sections)
+	if doc.SectionStart > maxSec || doc.SectionEnd < minSec {
+		return false
+	}
+	for _, w := range busy.Weeks {
+		if slices.Contains(doc.WeekList, w) {
+			return true
+		}
+	}
+	return false
+}
+
+// keywordScore 与keywordQueries的权重保持一致,返回0表示不匹配
+func keywordScore(doc classDoc, keyWords string) float64 {
+	classname, teacher := strings.ToLower(doc.Classname), strings.ToLower(doc.Teacher)
+
+	var score float64
+	if classname == keyWords {
+		score += 10
+	}
+	if teacher == keyWords {
+		score += 8
+	}
+	if strings.Contains(classname, keyWords) {
+		score += 4
+	}
+	if strings.Contains(teacher, keyWords) {
+		score += 3
+	}
+	for _, alias := range doc.Aliases {
+		if strings.Contains(strings.ToLower(alias), keyWords) {
+			score += 3
+			break
+		}
+	}
+	// 容错:关键词中至少75%的字出现在课程名称中
+	if overlap := runeOverlap(keyWords, classname); overlap >= 0.75 {
+		score += overlap
+	}
+	return score
+}
+
+// runeOverlap 返回s中出现在target里的字符比例,忽略空白
+func runeOverlap(s, target string) float64 {
+	var total, hit int
+	for _, r := range s {
+		if r == ' ' || r == '\t' {
+			continue
+		}
+		total++
+		if strings.ContainsRune(target, r) {
+			hit++
+		}
+	}
+	if total == 0 {
+		return 0
+	}
+	return float64(hit) / float64(total)
+}
+
+// embeddedSortValues 与searchSorters的排序字段一致,最后都是id
+// 数值统一用float64,这样从游标解码出来的值可以直接比较
+func embeddedSortValues(sortBy int, doc classDoc, score float64) []interface{} {
+	switch sortBy {
+	case model.SortCreditDesc, model.SortCreditAsc:
+		return []interface{}{doc.Credit, doc.ID}
+	case model.SortTime:
+		return []interface{}{float64(doc.Day), float64(doc.SectionStart), doc.ID}
+	default:
+		return []interface{}{score, doc.ID}
+	}
+}
+
+func sortDescending(sortBy int) bool {
+	return sortBy != model.SortCreditAsc && sortBy != model.SortTime
+}
+
+func validEmbeddedCursor(sortBy int, after []interface{}) bool {
+	want := embeddedSortValues(sortBy, classDoc{}, 0)
+	if len(after) != len(want) {
+		return false
+	}
+	for i := range after {
+		if _, ok := want[i].(string); ok {
+			if _, ok := after[i].(string); !ok {
+				return false
+			}
+		} else if _, ok := after[i].(float64); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// compareSortValues 数值按desc决定方向,id总是升序
+func compareSortValues(a, b []interface{}, desc bool) int {
+	for i := range a {
+		if s, ok := a[i].(string); ok {
+			if c := strings.Compare(s, b[i].(string)); c != 0 {
+				return c
+			}
+			continue
+		}
+		x, y := a[i].(float64), b[i].(float64)
+		if x == y {
+			continue
+		}
+		if (x < y) != desc {
+			return -1
+		}
+		return 1
+	}
+	return 0
+}
+
+// embeddedFacets 统计的数量和顺序与facetAggregations一致
+func embeddedFacets(hits []embeddedHit) []model.Facet {
+	counts := make(map[string]map[string]int64, len(model.Facets))
+	for _, field := range model.Facets {
+		counts[field] = make(map[string]int64)
+	}
+	add := func(field, value string) {
+		if value != "" {
+			counts[field][value]++
+		}
+	}
+	for _, hit := range hits {
+		doc := hit.doc
+		add(model.FacetDay, strconv.FormatInt(doc.Day, 10))
+		add(model.FacetBuilding, doc.Building)
+		add(model.FacetNature, doc.Nature)
+		add(model.FacetCredit, strconv.FormatFloat(doc.Credit, 'f', -1, 64))
+		add(model.FacetClassWhen, doc.ClassWhen)
+		for _, w := range doc.WeekList {
+			add(model.FacetWeek, strconv.Itoa(w))
+		}
+	}
+
+	sizes := map[string]int{
+		model.FacetDay:       7,
+		model.FacetBuilding:  50,
+		model.FacetNature:    50,
+		model.FacetCredit:    20,
+		model.FacetClassWhen: 50,
+		model.FacetWeek:      30,
+	}
+	byKey := map[string]bool{model.FacetDay: true, model.FacetCredit: true, model.FacetWeek: true}
+
+	facets := make([]model.Facet, 0, len(model.Facets))
+	for _, field := range model.Facets {
+		buckets := make([]model.FacetBucket, 0, len(counts[field]))
+		for value, count := range counts[field] {
+			buckets = append(buckets, model.FacetBucket{Value: value, Count: count})
+		}
+		sort.Slice(buckets, func(i, j int) bool {
+			if byKey[field] {
+				x, _ := strconv.ParseFloat(buckets[i].Value, 64)
+				y, _ := strconv.ParseFloat(buckets[j].Value, 64)
+				return x < y
+			}
+			if buckets[i].Count != buckets[j].Count {
+				return buckets[i].Count > buckets[j].Count
+			}
+			return buckets[i].Value < buckets[j].Value
+		})
+		if len(buckets) > sizes[field] {
+			buckets = buckets[:sizes[field]]
+		}
+		facets = append(facets, model.Facet{Field: field, Buckets: buckets})
+	}
+	return facets
+}
+
+// SuggestClassInfo 课程名称、简称或教师以prefix开头的联想词,按包含的课程数排序
+func (e *EmbeddedIndex) SuggestClassInfo(ctx context.Context, prefix, xnm, xqm string, size int) ([]model.Suggestion, error) {
+	prefix = strings.ToLower(strings.TrimSpace(prefix))
+	if prefix == "" {
+		return nil, nil
+	}
+
+	classnames, teachers := make(map[string]int64), make(map[string]int64)
+	e.mu.RLock()
+	for _, doc := range e.classes {
+		if doc.Year != xnm || doc.Semester != xqm {
+			continue
+		}
+		if hasPrefixFold(doc.Classname, prefix) || slices.ContainsFunc(doc.Aliases, func(a string) bool {
+			return hasPrefixFold(a, prefix)
+		}) {
+			classnames[doc.Classname]++
+		}
+		if hasPrefixFold(doc.Teacher, prefix) {
+			teachers[doc.Teacher]++
+		}
+	}
+	e.mu.RUnlock()
+
+	var suggestions []model.Suggestion
+	for _, group := range []struct {
+		typ    string
+		counts map[string]int64
+	}{{model.SuggestClassname, classnames}, {model.SuggestTeacher, teachers}} {
+		var part []model.Suggestion
+		for text, count := range group.counts {
+			if text != "" {
+				part = append(part, model.Suggestion{Text: text, Type: group.typ, Count: count})
+			}
+		}
+		sort.Slice(part, func(i, j int) bool {
+			if part[i].Count != part[j].Count {
+				return part[i].Count > part[j].Count
+			}
+			return part[i].Text < part[j].Text
+		})
+		if len(part) > size {
+			part = part[:size]
+		}
+		suggestions = append(suggestions, part...)
+	}
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		return suggestions[i].Count > suggestions[j].Count
+	})
+	if len(suggestions) > size {
+		suggestions = suggestions[:size]
+	}
+	return suggestions, nil
+}
+
+func hasPrefixFold(s, prefix string) bool {
+	return strings.HasPrefix(strings.ToLower(s), prefix)
+}
+
+func occupancyID(o classroomOccupancy) string {
+	id := fmt.Sprintf("%s-%s-%s-%d-%v-%v", o.Year, o.Semester, o.Where, o.Day, o.Weeks, o.Sections)
+	if o.Upload != "" {
+		id = "upload-" + o.Upload + "-" + id
+	}
+	return id
+}
+
+func (e *EmbeddedIndex) AddClassroomOccupancy(ctx context.Context, year, semester string, cwtPairs ...model.CTWPair) error {
+	return e.addOccupancy(year, semester, "", cwtPairs)
+}
+
+func (e *EmbeddedIndex) AddUploadedOccupancy(ctx context.Context, year, semester, version string, cwtPairs ...model.CTWPair) error {
+	return e.addOccupancy(year, semester, version, cwtPairs)
+}
+
+func (e *EmbeddedIndex) ClearUploadedOccupancy(ctx context.Context, year, semester, keep string) error {
+	e.deleteOccupancy(year, semester, func(o classroomOccupancy) bool {
+		return o.Upload != "" && o.Upload != keep
+	})
+	return nil
+}
+
+func (e *EmbeddedIndex) DeleteUploadedOccupancy(ctx context.Context, year, semester, version string) error {
+	e.deleteOccupancy(year, semester, func(o classroomOccupancy) bool {
+		return o.Upload == version
+	})
+	return nil
+}
+
+func (e *EmbeddedIndex) ClearLegacyOccupancy(ctx context.Context, year, semester string) error {
+	e.deleteOccupancy(year, semester, func(o classroomOccupancy) bool {
+		return o.Upload == "" && !o.FromClass
+	})
+	return nil
+}
+
+func (e *EmbeddedIndex) deleteOccupancy(year, semester string, match func(o classroomOccupancy) bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for id, o := range e.occupancies {
+		if o.Year == year && o.Semester == semester && match(o) {
+			delete(e.occupancies, id)
+		}
+	}
+}
+
+func (e *EmbeddedIndex) addOccupancy(year, semester, upload string, cwtPairs []model.CTWPair) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for _, cwtPair := range cwtPairs {
+		o := classroomOccupancy{
+			Year:      year,
+			Semester:  semester,
+			Where:     cwtPair.Where,
+			Weeks:     cwtPair.CT.Weeks,
+			Day:       cwtPair.CT.Day,
+			Sections:  cwtPair.CT.Sections,
+			Upload:    upload,
+			FromClass: upload == "",
+		}
+		e.occupancies[occupancyID(o)] = o
+	}
+	return nil
+}
+
+// ClearClassroomOccupancy 删除教室占用信息，只保留year和semester的
+func (e *EmbeddedIndex) ClearClassroomOccupancy(ctx context.Context, year, semester string) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for id, o := range e.occupancies {
+		if o.Year != year || o.Semester != semester {
+			delete(e.occupancies, id)
+		}
+	}
+	return nil
+}
+
+func (e *EmbeddedIndex) GetAllClassroom(ctx context.Context, wherePrefix string) ([]string, error) {
+	var wheres []string
+	for _, w := range e.classrooms {
+		if strings.HasPrefix(w, wherePrefix) {
+			wheres = append(wheres, w)
+		}
+	}
+	return wheres, nil
+}
+
+func (e *EmbeddedIndex) QueryAvailableClassrooms(ctx context.Context, year, semester string, week, day, section int, wherePrefix string) (map[string]bool, error) {
+	allWheres, _ := e.GetAllClassroom(ctx, wherePrefix)
+	var occupancyStat = make(map[string]bool, len(allWheres))
+	//先全部标记为空闲
+	for _, w := range allWheres {
+		occupancyStat[w] = true
+	}
+
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	//标记为占用
+	for _, o := range e.occupancies {
+		if o.Year == year && o.Semester == semester && o.Day == day &&
+			strings.HasPrefix(o.Where, wherePrefix) &&
+			slices.Contains(o.Weeks, week) && slices.Contains(o.Sections, section) {
+			occupancyStat[o.Where] = false
+		}
+	}
+	return occupancyStat, nil
+}
+
+// CountOccupiedSections 统计某一周某一天每个教室被占用的节数,没有被占用的教室不在结果中
+func (e *EmbeddedIndex) CountOccupiedSections(ctx context.Context, year, semester string, week, day int, wherePrefix string) (map[string]int, error) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	var occupied = make(map[string]map[int]struct{})
+	for _, o := range e.occupancies {
+		if o.Year != year || o.Semester != semester || o.Day != day ||
+			!strings.HasPrefix(o.Where, wherePrefix) || !slices.Contains(o.Weeks, week) {
+			continue
+		}
+		if occupied[o.Where] == nil {
+			occupied[o.Where] = make(map[int]struct{})
+		}
+		for _, section := range o.Sections {
+			occupied[o.Where][section] = struct{}{}
+		}
+	}
+	var counts = make(map[string]int, len(occupied))
+	for where, sections := range occupied {
+		counts[where] = len(sections)
+	}
+	return counts, nil
+}
+
+func (e *EmbeddedIndex) GetClassroomOccupancy(ctx context.Context, year, semester, where string, week int) ([]model.CTime, error) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	var cts = make([]model.CTime, 0)
+	for _, o := range e.occupancies {
+		if o.Year == year && o.Semester == semester && o.Where == where && slices.Contains(o.Weeks, week) {
+			cts = append(cts, model.CTime{Weeks: o.Weeks, Day: o.Day, Sections: o.Sections})
+		}
+	}
+	return cts, nil
+}
+
+func (e *EmbeddedIndex) SaveClassroomMeta(ctx context.Context, meta model.ClassroomMeta) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.metas[meta.Where] = meta
+	return nil
+}
+
+func (e *EmbeddedIndex) DeleteClassroomMeta(ctx context.Context, where string) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	delete(e.metas, where)
+	return nil
+}
+
+// GetClassroomMeta 没有维护该教室的信息时返回nil
+func (e *EmbeddedIndex) GetClassroomMeta(ctx context.Context, where string) (*model.ClassroomMeta, error) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	meta, ok := e.metas[where]
+	if !ok {
+		return nil, nil
+	}
+	return &meta, nil
+}
@@ -3,10 +3,12 @@ package data
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/asynccnu/ccnubox-be/be-class/internal/conf"
 	clog "github.com/asynccnu/ccnubox-be/be-class/internal/log"
 	"github.com/olivere/elastic/v7"
+	"io"
 	"os"
 )
 
@@ -28,10 +30,15 @@ func NewEsClient(c *conf.Data) (*elastic.Client, error) {
 
 	clog.LogPrinter.Info("connect to elasticsearch successfully")
 
-	createIndex(ctx, cli, c.Es.KeepDataAfterRestart, classIndexName, classMapping)
+	pinyin := hasPinyinPlugin(ctx, cli)
+	if err = migrateIndex(ctx, cli, c.Es.KeepDataAfterRestart, classIndexName, classIndexVersionName(pinyin), classMapping(pinyin), rebuildClassDoc); err != nil {
+		clog.LogPrinter.Errorf("es: failed to migrate index %s: %v", classIndexName, err)
+		return nil, err
+	}
 	createIndex(ctx, cli, c.Es.KeepDataAfterRestart, freeClassroomIndex, freeClassroomMapping)
 
 	createIndex(ctx, cli, c.Es.KeepDataAfterRestart, classroomIndex, classroomMapping)
+	createIndex(ctx, cli, true, classroomMetaIndex, classroomMetaMapping)
 
 	//存入classroom信息
 	err = createInitialClassrooms(cli, c.Es.Classroom)
@@ -43,6 +50,34 @@ func NewEsClient(c *conf.Data) (*elastic.Client, error) {
 	return cli, nil
 }
 
+const pinyinPlugin = "analysis-pinyin"
+
+// hasPinyinPlugin 检查es的所有节点是否都安装了拼音分词插件
+func hasPinyinPlugin(ctx context.Context, cli *elastic.Client) bool {
+	info, err := cli.NodesInfo().Metric("plugins").Do(ctx)
+	if err != nil {
+		clog.LogPrinter.Warnf("es: failed to get nodes plugins: %v", err)
+		return false
+	}
+	if len(info.Nodes) == 0 {
+		return false
+	}
+	for id, node := range info.Nodes {
+		found := false
+		for _, p := range node.Plugins {
+			if p.Name == pinyinPlugin {
+				found = true
+				break
+			}
+		}
+		if !found {
+			clog.LogPrinter.Warnf("es: node %v has no %s plugin, pinyin search is disabled", id, pinyinPlugin)
+			return false
+		}
+	}
+	return true
+}
+
 func createIndex(ctx context.Context, cli *elastic.Client, keepData bool, indexName string, mapping string) {
 	// 检查索引是否存在
 	exist, err := cli.IndexExists(indexName).Do(ctx)
@@ -78,6 +113,115 @@ func createIndex(ctx context.Context, cli *elastic.Client, keepData bool, indexN
 	clog.LogPrinter.Info("Es create index successfully")
 }
 
+// migrateIndex 让alias指向名为target的索引,target带有mapping的版本号
+// 已经指向target时直接返回;否则新建target,把alias原来的数据(旧版本的索引,或者以alias为名的索引)reindex过去,
+// 再原子地删除旧索引并把alias指向target.keepData为false时不迁移数据
+// 迁移期间写入旧索引的数据会丢失,由定期对账补上
+// rebuild不为空时逐个文档重新生成后写入,用于重新计算由其他字段推出的字段
+func migrateIndex(ctx context.Context, cli *elastic.Client, keepData bool, alias, target, mapping string, rebuild rebuildDoc) error {
+	var sources []string
+	exist, err := cli.IndexExists(alias).Do(ctx)
+	if err != nil {
+		return err
+	}
+	if exist {
+		res, err := cli.Aliases().Index(alias).Do(ctx)
+		if err != nil {
+			return err
+		}
+		for name := range res.Indices {
+			sources = append(sources, name)
+		}
+	}
+	if keepData && len(sources) == 1 && sources[0] == target {
+		return nil
+	}
+
+	// target存在但alias没有指向它,是上次迁移中断留下的
+	createIndex(ctx, cli, false, target, mapping)
+
+	if keepData && len(sources) > 0 && rebuild != nil {
+		total, err := copyIndex(ctx, cli, alias, target, rebuild)
+		if err != nil {
+			return fmt.Errorf("rebuild %s to %s: %w", alias, target, err)
+		}
+		clog.LogPrinter.Infof("es: rebuilt %d docs from %s to %s", total, alias, target)
+	} else if keepData && len(sources) > 0 {
+		res, err := cli.Reindex().
+			SourceIndex(alias).
+			DestinationIndex(target).
+			Refresh("true").
+			WaitForCompletion(true).
+			Do(ctx)
+		if err != nil {
+			return fmt.Errorf("reindex %s to %s: %w", alias, target, err)
+		}
+		if len(res.Failures) > 0 {
+			return fmt.Errorf("reindex %s to %s: %d failures", alias, target, len(res.Failures))
+		}
+		clog.LogPrinter.Infof("es: reindexed %d docs from %s to %s", res.Total, alias, target)
+	}
+
+	actions := cli.Alias()
+	for _, name := range sources {
+		if name != target {
+			actions = actions.Action(elastic.NewAliasRemoveIndexAction(name))
+		}
+	}
+	res, err := actions.Action(elastic.NewAliasAddAction(alias).Index(target)).Do(ctx)
+	if err != nil {
+		return fmt.Errorf("switch alias %s to %s: %w", alias, target, err)
+	}
+	if !res.Acknowledged {
+		return fmt.Errorf("switch alias %s to %s not acknowledged", alias, target)
+	}
+	clog.LogPrinter.Infof("es: alias %s now points to %s", alias, target)
+	return nil
+}
+
+// rebuildDoc 根据旧索引中的文档生成新索引的文档
+type rebuildDoc func(source json.RawMessage) (interface{}, error)
+
+// copyIndex 分批读出alias中的文档,用rebuild重新生成后写入target
+func copyIndex(ctx context.Context, cli *elastic.Client, alias, target string, rebuild rebuildDoc) (int64, error) {
+	scroll := cli.Scroll(alias).Size(1000)
+	defer func() {
+		_ = scroll.Clear(context.Background())
+	}()
+
+	var total int64
+	for {
+		res, err := scroll.Do(ctx)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return total, err
+		}
+		bulk := cli.Bulk().Index(target)
+		for _, hit := range res.Hits.Hits {
+			doc, err := rebuild(hit.Source)
+			if err != nil {
+				return total, fmt.Errorf("rebuild doc %s: %w", hit.Id, err)
+			}
+			bulk.Add(elastic.NewBulkIndexRequest().Id(hit.Id).Doc(doc))
+		}
+		if bulk.NumberOfActions() == 0 {
+			continue
+		}
+		bulkRes, err := bulk.Do(ctx)
+		if err != nil {
+			return total, err
+		}
+		if bulkRes.Errors {
+			return total, fmt.Errorf("%d docs failed to index", len(bulkRes.Failed()))
+		}
+		total += int64(len(res.Hits.Hits))
+	}
+	_, err := cli.Refresh(target).Do(ctx)
+	return total, err
+}
+
 const (
 	classroomIndex   = "ccnubox-classroom"
 	classroomMapping = `{
@@ -89,23 +233,32 @@ const (
 }`
 )
 
-func createInitialClassrooms(cli *elastic.Client, filePath string) error {
+// loadClassrooms 读取classroom文件中的所有教室
+func loadClassrooms(filePath string) ([]string, error) {
 	var data struct {
 		ClassRooms []string `json:"class_rooms"`
 	}
 	f, err := os.Open(filePath)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer f.Close()
 
 	decoder := json.NewDecoder(f)
 	err = decoder.Decode(&data)
+	if err != nil {
+		return nil, err
+	}
+	return data.ClassRooms, nil
+}
+
+func createInitialClassrooms(cli *elastic.Client, filePath string) error {
+	classrooms, err := loadClassrooms(filePath)
 	if err != nil {
 		return err
 	}
 
-	for _, classroom := range data.ClassRooms {
+	for _, classroom := range classrooms {
 		tmp := struct {
 			Where string `json:"where"`
 		}{
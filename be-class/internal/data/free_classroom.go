@@ -18,7 +18,9 @@ const (
 			"where": { "type": "keyword" },
 			"weeks": { "type": "integer" },
 			"day": { "type": "integer" },
-			"sections": { "type": "integer" }
+			"sections": { "type": "integer" },
+			"upload": { "type": "keyword" },
+			"from_class": { "type": "boolean" }
 		}
 	}
 }`
@@ -37,15 +39,81 @@ func (f *FreeClassroomData) GetAllClassroom(ctx context.Context, wherePrefix str
 	return f.getAllWheres(ctx, wherePrefix)
 }
 
+// AddClassroomOccupancy 添加根据课程信息推出的占用信息
 func (f *FreeClassroomData) AddClassroomOccupancy(ctx context.Context, year, semester string, cwtPairs ...model.CTWPair) error {
+	return f.addOccupancy(ctx, year, semester, "", cwtPairs)
+}
+
+// AddUploadedOccupancy 添加从选课手册的某个版本中解析出的占用信息
+func (f *FreeClassroomData) AddUploadedOccupancy(ctx context.Context, year, semester, version string, cwtPairs ...model.CTWPair) error {
+	return f.addOccupancy(ctx, year, semester, version, cwtPairs)
+}
+
+// ClearUploadedOccupancy 删除某个学期从选课手册中解析出的占用信息,只保留版本keep的
+func (f *FreeClassroomData) ClearUploadedOccupancy(ctx context.Context, year, semester, keep string) error {
+	query := elastic.NewBoolQuery().
+		Must(
+			elastic.NewTermQuery("year", year),
+			elastic.NewTermQuery("semester", semester),
+			elastic.NewExistsQuery("upload"),
+		).
+		MustNot(elastic.NewTermQuery("upload", keep))
+	return f.deleteOccupancy(ctx, query, fmt.Sprintf("uploaded classroom occupancy of %s-%s except %s", year, semester, keep))
+}
+
+// DeleteUploadedOccupancy 删除选课手册某个版本的占用信息
+func (f *FreeClassroomData) DeleteUploadedOccupancy(ctx context.Context, year, semester, version string) error {
+	query := elastic.NewBoolQuery().
+		Must(
+			elastic.NewTermQuery("year", year),
+			elastic.NewTermQuery("semester", semester),
+			elastic.NewTermQuery("upload", version),
+		)
+	return f.deleteOccupancy(ctx, query, fmt.Sprintf("uploaded classroom occupancy[%s] of %s-%s", version, year, semester))
+}
+
+// ClearLegacyOccupancy 删除既不是课程信息推出的、也不属于选课手册某个版本的占用信息
+// 旧的上传接口写入的占用信息没有来源,只能在课程信息推出的占用全部重新写入之后清除
+func (f *FreeClassroomData) ClearLegacyOccupancy(ctx context.Context, year, semester string) error {
+	query := elastic.NewBoolQuery().
+		Must(
+			elastic.NewTermQuery("year", year),
+			elastic.NewTermQuery("semester", semester),
+		).
+		MustNot(
+			elastic.NewExistsQuery("upload"),
+			elastic.NewExistsQuery("from_class"),
+		)
+	return f.deleteOccupancy(ctx, query, fmt.Sprintf("legacy classroom occupancy of %s-%s", year, semester))
+}
+
+func (f *FreeClassroomData) deleteOccupancy(ctx context.Context, query elastic.Query, what string) error {
+	deleteResponse, err := f.cli.DeleteByQuery().
+		Index(freeClassroomIndex).
+		Query(query).
+		Conflicts("proceed").
+		Refresh("true").
+		Do(ctx)
+	if err != nil {
+		clog.LogPrinter.Errorf("delete %s failed: %v", what, err)
+		return err
+	}
+	clog.LogPrinter.Infof("delete %d %s", deleteResponse.Deleted, what)
+	return nil
+}
+
+// addOccupancy upload不为空时表示来自选课手册的哪个版本,为空时表示由课程信息推出
+func (f *FreeClassroomData) addOccupancy(ctx context.Context, year, semester, upload string, cwtPairs []model.CTWPair) error {
 	// 定义文档结构
 	type ClassroomOccupancy struct {
-		Year     string `json:"year"`
-		Semester string `json:"semester"`
-		Where    string `json:"where"`
-		Weeks    []int  `json:"weeks"`
-		Day      int    `json:"day"`
-		Sections []int  `json:"sections"`
+		Year      string `json:"year"`
+		Semester  string `json:"semester"`
+		Where     string `json:"where"`
+		Weeks     []int  `json:"weeks"`
+		Day       int    `json:"day"`
+		Sections  []int  `json:"sections"`
+		Upload    string `json:"upload,omitempty"`
+		FromClass bool   `json:"from_class,omitempty"`
 	}
 
 	// 检查空数据
@@ -59,12 +127,14 @@ func (f *FreeClassroomData) AddClassroomOccupancy(ctx context.Context, year, sem
 	for _, cwtPair := range cwtPairs {
 		// 构建文档
 		doc := ClassroomOccupancy{
-			Year:     year,
-			Semester: semester,
-			Where:    cwtPair.Where,
-			Weeks:    cwtPair.CT.Weeks,
-			Day:      cwtPair.CT.Day,
-			Sections: cwtPair.CT.Sections,
+			Year:      year,
+			Semester:  semester,
+			Where:     cwtPair.Where,
+			Weeks:     cwtPair.CT.Weeks,
+			Day:       cwtPair.CT.Day,
+			Sections:  cwtPair.CT.Sections,
+			Upload:    upload,
+			FromClass: upload == "",
 		}
 
 		// 生成唯一ID
@@ -75,6 +145,10 @@ func (f *FreeClassroomData) AddClassroomOccupancy(ctx context.Context, year, sem
 			cwtPair.CT.Day,
 			cwtPair.CT.Weeks,
 			cwtPair.CT.Sections)
+		// 和课程信息推出的占用以及其他版本分开,切换版本时不会覆盖它们
+		if upload != "" {
+			docID = "upload-" + upload + "-" + docID
+		}
 
 		// 添加到批量请求
 		req := elastic.NewBulkIndexRequest().
@@ -160,6 +234,47 @@ func (f *FreeClassroomData) QueryAvailableClassrooms(ctx context.Context, year,
 
 	return occupancyStat, nil
 }
+
+// CountOccupiedSections 统计某一周某一天每个教室被占用的节数,没有被占用的教室不在结果中
+func (f *FreeClassroomData) CountOccupiedSections(ctx context.Context, year, semester string, week, day int, wherePrefix string) (map[string]int, error) {
+	boolQuery := elastic.NewBoolQuery().
+		Must(
+			elastic.NewTermQuery("year", year),
+			elastic.NewTermQuery("semester", semester),
+			elastic.NewPrefixQuery("where", wherePrefix),
+			elastic.NewTermQuery("weeks", week),
+			elastic.NewTermQuery("day", day),
+		)
+	//同一节可能有多门课,按节次去重
+	termsAgg := elastic.NewTermsAggregation().Field("where").Size(10000).
+		SubAggregation("sections", elastic.NewTermsAggregation().Field("sections").Size(32))
+
+	searchResult, err := f.cli.Search().
+		Index(freeClassroomIndex).
+		Query(boolQuery).
+		Aggregation("occupied_wheres", termsAgg).
+		Size(0).
+		Do(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var counts = make(map[string]int)
+	aggResult, found := searchResult.Aggregations.Terms("occupied_wheres")
+	if !found {
+		return counts, nil
+	}
+	for _, bucket := range aggResult.Buckets {
+		sections, ok := bucket.Terms("sections")
+		if !ok {
+			continue
+		}
+		counts[bucket.Key.(string)] = len(sections.Buckets)
+	}
+	return counts, nil
+}
+
 func (f *FreeClassroomData) getAllWheres(ctx context.Context, wherePrefix string) ([]string, error) {
 	boolQuery := elastic.NewBoolQuery().
 		Must(
@@ -0,0 +1,79 @@
+package data
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/asynccnu/ccnubox-be/be-class/internal/conf"
+	"github.com/asynccnu/ccnubox-be/be-class/internal/model"
+)
+
+const (
+	SearchBackendES       = "es"
+	SearchBackendEmbedded = "embedded"
+)
+
+// ClassIndex 课程信息的存储,es和内嵌索引各有一份实现
+type ClassIndex interface {
+	AddClassInfo(ctx context.Context, classInfos ...model.ClassInfo) error
+	ClearClassInfo(ctx context.Context, xnm, xqm string)
+	SearchClassInfo(ctx context.Context, q model.ClassSearchQuery) (model.ClassSearchResult, error)
+	SuggestClassInfo(ctx context.Context, prefix, xnm, xqm string, size int) ([]model.Suggestion, error)
+	GetBatchClassInfos(ctx context.Context, year, semester string, page, pageSize int) ([]model.ClassInfo, int, error)
+	DeleteClassInfo(ctx context.Context, ids ...string) error
+	ScanClassInfos(ctx context.Context, xnm, xqm string, f func([]model.ClassInfo) error) error
+}
+
+// ClassroomIndex 教室及其占用情况的存储
+type ClassroomIndex interface {
+	AddClassroomOccupancy(ctx context.Context, year, semester string, cwtPairs ...model.CTWPair) error
+	AddUploadedOccupancy(ctx context.Context, year, semester, version string, cwtPairs ...model.CTWPair) error
+	ClearUploadedOccupancy(ctx context.Context, year, semester, keep string) error
+	DeleteUploadedOccupancy(ctx context.Context, year, semester, version string) error
+	ClearLegacyOccupancy(ctx context.Context, year, semester string) error
+	ClearClassroomOccupancy(ctx context.Context, year, semester string) error
+	GetAllClassroom(ctx context.Context, wherePrefix string) ([]string, error)
+	QueryAvailableClassrooms(ctx context.Context, year, semester string, week, day, section int, wherePrefix string) (map[string]bool, error)
+	CountOccupiedSections(ctx context.Context, year, semester string, week, day int, wherePrefix string) (map[string]int, error)
+	GetClassroomOccupancy(ctx context.Context, year, semester, where string, week int) ([]model.CTime, error)
+	SaveClassroomMeta(ctx context.Context, meta model.ClassroomMeta) error
+	DeleteClassroomMeta(ctx context.Context, where string) error
+	GetClassroomMeta(ctx context.Context, where string) (*model.ClassroomMeta, error)
+}
+
+var (
+	_ ClassIndex     = (*ClassData)(nil)
+	_ ClassroomIndex = (*FreeClassroomData)(nil)
+	_ ClassIndex     = (*EmbeddedIndex)(nil)
+	_ ClassroomIndex = (*EmbeddedIndex)(nil)
+)
+
+// Indexes 根据配置选出的存储实现
+type Indexes struct {
+	Class     ClassIndex
+	Classroom ClassroomIndex
+}
+
+// NewIndexes 按data.searchBackend选择存储,默认使用es
+func NewIndexes(c *conf.Data) (*Indexes, func(), error) {
+	switch c.GetSearchBackend() {
+	case "", SearchBackendES:
+		cli, err := NewEsClient(c)
+		if err != nil {
+			return nil, nil, err
+		}
+		classData, cleanup, err := NewClassData(cli)
+		if err != nil {
+			return nil, nil, err
+		}
+		return &Indexes{Class: classData, Classroom: NewFreeClassroomData(cli)}, cleanup, nil
+	case SearchBackendEmbedded:
+		idx, cleanup, err := NewEmbeddedIndex(c.GetEmbedded())
+		if err != nil {
+			return nil, nil, err
+		}
+		return &Indexes{Class: idx, Classroom: idx}, cleanup, nil
+	default:
+		return nil, nil, fmt.Errorf("unknown search backend: %s", c.GetSearchBackend())
+	}
+}
@@ -0,0 +1,435 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/asynccnu/ccnubox-be/be-class/internal/conf"
+	"github.com/asynccnu/ccnubox-be/be-class/internal/model"
+	"github.com/olivere/elastic/v7"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var contractClasses = []model.ClassInfo{
+	{ID: "c1", Classname: "高等数学A(一)", Teacher: "张三", Where: "n101", Day: 1, ClassWhen: "1-2", Weeks: 0xffff, Credit: 5, Nature: "专业必修", Year: "2024", Semester: "1"},
+	{ID: "c2", Classname: "线性代数", Teacher: "李四", Where: "n201", Day: 2, ClassWhen: "3-4", Weeks: 0b10101, Credit: 3, Nature: "通识必修", Year: "2024", Semester: "1"},
+	{ID: "c3", Classname: "计算机网络", Teacher: "张伟", Where: "7101", Day: 1, ClassWhen: "5-6", Weeks: 0xff, Credit: 2.5, Nature: "专业选修", Year: "2024", Semester: "1"},
+	{ID: "c4", Classname: "数据结构", Teacher: "王五", Where: "N305", Day: 3, ClassWhen: "1-2", Weeks: 0xffff, Credit: 3, Nature: "专业必修", Year: "2024", Semester: "1"},
+	{ID: "c5", Classname: "高等数学A(二)", Teacher: "张三", Where: "n101", Day: 1, ClassWhen: "1-2", Weeks: 0xffff, Credit: 5, Nature: "专业必修", Year: "2023", Semester: "2"},
+}
+
+func classIDs(infos []model.ClassInfo) []string {
+	ids := make([]string, 0, len(infos))
+	for _, info := range infos {
+		ids = append(ids, info.ID)
+	}
+	return ids
+}
+
+func float64Ptr(f float64) *float64 { return &f }
+
+func bucket(value string, count int64) model.FacetBucket {
+	return model.FacetBucket{Value: value, Count: count}
+}
+
+// testClassIndexContract 所有ClassIndex的实现都需要满足的行为,sync用于等待写入对查询可见
+func testClassIndexContract(t *testing.T, idx ClassIndex, sync func()) {
+	ctx := context.Background()
+	require.NoError(t, idx.AddClassInfo(ctx, contractClasses...))
+	sync()
+
+	search := func(q model.ClassSearchQuery) model.ClassSearchResult {
+		q.Year, q.Semester = "2024", "1"
+		if q.PageSize == 0 {
+			q.Page, q.PageSize = 1, 10
+		}
+		res, err := idx.SearchClassInfo(ctx, q)
+		require.NoError(t, err)
+		return res
+	}
+
+	t.Run("keyword", func(t *testing.T) {
+		res := search(model.ClassSearchQuery{KeyWords: "高等数学A(一)"})
+		require.NotEmpty(t, res.ClassInfos)
+		assert.Equal(t, "c1", res.ClassInfos[0].ID)
+
+		assert.Equal(t, []string{"c1"}, classIDs(search(model.ClassSearchQuery{KeyWords: "高数"}).ClassInfos))
+		assert.ElementsMatch(t, []string{"c1", "c3"}, classIDs(search(model.ClassSearchQuery{KeyWords: "张"}).ClassInfos))
+		assert.Empty(t, search(model.ClassSearchQuery{KeyWords: "不存在的课"}).ClassInfos)
+	})
+
+	t.Run("filter", func(t *testing.T) {
+		tests := []struct {
+			q    model.ClassSearchQuery
+			want []string
+		}{
+			{model.ClassSearchQuery{Days: []int64{1}}, []string{"c1", "c3"}},
+			{model.ClassSearchQuery{WherePrefix: "n"}, []string{"c1", "c2", "c4"}},
+			{model.ClassSearchQuery{MinCredit: float64Ptr(3)}, []string{"c1", "c2", "c4"}},
+			{model.ClassSearchQuery{MaxCredit: float64Ptr(3)}, []string{"c2", "c3", "c4"}},
+			{model.ClassSearchQuery{Natures: []string{"专业必修"}}, []string{"c1", "c4"}},
+			{model.ClassSearchQuery{Week: 2}, []string{"c1", "c3", "c4"}},
+			{model.ClassSearchQuery{SectionStart: 3}, []string{"c2", "c3"}},
+			{model.ClassSearchQuery{SectionEnd: 2}, []string{"c1", "c4"}},
+			{model.ClassSearchQuery{Busy: []model.CTime{{Day: 1, Sections: []int{2, 3}, Weeks: []int{1}}}}, []string{"c2", "c3", "c4"}},
+			{model.ClassSearchQuery{Busy: []model.CTime{{Day: 2, Sections: []int{3}, Weeks: []int{2}}}}, []string{"c1", "c2", "c3", "c4"}},
+		}
+		for _, tt := range tests {
+			res := search(tt.q)
+			assert.ElementsMatch(t, tt.want, classIDs(res.ClassInfos), "%+v", tt.q)
+			assert.Equal(t, int64(len(tt.want)), res.Total, "%+v", tt.q)
+		}
+	})
+
+	t.Run("sort and paging", func(t *testing.T) {
+		res := search(model.ClassSearchQuery{Sort: model.SortCreditDesc})
+		assert.Equal(t, []string{"c1", "c2", "c4", "c3"}, classIDs(res.ClassInfos))
+		res = search(model.ClassSearchQuery{Sort: model.SortTime})
+		assert.Equal(t, []string{"c1", "c3", "c2", "c4"}, classIDs(res.ClassInfos))
+
+		var byCursor, byPage []string
+		cursor := ""
+		for {
+			res := search(model.ClassSearchQuery{Sort: model.SortCreditAsc, PageSize: 3, Cursor: cursor})
+			byCursor = append(byCursor, classIDs(res.ClassInfos)...)
+			if res.NextCursor == "" {
+				break
+			}
+			cursor = res.NextCursor
+		}
+		for page := 1; page <= 2; page++ {
+			res := search(model.ClassSearchQuery{Sort: model.SortCreditAsc, Page: page, PageSize: 3})
+			byPage = append(byPage, classIDs(res.ClassInfos)...)
+		}
+		assert.Equal(t, []string{"c3", "c2", "c4", "c1"}, byCursor)
+		assert.Equal(t, byPage, byCursor)
+
+		_, err := idx.SearchClassInfo(ctx, model.ClassSearchQuery{Year: "2024", Semester: "1", PageSize: 3, Cursor: "not a cursor"})
+		assert.Error(t, err)
+	})
+
+	t.Run("facets", func(t *testing.T) {
+		res := search(model.ClassSearchQuery{WithFacets: true})
+		facets := make(map[string][]model.FacetBucket)
+		for _, f := range res.Facets {
+			facets[f.Field] = f.Buckets
+		}
+		assert.Equal(t, []model.FacetBucket{bucket("1", 2), bucket("2", 1), bucket("3", 1)}, facets[model.FacetDay])
+		assert.Equal(t, []model.FacetBucket{bucket("专业必修", 2), bucket("专业选修", 1), bucket("通识必修", 1)}, facets[model.FacetNature])
+		assert.Equal(t, []model.FacetBucket{bucket("2.5", 1), bucket("3", 2), bucket("5", 1)}, facets[model.FacetCredit])
+		assert.ElementsMatch(t, []model.FacetBucket{bucket("n", 3), bucket("7", 1)}, facets[model.FacetBuilding])
+		assert.Len(t, facets[model.FacetWeek], 16)
+	})
+
+	t.Run("suggest", func(t *testing.T) {
+		suggestions, err := idx.SuggestClassInfo(ctx, "张", "2024", "1", 10)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []model.Suggestion{
+			{Text: "张三", Type: model.SuggestTeacher, Count: 1},
+			{Text: "张伟", Type: model.SuggestTeacher, Count: 1},
+		}, suggestions)
+
+		suggestions, err = idx.SuggestClassInfo(ctx, "高", "2024", "1", 10)
+		require.NoError(t, err)
+		assert.Equal(t, []model.Suggestion{{Text: "高等数学A(一)", Type: model.SuggestClassname, Count: 1}}, suggestions)
+	})
+
+	t.Run("delete and scan", func(t *testing.T) {
+		require.NoError(t, idx.DeleteClassInfo(ctx, "c4", "not-exist"))
+		sync()
+		var scanned []model.ClassInfo
+		require.NoError(t, idx.ScanClassInfos(ctx, "2024", "1", func(infos []model.ClassInfo) error {
+			scanned = append(scanned, infos...)
+			return nil
+		}))
+		assert.ElementsMatch(t, []string{"c1", "c2", "c3"}, classIDs(scanned))
+		assert.Contains(t, scanned, contractClasses[0])
+
+		require.NoError(t, idx.AddClassInfo(ctx, contractClasses[3]))
+		sync()
+	})
+
+	t.Run("batch and clear", func(t *testing.T) {
+		infos, total, err := idx.GetBatchClassInfos(ctx, "2024", "1", 1, 3)
+		require.NoError(t, err)
+		assert.Len(t, infos, 3)
+		assert.Equal(t, 4, total)
+
+		idx.ClearClassInfo(ctx, "2024", "1")
+		sync()
+		_, total, err = idx.GetBatchClassInfos(ctx, "2023", "2", 1, 10)
+		require.NoError(t, err)
+		assert.Equal(t, 0, total)
+		_, total, err = idx.GetBatchClassInfos(ctx, "2024", "1", 1, 10)
+		require.NoError(t, err)
+		assert.Equal(t, 4, total)
+	})
+}
+
+var contractClassrooms = `{"class_rooms":["n101","n102","n201","7101"]}`
+
+// testClassroomIndexContract 所有ClassroomIndex的实现都需要满足的行为,教室为contractClassrooms
+func testClassroomIndexContract(t *testing.T, idx ClassroomIndex, sync func()) {
+	ctx := context.Background()
+
+	wheres, err := idx.GetAllClassroom(ctx, "n")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"n101", "n102", "n201"}, wheres)
+
+	require.NoError(t, idx.AddClassroomOccupancy(ctx, "2024", "1", model.CTWPair{
+		CT:    model.CTime{Weeks: []int{1, 2}, Day: 1, Sections: []int{1, 2}},
+		Where: "n101",
+	}))
+	sync()
+
+	stat, err := idx.QueryAvailableClassrooms(ctx, "2024", "1", 1, 1, 1, "n1")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]bool{"n101": false, "n102": true}, stat)
+
+	stat, err = idx.QueryAvailableClassrooms(ctx, "2024", "1", 3, 1, 1, "n1")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]bool{"n101": true, "n102": true}, stat)
+
+	require.NoError(t, idx.AddClassroomOccupancy(ctx, "2024", "1", model.CTWPair{
+		CT:    model.CTime{Weeks: []int{1}, Day: 1, Sections: []int{2, 3}},
+		Where: "n101",
+	}))
+	sync()
+	counts, err := idx.CountOccupiedSections(ctx, "2024", "1", 1, 1, "n1")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"n101": 3}, counts)
+	counts, err = idx.CountOccupiedSections(ctx, "2024", "1", 2, 1, "n1")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"n101": 2}, counts)
+	counts, err = idx.CountOccupiedSections(ctx, "2024", "1", 1, 2, "n1")
+	require.NoError(t, err)
+	assert.Empty(t, counts)
+
+	// 选课手册中的占用按版本分开保存,可以单独清除,不影响课程信息推出的
+	require.NoError(t, idx.AddUploadedOccupancy(ctx, "2024", "1", "v1",
+		model.CTWPair{CT: model.CTime{Weeks: []int{1}, Day: 1, Sections: []int{1, 2}}, Where: "n101"},
+		model.CTWPair{CT: model.CTime{Weeks: []int{1}, Day: 1, Sections: []int{5}}, Where: "n104"},
+	))
+	require.NoError(t, idx.AddUploadedOccupancy(ctx, "2024", "1", "v2",
+		model.CTWPair{CT: model.CTime{Weeks: []int{1}, Day: 1, Sections: []int{5}}, Where: "n102"},
+		model.CTWPair{CT: model.CTime{Weeks: []int{1}, Day: 1, Sections: []int{7}}, Where: "n103"},
+	))
+	sync()
+	counts, err = idx.CountOccupiedSections(ctx, "2024", "1", 1, 1, "n1")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"n101": 3, "n102": 1, "n103": 1, "n104": 1}, counts)
+	require.NoError(t, idx.ClearUploadedOccupancy(ctx, "2024", "1", "v2"))
+	sync()
+	counts, err = idx.CountOccupiedSections(ctx, "2024", "1", 1, 1, "n1")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"n101": 3, "n102": 1, "n103": 1}, counts)
+	require.NoError(t, idx.DeleteUploadedOccupancy(ctx, "2024", "1", "v2"))
+	// 课程信息推出的占用有来源,不会被当作旧数据清除
+	require.NoError(t, idx.ClearLegacyOccupancy(ctx, "2024", "1"))
+	sync()
+	counts, err = idx.CountOccupiedSections(ctx, "2024", "1", 1, 1, "n1")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"n101": 3}, counts)
+
+	cts, err := idx.GetClassroomOccupancy(ctx, "2024", "1", "n101", 1)
+	require.NoError(t, err)
+	assert.Len(t, cts, 2)
+	cts, err = idx.GetClassroomOccupancy(ctx, "2024", "1", "n101", 3)
+	require.NoError(t, err)
+	assert.Empty(t, cts)
+
+	require.NoError(t, idx.ClearClassroomOccupancy(ctx, "2024", "1"))
+	sync()
+	stat, _ = idx.QueryAvailableClassrooms(ctx, "2024", "1", 1, 1, 1, "n1")
+	assert.False(t, stat["n101"])
+
+	require.NoError(t, idx.ClearClassroomOccupancy(ctx, "2025", "1"))
+	sync()
+	stat, _ = idx.QueryAvailableClassrooms(ctx, "2024", "1", 1, 1, 1, "n1")
+	assert.True(t, stat["n101"])
+}
+
+func testClassroomMetaContract(t *testing.T, idx ClassroomIndex, sync func()) {
+	ctx := context.Background()
+
+	meta, err := idx.GetClassroomMeta(ctx, "n101")
+	require.NoError(t, err)
+	assert.Nil(t, meta)
+
+	want := model.ClassroomMeta{Where: "n101", Capacity: 120, Equipment: []string{"投影仪", "空调"}, Note: "后门坏了", UpdatedAt: 1}
+	require.NoError(t, idx.SaveClassroomMeta(ctx, want))
+	sync()
+	meta, err = idx.GetClassroomMeta(ctx, "n101")
+	require.NoError(t, err)
+	assert.Equal(t, &want, meta)
+
+	require.NoError(t, idx.DeleteClassroomMeta(ctx, "n101"))
+	require.NoError(t, idx.DeleteClassroomMeta(ctx, "n101"))
+	sync()
+	meta, err = idx.GetClassroomMeta(ctx, "n101")
+	require.NoError(t, err)
+	assert.Nil(t, meta)
+}
+
+func writeContractClassrooms(t *testing.T) string {
+	path := filepath.Join(t.TempDir(), "classrooms.json")
+	require.NoError(t, os.WriteFile(path, []byte(contractClassrooms), 0o644))
+	return path
+}
+
+func TestEmbeddedIndex_Contract(t *testing.T) {
+	newIndex := func() *EmbeddedIndex {
+		idx, _, err := NewEmbeddedIndex(&conf.Data_Embedded{Classroom: writeContractClassrooms(t)})
+		require.NoError(t, err)
+		return idx
+	}
+	t.Run("class", func(t *testing.T) { testClassIndexContract(t, newIndex(), func() {}) })
+	t.Run("classroom", func(t *testing.T) { testClassroomIndexContract(t, newIndex(), func() {}) })
+	t.Run("classroom meta", func(t *testing.T) { testClassroomMetaContract(t, newIndex(), func() {}) })
+}
+
+// 需要es,会重建索引,所以只有设置了ES_URL时才执行
+func TestEsIndex_Contract(t *testing.T) {
+	url := os.Getenv("ES_URL")
+	if url == "" {
+		t.Skip("ES_URL is not set")
+	}
+	c := &conf.Data{Es: &conf.Data_ES{
+		Url:       url,
+		Username:  os.Getenv("ES_USERNAME"),
+		Password:  os.Getenv("ES_PASSWORD"),
+		Classroom: writeContractClassrooms(t),
+	}}
+	cli, err := NewEsClient(c)
+	require.NoError(t, err)
+	sync := func() {
+		_, err := cli.Refresh(classIndexName, freeClassroomIndex, classroomIndex, classroomMetaIndex).Do(context.Background())
+		require.NoError(t, err)
+	}
+	classData, _, err := NewClassData(cli)
+	require.NoError(t, err)
+
+	t.Run("class", func(t *testing.T) { testClassIndexContract(t, classData, sync) })
+	t.Run("classroom", func(t *testing.T) { testClassroomIndexContract(t, NewFreeClassroomData(cli), sync) })
+	t.Run("classroom meta", func(t *testing.T) { testClassroomMetaContract(t, NewFreeClassroomData(cli), sync) })
+}
+
+// 模拟升级前直接以别名为名、使用动态mapping的旧索引,迁移后新字段可以使用且数据不丢
+func TestEsIndex_MigrateExistingIndex(t *testing.T) {
+	url := os.Getenv("ES_URL")
+	if url == "" {
+		t.Skip("ES_URL is not set")
+	}
+	ctx := context.Background()
+	cli, err := elastic.NewClient(elastic.SetURL(url), elastic.SetSniff(false),
+		elastic.SetBasicAuth(os.Getenv("ES_USERNAME"), os.Getenv("ES_PASSWORD")))
+	require.NoError(t, err)
+
+	alias := "ccnubox-test-migrate"
+	deleteAll := func() {
+		for _, name := range []string{alias, alias + "-v2", alias + "-v3"} {
+			_, _ = cli.DeleteIndex(name).Do(ctx)
+		}
+	}
+	deleteAll()
+	t.Cleanup(deleteAll)
+
+	_, err = cli.CreateIndex(alias).Do(ctx)
+	require.NoError(t, err)
+	// 旧版本按错误的规则推出的教学楼,迁移时重新计算
+	doc := newClassDoc(contractClasses[0])
+	doc.Building = "n1"
+	_, err = cli.Index().Index(alias).Id("c1").BodyJson(doc).Refresh("true").Do(ctx)
+	require.NoError(t, err)
+
+	mapping := classMapping(hasPinyinPlugin(ctx, cli))
+	count := func() int64 {
+		res, err := cli.Search(alias).
+			Query(elastic.NewPrefixQuery("where.keyword", "N1").CaseInsensitive(true)).
+			Aggregation("building", elastic.NewTermsAggregation().Field("building")).
+			Do(ctx)
+		require.NoError(t, err)
+		return res.TotalHits()
+	}
+	building := func() string {
+		res, err := cli.Search(alias).Query(elastic.NewIdsQuery().Ids("c1")).Do(ctx)
+		require.NoError(t, err)
+		require.Len(t, res.Hits.Hits, 1)
+		var doc classDoc
+		require.NoError(t, json.Unmarshal(res.Hits.Hits[0].Source, &doc))
+		return doc.Building
+	}
+	indices := func() []string {
+		res, err := cli.Aliases().Index(alias).Do(ctx)
+		require.NoError(t, err)
+		var names []string
+		for name := range res.Indices {
+			names = append(names, name)
+		}
+		return names
+	}
+
+	require.NoError(t, migrateIndex(ctx, cli, true, alias, alias+"-v2", mapping, rebuildClassDoc))
+	assert.Equal(t, []string{alias + "-v2"}, indices())
+	assert.Equal(t, int64(1), count())
+	assert.Equal(t, "n", building())
+
+	// 已经是当前版本时不做任何事
+	require.NoError(t, migrateIndex(ctx, cli, true, alias, alias+"-v2", mapping, nil))
+	assert.Equal(t, int64(1), count())
+
+	// 再升级一个版本,旧版本的索引被删除
+	require.NoError(t, migrateIndex(ctx, cli, true, alias, alias+"-v3", mapping, nil))
+	assert.Equal(t, []string{alias + "-v3"}, indices())
+	assert.Equal(t, int64(1), count())
+	exist, err := cli.IndexExists(alias + "-v2").Do(ctx)
+	require.NoError(t, err)
+	assert.False(t, exist)
+
+	// 不保留数据时重建
+	require.NoError(t, migrateIndex(ctx, cli, false, alias, alias+"-v3", mapping, nil))
+	assert.Equal(t, int64(0), count())
+}
+
+func TestEmbeddedIndex_Persistence(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "index.json")
+	idx, cleanup, err := NewEmbeddedIndex(&conf.Data_Embedded{Path: path})
+	require.NoError(t, err)
+	require.NoError(t, idx.AddClassInfo(context.Background(), contractClasses...))
+	cleanup()
+
+	idx, _, err = NewEmbeddedIndex(&conf.Data_Embedded{Path: path})
+	require.NoError(t, err)
+	res, err := idx.SearchClassInfo(context.Background(), model.ClassSearchQuery{Year: "2024", Semester: "1", KeyWords: "线代", Page: 1, PageSize: 10})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"c2"}, classIDs(res.ClassInfos))
+}
+
+func TestEmbeddedIndex_ClearLegacyOccupancy(t *testing.T) {
+	ctx := context.Background()
+	// 旧的上传接口写入的占用没有来源
+	path := filepath.Join(t.TempDir(), "index.json")
+	b, err := json.Marshal(embeddedSnapshot{Occupancies: []classroomOccupancy{
+		{Year: "2024", Semester: "1", Where: "n102", Weeks: []int{1}, Day: 1, Sections: []int{3}},
+	}})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, b, 0644))
+	idx, _, err := NewEmbeddedIndex(&conf.Data_Embedded{Path: path})
+	require.NoError(t, err)
+	require.NoError(t, idx.AddClassroomOccupancy(ctx, "2024", "1", model.CTWPair{
+		CT:    model.CTime{Weeks: []int{1}, Day: 1, Sections: []int{1}},
+		Where: "n101",
+	}))
+
+	counts, err := idx.CountOccupiedSections(ctx, "2024", "1", 1, 1, "n1")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"n101": 1, "n102": 1}, counts)
+	require.NoError(t, idx.ClearLegacyOccupancy(ctx, "2024", "1"))
+	counts, err = idx.CountOccupiedSections(ctx, "2024", "1", 1, 1, "n1")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"n101": 1}, counts)
+}
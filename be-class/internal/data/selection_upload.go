@@ -0,0 +1,129 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/asynccnu/ccnubox-be/be-class/internal/errcode"
+	"github.com/asynccnu/ccnubox-be/be-class/internal/model"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	uploadJobKeyPrefix    = "ccnubox_selection_upload_job"
+	uploadKeyPrefix       = "ccnubox_selection_upload"
+	uploadPairsKeyPrefix  = "ccnubox_selection_upload_pairs"
+	uploadActiveKeyPrefix = "ccnubox_selection_upload_active"
+	// 任务只用于查询进度,保留一天
+	uploadJobExpire = 24 * time.Hour
+)
+
+// SelectionUploadStore 把上传任务和每个版本的占用信息保存在redis中,版本不过期
+type SelectionUploadStore struct {
+	cli *redis.Client
+}
+
+func NewSelectionUploadStore(cli *redis.Client) *SelectionUploadStore {
+	return &SelectionUploadStore{cli: cli}
+}
+
+func (s *SelectionUploadStore) SaveJob(ctx context.Context, job model.UploadJob) error {
+	b, err := json.Marshal(job)
+	if err != nil {
+		return err
+	}
+	return s.cli.Set(ctx, fmt.Sprintf("%s:%s", uploadJobKeyPrefix, job.ID), b, uploadJobExpire).Err()
+}
+
+func (s *SelectionUploadStore) GetJob(ctx context.Context, id string) (model.UploadJob, error) {
+	var job model.UploadJob
+	b, err := s.cli.Get(ctx, fmt.Sprintf("%s:%s", uploadJobKeyPrefix, id)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return job, errcode.Err_UploadJobNotFound
+	}
+	if err != nil {
+		return job, err
+	}
+	err = json.Unmarshal(b, &job)
+	return job, err
+}
+
+// SaveUpload 版本信息存在学期的hash中,占用信息单独存一个key
+func (s *SelectionUploadStore) SaveUpload(ctx context.Context, upload model.SelectionUpload, cwtPairs []model.CTWPair) error {
+	meta, err := json.Marshal(upload)
+	if err != nil {
+		return err
+	}
+	pairs, err := json.Marshal(cwtPairs)
+	if err != nil {
+		return err
+	}
+	_, err = s.cli.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, uploadPairsKey(upload.Year, upload.Semester, upload.Version), pairs, 0)
+		pipe.HSet(ctx, uploadKey(upload.Year, upload.Semester), upload.Version, meta)
+		return nil
+	})
+	return err
+}
+
+func (s *SelectionUploadStore) ListUploads(ctx context.Context, year, semester string) ([]model.SelectionUpload, error) {
+	vals, err := s.cli.HVals(ctx, uploadKey(year, semester)).Result()
+	if err != nil {
+		return nil, err
+	}
+	var uploads = make([]model.SelectionUpload, 0, len(vals))
+	for _, val := range vals {
+		var upload model.SelectionUpload
+		if err := json.Unmarshal([]byte(val), &upload); err != nil {
+			return nil, err
+		}
+		uploads = append(uploads, upload)
+	}
+	return uploads, nil
+}
+
+func (s *SelectionUploadStore) GetUploadPairs(ctx context.Context, year, semester, version string) ([]model.CTWPair, error) {
+	b, err := s.cli.Get(ctx, uploadPairsKey(year, semester, version)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, errcode.Err_UploadVersionNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	var cwtPairs []model.CTWPair
+	err = json.Unmarshal(b, &cwtPairs)
+	return cwtPairs, err
+}
+
+func (s *SelectionUploadStore) DeleteUpload(ctx context.Context, year, semester, version string) error {
+	_, err := s.cli.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HDel(ctx, uploadKey(year, semester), version)
+		pipe.Del(ctx, uploadPairsKey(year, semester, version))
+		return nil
+	})
+	return err
+}
+
+func (s *SelectionUploadStore) SetActiveUpload(ctx context.Context, year, semester, version string) error {
+	return s.cli.Set(ctx, fmt.Sprintf("%s:%s:%s", uploadActiveKeyPrefix, year, semester), version, 0).Err()
+}
+
+// GetActiveUpload 还没有上传过时返回空
+func (s *SelectionUploadStore) GetActiveUpload(ctx context.Context, year, semester string) (string, error) {
+	version, err := s.cli.Get(ctx, fmt.Sprintf("%s:%s:%s", uploadActiveKeyPrefix, year, semester)).Result()
+	if errors.Is(err, redis.Nil) {
+		return "", nil
+	}
+	return version, err
+}
+
+func uploadKey(year, semester string) string {
+	return fmt.Sprintf("%s:%s:%s", uploadKeyPrefix, year, semester)
+}
+
+func uploadPairsKey(year, semester, version string) string {
+	return fmt.Sprintf("%s:%s:%s:%s", uploadPairsKeyPrefix, year, semester, version)
+}
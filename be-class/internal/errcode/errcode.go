@@ -13,8 +13,13 @@ func New(code int, msg string) *Err {
 }
 
 var (
-	Err_EsAddClassInfo      = New(450, "创建classInfo失败")
-	Err_EsSearchClassInfo   = New(451, "查询classInfo失败")
-	Err_FreeClassroomSearch = New(452, "查询freeClassroom失败")
-	ErrCCNULogin            = New(453, "CCNU登录失败")
+	Err_EsAddClassInfo        = New(450, "创建classInfo失败")
+	Err_EsSearchClassInfo     = New(451, "查询classInfo失败")
+	Err_FreeClassroomSearch   = New(452, "查询freeClassroom失败")
+	ErrCCNULogin              = New(453, "CCNU登录失败")
+	Err_EsDeleteClassInfo     = New(454, "删除classInfo失败")
+	Err_ClassroomSchedule     = New(455, "查询教室占用情况失败")
+	Err_ClassroomMeta         = New(456, "保存教室信息失败")
+	Err_UploadJobNotFound     = New(457, "上传任务不存在或已过期")
+	Err_UploadVersionNotFound = New(458, "上传的版本不存在")
 )
@@ -26,6 +26,22 @@ var (
 		},
 		[]string{"uri"},
 	)
+	// ClassSyncEvents 处理的课程信息变更事件数
+	ClassSyncEvents = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "class_sync_events_total",
+			Help: "The total number of class events applied to the search index",
+		},
+		[]string{"type", "result"},
+	)
+	// ClassSyncDrift 最近一次对账发现的与be-classlist不一致的课程数
+	ClassSyncDrift = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "class_sync_drift",
+			Help: "The number of classes out of sync found by the last reconciliation",
+		},
+		[]string{"kind"},
+	)
 )
 
 // QPSMiddleware 记录QPS
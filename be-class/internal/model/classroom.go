@@ -0,0 +1,53 @@
+package model
+
+import "fmt"
+
+// ClassroomMeta 教室的基本信息,由管理员维护
+type ClassroomMeta struct {
+	Where     string   `json:"where"`     //教室
+	Capacity  int      `json:"capacity"`  //座位数
+	Equipment []string `json:"equipment"` //设备,比如"投影仪","空调"
+	Note      string   `json:"note"`      //备注
+	UpdatedAt int64    `json:"updated_at"`
+}
+
+// 教务系统中的校区号
+const (
+	CampusMain  = 1 //本部
+	CampusNanhu = 2 //南湖校区
+)
+
+// ClassroomLocation 教室所在的校区、教学楼和楼层
+type ClassroomLocation struct {
+	Campus   int
+	Building string //教学楼编号,即教室号的前缀,比如"7"、"n"
+	Floor    int
+}
+
+// Prefix 查询空闲教室时使用的地点前缀,没有指定楼层时为教学楼编号
+func (l ClassroomLocation) Prefix() string {
+	if l.Building != "" && l.Floor > 0 {
+		return fmt.Sprintf("%s%d", l.Building, l.Floor)
+	}
+	return l.Building
+}
+
+// Contains 判断other是否在l的范围内,l中为零值的字段不参与比较
+func (l ClassroomLocation) Contains(other ClassroomLocation) bool {
+	return (l.Campus == 0 || l.Campus == other.Campus) &&
+		(l.Building == "" || l.Building == other.Building) &&
+		(l.Floor == 0 || l.Floor == other.Floor)
+}
+
+// Campus 校区以及其中有教室的教学楼
+type Campus struct {
+	ID        int
+	Name      string
+	Buildings []Building
+}
+
+type Building struct {
+	ID     string
+	Name   string
+	Floors []int
+}
@@ -12,6 +12,7 @@ type ClassInfo struct {
 	Weeks        int64   `gorm:"column:weeks;not null" json:"weeks"`                 //哪些周
 	Semester     string  `gorm:"column:semester;not null" json:"semester"`           //学期
 	Year         string  `gorm:"column:year;not null" json:"year"`                   //学年
+	Nature       string  `gorm:"column:nature" json:"nature"`                        //课程性质
 }
 
 type CTime struct {
@@ -0,0 +1,71 @@
+package model
+
+const (
+	SortRelevance  = iota //按匹配程度
+	SortCreditDesc        //按学分从高到低
+	SortCreditAsc         //按学分从低到高
+	SortTime              //按上课时间
+)
+
+// 支持统计的维度
+const (
+	FacetDay       = "day"
+	FacetBuilding  = "building"
+	FacetNature    = "nature"
+	FacetCredit    = "credit"
+	FacetClassWhen = "class_when"
+	FacetWeek      = "week"
+)
+
+var Facets = []string{FacetDay, FacetBuilding, FacetNature, FacetCredit, FacetClassWhen, FacetWeek}
+
+type ClassSearchQuery struct {
+	KeyWords     string
+	Year         string
+	Semester     string
+	Page         int
+	PageSize     int
+	Cursor       string //不为空时忽略Page
+	Days         []int64
+	SectionStart int //0表示不限
+	SectionEnd   int //0表示不限
+	Week         int //0表示不限
+	WherePrefix  string
+	MinCredit    *float64
+	MaxCredit    *float64
+	Natures      []string
+	FitFreeTime  bool //为true时排除和StuID的课表冲突的课程
+	StuID        string
+	Busy         []CTime //需要避开的上课时间,FitFreeTime为true时由StuID的课表填充
+	Sort         int
+	WithFacets   bool
+}
+
+type ClassSearchResult struct {
+	ClassInfos []ClassInfo
+	Facets     []Facet
+	NextCursor string
+	Total      int64
+}
+
+type Facet struct {
+	Field   string
+	Buckets []FacetBucket
+}
+
+type FacetBucket struct {
+	Value string
+	Count int64
+}
+
+const (
+	SuggestClassname = "classname" //课程名称
+	SuggestTeacher   = "teacher"   //教师
+)
+
+// Suggestion 搜索框的联想词
+type Suggestion struct {
+	Text  string
+	Type  string //SuggestClassname或SuggestTeacher
+	Count int64  //包含该联想词的课程数
+}
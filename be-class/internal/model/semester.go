@@ -0,0 +1,11 @@
+package model
+
+// SemesterDay 某一天在校历中的信息
+type SemesterDay struct {
+	Year       string // 学年,在假期中时为假期前的学期
+	Semester   string
+	InSemester bool // 是否在学期的起止时间内
+	Week       int  // 第几周,不在学期内时为0
+	Weekday    int  // 按星期几的课表上课,调休时为被替换的那一天
+	IsHoliday  bool
+}
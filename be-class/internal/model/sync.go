@@ -0,0 +1,29 @@
+package model
+
+// 课程信息变更事件的类型,与be-classlist发送的保持一致
+const (
+	ClassEventUpsert = "upsert"
+	ClassEventDelete = "delete"
+)
+
+// ClassEvent be-classlist发送的课程信息变更事件
+type ClassEvent struct {
+	Type      string     `json:"type"`
+	ID        string     `json:"id"`
+	Class     *ClassInfo `json:"class,omitempty"` //upsert时为变更后的课程
+	Timestamp int64      `json:"timestamp"`       //事件产生的时间,单位ms
+}
+
+// ClassSyncReport 一次对账的结果
+type ClassSyncReport struct {
+	Source  int //be-classlist中的课程数
+	Indexed int //对账前索引中的课程数
+	Missing int //索引中缺少的课程数
+	Changed int //索引中内容不一致的课程数
+	Extra   int //索引中多出来的课程数
+}
+
+// Drift 索引与be-classlist不一致的课程总数
+func (r ClassSyncReport) Drift() int {
+	return r.Missing + r.Changed + r.Extra
+}
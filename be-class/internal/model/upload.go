@@ -0,0 +1,56 @@
+package model
+
+// 选课手册上传任务的状态
+const (
+	UploadJobPending  = "pending"  //等待处理
+	UploadJobParsing  = "parsing"  //正在解析表格
+	UploadJobSaving   = "saving"   //正在写入占用信息
+	UploadJobFinished = "finished" //完成
+	UploadJobFailed   = "failed"   //失败
+)
+
+// UploadRowError 选课手册中无法解析的一行
+type UploadRowError struct {
+	Sheet  string `json:"sheet"`
+	Row    int    `json:"row"` //行号,从1开始,与表格中显示的一致
+	Value  string `json:"value"`
+	Reason string `json:"reason"`
+}
+
+// UploadJob 一次选课手册上传的处理进度
+type UploadJob struct {
+	ID         string           `json:"id"`
+	Year       string           `json:"year"`
+	Semester   string           `json:"semester"`
+	Filename   string           `json:"filename"`
+	DryRun     bool             `json:"dry_run"` //为true时只解析不写入
+	Status     string           `json:"status"`
+	TotalRows  int              `json:"total_rows"`
+	ParsedRows int              `json:"parsed_rows"`
+	Pairs      int              `json:"pairs"` //解析出的占用信息数
+	ErrorCount int              `json:"error_count"`
+	Errors     []UploadRowError `json:"errors"`            //无法解析的行,最多保留前100条
+	Version    string           `json:"version,omitempty"` //写入成功后的版本号
+	Message    string           `json:"message,omitempty"` //失败的原因
+	CreatedAt  int64            `json:"created_at"`
+	UpdatedAt  int64            `json:"updated_at"`
+}
+
+// SelectionUpload 一个学期的一次选课手册上传,可以回滚到其中任意一个版本
+type SelectionUpload struct {
+	Version   string `json:"version"`
+	Year      string `json:"year"`
+	Semester  string `json:"semester"`
+	Filename  string `json:"filename"`
+	Pairs     int    `json:"pairs"`
+	CreatedAt int64  `json:"created_at"`
+}
+
+// ColumnMapping 一个sheet中上课时间和上课地点所在的列
+// 设置了表头名称时按第一行的表头查找列,否则使用列的索引(从0开始)
+type ColumnMapping struct {
+	ClassTimeIdx     uint   `json:"class_time_idx"`
+	ClassWhereIdx    uint   `json:"class_where_idx"`
+	ClassTimeHeader  string `json:"class_time_header"`
+	ClassWhereHeader string `json:"class_where_header"`
+}
@@ -0,0 +1,91 @@
+package tool
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/asynccnu/ccnubox-be/be-class/internal/model"
+)
+
+// BuildingOf 从上课地点推出教学楼,与ParseClassroom的教学楼编号一致
+// 比如"n101"为南湖综合楼"n","10414A"为10号楼"10",地点后面的备注会被忽略,推不出时返回空
+func BuildingOf(where string) string {
+	where = strings.TrimSpace(where)
+	if end := strings.IndexFunc(where, func(r rune) bool {
+		return r > unicode.MaxASCII || !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}); end >= 0 {
+		where = where[:end]
+	}
+	loc, ok := ParseClassroom(where)
+	if !ok {
+		return ""
+	}
+	return loc.Building
+}
+
+var (
+	campusNames = map[int]string{
+		model.CampusMain:  "本部",
+		model.CampusNanhu: "南湖校区",
+	}
+	buildingNames = map[string]string{
+		"3":  "3号教学楼",
+		"7":  "7号教学楼",
+		"8":  "8号教学楼",
+		"9":  "9号教学楼",
+		"10": "10号教学楼",
+		"n":  "南湖综合楼",
+	}
+)
+
+// ParseClassroom 从教室号推出校区、教学楼和楼层
+// 本部的教室号为楼号+楼层+两位房间号,比如"7205"、"10414A";南湖的为n+楼层+两位房间号,比如"n101"
+func ParseClassroom(classroom string) (model.ClassroomLocation, bool) {
+	s := strings.TrimRightFunc(strings.ToLower(strings.TrimSpace(classroom)), unicode.IsLetter)
+	campus := model.CampusMain
+	if strings.HasPrefix(s, "n") {
+		campus = model.CampusNanhu
+		s = s[1:]
+	}
+	if len(s) < 3 || strings.TrimLeftFunc(s, unicode.IsDigit) != "" {
+		return model.ClassroomLocation{}, false
+	}
+	building := s[:len(s)-3]
+	if campus == model.CampusNanhu {
+		if building != "" {
+			return model.ClassroomLocation{}, false
+		}
+		building = "n"
+	}
+	if building == "" {
+		return model.ClassroomLocation{}, false
+	}
+	return model.ClassroomLocation{
+		Campus:   campus,
+		Building: building,
+		Floor:    int(s[len(s)-3] - '0'),
+	}, true
+}
+
+// CampusOf 从地点前缀推出校区,南湖的教室以n开头
+func CampusOf(wherePrefix string) int {
+	if strings.HasPrefix(strings.ToLower(wherePrefix), "n") {
+		return model.CampusNanhu
+	}
+	return model.CampusMain
+}
+
+func CampusName(campus int) string {
+	if name, ok := campusNames[campus]; ok {
+		return name
+	}
+	return fmt.Sprintf("校区%d", campus)
+}
+
+func BuildingName(building string) string {
+	if name, ok := buildingNames[building]; ok {
+		return name
+	}
+	return building + "号楼"
+}
@@ -0,0 +1,46 @@
+package tool
+
+import (
+	"testing"
+
+	"github.com/asynccnu/ccnubox-be/be-class/internal/model"
+)
+
+func TestBuildingOf(t *testing.T) {
+	tests := map[string]string{
+		"n101":      "n",
+		"N512":      "n",
+		"7205":      "7",
+		"10414A":    "10",
+		"n101(多媒体)": "n",
+		"体育场":       "",
+		"":          "",
+	}
+	for where, want := range tests {
+		if got := BuildingOf(where); got != want {
+			t.Errorf("BuildingOf(%q) = %q, want %q", where, got, want)
+		}
+	}
+}
+
+func TestParseClassroom(t *testing.T) {
+	tests := map[string]struct {
+		loc model.ClassroomLocation
+		ok  bool
+	}{
+		"n101":   {model.ClassroomLocation{Campus: model.CampusNanhu, Building: "n", Floor: 1}, true},
+		"N537":   {model.ClassroomLocation{Campus: model.CampusNanhu, Building: "n", Floor: 5}, true},
+		"7205":   {model.ClassroomLocation{Campus: model.CampusMain, Building: "7", Floor: 2}, true},
+		"10414A": {model.ClassroomLocation{Campus: model.CampusMain, Building: "10", Floor: 4}, true},
+		"n1101":  {ok: false},
+		"205":    {ok: false},
+		"体育场":    {ok: false},
+		"":       {ok: false},
+	}
+	for classroom, want := range tests {
+		loc, ok := ParseClassroom(classroom)
+		if ok != want.ok || loc != want.loc {
+			t.Errorf("ParseClassroom(%q) = %+v, %v, want %+v, %v", classroom, loc, ok, want.loc, want.ok)
+		}
+	}
+}
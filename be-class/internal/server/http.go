@@ -31,6 +31,9 @@ func NewHTTPServer(c *conf.Server, svc *service.SelectionUploader) *http.Server
 	srv := http.NewServer(opts...)
 	//srv.Handle("/metrics", promhttp.Handler())
 
-	srv.HandleFunc("/class_selection/upload", svc.UploadSelection)
+	srv.HandleFunc("/class_selection/upload", svc.Admin(svc.UploadSelection))
+	srv.HandleFunc("/class_selection/job", svc.Admin(svc.GetUploadJob))
+	srv.HandleFunc("/class_selection/uploads", svc.Admin(svc.ListUploads))
+	srv.HandleFunc("/class_selection/rollback", svc.Admin(svc.RollbackUpload))
 	return srv
 }
@@ -6,11 +6,13 @@ import (
 	pb "github.com/asynccnu/ccnubox-be/be-api/gen/proto/classService/v1"
 	v1 "github.com/asynccnu/ccnubox-be/be-api/gen/proto/classlist/v1"
 	"github.com/asynccnu/ccnubox-be/be-class/internal/model"
+	"strings"
 )
 
 type ClassInfoProxy interface {
 	AddClassInfoToClassListService(ctx context.Context, request *v1.AddClassRequest) (*v1.AddClassResponse, error)
-	SearchClassInfo(ctx context.Context, keyWords string, xnm, xqm string, page, pageSize int) ([]model.ClassInfo, error)
+	SearchClassInfo(ctx context.Context, q model.ClassSearchQuery) (model.ClassSearchResult, error)
+	SuggestClassInfo(ctx context.Context, prefix, xnm, xqm string, size int) ([]model.Suggestion, error)
 }
 
 type ClassServiceService struct {
@@ -25,23 +27,100 @@ func NewClassServiceService(cp ClassInfoProxy) *ClassServiceService {
 }
 
 func (s *ClassServiceService) SearchClass(ctx context.Context, req *pb.SearchRequest) (*pb.SearchReply, error) {
-	if req.Page <= 0 || req.PageSize <= 0 {
+	if req.PageSize <= 0 || (req.Page <= 0 && req.Cursor == "") {
 		return &pb.SearchReply{}, errors.New("page and pageSize must be greater than 0")
 	}
-	classInfos, err := s.cp.SearchClassInfo(ctx, req.SearchKeyWords, req.Year, req.Semester, int(req.Page), int(req.PageSize))
+	filter := req.GetFilter()
+	if filter.GetFitFreeTime() && req.GetStuId() == "" {
+		return &pb.SearchReply{}, errors.New("stu_id is required when fit_free_time is set")
+	}
+	if req.SearchKeyWords == "" && filter == nil {
+		return &pb.SearchReply{}, errors.New("searchKeyWords or filter is required")
+	}
+
+	q := model.ClassSearchQuery{
+		KeyWords:     req.SearchKeyWords,
+		Year:         req.Year,
+		Semester:     req.Semester,
+		Page:         int(req.Page),
+		PageSize:     int(req.PageSize),
+		Cursor:       req.Cursor,
+		Days:         filter.GetDays(),
+		SectionStart: int(filter.GetSectionStart()),
+		SectionEnd:   int(filter.GetSectionEnd()),
+		Week:         int(filter.GetWeek()),
+		WherePrefix:  filter.GetWherePrefix(),
+		Natures:      filter.GetNatures(),
+		FitFreeTime:  filter.GetFitFreeTime(),
+		StuID:        req.GetStuId(),
+		Sort:         int(req.GetSort()),
+		WithFacets:   req.GetWithFacets(),
+	}
+	if filter != nil && filter.MinCredit != nil {
+		minCredit := filter.GetMinCredit()
+		q.MinCredit = &minCredit
+	}
+	if filter != nil && filter.MaxCredit != nil {
+		maxCredit := filter.GetMaxCredit()
+		q.MaxCredit = &maxCredit
+	}
+
+	res, err := s.cp.SearchClassInfo(ctx, q)
 	if err != nil {
 		return &pb.SearchReply{}, err
 	}
 	var pClassInfos = make([]*pb.ClassInfo, 0)
-	for _, classInfo := range classInfos {
+	for _, classInfo := range res.ClassInfos {
 		info := HandleClassInfo(classInfo)
 		pClassInfos = append(pClassInfos, info)
 	}
+	var facets = make([]*pb.SearchFacet, 0, len(res.Facets))
+	for _, facet := range res.Facets {
+		pFacet := &pb.SearchFacet{Field: facet.Field}
+		for _, b := range facet.Buckets {
+			pFacet.Buckets = append(pFacet.Buckets, &pb.FacetBucket{Value: b.Value, Count: b.Count})
+		}
+		facets = append(facets, pFacet)
+	}
 	return &pb.SearchReply{
 		ClassInfos: pClassInfos,
+		Facets:     facets,
+		NextCursor: res.NextCursor,
+		Total:      res.Total,
 	}, nil
 }
 
+const (
+	defaultSuggestSize = 10
+	maxSuggestSize     = 30
+)
+
+func (s *ClassServiceService) SuggestClass(ctx context.Context, req *pb.SuggestRequest) (*pb.SuggestReply, error) {
+	prefix := strings.TrimSpace(req.GetPrefix())
+	if prefix == "" {
+		return &pb.SuggestReply{}, nil
+	}
+	size := int(req.GetSize())
+	if size <= 0 {
+		size = defaultSuggestSize
+	}
+	size = min(size, maxSuggestSize)
+
+	suggestions, err := s.cp.SuggestClassInfo(ctx, prefix, req.GetYear(), req.GetSemester(), size)
+	if err != nil {
+		return &pb.SuggestReply{}, err
+	}
+	var pSuggestions = make([]*pb.Suggestion, 0, len(suggestions))
+	for _, sg := range suggestions {
+		pSuggestions = append(pSuggestions, &pb.Suggestion{
+			Text:  sg.Text,
+			Type:  sg.Type,
+			Count: sg.Count,
+		})
+	}
+	return &pb.SuggestReply{Suggestions: pSuggestions}, nil
+}
+
 func (s *ClassServiceService) AddClass(ctx context.Context, req *pb.AddClassRequest) (*pb.AddClassReply, error) {
 	preq := &v1.AddClassRequest{
 		StuId:    req.GetStuId(),
@@ -53,6 +132,7 @@ func (s *ClassServiceService) AddClass(ctx context.Context, req *pb.AddClassRequ
 		Semester: req.GetSemester(),
 		Year:     req.GetYear(),
 		Day:      req.GetDay(),
+		Force:    req.GetForce(),
 	}
 	if req.Credit != nil {
 		var credit = req.GetCredit()
@@ -80,5 +160,6 @@ func HandleClassInfo(classInfo model.ClassInfo) *pb.ClassInfo {
 		Semester:     classInfo.Semester,
 		Year:         classInfo.Year,
 		Id:           classInfo.ID,
+		Nature:       classInfo.Nature,
 	}
 }
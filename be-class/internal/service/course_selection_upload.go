@@ -1,42 +1,72 @@
 package service
 
 import (
-	"bytes"
 	"context"
+	"crypto/subtle"
 	"encoding/json"
-	"fmt"
+	"errors"
+	"github.com/asynccnu/ccnubox-be/be-class/internal/conf"
+	"github.com/asynccnu/ccnubox-be/be-class/internal/errcode"
 	"github.com/asynccnu/ccnubox-be/be-class/internal/model"
-	"github.com/xuri/excelize/v2"
+	"io"
 	"net/http"
 	"strings"
 )
 
-// 必要的列的索引
-type NecessaryIndex struct {
-	ClassTimeIdx  uint `json:"class_time_idx"`
-	ClassWhereIdx uint `json:"class_where_idx"`
+type UploadReq struct {
+	Year     string                         `json:"year"`
+	Semester string                         `json:"semester"`
+	Sheets   map[string]model.ColumnMapping `json:"sheets"`  // sheet名，以及每个sheet的上课时间和教学地点的列,可以传列的索引[数字,从0开始]或者表头的名称
+	DryRun   bool                           `json:"dry_run"` // 为true时只解析并报告无法解析的行，不写入
 }
 
-type UploadReq struct {
-	Year     string                    `json:"year"`
-	Semester string                    `json:"semester"`
-	Sheets   map[string]NecessaryIndex `json:"sheets"` // sheet名，以及每个sheet的上课时间和教学地点的索引[数字],索引从0开始,比如上课时间是第7列，就传6
+type RollbackReq struct {
+	Year     string `json:"year"`
+	Semester string `json:"semester"`
+	Version  string `json:"version"`
+}
+
+// UploadHistory 某个学期上传过的所有版本
+type UploadHistory struct {
+	Active  string                  `json:"active"` // 当前生效的版本
+	Uploads []model.SelectionUpload `json:"uploads"`
 }
 
-type FreeClassRoomSaver interface {
-	SaveFreeClassRoomInfo(ctx context.Context, year, semester string, cwtPairs []model.CTWPair) error
+type SelectionUploadManager interface {
+	StartUpload(ctx context.Context, req UploadReq, filename string, file []byte) (model.UploadJob, error)
+	GetUploadJob(ctx context.Context, id string) (model.UploadJob, error)
+	ListUploads(ctx context.Context, year, semester string) (UploadHistory, error)
+	RollbackUpload(ctx context.Context, year, semester, version string) error
 }
 
-// 处理上传选课手册的http服务
+// 处理上传选课手册的http服务,只有管理员可以调用
 type SelectionUploader struct {
-	freeClassRoom FreeClassRoomSaver
+	uploader    SelectionUploadManager
+	adminTokens []string
 }
 
-func NewSelectionUploader(freeClassRoom FreeClassRoomSaver) *SelectionUploader {
+func NewSelectionUploader(c *conf.Server, uploader SelectionUploadManager) *SelectionUploader {
 	return &SelectionUploader{
-		freeClassRoom: freeClassRoom,
+		uploader:    uploader,
+		adminTokens: c.GetHttp().GetAdminTokens(),
 	}
 }
+
+// Admin 校验请求头中的token,没有配置token时拒绝所有请求
+func (s *SelectionUploader) Admin(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		for _, t := range s.adminTokens {
+			if token != "" && subtle.ConstantTimeCompare([]byte(token), []byte(t)) == 1 {
+				next(w, r)
+				return
+			}
+		}
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+	}
+}
+
+// UploadSelection 上传选课手册,在后台解析,返回的任务id用于查询进度
 func (s *SelectionUploader) UploadSelection(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPost {
 		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
@@ -55,172 +85,105 @@ func (s *SelectionUploader) UploadSelection(w http.ResponseWriter, r *http.Reque
 		http.Error(w, "Invalid JSON format", http.StatusBadRequest)
 		return
 	}
+	if req.Year == "" || req.Semester == "" || len(req.Sheets) == 0 {
+		http.Error(w, "year, semester and sheets are required", http.StatusBadRequest)
+		return
+	}
 
 	// 解析上传的文件
-	file, _, err := r.FormFile("file")
+	file, header, err := r.FormFile("file")
 	if err != nil {
 		http.Error(w, "Failed to get file", http.StatusBadRequest)
 		return
 	}
 	defer file.Close()
 
-	buf := new(bytes.Buffer)
-	_, err = buf.ReadFrom(file)
+	data, err := io.ReadAll(file)
 	if err != nil {
 		http.Error(w, "Failed to read file", http.StatusInternalServerError)
 		return
 	}
 
-	f, err := excelize.OpenReader(buf)
+	job, err := s.uploader.StartUpload(r.Context(), req, header.Filename, data)
 	if err != nil {
-		http.Error(w, "Failed to open file", http.StatusInternalServerError)
+		http.Error(w, "Failed to create upload job", http.StatusInternalServerError)
 		return
 	}
-	defer f.Close()
+	writeJSON(w, http.StatusAccepted, job)
+}
 
-	ctwPairs, err := getCWTPairs(f, req.Sheets)
+// GetUploadJob 查询上传任务的进度,解析出错的行也在其中
+func (s *SelectionUploader) GetUploadJob(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	id := r.URL.Query().Get("id")
+	if id == "" {
+		http.Error(w, "id is required", http.StatusBadRequest)
+		return
+	}
+	job, err := s.uploader.GetUploadJob(r.Context(), id)
+	if errors.Is(err, errcode.Err_UploadJobNotFound) {
+		http.Error(w, "Upload job not found", http.StatusNotFound)
+		return
+	}
 	if err != nil {
-		http.Error(w, fmt.Sprintf("Failed to handle excel:%v", err), http.StatusInternalServerError)
+		http.Error(w, "Failed to get upload job", http.StatusInternalServerError)
+		return
 	}
+	writeJSON(w, http.StatusOK, job)
+}
 
-	err = s.freeClassRoom.SaveFreeClassRoomInfo(r.Context(), req.Year, req.Semester, ctwPairs)
+// ListUploads 查询某个学期上传过的所有版本
+func (s *SelectionUploader) ListUploads(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	year, semester := r.URL.Query().Get("year"), r.URL.Query().Get("semester")
+	if year == "" || semester == "" {
+		http.Error(w, "year and semester are required", http.StatusBadRequest)
+		return
+	}
+	history, err := s.uploader.ListUploads(r.Context(), year, semester)
 	if err != nil {
-		http.Error(w, fmt.Sprintf("Failed to save free classlist room info:%v", err), http.StatusInternalServerError)
+		http.Error(w, "Failed to list uploads", http.StatusInternalServerError)
+		return
 	}
-
-	// 设置响应头，内容类型为 JSON
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
-
-	w.Write([]byte(`{"msg":"success"}`))
+	writeJSON(w, http.StatusOK, history)
 }
 
-func getCWTPairs(f *excelize.File, mp map[string]NecessaryIndex) ([]model.CTWPair, error) {
-	type ClassRoomTimeData struct {
-		Time  string
-		Where string
+// RollbackUpload 回滚到某个学期之前上传的版本
+func (s *SelectionUploader) RollbackUpload(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
 	}
-	var datas []ClassRoomTimeData
-
-	//读取每个sheets的上课时间和上课地点
-	for sheetName, necessaryIndex := range mp {
-		rows, err := f.GetRows(sheetName)
-		if err != nil {
-			return nil, err
-		}
-		for i := 0; i < len(rows); i++ {
-			if i == 0 {
-				continue
-			}
-			datas = append(datas, ClassRoomTimeData{
-				Time:  rows[i][necessaryIndex.ClassTimeIdx],
-				Where: rows[i][necessaryIndex.ClassWhereIdx],
-			})
-		}
+	var req RollbackReq
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid JSON format", http.StatusBadRequest)
+		return
 	}
-
-	var ctwPairs []model.CTWPair
-
-	for _, data := range datas {
-		ctimes := parseTime(data.Time)
-		wheres := strings.Split(data.Where, ";")
-
-		for _, ct := range ctimes {
-			for _, where := range wheres {
-				ctwPairs = append(ctwPairs, model.CTWPair{
-					CT:    ct,
-					Where: where,
-				})
-			}
-		}
+	if req.Year == "" || req.Semester == "" || req.Version == "" {
+		http.Error(w, "year, semester and version are required", http.StatusBadRequest)
+		return
+	}
+	err := s.uploader.RollbackUpload(r.Context(), req.Year, req.Semester, req.Version)
+	if errors.Is(err, errcode.Err_UploadVersionNotFound) {
+		http.Error(w, "Upload version not found", http.StatusNotFound)
+		return
 	}
-	return ctwPairs, nil
+	if err != nil {
+		http.Error(w, "Failed to rollback upload", http.StatusInternalServerError)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]string{"msg": "success"})
 }
 
-// 看几种典型的时间格式
-// 星期四第3-4节{4-19周}
-// 星期一第1-2节{4-18周(双)};星期二第7-8节{4-19周}
-// 星期一第5-8节{4-6周(双),7-8周};星期二第5-8节{4-6周(双),7-8周};星期四第1-4节{4-6周(双),7-8周};星期五第1-4节{4-6周(双),7-8周}
-// 星期一第9-10节{5-17周(单)};星期二第1-2节{4-19周}
-func parseTime(val string) []model.CTime {
-	var mp = map[string]int{
-		"一": 1,
-		"二": 2,
-		"三": 3,
-		"四": 4,
-		"五": 5,
-		"六": 6,
-		"日": 7,
-	}
-
-	uniteTimes := strings.Split(val, ";")
-	res := make([]model.CTime, 0, len(uniteTimes))
-	for _, uniteTime := range uniteTimes {
-		var tt model.CTime
-
-		index := strings.Index(uniteTime, "{")
-		tmp1 := uniteTime[:index]                     //代表 "星期一第1-2节" 这样的部分
-		tmp2 := uniteTime[index+1 : len(uniteTime)-1] //代表 4-19周 这个部分
-
-		//获取星期几和第几节
-		index = strings.Index(tmp1, "第")
-		xinqi := tmp1[:index]  // 代表如 "星期一"的部分
-		jieshu := tmp1[index:] // 代表如 "第1-2节" 的部分
-
-		dayStr := strings.TrimPrefix(xinqi, "星期")
-		day := mp[dayStr] //星期几
-
-		var jieStart, jieEnd int
-		fmt.Sscanf(jieshu, "第%d-%d节", &jieStart, &jieEnd) //第几节
-
-		tt.Day = day
-		for i := jieStart; i <= jieEnd; i++ {
-			tt.Sections = append(tt.Sections, i)
-		}
-
-		//开始获取周数
-		weekStrs := strings.Split(tmp2, ",")
-
-		for _, weekStr := range weekStrs {
-
-			index = strings.Index(weekStr, "(")
-			var weekStart, weekEnd int
-			var pattern int // 1代表单周，2代表双周，0代表没有
-			//先看看有没有括号
-			if index == -1 {
-				fmt.Sscanf(weekStr, "%d-%d周", &weekStart, &weekEnd)
-			} else {
-				var patternStr string
-				//"%s" 读取的是一个不包含空格的字符串，它会直接匹配 "双)"
-				//括号 () 仍然被解析为字符串的一部分，因为 Sscanf 不能自动去掉这些符号
-				fmt.Sscanf(weekStr, "%d-%d周(%s)", &weekStart, &weekEnd, &patternStr)
-				// 手动去掉末尾的 ")"
-				patternStr = strings.TrimSuffix(patternStr, ")")
-				if patternStr == "单" {
-					pattern = 1
-				}
-				if patternStr == "双" {
-					pattern = 2
-				}
-			}
-			for i := weekStart; i <= weekEnd; i++ {
-				if pattern == 0 {
-					tt.Weeks = append(tt.Weeks, i)
-				}
-				if pattern == 1 {
-					if i%2 == 1 {
-						tt.Weeks = append(tt.Weeks, i)
-					}
-				}
-				if pattern == 2 {
-					if i%2 == 0 {
-						tt.Weeks = append(tt.Weeks, i)
-					}
-				}
-			}
-		}
-		res = append(res, tt)
-		//fmt.Printf("%+v\n",res)
-	}
-	return res
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	// 设置响应头，内容类型为 JSON
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v)
 }
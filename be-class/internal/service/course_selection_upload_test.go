@@ -4,110 +4,142 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"github.com/asynccnu/ccnubox-be/be-class/internal/conf"
+	"github.com/asynccnu/ccnubox-be/be-class/internal/errcode"
 	"github.com/asynccnu/ccnubox-be/be-class/internal/model"
 	"github.com/stretchr/testify/assert"
-	"io"
+	"github.com/stretchr/testify/require"
 	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
-	"os"
 	"testing"
 )
 
-// 模拟的 FreeClassRoomSaver 实现，用于测试
-type MockFreeClassRoomBiz struct{}
+// 模拟的 SelectionUploadManager 实现，用于测试
+type MockSelectionUploadManager struct {
+	req      UploadReq
+	filename string
+	file     []byte
+}
+
+func (m *MockSelectionUploadManager) StartUpload(ctx context.Context, req UploadReq, filename string, file []byte) (model.UploadJob, error) {
+	m.req, m.filename, m.file = req, filename, file
+	return model.UploadJob{ID: "job1", Status: model.UploadJobPending, DryRun: req.DryRun}, nil
+}
 
-func (m *MockFreeClassRoomBiz) SaveFreeClassRoomInfo(ctx context.Context, year, semester string, cwtPairs []model.CTWPair) error {
-	file, err := os.Create("data.json")
-	if err != nil {
-		return err
+func (m *MockSelectionUploadManager) GetUploadJob(ctx context.Context, id string) (model.UploadJob, error) {
+	if id != "job1" {
+		return model.UploadJob{}, errcode.Err_UploadJobNotFound
 	}
-	encoder := json.NewEncoder(file)
-	//encoder.SetIndent("", "  ") // 设置缩进使得JSON更具可读性
-	err = encoder.Encode(&cwtPairs)
-	if err != nil {
-		return err
+	return model.UploadJob{ID: "job1", Status: model.UploadJobFinished}, nil
+}
+
+func (m *MockSelectionUploadManager) ListUploads(ctx context.Context, year, semester string) (UploadHistory, error) {
+	return UploadHistory{Active: "v1", Uploads: []model.SelectionUpload{{Version: "v1"}}}, nil
+}
+
+func (m *MockSelectionUploadManager) RollbackUpload(ctx context.Context, year, semester, version string) error {
+	if version != "v1" {
+		return errcode.Err_UploadVersionNotFound
 	}
 	return nil
 }
 
+func newTestUploader(m SelectionUploadManager) *SelectionUploader {
+	return NewSelectionUploader(&conf.Server{Http: &conf.Server_HTTP{AdminTokens: []string{"secret"}}}, m)
+}
+
 func TestUploadSelection(t *testing.T) {
 	// 模拟上传的 JSON 数据
 	jsonData := `{
 		"year": "2024",
 		"semester": "2",
+		"dry_run": true,
 		"sheets": {
 			"2024级": {
 				"class_time_idx": 7,
 				"class_where_idx": 8
 			},
-			"2023级" : {
-				"class_time_idx": 7,
-				"class_where_idx": 8
-			},
-			"2022级" : {
-				"class_time_idx": 7,
-				"class_where_idx": 8
-			},
-			"2021级" : {
-				"class_time_idx": 7,
-				"class_where_idx": 8
-			},
 			"公共课" : {
-				"class_time_idx": 7,
-				"class_where_idx": 8
+				"class_time_header": "上课时间",
+				"class_where_header": "教学地点"
 			}
 		}
 	}`
 
-	excelFile, err := os.OpenFile("./2024-2025学年第1学期选课手册.xlsx", os.O_RDONLY, 0644)
-	if err != nil {
-		t.Fatalf("Failed to create test Excel file: %v", err)
-	}
-
 	// 创建请求体（multipart/form-data）
 	body := new(bytes.Buffer)
 	writer := multipart.NewWriter(body)
 
 	// 添加 JSON 数据部分
 	part, err := writer.CreateFormField("json_data")
-	if err != nil {
-		t.Fatalf("Failed to create form field for json_data: %v", err)
-	}
+	require.NoError(t, err)
 	part.Write([]byte(jsonData))
 
 	// 添加文件部分
 	filePart, err := writer.CreateFormFile("file", "test.xlsx")
-	if err != nil {
-		t.Fatalf("Failed to create form file: %v", err)
-	}
-	_, err = io.Copy(filePart, excelFile)
-	if err != nil {
-		t.Fatalf("Failed to copy Excel file data: %v", err)
-	}
+	require.NoError(t, err)
+	filePart.Write([]byte("xlsx"))
+	require.NoError(t, writer.Close())
 
-	// 结束 multipart 编写
-	err = writer.Close()
-	if err != nil {
-		t.Fatalf("Failed to close multipart writer: %v", err)
+	m := &MockSelectionUploadManager{}
+	handler := newTestUploader(m)
+	newReq := func(token string) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/class_selection/upload", bytes.NewReader(body.Bytes()))
+		req.Header.Set("Content-Type", writer.FormDataContentType())
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		return req
 	}
 
-	// 创建模拟请求
-	req := httptest.NewRequest(http.MethodPost, "/upload", body)
-	req.Header.Set("Content-Type", writer.FormDataContentType())
+	for _, token := range []string{"", "wrong"} {
+		rr := httptest.NewRecorder()
+		handler.Admin(handler.UploadSelection)(rr, newReq(token))
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	}
 
-	// 使用 httptest.NewRecorder() 来模拟响应
 	rr := httptest.NewRecorder()
+	handler.Admin(handler.UploadSelection)(rr, newReq("secret"))
+	require.Equal(t, http.StatusAccepted, rr.Code, rr.Body.String())
+
+	var job model.UploadJob
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &job))
+	assert.Equal(t, "job1", job.ID)
+	assert.True(t, m.req.DryRun)
+	assert.Equal(t, uint(7), m.req.Sheets["2024级"].ClassTimeIdx)
+	assert.Equal(t, "上课时间", m.req.Sheets["公共课"].ClassTimeHeader)
+	assert.Equal(t, "test.xlsx", m.filename)
+	assert.Equal(t, []byte("xlsx"), m.file)
+}
 
-	// 创建服务和处理器
-	selectionUploader := &SelectionUploader{
-		freeClassRoom: &MockFreeClassRoomBiz{},
+func TestSelectionUploader_JobAndRollback(t *testing.T) {
+	handler := newTestUploader(&MockSelectionUploadManager{})
+	do := func(h http.HandlerFunc, method, target string, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, target, bytes.NewBufferString(body))
+		req.Header.Set("Authorization", "Bearer secret")
+		rr := httptest.NewRecorder()
+		handler.Admin(h)(rr, req)
+		return rr
 	}
 
-	// 调用 UploadSelection 方法
-	selectionUploader.UploadSelection(rr, req)
+	assert.Equal(t, http.StatusOK, do(handler.GetUploadJob, http.MethodGet, "/class_selection/job?id=job1", "").Code)
+	assert.Equal(t, http.StatusNotFound, do(handler.GetUploadJob, http.MethodGet, "/class_selection/job?id=job2", "").Code)
+	assert.Equal(t, http.StatusBadRequest, do(handler.ListUploads, http.MethodGet, "/class_selection/uploads?year=2024", "").Code)
 
-	t.Log(rr.Body.String())
-	// 检查响应状态
-	assert.Equal(t, http.StatusOK, rr.Code, "Expected status code 200")
+	rr := do(handler.ListUploads, http.MethodGet, "/class_selection/uploads?year=2024&semester=1", "")
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), `"active":"v1"`)
+
+	assert.Equal(t, http.StatusOK, do(handler.RollbackUpload, http.MethodPost, "/class_selection/rollback", `{"year":"2024","semester":"1","version":"v1"}`).Code)
+	assert.Equal(t, http.StatusNotFound, do(handler.RollbackUpload, http.MethodPost, "/class_selection/rollback", `{"year":"2024","semester":"1","version":"v2"}`).Code)
+}
+
+func TestSelectionUploader_NoAdminTokens(t *testing.T) {
+	handler := NewSelectionUploader(&conf.Server{}, &MockSelectionUploadManager{})
+	req := httptest.NewRequest(http.MethodGet, "/class_selection/job?id=job1", nil)
+	req.Header.Set("Authorization", "Bearer ")
+	rr := httptest.NewRecorder()
+	handler.Admin(handler.GetUploadJob)(rr, req)
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
 }
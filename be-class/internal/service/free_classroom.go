@@ -2,13 +2,38 @@ package service
 
 import (
 	"context"
+	"errors"
+	"sort"
+	"strings"
 
 	pb "github.com/asynccnu/ccnubox-be/be-api/gen/proto/classService/v1"
 	"github.com/asynccnu/ccnubox-be/be-class/internal/errcode"
+	"github.com/asynccnu/ccnubox-be/be-class/internal/model"
+	"github.com/asynccnu/ccnubox-be/be-class/internal/pkg/tool"
 )
 
 type FreeClassroomSearcher interface {
-	SearchAvailableClassroom(ctx context.Context, year, semester, stuID string, week, day int, sections []int, wherePrefix string) ([]AvailableClassroomStat, error)
+	SearchAvailableClassroom(ctx context.Context, year, semester, stuID string, week, day int, sections []int, wherePrefix string, loc model.ClassroomLocation) ([]AvailableClassroomStat, error)
+	GetClassroomCatalog(ctx context.Context) ([]model.Campus, error)
+	RecommendQuietClassroom(ctx context.Context, q QuietClassroomQuery) ([]QuietClassroom, error)
+}
+
+type ClassroomDetailer interface {
+	GetClassroomSchedule(ctx context.Context, year, semester, classroom string, week int) (ClassroomSchedule, error)
+	SaveClassroomMeta(ctx context.Context, meta model.ClassroomMeta) error
+	DeleteClassroomMeta(ctx context.Context, classroom string) error
+}
+
+// ClassroomSchedule 一个教室一周的占用情况
+type ClassroomSchedule struct {
+	Meta  *model.ClassroomMeta //没有维护时为nil
+	Slots []ClassroomSlot      //只有被占用的节次
+}
+
+type ClassroomSlot struct {
+	Day     int
+	Section int
+	Classes []model.ClassInfo //占用该节次的课程
 }
 
 type AvailableClassroomStat struct {
@@ -16,26 +41,61 @@ type AvailableClassroomStat struct {
 	AvailableStat []bool
 }
 
+// QuietClassroomQuery 推荐安静教室的条件
+type QuietClassroomQuery struct {
+	Year, Semester, StuID string
+	Week, Day             int
+	StartSection          int //从第几节开始
+	Duration              int //需要连续空闲的节数
+	WherePrefix           string
+	Location              string //用户当前的位置,为空时不考虑距离
+	Limit                 int
+}
+
+// QuietClassroom 推荐的教室
+type QuietClassroom struct {
+	Classroom        string
+	FreeStart        int
+	FreeEnd          int
+	OccupiedSections int //这一天有课的节数,-1表示没有数据
+	Score            float64
+	Reasons          []string
+}
+
 type FreeClassroomSvc struct {
 	pb.UnimplementedFreeClassroomSvcServer
 	searcher FreeClassroomSearcher
+	detailer ClassroomDetailer
 }
 
-func NewFreeClassroomSvc(searcher FreeClassroomSearcher) *FreeClassroomSvc {
+func NewFreeClassroomSvc(searcher FreeClassroomSearcher, detailer ClassroomDetailer) *FreeClassroomSvc {
 	return &FreeClassroomSvc{
 		searcher: searcher,
+		detailer: detailer,
 	}
 }
 
 func (s *FreeClassroomSvc) QueryFreeClassroom(ctx context.Context, req *pb.QueryFreeClassroomReq) (*pb.QueryFreeClassroomResp, error) {
+	loc := model.ClassroomLocation{
+		Campus:   int(req.Campus),
+		Building: strings.ToLower(req.Building),
+		Floor:    int(req.Floor),
+	}
+	if err := checkClassroomLocation(req.WherePrefix, loc); err != nil {
+		return &pb.QueryFreeClassroomResp{}, err
+	}
+
 	intSections := make([]int, len(req.Sections))
 	for i, section := range req.Sections {
 		intSections[i] = int(section)
 	}
-	stats, err := s.searcher.SearchAvailableClassroom(ctx, req.Year, req.Semester, req.StuID, int(req.Week), int(req.Day), intSections, req.WherePrefix)
+	stats, err := s.searcher.SearchAvailableClassroom(ctx, req.Year, req.Semester, req.StuID, int(req.Week), int(req.Day), intSections, req.WherePrefix, loc)
 	if err != nil {
 		return &pb.QueryFreeClassroomResp{}, errcode.Err_FreeClassroomSearch
 	}
+	sort.Slice(stats, func(i, j int) bool {
+		return stats[i].Classroom < stats[j].Classroom
+	})
 
 	var res = make([]*pb.ClassroomAvailableStat, 0, len(stats))
 	for _, stat := range stats {
@@ -45,6 +105,226 @@ func (s *FreeClassroomSvc) QueryFreeClassroom(ctx context.Context, req *pb.Query
 		})
 	}
 	return &pb.QueryFreeClassroomResp{
-		Stat: res,
+		Stat:      res,
+		Buildings: groupByBuilding(res),
+	}, nil
+}
+
+// checkClassroomLocation 没有地点前缀时必须指定校区或教学楼,楼层需要和教学楼一起指定
+func checkClassroomLocation(wherePrefix string, loc model.ClassroomLocation) error {
+	if wherePrefix != "" {
+		return nil
+	}
+	if loc.Campus == 0 && loc.Building == "" {
+		return errors.New("wherePrefix, campus or building is required")
+	}
+	if loc.Floor != 0 && loc.Building == "" {
+		return errors.New("building is required when floor is specified")
+	}
+	if loc.Campus != 0 && loc.Building != "" && tool.CampusOf(loc.Building) != loc.Campus {
+		return errors.New("building is not in the campus")
+	}
+	return nil
+}
+
+// groupByBuilding 把教室按教学楼和楼层分组,推不出位置的教室放在最后
+func groupByBuilding(stats []*pb.ClassroomAvailableStat) []*pb.BuildingAvailableStat {
+	var (
+		buildings []*pb.BuildingAvailableStat
+		others    = &pb.BuildingAvailableStat{Name: "其他", Floors: []*pb.FloorAvailableStat{{}}}
+		index     = make(map[string]*pb.BuildingAvailableStat)
+		floors    = make(map[model.ClassroomLocation]*pb.FloorAvailableStat)
+	)
+	for _, stat := range stats {
+		loc, ok := tool.ParseClassroom(stat.Classroom)
+		if !ok {
+			others.Floors[0].Stat = append(others.Floors[0].Stat, stat)
+			continue
+		}
+		b, ok := index[loc.Building]
+		if !ok {
+			b = &pb.BuildingAvailableStat{
+				Campus:   int32(loc.Campus),
+				Building: loc.Building,
+				Name:     tool.BuildingName(loc.Building),
+			}
+			index[loc.Building] = b
+			buildings = append(buildings, b)
+		}
+		floor, ok := floors[loc]
+		if !ok {
+			floor = &pb.FloorAvailableStat{Floor: int32(loc.Floor)}
+			floors[loc] = floor
+			b.Floors = append(b.Floors, floor)
+		}
+		floor.Stat = append(floor.Stat, stat)
+	}
+
+	for _, b := range buildings {
+		sort.Slice(b.Floors, func(i, j int) bool {
+			return b.Floors[i].Floor < b.Floors[j].Floor
+		})
+	}
+
+	sort.SliceStable(buildings, func(i, j int) bool {
+		if buildings[i].Campus != buildings[j].Campus {
+			return buildings[i].Campus < buildings[j].Campus
+		}
+		if len(buildings[i].Building) != len(buildings[j].Building) {
+			return len(buildings[i].Building) < len(buildings[j].Building)
+		}
+		return buildings[i].Building < buildings[j].Building
+	})
+	if len(others.Floors[0].Stat) > 0 {
+		buildings = append(buildings, others)
+	}
+	return buildings
+}
+
+func (s *FreeClassroomSvc) GetClassroomCatalog(ctx context.Context, req *pb.GetClassroomCatalogReq) (*pb.GetClassroomCatalogResp, error) {
+	campuses, err := s.searcher.GetClassroomCatalog(ctx)
+	if err != nil {
+		return &pb.GetClassroomCatalogResp{}, errcode.Err_FreeClassroomSearch
+	}
+
+	var res = make([]*pb.Campus, 0, len(campuses))
+	for _, campus := range campuses {
+		c := &pb.Campus{Id: int32(campus.ID), Name: campus.Name}
+		for _, building := range campus.Buildings {
+			b := &pb.Building{Id: building.ID, Name: building.Name}
+			for _, floor := range building.Floors {
+				b.Floors = append(b.Floors, int32(floor))
+			}
+			c.Buildings = append(c.Buildings, b)
+		}
+		res = append(res, c)
+	}
+	return &pb.GetClassroomCatalogResp{Campuses: res}, nil
+}
+
+const (
+	// MaxSection 一天的最大节次
+	MaxSection = 12
+
+	defaultQuietClassroomLimit = 5
+	maxQuietClassroomLimit     = 20
+)
+
+func (s *FreeClassroomSvc) RecommendQuietClassroom(ctx context.Context, req *pb.RecommendQuietClassroomReq) (*pb.RecommendQuietClassroomResp, error) {
+	if req.Day < 1 || req.Day > 7 || req.Week < 1 {
+		return &pb.RecommendQuietClassroomResp{}, errors.New("invalid week or day")
+	}
+	if req.StartSection < 1 || req.Duration < 1 || req.StartSection+req.Duration-1 > MaxSection {
+		return &pb.RecommendQuietClassroomResp{}, errors.New("invalid startSection or duration")
+	}
+	if req.WherePrefix == "" {
+		return &pb.RecommendQuietClassroomResp{}, errors.New("wherePrefix is required")
+	}
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultQuietClassroomLimit
+	}
+	limit = min(limit, maxQuietClassroomLimit)
+
+	classrooms, err := s.searcher.RecommendQuietClassroom(ctx, QuietClassroomQuery{
+		Year:         req.Year,
+		Semester:     req.Semester,
+		StuID:        req.StuID,
+		Week:         int(req.Week),
+		Day:          int(req.Day),
+		StartSection: int(req.StartSection),
+		Duration:     int(req.Duration),
+		WherePrefix:  req.WherePrefix,
+		Location:     req.Location,
+		Limit:        limit,
+	})
+	if err != nil {
+		return &pb.RecommendQuietClassroomResp{}, err
+	}
+
+	var res = make([]*pb.QuietClassroom, 0, len(classrooms))
+	for _, c := range classrooms {
+		res = append(res, &pb.QuietClassroom{
+			Classroom:        c.Classroom,
+			FreeStart:        int32(c.FreeStart),
+			FreeEnd:          int32(c.FreeEnd),
+			OccupiedSections: int32(c.OccupiedSections),
+			Score:            c.Score,
+			Reasons:          c.Reasons,
+		})
+	}
+	return &pb.RecommendQuietClassroomResp{
+		Classrooms: res,
 	}, nil
 }
+
+func (s *FreeClassroomSvc) GetClassroomSchedule(ctx context.Context, req *pb.GetClassroomScheduleReq) (*pb.GetClassroomScheduleResp, error) {
+	if req.Classroom == "" || req.Week < 1 {
+		return &pb.GetClassroomScheduleResp{}, errors.New("classroom and week are required")
+	}
+	schedule, err := s.detailer.GetClassroomSchedule(ctx, req.Year, req.Semester, req.Classroom, int(req.Week))
+	if err != nil {
+		return &pb.GetClassroomScheduleResp{}, errcode.Err_ClassroomSchedule
+	}
+
+	var slots = make([]*pb.ClassroomSlot, 0, len(schedule.Slots))
+	for _, slot := range schedule.Slots {
+		var classes = make([]*pb.OccupyingClass, 0, len(slot.Classes))
+		for _, info := range slot.Classes {
+			classes = append(classes, &pb.OccupyingClass{
+				Id:           info.ID,
+				Classname:    info.Classname,
+				Teacher:      info.Teacher,
+				ClassWhen:    info.ClassWhen,
+				WeekDuration: info.WeekDuration,
+			})
+		}
+		slots = append(slots, &pb.ClassroomSlot{
+			Day:     int32(slot.Day),
+			Section: int32(slot.Section),
+			Classes: classes,
+		})
+	}
+
+	var meta *pb.ClassroomMeta
+	if schedule.Meta != nil {
+		meta = &pb.ClassroomMeta{
+			Classroom: schedule.Meta.Where,
+			Capacity:  int32(schedule.Meta.Capacity),
+			Equipment: schedule.Meta.Equipment,
+			Note:      schedule.Meta.Note,
+			UpdatedAt: schedule.Meta.UpdatedAt,
+		}
+	}
+	return &pb.GetClassroomScheduleResp{
+		Meta:  meta,
+		Slots: slots,
+	}, nil
+}
+
+func (s *FreeClassroomSvc) SaveClassroomMeta(ctx context.Context, req *pb.SaveClassroomMetaReq) (*pb.SaveClassroomMetaResp, error) {
+	meta := req.GetMeta()
+	if meta.GetClassroom() == "" || meta.GetCapacity() < 0 {
+		return &pb.SaveClassroomMetaResp{}, errors.New("invalid classroom meta")
+	}
+	err := s.detailer.SaveClassroomMeta(ctx, model.ClassroomMeta{
+		Where:     meta.GetClassroom(),
+		Capacity:  int(meta.GetCapacity()),
+		Equipment: meta.GetEquipment(),
+		Note:      meta.GetNote(),
+	})
+	if err != nil {
+		return &pb.SaveClassroomMetaResp{}, errcode.Err_ClassroomMeta
+	}
+	return &pb.SaveClassroomMetaResp{}, nil
+}
+
+func (s *FreeClassroomSvc) DeleteClassroomMeta(ctx context.Context, req *pb.DeleteClassroomMetaReq) (*pb.DeleteClassroomMetaResp, error) {
+	if req.Classroom == "" {
+		return &pb.DeleteClassroomMetaResp{}, errors.New("classroom is required")
+	}
+	if err := s.detailer.DeleteClassroomMeta(ctx, req.Classroom); err != nil {
+		return &pb.DeleteClassroomMetaResp{}, errcode.Err_ClassroomMeta
+	}
+	return &pb.DeleteClassroomMetaResp{}, nil
+}
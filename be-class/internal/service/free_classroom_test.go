@@ -0,0 +1,43 @@
+package service
+
+import (
+	"testing"
+
+	pb "github.com/asynccnu/ccnubox-be/be-api/gen/proto/classService/v1"
+	"github.com/asynccnu/ccnubox-be/be-class/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupByBuilding(t *testing.T) {
+	var stats []*pb.ClassroomAvailableStat
+	for _, classroom := range []string{"10101", "3201", "3101", "n101", "7101", "3102", "体育场"} {
+		stats = append(stats, &pb.ClassroomAvailableStat{Classroom: classroom})
+	}
+
+	buildings := groupByBuilding(stats)
+	require.Len(t, buildings, 5)
+
+	var got []string
+	for _, b := range buildings {
+		got = append(got, b.Name)
+	}
+	assert.Equal(t, []string{"3号教学楼", "7号教学楼", "10号教学楼", "南湖综合楼", "其他"}, got)
+
+	assert.Equal(t, int32(model.CampusMain), buildings[0].Campus)
+	require.Len(t, buildings[0].Floors, 2)
+	assert.Equal(t, int32(1), buildings[0].Floors[0].Floor)
+	assert.Len(t, buildings[0].Floors[0].Stat, 2)
+	assert.Equal(t, "3201", buildings[0].Floors[1].Stat[0].Classroom)
+	assert.Equal(t, int32(model.CampusNanhu), buildings[3].Campus)
+	assert.Equal(t, "体育场", buildings[4].Floors[0].Stat[0].Classroom)
+}
+
+func TestCheckClassroomLocation(t *testing.T) {
+	assert.NoError(t, checkClassroomLocation("n1", model.ClassroomLocation{}))
+	assert.NoError(t, checkClassroomLocation("", model.ClassroomLocation{Campus: model.CampusNanhu}))
+	assert.NoError(t, checkClassroomLocation("", model.ClassroomLocation{Building: "7", Floor: 2}))
+	assert.Error(t, checkClassroomLocation("", model.ClassroomLocation{}))
+	assert.Error(t, checkClassroomLocation("", model.ClassroomLocation{Campus: model.CampusMain, Floor: 2}))
+	assert.Error(t, checkClassroomLocation("", model.ClassroomLocation{Campus: model.CampusMain, Building: "n"}))
+}
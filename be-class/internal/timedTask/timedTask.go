@@ -5,11 +5,9 @@ import (
 	"github.com/asynccnu/ccnubox-be/be-class/internal/biz"
 	"github.com/asynccnu/ccnubox-be/be-class/internal/client"
 	clog "github.com/asynccnu/ccnubox-be/be-class/internal/log"
-	"github.com/asynccnu/ccnubox-be/be-class/internal/pkg/semesterInfo"
 	"github.com/asynccnu/ccnubox-be/be-class/internal/pkg/tool"
 	"github.com/google/wire"
 	"github.com/robfig/cron/v3"
-	"strconv"
 	"time"
 )
 
@@ -19,27 +17,46 @@ var ProviderSet = wire.NewSet(NewTask)
 type Task struct {
 	classServiceUserCase *biz.ClassServiceUserCase
 	freeClassroomBiz     *biz.FreeClassroomBiz
-	classlistService     *client.ClassListService
+	calendar             *client.CalendarService
 	c                    *cron.Cron
 }
 
-func NewTask(classServiceUserCase *biz.ClassServiceUserCase, freeClassroomBiz *biz.FreeClassroomBiz, classlistService *client.ClassListService) *Task {
+func NewTask(classServiceUserCase *biz.ClassServiceUserCase, freeClassroomBiz *biz.FreeClassroomBiz, calendar *client.CalendarService) *Task {
 	return &Task{
 		classServiceUserCase: classServiceUserCase,
 		freeClassroomBiz:     freeClassroomBiz,
-		classlistService:     classlistService,
+		calendar:             calendar,
 		c:                    cron.New(),
 	}
 }
 
-// RegisterAddClassInfosToESTask 实现 Task 的 RegisterAddClassInfosToESTask 方法
-func (t Task) RegisterAddClassInfosToESTask() {
+// currentSemester 从校历获取当前的学年和学期,校历不可用时按月份推算
+func (t Task) currentSemester(ctx context.Context) (xnm, xqm string) {
+	day, err := t.calendar.GetSemesterDay(ctx, "")
+	if err != nil {
+		clog.LogPrinter.Warnf("get semester from calendar failed, fall back to month-based semester: %v", err)
+		return tool.GetXnmAndXqm(time.Now())
+	}
+	return day.Year, day.Semester
+}
+
+// RegisterSyncClassInfoTask 消费be-classlist的课程变更事件实时更新索引,并定时对账修复遗漏的变更
+func (t Task) RegisterSyncClassInfoTask() {
 	ctx := context.Background()
-	//程序开始时先执行一次
+
 	go func() {
-		xnm, xqm := tool.GetXnmAndXqm(time.Now())
-		clog.LogPrinter.Info("开始执行 RegisterAddClassInfosToESTask 任务")
-		t.classServiceUserCase.AddClassInfosToES(ctx, xnm, xqm)
+		if err := t.classServiceUserCase.SyncClassEvents(); err != nil {
+			clog.LogPrinter.Errorf("consume class events failed: %v", err)
+		}
+	}()
+
+	//程序开始时先执行一次,索引为空时会补全所有课程
+	go func() {
+		xnm, xqm := t.currentSemester(ctx)
+		clog.LogPrinter.Info("开始执行 ReconcileClassInfos 任务")
+		if _, err := t.classServiceUserCase.ReconcileClassInfos(ctx, xnm, xqm); err != nil {
+			clog.LogPrinter.Errorf("reconcile class_info failed: %v", err)
+		}
 
 		clog.LogPrinter.Info("等待数据刷新")
 		//等待数据刷新
@@ -49,11 +66,21 @@ func (t Task) RegisterAddClassInfosToESTask() {
 		_ = t.freeClassroomBiz.SaveFreeClassRoomFromLocal(ctx, xnm, xqm)
 	}()
 
-	// 每天凌晨 3 点执行
-	err := t.AddTask("0 3 * * *", func() {
-		xnm, xqm := tool.GetXnmAndXqm(time.Now())
-		clog.LogPrinter.Info("开始执行 AddClassInfosToES 任务")
-		t.classServiceUserCase.AddClassInfosToES(ctx, xnm, xqm)
+	// 每6小时对账一次
+	err := t.AddTask("0 */6 * * *", func() {
+		xnm, xqm := t.currentSemester(ctx)
+		clog.LogPrinter.Info("开始执行 ReconcileClassInfos 任务")
+		if _, err := t.classServiceUserCase.ReconcileClassInfos(ctx, xnm, xqm); err != nil {
+			clog.LogPrinter.Errorf("reconcile class_info failed: %v", err)
+		}
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	// 每天凌晨 3 点根据课程重新计算教室占用
+	err = t.AddTask("0 3 * * *", func() {
+		xnm, xqm := t.currentSemester(ctx)
 		clog.LogPrinter.Info("开始执行 SaveFreeClassRoomFromLocal 任务")
 		_ = t.freeClassroomBiz.SaveFreeClassRoomFromLocal(ctx, xnm, xqm)
 	})
@@ -69,7 +96,7 @@ func (t Task) RegisterClearClassInfoTask() {
 	// 每天凌晨5点执行（5字段格式）
 	err := t.AddTask("0 5 * * *", func() {
 		clog.LogPrinter.Info("开始执行 ClearClassInfo 任务")
-		xnm, xqm := tool.GetXnmAndXqm(time.Now())
+		xnm, xqm := t.currentSemester(ctx)
 		t.classServiceUserCase.DeleteSchoolClassInfosFromES(ctx, xnm, xqm)
 		_ = t.freeClassroomBiz.ClearClassroomOccupancyFromES(ctx, xnm, xqm)
 	})
@@ -81,25 +108,25 @@ func (t Task) RegisterClearClassInfoTask() {
 func (t Task) RegisterCrawFreeClassroomTask(stuId string) {
 	ctx := context.Background()
 
-	schoolTime, err := t.classlistService.GetSchoolDay(ctx)
-	if err != nil {
-		clog.LogPrinter.Errorf("get school day failed: %v", err)
-		return
-	}
-	si, err := semesterInfo.GetSemesterInfo(schoolTime)
-	if err != nil {
-		clog.LogPrinter.Errorf("get semester info failed: %v", err)
-		return
+	// 每次执行时重新查询校历,保证加载的是当周的空教室
+	load := func() {
+		day, err := t.calendar.GetSemesterDay(ctx, "")
+		if err != nil {
+			clog.LogPrinter.Errorf("get semester day failed: %v", err)
+			return
+		}
+		if !day.InSemester {
+			clog.LogPrinter.Infof("not in semester[%s-%s], skip loading free classroom", day.Year, day.Semester)
+			return
+		}
+		t.freeClassroomBiz.LoadOneWeekFreeClassRoom(ctx, stuId, day.Year, day.Semester, day.Week)
 	}
-	go func() {
-		// 程序开始时先执行一次
-		t.freeClassroomBiz.LoadOneWeekFreeClassRoom(ctx, stuId, strconv.Itoa(si.Year), strconv.Itoa(si.Semester), si.WeekNumber)
-	}()
+
+	// 程序开始时先执行一次
+	go load()
 
 	// 每周一4点执行
-	err = t.AddTask("0 4 * * 1", func() {
-		t.freeClassroomBiz.LoadOneWeekFreeClassRoom(ctx, stuId, strconv.Itoa(si.Year), strconv.Itoa(si.Semester), si.WeekNumber)
-	})
+	err := t.AddTask("0 4 * * 1", load)
 	if err != nil {
 		panic(err)
 	}
@@ -31,7 +31,8 @@ var (
 )
 
 func init() {
-	prometheus.MustRegister(metrics.Counter, metrics.Summary)
+	prometheus.MustRegister(metrics.Counter, metrics.Summary,
+		metrics.CrawlerCounter, metrics.CrawlerSummary, metrics.CrawlerHealthGauge, metrics.CrawlerDemotedGauge)
 	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
 }
 
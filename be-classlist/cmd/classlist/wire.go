@@ -17,6 +17,7 @@ import (
 	"github.com/asynccnu/ccnubox-be/be-classlist/internal/registry"
 	"github.com/asynccnu/ccnubox-be/be-classlist/internal/server"
 	"github.com/asynccnu/ccnubox-be/be-classlist/internal/service"
+	"github.com/asynccnu/ccnubox-be/common/queue"
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/wire"
@@ -32,10 +33,12 @@ func wireApp(*conf.Server, *conf.Data, *conf.Registry, *conf.SchoolDay, *conf.De
 		service.ProviderSet,
 		client.ProviderSet,
 		newApp,
-		wire.Bind(new(biz.ClassCrawler), new(*crawler.Crawler)),
+		wire.Bind(new(biz.ClassCrawler), new(*crawler.CompositeCrawler)),
+		wire.Bind(new(biz.CrawlerHealthReporter), new(*crawler.CompositeCrawler)),
 		wire.Bind(new(biz.RefreshLogRepo), new(*data.RefreshLogRepo)),
-		wire.Bind(new(biz.DelayQueue), new(*data.DelayKafka)),
+		wire.Bind(new(biz.DelayQueue), new(*queue.RedisDelayQueue)),
 		wire.Bind(new(biz.CCNUServiceProxy), new(*client.CCNUService)),
+		wire.Bind(new(service.SemesterProvider), new(*client.CalendarService)),
 		wire.Bind(new(biz.ClassRepo), new(*data.ClassRepo)),
 		wire.Bind(new(biz.JxbRepo), new(*data.JxbDBRepo)),
 		wire.Bind(new(data.Transaction), new(*data.Data)),
@@ -15,6 +15,7 @@ import (
 	"github.com/asynccnu/ccnubox-be/be-classlist/internal/registry"
 	"github.com/asynccnu/ccnubox-be/be-classlist/internal/server"
 	"github.com/asynccnu/ccnubox-be/be-classlist/internal/service"
+	"github.com/asynccnu/ccnubox-be/common/queue"
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/log"
 	"io"
@@ -40,30 +41,42 @@ func wireApp(confServer *conf.Server, confData *conf.Data, confRegistry *conf.Re
 	studentAndCourseDBRepo := data.NewStudentAndCourseDBRepo(dataData)
 	studentAndCourseCacheRepo := data.NewStudentAndCourseCacheRepo(redisClient, confServer)
 	studentAndCourseRepo := data.NewStudentAndCourseRepo(studentAndCourseDBRepo, studentAndCourseCacheRepo)
-	classRepo := data.NewClassRepo(classInfoRepo, dataData, studentAndCourseRepo)
+	kafkaProducerBuilder := data.NewKafkaProducerBuilder(confData)
+	classEventProducer, cleanup2, err := data.NewClassEventProducer(confData, kafkaProducerBuilder, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	classRepo := data.NewClassRepo(classInfoRepo, dataData, studentAndCourseRepo, classEventProducer)
 	crawlerCrawler := crawler.NewClassCrawler()
+	crawler2 := crawler.NewClassCrawler2()
+	compositeCrawler := crawler.NewCompositeCrawler(confServer, crawlerCrawler, crawler2)
 	jxbDBRepo := data.NewJxbDBRepo(dataData, logger)
 	etcdRegistry := registry.NewRegistrarServer(confRegistry, logger)
 	userServiceClient, err := client.NewClient(etcdRegistry, confRegistry, logger)
 	if err != nil {
+		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
 	ccnuService := client.NewCCNUService(userServiceClient)
-	kafkaProducerBuilder := data.NewKafkaProducerBuilder(confData)
-	kafkaConsumerBuilder := data.NewKafkaConsumerBuilder(confData)
-	delayKafkaConfig := data.NewDelayKafkaConfig()
-	delayKafka, cleanup2, err := data.NewDelayKafka(kafkaProducerBuilder, kafkaConsumerBuilder, delayKafkaConfig, logger)
+	delayQueueConfig := data.NewDelayQueueConfig()
+	redisDelayQueue, cleanup3 := queue.NewRedisDelayQueue(redisClient, delayQueueConfig, logger)
+	refreshLogRepo := data.NewRefreshLogRepo(db, confServer)
+	classUsecase, cleanup4 := biz.NewClassUsecase(classRepo, compositeCrawler, compositeCrawler, jxbDBRepo, ccnuService, redisDelayQueue, refreshLogRepo, confServer)
+	calendarService, err := client.NewCalendarService(etcdRegistry, confRegistry, logger)
 	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
-	refreshLogRepo := data.NewRefreshLogRepo(db, confServer)
-	classUsecase, cleanup3 := biz.NewClassUsecase(classRepo, crawlerCrawler, jxbDBRepo, ccnuService, delayKafka, refreshLogRepo, confServer)
-	classListService := service.NewClasserService(classUsecase, schoolDay, logger, defaults)
+	classListService := service.NewClasserService(classUsecase, calendarService, schoolDay, logger, defaults)
 	grpcServer := server.NewGRPCServer(confServer, classListService, logger)
 	app := newApp(logger, grpcServer, etcdRegistry)
 	return app, func() {
+		cleanup4()
 		cleanup3()
 		cleanup2()
 		cleanup()
@@ -18,6 +18,11 @@ type ClassCrawler interface {
 	GetClassInfoForGraduateStudent(ctx context.Context, stuID, year, semester, cookie string) ([]*ClassInfo, []*StudentCourse, error)
 }
 
+type CrawlerHealthReporter interface {
+	//获取各爬虫策略的健康状态
+	Health() []*CrawlerHealth
+}
+
 type ClassRepo interface {
 	GetClassesFromLocal(ctx context.Context, stuID, year, semester string) ([]*ClassInfo, error)
 	GetSpecificClassInfo(ctx context.Context, classID string) (*ClassInfo, error)
@@ -36,6 +41,7 @@ type ClassRepo interface {
 	IsClassOfficial(ctx context.Context, stuID, year, semester, classID string) bool
 	GetClassNote(ctx context.Context, stuID, year, semester, classID string) string
 	UpdateClassNote(ctx context.Context, stuID, year, semester, classID, note string) error
+	GetTerms(ctx context.Context, stuID string) ([]*Term, error)
 }
 
 type JxbRepo interface {
@@ -63,3 +69,9 @@ type DelayQueue interface {
 	Consume(groupID string, f func(key, value []byte)) error
 	Close()
 }
+
+// RetryableDelayQueue 支持按处理结果重试的延迟队列,f返回错误时任务会在退避后重新投递
+type RetryableDelayQueue interface {
+	DelayQueue
+	ConsumeWithRetry(groupID string, f func(key, value []byte) error) error
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"math/rand"
+	"sort"
 	"sync"
 	"time"
 
@@ -20,11 +21,12 @@ import (
 )
 
 type ClassUsecase struct {
-	classRepo ClassRepo
-	crawler   ClassCrawler
-	ccnu      CCNUServiceProxy
-	jxbRepo   JxbRepo
-	delayQue  DelayQueue
+	classRepo     ClassRepo
+	crawler       ClassCrawler
+	crawlerHealth CrawlerHealthReporter
+	ccnu          CCNUServiceProxy
+	jxbRepo       JxbRepo
+	delayQue      DelayQueue
 
 	refreshLogRepo  RefreshLogRepo
 	waitCrawTime    time.Duration
@@ -43,7 +45,7 @@ func (cluc *ClassUsecase) Close() {
 	}
 }
 
-func NewClassUsecase(classRepo ClassRepo, crawler ClassCrawler,
+func NewClassUsecase(classRepo ClassRepo, crawler ClassCrawler, crawlerHealth CrawlerHealthReporter,
 	JxbRepo JxbRepo, Cs CCNUServiceProxy, delayQue DelayQueue, refreshLog RefreshLogRepo,
 	cf *conf.Server) (*ClassUsecase, func()) {
 
@@ -63,6 +65,7 @@ func NewClassUsecase(classRepo ClassRepo, crawler ClassCrawler,
 	cluc := &ClassUsecase{
 		classRepo:       classRepo,
 		crawler:         crawler,
+		crawlerHealth:   crawlerHealth,
 		jxbRepo:         JxbRepo,
 		delayQue:        delayQue,
 		ccnu:            Cs,
@@ -78,7 +81,13 @@ func NewClassUsecase(classRepo ClassRepo, crawler ClassCrawler,
 	}
 	// 开启一个协程来处理重试消息
 	go func() {
-		if err := cluc.delayQue.Consume("be-classlist-refresh-retry", cluc.handleRetryMsg); err != nil {
+		var err error
+		if rq, ok := cluc.delayQue.(RetryableDelayQueue); ok {
+			err = rq.ConsumeWithRetry("be-classlist-refresh-retry", cluc.retryRefresh)
+		} else {
+			err = cluc.delayQue.Consume("be-classlist-refresh-retry", cluc.handleRetryMsg)
+		}
+		if err != nil && !errors.Is(err, context.Canceled) {
 			classLog.GlobalLogHelper.Errorf("Error consuming retry message: %v", err)
 		}
 	}()
@@ -301,10 +310,43 @@ wrapRes: //包装结果
 	return classInfos, lastRefreshTime, nil
 }
 
-func (cluc *ClassUsecase) AddClass(ctx context.Context, stuID string, info *ClassInfo) error {
+// AddClass 手动添加课程,force为false时若与已有课程时间冲突则拒绝添加
+func (cluc *ClassUsecase) AddClass(ctx context.Context, stuID string, info *ClassInfo, force bool) error {
+	logh := classLog.GetLogHelperFromCtx(ctx)
+	if !force {
+		conflicts, err := cluc.CheckConflict(ctx, stuID, info, "")
+		if err != nil {
+			return err
+		}
+		if len(conflicts) > 0 {
+			logh.Warnf("class [%v] conflicts with %d existing classes", info.ID, len(conflicts))
+			return errcode.ErrClassConflict
+		}
+	}
 	return cluc.addClass(ctx, stuID, info, true)
 }
 
+// CheckConflict 检查候选课程与学生该学期已有课程是否存在时间冲突,excludeID用于排除候选课程自身
+func (cluc *ClassUsecase) CheckConflict(ctx context.Context, stuID string, info *ClassInfo, excludeID string) ([]*ClassConflict, error) {
+	existing, err := cluc.classRepo.GetClassesFromLocal(ctx, stuID, info.Year, info.Semester)
+	if err != nil {
+		//该学期还没有任何课程,自然不存在冲突
+		if errors.Is(err, errcode.ErrClassNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	others := make([]*ClassInfo, 0, len(existing))
+	for _, ci := range existing {
+		if ci.ID == excludeID || ci.ID == info.ID {
+			continue
+		}
+		others = append(others, ci)
+	}
+	return FindConflicts(info, others), nil
+}
+
 func (cluc *ClassUsecase) DeleteClass(ctx context.Context, stuID, year, semester, classId string) error {
 	logh := classLog.GetLogHelperFromCtx(ctx)
 
@@ -526,27 +568,33 @@ func (cluc *ClassUsecase) sendRetryMsg(stuID, year, semester string) error {
 
 // 处理重试消息
 func (cluc *ClassUsecase) handleRetryMsg(key, val []byte) {
+	_ = cluc.retryRefresh(key, val)
+}
+
+// retryRefresh 重新爬取课表,返回的错误会让支持重试的延迟队列稍后再次投递
+// 消息本身格式错误时重试没有意义,只记录日志并返回nil
+func (cluc *ClassUsecase) retryRefresh(key, val []byte) error {
 	var retryInfo = map[string]string{}
 
 	err := json.Unmarshal(val, &retryInfo)
 	if err != nil {
 		classLog.GlobalLogHelper.Errorf("Error unmarshalling retry info: %v", string(val))
-		return
+		return nil
 	}
 	stuID, ok := retryInfo["stu_id"]
 	if !ok {
 		classLog.GlobalLogHelper.Errorf("Error getting stu_id from retry info: %v", string(val))
-		return
+		return nil
 	}
 	year, ok := retryInfo["year"]
 	if !ok {
 		classLog.GlobalLogHelper.Errorf("Error getting year from retry info: %v", string(val))
-		return
+		return nil
 	}
 	semester, ok := retryInfo["semester"]
 	if !ok {
 		classLog.GlobalLogHelper.Errorf("Error getting semester from retry info: %v", string(val))
-		return
+		return nil
 	}
 
 	valLogger := log.With(classLog.GlobalLogger,
@@ -557,24 +605,25 @@ func (cluc *ClassUsecase) handleRetryMsg(key, val []byte) {
 	crawClassInfos_, crawScs, crawErr := cluc.getCourseFromCrawler(ctx, stuID, year, semester)
 	if crawErr != nil {
 		classLog.GlobalLogHelper.Errorf("Error retry getting class info from crawler: %v", crawErr)
-		return
+		return crawErr
 	}
 
 	//保存课程信息
 	saveErr := cluc.classRepo.SaveClass(ctx, stuID, year, semester, crawClassInfos_, crawScs)
 	if saveErr != nil {
 		classLog.GlobalLogHelper.Errorf("Error after retry getting class,but saving class info to database: %v", saveErr)
-		return
+		return saveErr
 	}
 
 	//插入一条log
 	logID, insertLogErr := cluc.refreshLogRepo.InsertRefreshLog(ctx, stuID, year, semester)
 	if insertLogErr != nil {
 		classLog.GlobalLogHelper.Errorf("Error after retry getting class, but inserting refresh log: %v", insertLogErr)
-		return
+		return insertLogErr
 	}
 	//更新日志状态
 	_ = cluc.refreshLogRepo.UpdateRefreshLogStatus(ctx, logID, do.Ready)
+	return nil
 }
 
 // goroutineSafeRandIntn 用于在多协程环境中安全地生成随机数
@@ -608,6 +657,85 @@ func (cluc *ClassUsecase) UpdateClassNote(ctx context.Context, stuID, year, seme
 	return nil
 }
 
+// GetTerms 获取学生有课表数据的所有学期,按学年学期倒序排列
+func (cluc *ClassUsecase) GetTerms(ctx context.Context, stuID string) ([]*Term, error) {
+	terms, err := cluc.classRepo.GetTerms(ctx, stuID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	for _, term := range terms {
+		term.LastRefreshTime = cluc.refreshLogRepo.GetLastRefreshTime(ctx, stuID, term.Year, term.Semester, now)
+	}
+	sort.Slice(terms, func(i, j int) bool {
+		if terms[i].Year != terms[j].Year {
+			return terms[i].Year > terms[j].Year
+		}
+		return terms[i].Semester > terms[j].Semester
+	})
+	return terms, nil
+}
+
+// CopyAddedClasses 将源学期手动添加的课程复制到目标学期
+// 目标学期已存在或存在时间冲突(force为false时)的课程会被跳过,withNote为true时同时复制课程备注
+func (cluc *ClassUsecase) CopyAddedClasses(ctx context.Context, stuID, fromYear, fromSemester, toYear, toSemester string,
+	withNote, force bool) (copied []*ClassInfo, skipped []*ClassInfo, err error) {
+	logh := classLog.GetLogHelperFromCtx(ctx)
+
+	added, err := cluc.classRepo.GetAddedClasses(ctx, stuID, fromYear, fromSemester)
+	if err != nil {
+		return nil, nil, errcode.ErrClassFound
+	}
+
+	for _, src := range added {
+		info := *src
+		info.Year = toYear
+		info.Semester = toSemester
+		info.UpdateID()
+
+		err := cluc.AddClass(ctx, stuID, &info, force)
+		if errors.Is(err, errcode.ErrClassIsExist) || errors.Is(err, errcode.ErrClassConflict) {
+			skipped = append(skipped, &info)
+			continue
+		}
+		if err != nil {
+			logh.Errorf("copy class [%v] to [%v %v] failed:%v", src.ID, toYear, toSemester, err)
+			return copied, skipped, err
+		}
+
+		if withNote {
+			note := cluc.classRepo.GetClassNote(ctx, stuID, fromYear, fromSemester, src.ID)
+			if note != "" {
+				if err := cluc.classRepo.UpdateClassNote(ctx, stuID, toYear, toSemester, info.ID, note); err != nil {
+					logh.Warnf("copy note of class [%v] to [%v] failed:%v", src.ID, info.ID, err)
+				} else {
+					info.Note = note
+				}
+			}
+		}
+		copied = append(copied, &info)
+	}
+	return copied, skipped, nil
+}
+
+// ImportClasses 批量导入手动添加的课程,返回与infos一一对应的错误,导入成功的位置为nil
+func (cluc *ClassUsecase) ImportClasses(ctx context.Context, stuID string, infos []*ClassInfo, force bool) []error {
+	logh := classLog.GetLogHelperFromCtx(ctx)
+	errs := make([]error, len(infos))
+	for i, info := range infos {
+		errs[i] = cluc.AddClass(ctx, stuID, info, force)
+		if errs[i] != nil {
+			logh.Warnf("import class [%v] failed:%v", info.ID, errs[i])
+		}
+	}
+	return errs
+}
+
+// GetCrawlerHealth 获取各爬虫策略的健康状态
+func (cluc *ClassUsecase) GetCrawlerHealth() []*CrawlerHealth {
+	return cluc.crawlerHealth.Health()
+}
+
 // Student 学生接口
 type Student interface {
 	GetClass(ctx context.Context, stuID, year, semester, cookie string, craw ClassCrawler) ([]*ClassInfo, []*StudentCourse, error)
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"strings"
 	"time"
+
+	"github.com/asynccnu/ccnubox-be/be-classlist/internal/pkg/tool"
 )
 
 type ClassInfo struct {
@@ -23,6 +25,7 @@ type ClassInfo struct {
 	Year         string  //学年
 	Note         string  //备注
 	IsOfficial   bool    // 是否为官方课程
+	Nature       string  //课程性质,如"专业主干课程",只有从教务系统爬取的课程才有
 }
 
 func (ci *ClassInfo) UpdateID() {
@@ -34,6 +37,60 @@ func (ci *ClassInfo) UpdateJxbId() {
 	ci.JxbId = strings.Join(strs, ":")
 }
 
+// ClassSlot 课程占用的一个时间段,即第几周的星期几的第几节
+type ClassSlot struct {
+	Week    int64
+	Day     int64
+	Section int64
+}
+
+// ClassConflict 与候选课程时间重叠的已有课程
+type ClassConflict struct {
+	Info  *ClassInfo
+	Slots []ClassSlot //重叠的时间段
+}
+
+// Slots 展开课程所占用的所有时间段
+func (ci *ClassInfo) Slots() []ClassSlot {
+	weeks := tool.ParseWeeks(ci.Weeks)
+	sections := tool.ParseClassWhen(ci.ClassWhen)
+	slots := make([]ClassSlot, 0, len(weeks)*len(sections))
+	for _, week := range weeks {
+		for _, section := range sections {
+			slots = append(slots, ClassSlot{Week: int64(week), Day: ci.Day, Section: int64(section)})
+		}
+	}
+	return slots
+}
+
+// FindConflicts 找出existing中与candidate时间重叠的课程
+func FindConflicts(candidate *ClassInfo, existing []*ClassInfo) []*ClassConflict {
+	occupied := make(map[ClassSlot]struct{})
+	for _, slot := range candidate.Slots() {
+		occupied[slot] = struct{}{}
+	}
+	if len(occupied) == 0 {
+		return nil
+	}
+
+	var conflicts []*ClassConflict
+	for _, info := range existing {
+		if info == nil || info.Day != candidate.Day || info.Weeks&candidate.Weeks == 0 {
+			continue
+		}
+		var overlapped []ClassSlot
+		for _, slot := range info.Slots() {
+			if _, ok := occupied[slot]; ok {
+				overlapped = append(overlapped, slot)
+			}
+		}
+		if len(overlapped) > 0 {
+			conflicts = append(conflicts, &ClassConflict{Info: info, Slots: overlapped})
+		}
+	}
+	return conflicts
+}
+
 type StudentCourse struct {
 	StuID           string //学号
 	ClaID           string //课程ID
@@ -44,3 +101,27 @@ type StudentCourse struct {
 	CreatedAt       time.Time
 	UpdatedAt       time.Time
 }
+
+// Term 学生有课表数据的一个学期
+type Term struct {
+	Year            string     //学年
+	Semester        string     //学期
+	ClassNum        int64      //官方课程数量
+	AddedClassNum   int64      //手动添加的课程数量
+	LastRefreshTime *time.Time //上次成功刷新的时间,从未刷新过为nil
+}
+
+// CrawlerHealth 某一爬虫策略的健康状态
+type CrawlerHealth struct {
+	Name                string        //策略名称
+	StudentType         string        //学生类型,undergraduate或graduate,两者的状态分开统计
+	Healthy             bool          //是否健康,降级中的策略为false
+	SuccessRate         float64       //滑动窗口内的成功率
+	AvgLatency          time.Duration //滑动窗口内的平均耗时
+	Total               int64         //总请求次数
+	Failures            int64         //总失败次数
+	ConsecutiveFailures int64         //连续失败次数
+	DemotedUntil        time.Time     //降级截止时间,未降级为零值
+	LastError           string        //最近一次失败原因
+	LastSuccessTime     time.Time     //最近一次成功的时间
+}
@@ -0,0 +1,46 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	calendarv1 "github.com/asynccnu/ccnubox-be/be-api/gen/proto/calendar/v1"
+	"github.com/asynccnu/ccnubox-be/be-classlist/internal/conf"
+	"github.com/go-kratos/kratos/contrib/registry/etcd/v2"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/middleware/tracing"
+	"github.com/go-kratos/kratos/v2/transport/grpc"
+)
+
+// CalendarService 从be-calendar的校历中获取当前学期
+type CalendarService struct {
+	cs calendarv1.CalendarServiceClient
+}
+
+func NewCalendarService(r *etcd.Registry, cf *conf.Registry, logger log.Logger) (*CalendarService, error) {
+	conn, err := grpc.DialInsecure(
+		context.Background(),
+		grpc.WithEndpoint(cf.Calendarsvc),
+		grpc.WithDiscovery(r),
+		grpc.WithTimeout(3*time.Second), //查询失败时会回退到配置文件,不需要等太久
+		grpc.WithMiddleware(
+			tracing.Client(),
+			recovery.Recovery(),
+		),
+	)
+	if err != nil {
+		log.NewHelper(logger).WithContext(context.Background()).Errorw("kind", "grpc-client", "reason", "GRPC_CLIENT_INIT_ERROR", "err", err)
+		return nil, err
+	}
+	return &CalendarService{cs: calendarv1.NewCalendarServiceClient(conn)}, nil
+}
+
+// GetCurrentSemester 获取今天所在的学期,在假期中时为假期前的学期
+func (c *CalendarService) GetCurrentSemester(ctx context.Context) (*calendarv1.Semester, error) {
+	resp, err := c.cs.GetSemesterDay(ctx, &calendarv1.GetSemesterDayRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetSemester(), nil
+}
@@ -2,4 +2,4 @@ package client
 
 import "github.com/google/wire"
 
-var ProviderSet = wire.NewSet(NewClient, NewCCNUService)
+var ProviderSet = wire.NewSet(NewClient, NewCCNUService, NewCalendarService)
@@ -115,6 +115,7 @@ type Server struct {
 	BlackListExpiration int32                  `protobuf:"varint,6,opt,name=blackListExpiration,proto3" json:"blackListExpiration,omitempty"` // 黑名单过期时间,如果要查询的课程在数据库不存在,列入黑名单,单位s
 	WaitUserSvcTime     int32                  `protobuf:"varint,7,opt,name=waitUserSvcTime,proto3" json:"waitUserSvcTime,omitempty"`         // 等待用户服务的时间,单位ms
 	RefreshInterval     int32                  `protobuf:"varint,8,opt,name=refreshInterval,proto3" json:"refreshInterval,omitempty"`         // 刷新间隔时间,单位s
+	Crawler             *Crawler               `protobuf:"bytes,9,opt,name=crawler,proto3" json:"crawler,omitempty"`
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
@@ -205,6 +206,82 @@ func (x *Server) GetRefreshInterval() int32 {
 	return 0
 }
 
+func (x *Server) GetCrawler() *Crawler {
+	if x != nil {
+		return x.Crawler
+	}
+	return nil
+}
+
+// 组合爬虫的配置
+type Crawler struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Strategies       []string               `protobuf:"bytes,1,rep,name=strategies,proto3" json:"strategies,omitempty"`              // 爬虫策略的尝试顺序,可选 api(教务系统接口), html(本科教务页面解析)
+	FailureThreshold int32                  `protobuf:"varint,2,opt,name=failureThreshold,proto3" json:"failureThreshold,omitempty"` // 连续失败多少次后降级
+	Cooldown         int32                  `protobuf:"varint,3,opt,name=cooldown,proto3" json:"cooldown,omitempty"`                 // 降级持续时间,单位s
+	Window           int32                  `protobuf:"varint,4,opt,name=window,proto3" json:"window,omitempty"`                     // 统计成功率的滑动窗口大小
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Crawler) Reset() {
+	*x = Crawler{}
+	mi := &file_conf_conf_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Crawler) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Crawler) ProtoMessage() {}
+
+func (x *Crawler) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Crawler.ProtoReflect.Descriptor instead.
+func (*Crawler) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Crawler) GetStrategies() []string {
+	if x != nil {
+		return x.Strategies
+	}
+	return nil
+}
+
+func (x *Crawler) GetFailureThreshold() int32 {
+	if x != nil {
+		return x.FailureThreshold
+	}
+	return 0
+}
+
+func (x *Crawler) GetCooldown() int32 {
+	if x != nil {
+		return x.Cooldown
+	}
+	return 0
+}
+
+func (x *Crawler) GetWindow() int32 {
+	if x != nil {
+		return x.Window
+	}
+	return 0
+}
+
 type Data struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Database      *Data_Database         `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
@@ -216,7 +293,7 @@ type Data struct {
 
 func (x *Data) Reset() {
 	*x = Data{}
-	mi := &file_conf_conf_proto_msgTypes[2]
+	mi := &file_conf_conf_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -228,7 +305,7 @@ func (x *Data) String() string {
 func (*Data) ProtoMessage() {}
 
 func (x *Data) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[2]
+	mi := &file_conf_conf_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -241,7 +318,7 @@ func (x *Data) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Data.ProtoReflect.Descriptor instead.
 func (*Data) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{2}
+	return file_conf_conf_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Data) GetDatabase() *Data_Database {
@@ -276,7 +353,7 @@ type Etcd struct {
 
 func (x *Etcd) Reset() {
 	*x = Etcd{}
-	mi := &file_conf_conf_proto_msgTypes[3]
+	mi := &file_conf_conf_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -288,7 +365,7 @@ func (x *Etcd) String() string {
 func (*Etcd) ProtoMessage() {}
 
 func (x *Etcd) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[3]
+	mi := &file_conf_conf_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -301,7 +378,7 @@ func (x *Etcd) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Etcd.ProtoReflect.Descriptor instead.
 func (*Etcd) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{3}
+	return file_conf_conf_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Etcd) GetAddr() string {
@@ -329,13 +406,14 @@ type Registry struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Etcd          *Etcd                  `protobuf:"bytes,1,opt,name=etcd,proto3" json:"etcd,omitempty"`
 	Usersvc       string                 `protobuf:"bytes,2,opt,name=usersvc,proto3" json:"usersvc,omitempty"`
+	Calendarsvc   string                 `protobuf:"bytes,3,opt,name=calendarsvc,proto3" json:"calendarsvc,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Registry) Reset() {
 	*x = Registry{}
-	mi := &file_conf_conf_proto_msgTypes[4]
+	mi := &file_conf_conf_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -347,7 +425,7 @@ func (x *Registry) String() string {
 func (*Registry) ProtoMessage() {}
 
 func (x *Registry) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[4]
+	mi := &file_conf_conf_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -360,7 +438,7 @@ func (x *Registry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Registry.ProtoReflect.Descriptor instead.
 func (*Registry) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{4}
+	return file_conf_conf_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Registry) GetEtcd() *Etcd {
@@ -377,6 +455,13 @@ func (x *Registry) GetUsersvc() string {
 	return ""
 }
 
+func (x *Registry) GetCalendarsvc() string {
+	if x != nil {
+		return x.Calendarsvc
+	}
+	return ""
+}
+
 type ZapLogConfigs struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	LogLevel          string                 `protobuf:"bytes,1,opt,name=log_level,json=logLevel,proto3" json:"log_level,omitempty"`                                 // 日志打印级别 debug, info, warning, error
@@ -394,7 +479,7 @@ type ZapLogConfigs struct {
 
 func (x *ZapLogConfigs) Reset() {
 	*x = ZapLogConfigs{}
-	mi := &file_conf_conf_proto_msgTypes[5]
+	mi := &file_conf_conf_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -406,7 +491,7 @@ func (x *ZapLogConfigs) String() string {
 func (*ZapLogConfigs) ProtoMessage() {}
 
 func (x *ZapLogConfigs) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[5]
+	mi := &file_conf_conf_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -419,7 +504,7 @@ func (x *ZapLogConfigs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ZapLogConfigs.ProtoReflect.Descriptor instead.
 func (*ZapLogConfigs) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{5}
+	return file_conf_conf_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ZapLogConfigs) GetLogLevel() string {
@@ -485,6 +570,7 @@ func (x *ZapLogConfigs) GetLogStdout() bool {
 	return false
 }
 
+// 校历服务不可用时使用的放假和上学日期
 type SchoolDay struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	HolidayTime   string                 `protobuf:"bytes,1,opt,name=holidayTime,proto3" json:"holidayTime,omitempty"` //放假日期(正式放假的第一天)
@@ -495,7 +581,7 @@ type SchoolDay struct {
 
 func (x *SchoolDay) Reset() {
 	*x = SchoolDay{}
-	mi := &file_conf_conf_proto_msgTypes[6]
+	mi := &file_conf_conf_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -507,7 +593,7 @@ func (x *SchoolDay) String() string {
 func (*SchoolDay) ProtoMessage() {}
 
 func (x *SchoolDay) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[6]
+	mi := &file_conf_conf_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -520,7 +606,7 @@ func (x *SchoolDay) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SchoolDay.ProtoReflect.Descriptor instead.
 func (*SchoolDay) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{6}
+	return file_conf_conf_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *SchoolDay) GetHolidayTime() string {
@@ -537,7 +623,7 @@ func (x *SchoolDay) GetSchoolTime() string {
 	return ""
 }
 
-// 前端不传参时使用的默认值
+// 前端不传参且校历服务不可用时使用的默认值
 type Defaults struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Year          string                 `protobuf:"bytes,1,opt,name=year,proto3" json:"year,omitempty"`
@@ -548,7 +634,7 @@ type Defaults struct {
 
 func (x *Defaults) Reset() {
 	*x = Defaults{}
-	mi := &file_conf_conf_proto_msgTypes[7]
+	mi := &file_conf_conf_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -560,7 +646,7 @@ func (x *Defaults) String() string {
 func (*Defaults) ProtoMessage() {}
 
 func (x *Defaults) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[7]
+	mi := &file_conf_conf_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -573,7 +659,7 @@ func (x *Defaults) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Defaults.ProtoReflect.Descriptor instead.
 func (*Defaults) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{7}
+	return file_conf_conf_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Defaults) GetYear() string {
@@ -601,7 +687,7 @@ type Server_GRPC struct {
 
 func (x *Server_GRPC) Reset() {
 	*x = Server_GRPC{}
-	mi := &file_conf_conf_proto_msgTypes[8]
+	mi := &file_conf_conf_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -613,7 +699,7 @@ func (x *Server_GRPC) String() string {
 func (*Server_GRPC) ProtoMessage() {}
 
 func (x *Server_GRPC) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[8]
+	mi := &file_conf_conf_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -662,7 +748,7 @@ type Data_Database struct {
 
 func (x *Data_Database) Reset() {
 	*x = Data_Database{}
-	mi := &file_conf_conf_proto_msgTypes[9]
+	mi := &file_conf_conf_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -674,7 +760,7 @@ func (x *Data_Database) String() string {
 func (*Data_Database) ProtoMessage() {}
 
 func (x *Data_Database) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[9]
+	mi := &file_conf_conf_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -687,7 +773,7 @@ func (x *Data_Database) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Data_Database.ProtoReflect.Descriptor instead.
 func (*Data_Database) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{2, 0}
+	return file_conf_conf_proto_rawDescGZIP(), []int{3, 0}
 }
 
 func (x *Data_Database) GetSource() string {
@@ -730,7 +816,7 @@ type Data_Redis struct {
 
 func (x *Data_Redis) Reset() {
 	*x = Data_Redis{}
-	mi := &file_conf_conf_proto_msgTypes[10]
+	mi := &file_conf_conf_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -742,7 +828,7 @@ func (x *Data_Redis) String() string {
 func (*Data_Redis) ProtoMessage() {}
 
 func (x *Data_Redis) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[10]
+	mi := &file_conf_conf_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -755,7 +841,7 @@ func (x *Data_Redis) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Data_Redis.ProtoReflect.Descriptor instead.
 func (*Data_Redis) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{2, 1}
+	return file_conf_conf_proto_rawDescGZIP(), []int{3, 1}
 }
 
 func (x *Data_Redis) GetAddr() string {
@@ -795,7 +881,7 @@ type Data_Kafka struct {
 
 func (x *Data_Kafka) Reset() {
 	*x = Data_Kafka{}
-	mi := &file_conf_conf_proto_msgTypes[11]
+	mi := &file_conf_conf_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -807,7 +893,7 @@ func (x *Data_Kafka) String() string {
 func (*Data_Kafka) ProtoMessage() {}
 
 func (x *Data_Kafka) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[11]
+	mi := &file_conf_conf_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -820,7 +906,7 @@ func (x *Data_Kafka) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Data_Kafka.ProtoReflect.Descriptor instead.
 func (*Data_Kafka) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{2, 2}
+	return file_conf_conf_proto_rawDescGZIP(), []int{3, 2}
 }
 
 func (x *Data_Kafka) GetBrokers() []string {
@@ -842,7 +928,7 @@ const file_conf_conf_proto_rawDesc = "" +
 	"\bregistry\x18\x03 \x01(\v2\x14.kratos.api.RegistryR\bregistry\x121\n" +
 	"\x06zaplog\x18\x04 \x01(\v2\x19.kratos.api.ZapLogConfigsR\x06zaplog\x123\n" +
 	"\tschoolday\x18\x05 \x01(\v2\x15.kratos.api.SchoolDayR\tschoolday\x120\n" +
-	"\bdefaults\x18\x06 \x01(\v2\x14.kratos.api.DefaultsR\bdefaults\"\x9b\x03\n" +
+	"\bdefaults\x18\x06 \x01(\v2\x14.kratos.api.DefaultsR\bdefaults\"\xca\x03\n" +
 	"\x06Server\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12+\n" +
 	"\x04grpc\x18\x02 \x01(\v2\x17.kratos.api.Server.GRPCR\x04grpc\x12\"\n" +
@@ -851,11 +937,19 @@ const file_conf_conf_proto_rawDesc = "" +
 	"\x11recycleExpiration\x18\x05 \x01(\x05R\x11recycleExpiration\x120\n" +
 	"\x13blackListExpiration\x18\x06 \x01(\x05R\x13blackListExpiration\x12(\n" +
 	"\x0fwaitUserSvcTime\x18\a \x01(\x05R\x0fwaitUserSvcTime\x12(\n" +
-	"\x0frefreshInterval\x18\b \x01(\x05R\x0frefreshInterval\x1aN\n" +
+	"\x0frefreshInterval\x18\b \x01(\x05R\x0frefreshInterval\x12-\n" +
+	"\acrawler\x18\t \x01(\v2\x13.kratos.api.CrawlerR\acrawler\x1aN\n" +
 	"\x04GRPC\x12\x18\n" +
 	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
 	"\x04addr\x18\x02 \x01(\tR\x04addr\x12\x18\n" +
-	"\atimeout\x18\x03 \x01(\x05R\atimeout\"\xbd\x03\n" +
+	"\atimeout\x18\x03 \x01(\x05R\atimeout\"\x89\x01\n" +
+	"\aCrawler\x12\x1e\n" +
+	"\n" +
+	"strategies\x18\x01 \x03(\tR\n" +
+	"strategies\x12*\n" +
+	"\x10failureThreshold\x18\x02 \x01(\x05R\x10failureThreshold\x12\x1a\n" +
+	"\bcooldown\x18\x03 \x01(\x05R\bcooldown\x12\x16\n" +
+	"\x06window\x18\x04 \x01(\x05R\x06window\"\xbd\x03\n" +
 	"\x04Data\x125\n" +
 	"\bdatabase\x18\x01 \x01(\v2\x19.kratos.api.Data.DatabaseR\bdatabase\x12,\n" +
 	"\x05redis\x18\x02 \x01(\v2\x16.kratos.api.Data.RedisR\x05redis\x12,\n" +
@@ -875,10 +969,11 @@ const file_conf_conf_proto_rawDesc = "" +
 	"\x04Etcd\x12\x12\n" +
 	"\x04addr\x18\x01 \x01(\tR\x04addr\x12\x1a\n" +
 	"\busername\x18\x02 \x01(\tR\busername\x12\x1a\n" +
-	"\bpassword\x18\x03 \x01(\tR\bpassword\"J\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\"l\n" +
 	"\bRegistry\x12$\n" +
 	"\x04etcd\x18\x01 \x01(\v2\x10.kratos.api.EtcdR\x04etcd\x12\x18\n" +
-	"\ausersvc\x18\x02 \x01(\tR\ausersvc\"\xc8\x02\n" +
+	"\ausersvc\x18\x02 \x01(\tR\ausersvc\x12 \n" +
+	"\vcalendarsvc\x18\x03 \x01(\tR\vcalendarsvc\"\xc8\x02\n" +
 	"\rZapLogConfigs\x12\x1b\n" +
 	"\tlog_level\x18\x01 \x01(\tR\blogLevel\x12\x1d\n" +
 	"\n" +
@@ -912,38 +1007,40 @@ func file_conf_conf_proto_rawDescGZIP() []byte {
 	return file_conf_conf_proto_rawDescData
 }
 
-var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_conf_conf_proto_goTypes = []any{
 	(*Bootstrap)(nil),     // 0: kratos.api.Bootstrap
 	(*Server)(nil),        // 1: kratos.api.Server
-	(*Data)(nil),          // 2: kratos.api.Data
-	(*Etcd)(nil),          // 3: kratos.api.Etcd
-	(*Registry)(nil),      // 4: kratos.api.Registry
-	(*ZapLogConfigs)(nil), // 5: kratos.api.ZapLogConfigs
-	(*SchoolDay)(nil),     // 6: kratos.api.SchoolDay
-	(*Defaults)(nil),      // 7: kratos.api.Defaults
-	(*Server_GRPC)(nil),   // 8: kratos.api.Server.GRPC
-	(*Data_Database)(nil), // 9: kratos.api.Data.Database
-	(*Data_Redis)(nil),    // 10: kratos.api.Data.Redis
-	(*Data_Kafka)(nil),    // 11: kratos.api.Data.Kafka
+	(*Crawler)(nil),       // 2: kratos.api.Crawler
+	(*Data)(nil),          // 3: kratos.api.Data
+	(*Etcd)(nil),          // 4: kratos.api.Etcd
+	(*Registry)(nil),      // 5: kratos.api.Registry
+	(*ZapLogConfigs)(nil), // 6: kratos.api.ZapLogConfigs
+	(*SchoolDay)(nil),     // 7: kratos.api.SchoolDay
+	(*Defaults)(nil),      // 8: kratos.api.Defaults
+	(*Server_GRPC)(nil),   // 9: kratos.api.Server.GRPC
+	(*Data_Database)(nil), // 10: kratos.api.Data.Database
+	(*Data_Redis)(nil),    // 11: kratos.api.Data.Redis
+	(*Data_Kafka)(nil),    // 12: kratos.api.Data.Kafka
 }
 var file_conf_conf_proto_depIdxs = []int32{
 	1,  // 0: kratos.api.Bootstrap.server:type_name -> kratos.api.Server
-	2,  // 1: kratos.api.Bootstrap.data:type_name -> kratos.api.Data
-	4,  // 2: kratos.api.Bootstrap.registry:type_name -> kratos.api.Registry
-	5,  // 3: kratos.api.Bootstrap.zaplog:type_name -> kratos.api.ZapLogConfigs
-	6,  // 4: kratos.api.Bootstrap.schoolday:type_name -> kratos.api.SchoolDay
-	7,  // 5: kratos.api.Bootstrap.defaults:type_name -> kratos.api.Defaults
-	8,  // 6: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
-	9,  // 7: kratos.api.Data.database:type_name -> kratos.api.Data.Database
-	10, // 8: kratos.api.Data.redis:type_name -> kratos.api.Data.Redis
-	11, // 9: kratos.api.Data.kafka:type_name -> kratos.api.Data.Kafka
-	3,  // 10: kratos.api.Registry.etcd:type_name -> kratos.api.Etcd
-	11, // [11:11] is the sub-list for method output_type
-	11, // [11:11] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	3,  // 1: kratos.api.Bootstrap.data:type_name -> kratos.api.Data
+	5,  // 2: kratos.api.Bootstrap.registry:type_name -> kratos.api.Registry
+	6,  // 3: kratos.api.Bootstrap.zaplog:type_name -> kratos.api.ZapLogConfigs
+	7,  // 4: kratos.api.Bootstrap.schoolday:type_name -> kratos.api.SchoolDay
+	8,  // 5: kratos.api.Bootstrap.defaults:type_name -> kratos.api.Defaults
+	9,  // 6: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
+	2,  // 7: kratos.api.Server.crawler:type_name -> kratos.api.Crawler
+	10, // 8: kratos.api.Data.database:type_name -> kratos.api.Data.Database
+	11, // 9: kratos.api.Data.redis:type_name -> kratos.api.Data.Redis
+	12, // 10: kratos.api.Data.kafka:type_name -> kratos.api.Data.Kafka
+	4,  // 11: kratos.api.Registry.etcd:type_name -> kratos.api.Etcd
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_conf_conf_proto_init() }
@@ -957,7 +1054,7 @@ func file_conf_conf_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_conf_conf_proto_rawDesc), len(file_conf_conf_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -44,14 +44,16 @@ func NewStudentAndCourseRepo(DB *StudentAndCourseDBRepo, Cache *StudentAndCourse
 type ClassRepo struct {
 	ClaRepo *ClassInfoRepo
 	Sac     *StudentAndCourseRepo
-	TxCtrl  Transaction //控制事务的开启
+	TxCtrl  Transaction         //控制事务的开启
+	Events  *ClassEventProducer //课程信息写入后通知be-class同步
 }
 
-func NewClassRepo(ClaRepo *ClassInfoRepo, TxCtrl Transaction, Sac *StudentAndCourseRepo) *ClassRepo {
+func NewClassRepo(ClaRepo *ClassInfoRepo, TxCtrl Transaction, Sac *StudentAndCourseRepo, Events *ClassEventProducer) *ClassRepo {
 	return &ClassRepo{
 		ClaRepo: ClaRepo,
 		Sac:     Sac,
 		TxCtrl:  TxCtrl,
+		Events:  Events,
 	}
 }
 
@@ -151,6 +153,7 @@ func (cla ClassRepo) AddClass(ctx context.Context, stuID, year, semester string,
 		logh.Errorf("Add Class [%v,%v,%v,%+v,%+v] failed:%v", stuID, year, semester, classInfo, sc, errTx)
 		return errTx
 	}
+	cla.Events.PublishUpsert(ctx, classInfo)
 	go func() {
 		//延迟双删
 		time.AfterFunc(1*time.Second, func() {
@@ -264,6 +267,7 @@ func (cla ClassRepo) UpdateClass(ctx context.Context, stuID, year, semester, old
 		logh.Errorf("Update Class [%v,%v,%v,%v,%+v,%+v] In DB  failed:%v", stuID, year, semester, oldClassID, newClassInfo, newSc, errTx)
 		return errTx
 	}
+	cla.Events.PublishUpsert(ctx, newClassInfo)
 
 	go func() {
 		//延迟双删
@@ -323,8 +327,10 @@ func (cla ClassRepo) SaveClass(ctx context.Context, stuID, year, semester string
 	})
 	if err != nil {
 		logh.Errorf("Save class [%+v] and scs [%v] failed:%v", classInfos, scs, err)
+		return err
 	}
-	return err
+	cla.Events.PublishUpsert(ctx, classInfos...)
+	return nil
 }
 
 // CheckSCIdsExist 检查学生课程ID是否存在
@@ -398,3 +404,31 @@ func (cla ClassRepo) UpdateClassNote(ctx context.Context, stuID, year, semester,
 
 	return nil
 }
+
+// GetTerms 获取学生有课程数据的所有学期及对应的课程数量
+func (cla ClassRepo) GetTerms(ctx context.Context, stuID string) ([]*biz.Term, error) {
+	logh := classLog.GetLogHelperFromCtx(ctx)
+	nums, err := cla.Sac.DB.GetTermClassNums(ctx, stuID)
+	if err != nil {
+		logh.Errorf("Mysql:get term class nums of %v failed: %v", stuID, err)
+		return nil, errcode.ErrClassFound
+	}
+
+	termMap := make(map[string]*biz.Term)
+	terms := make([]*biz.Term, 0)
+	for _, num := range nums {
+		key := num.Year + ":" + num.Semester
+		term, ok := termMap[key]
+		if !ok {
+			term = &biz.Term{Year: num.Year, Semester: num.Semester}
+			termMap[key] = term
+			terms = append(terms, term)
+		}
+		if num.IsManuallyAdded {
+			term.AddedClassNum += num.Num
+		} else {
+			term.ClassNum += num.Num
+		}
+	}
+	return terms, nil
+}
@@ -121,6 +121,33 @@ func (c ClassInfoDBRepo) GetAllClassInfos(ctx context.Context, xnm, xqm string,
 			false, xnm, xqm, err)
 		return nil, err
 	}
+	if len(cla) < 100 {
+		return cla, nil
+	}
+
+	// 批量插入的课程created_at相同,下一页从最后一条的created_at之后开始会漏掉同一时刻的其他课程
+	// 所以把和最后一条created_at相同的课程都放到这一页
+	var sameTime []*do.ClassInfo
+	last := cla[len(cla)-1].CreatedAt
+	err = db.Table(do.ClassInfoTableName).
+		Where(fmt.Sprintf(
+			`%s.year = ? AND %s.semester = ? AND %s.created_at = ?`, do.ClassInfoTableName, do.ClassInfoTableName, do.ClassInfoTableName),
+			xnm, xqm, last,
+		).
+		Find(&sameTime).Error
+	if err != nil {
+		logh.Errorf("Mysql:find classinfos  where (year = %s,semester = %s,created_at = %v) failed:%v", xnm, xqm, last, err)
+		return nil, err
+	}
+	seen := make(map[string]struct{}, len(cla))
+	for _, c := range cla {
+		seen[c.ID] = struct{}{}
+	}
+	for _, c := range sameTime {
+		if _, ok := seen[c.ID]; !ok {
+			cla = append(cla, c)
+		}
+	}
 	return cla, nil
 }
 
@@ -0,0 +1,122 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/asynccnu/ccnubox-be/be-classlist/internal/biz"
+	"github.com/asynccnu/ccnubox-be/be-classlist/internal/classLog"
+	"github.com/asynccnu/ccnubox-be/be-classlist/internal/conf"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// ClassEventTopic 课程信息变更事件的topic,be-class消费后同步课程搜索的索引
+const ClassEventTopic = "be-classlist-class-event"
+
+const (
+	ClassEventUpsert = "upsert"
+	ClassEventDelete = "delete" //class_info中的记录目前不会被删除,留给之后清理课程的逻辑使用
+)
+
+// ClassEvent 课程信息变更事件,以课程ID作为消息的key,保证同一课程的事件有序
+type ClassEvent struct {
+	Type      string           `json:"type"`
+	ID        string           `json:"id"`
+	Class     *ClassEventClass `json:"class,omitempty"` //upsert时为变更后的课程
+	Timestamp int64            `json:"timestamp"`       //事件产生的时间,单位ms
+}
+
+// ClassEventClass 事件中携带的课程信息,字段与be-class的课程文档保持一致
+type ClassEventClass struct {
+	ID           string  `json:"id"`
+	Day          int64   `json:"day"`
+	Teacher      string  `json:"teacher"`
+	Where        string  `json:"where"`
+	ClassWhen    string  `json:"class_when"`
+	WeekDuration string  `json:"week_duration"`
+	Classname    string  `json:"classname"`
+	Credit       float64 `json:"credit"`
+	Weeks        int64   `json:"weeks"`
+	Semester     string  `json:"semester"`
+	Year         string  `json:"year"`
+	Nature       string  `json:"nature"`
+}
+
+func newUpsertEvent(info *biz.ClassInfo) ClassEvent {
+	return ClassEvent{
+		Type: ClassEventUpsert,
+		ID:   info.ID,
+		Class: &ClassEventClass{
+			ID:           info.ID,
+			Day:          info.Day,
+			Teacher:      info.Teacher,
+			Where:        info.Where,
+			ClassWhen:    info.ClassWhen,
+			WeekDuration: info.WeekDuration,
+			Classname:    info.Classname,
+			Credit:       info.Credit,
+			Weeks:        info.Weeks,
+			Semester:     info.Semester,
+			Year:         info.Year,
+			Nature:       info.Nature,
+		},
+		Timestamp: time.Now().UnixMilli(),
+	}
+}
+
+// ClassEventProducer 发送课程信息变更事件
+// 没有配置kafka时不发送,be-class的定时对账会补上这些变更
+type ClassEventProducer struct {
+	kp  sarama.SyncProducer
+	log *log.Helper
+}
+
+func NewClassEventProducer(c *conf.Data, kpb *KafkaProducerBuilder, logger log.Logger) (*ClassEventProducer, func(), error) {
+	p := &ClassEventProducer{log: log.NewHelper(logger)}
+	if len(c.GetKafka().GetBrokers()) == 0 {
+		p.log.Warn("kafka is not configured, class events will not be published")
+		return p, func() {}, nil
+	}
+	kp, err := kpb.Build()
+	if err != nil {
+		return nil, nil, err
+	}
+	p.kp = kp
+	return p, func() {
+		if err := kp.Close(); err != nil {
+			p.log.Errorf("Error closing class event producer: %v", err)
+		}
+	}, nil
+}
+
+// PublishUpsert 发送课程新增或修改的事件
+// 数据库已经写入成功,发送失败只记录日志,不影响调用方
+func (p *ClassEventProducer) PublishUpsert(ctx context.Context, classInfos ...*biz.ClassInfo) {
+	if p == nil || p.kp == nil || len(classInfos) == 0 {
+		return
+	}
+	logh := classLog.GetLogHelperFromCtx(ctx)
+
+	msgs := make([]*sarama.ProducerMessage, 0, len(classInfos))
+	for _, info := range classInfos {
+		if info == nil {
+			continue
+		}
+		val, err := json.Marshal(newUpsertEvent(info))
+		if err != nil {
+			logh.Errorf("marshal class event [%v] failed: %v", info.ID, err)
+			continue
+		}
+		msgs = append(msgs, &sarama.ProducerMessage{
+			Topic:     ClassEventTopic,
+			Key:       sarama.StringEncoder(info.ID),
+			Value:     sarama.ByteEncoder(val),
+			Timestamp: time.Now(),
+		})
+	}
+	if err := p.kp.SendMessages(msgs); err != nil {
+		logh.Errorf("publish %d class events failed: %v", len(msgs), err)
+	}
+}
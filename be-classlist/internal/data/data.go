@@ -10,6 +10,7 @@ import (
 	"github.com/IBM/sarama"
 	"github.com/asynccnu/ccnubox-be/be-classlist/internal/conf"
 	"github.com/asynccnu/ccnubox-be/be-classlist/internal/data/do"
+	"github.com/asynccnu/ccnubox-be/common/queue"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/wire"
 	"github.com/redis/go-redis/v9"
@@ -29,13 +30,13 @@ var ProviderSet = wire.NewSet(
 	NewClassInfoCacheRepo,
 	NewJxbDBRepo,
 	NewRefreshLogRepo,
-	NewKafkaProducerBuilder,
-	NewKafkaConsumerBuilder,
-	NewDelayKafkaConfig,
-	NewDelayKafka,
+	NewDelayQueueConfig,
+	queue.NewRedisDelayQueue,
 	NewClassInfoRepo,
 	NewStudentAndCourseRepo,
 	NewClassRepo,
+	NewKafkaProducerBuilder,
+	NewClassEventProducer,
 )
 
 type Transaction interface {
@@ -0,0 +1,22 @@
+package data
+
+import (
+	"time"
+
+	"github.com/asynccnu/ccnubox-be/common/queue"
+)
+
+// NewDelayQueueConfig 课表刷新失败后的重试队列
+func NewDelayQueueConfig() queue.DelayQueueConfig {
+	return queue.DelayQueueConfig{
+		Name:         "be-classlist-refresh-retry",
+		Delay:        5 * time.Minute,
+		MaxAttempts:  3,
+		Backoff:      5 * time.Minute,
+		MaxBackoff:   time.Hour,
+		Visibility:   2 * time.Minute,
+		PollInterval: time.Second,
+		BatchSize:    10,
+		MaxDead:      1000,
+	}
+}
@@ -149,3 +149,26 @@ func (s StudentAndCourseDBRepo) UpdateCourseNote(ctx context.Context, stuID, yea
 	}
 	return nil
 }
+
+// TermClassNum 学生在某学期的课程数量
+type TermClassNum struct {
+	Year            string
+	Semester        string
+	IsManuallyAdded bool
+	Num             int64
+}
+
+// GetTermClassNums 按学年学期统计学生的官方课程与手动添加课程数量
+func (s StudentAndCourseDBRepo) GetTermClassNums(ctx context.Context, stuID string) ([]TermClassNum, error) {
+	db := s.data.DB(ctx).Table(do.StudentCourseTableName).WithContext(ctx)
+
+	var nums []TermClassNum
+	err := db.Select("year, semester, is_manually_added, count(*) as num").
+		Where("stu_id = ?", stuID).
+		Group("year, semester, is_manually_added").
+		Scan(&nums).Error
+	if err != nil {
+		return nil, err
+	}
+	return nums, nil
+}
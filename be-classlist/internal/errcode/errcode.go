@@ -19,4 +19,6 @@ var (
 	ErrRecover               = errors.New(460, v1.ErrorReason_RECOVERFAILED.String(), "恢复课程失败")
 	ErrGetStuIdByJxbId       = errors.New(461, v1.ErrorReason_GETSTUIDBYJXBID.String(), "通过jxb_id获取stu_ids获取失败")
 	ErrClassIsExist          = errors.New(462, v1.ErrorReason_CLASSISEXIST.String(), "已有该课程")
+	ErrClassConflict         = errors.New(463, v1.ErrorReason_CLASSCONFLICT.String(), "与已有课程时间冲突")
+	ErrImportFile            = errors.New(464, v1.ErrorReason_IMPORTFILEERROR.String(), "导入文件无法解析")
 )
@@ -58,3 +58,38 @@ func DelayMiddleware() middleware.Middleware {
 		}
 	}
 }
+
+var (
+	// CrawlerCounter 各爬虫策略的请求次数,student_type为undergraduate或graduate,result为success,failure或empty
+	CrawlerCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "crawler_request_total",
+			Help: "The total number of crawler requests",
+		},
+		[]string{"strategy", "student_type", "result"},
+	)
+	// CrawlerSummary 各爬虫策略的请求耗时
+	CrawlerSummary = prometheus.NewSummaryVec(
+		prometheus.SummaryOpts{
+			Name:       "crawler_request_delay",
+			Objectives: map[float64]float64{0.5: 0.05, 0.9: 0.01, 0.99: 0.001},
+		},
+		[]string{"strategy", "student_type"},
+	)
+	// CrawlerHealthGauge 各爬虫策略在滑动窗口内的成功率
+	CrawlerHealthGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "crawler_success_rate",
+			Help: "The success rate of crawler strategies in the sliding window",
+		},
+		[]string{"strategy", "student_type"},
+	)
+	// CrawlerDemotedGauge 各爬虫策略是否处于降级状态,1为降级
+	CrawlerDemotedGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "crawler_demoted",
+			Help: "Whether the crawler strategy is demoted",
+		},
+		[]string{"strategy", "student_type"},
+	)
+)
@@ -0,0 +1,318 @@
+package crawler
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/asynccnu/ccnubox-be/be-classlist/internal/biz"
+	"github.com/asynccnu/ccnubox-be/be-classlist/internal/classLog"
+	"github.com/asynccnu/ccnubox-be/be-classlist/internal/conf"
+	"github.com/asynccnu/ccnubox-be/be-classlist/internal/errcode"
+	"github.com/asynccnu/ccnubox-be/be-classlist/internal/metrics"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	StrategyAPI  = "api"  //Crawler,教务系统接口
+	StrategyHTML = "html" //Crawler2,本科教务页面解析
+
+	defaultFailureThreshold = 3
+	defaultCooldown         = 5 * time.Minute
+	defaultWindow           = 50
+
+	studentUndergraduate = "undergraduate"
+	studentGraduate      = "graduate"
+)
+
+var errEmptyResult = errors.New("crawler returned no class while another strategy did")
+
+// Strategy 一个具名的爬虫策略
+type Strategy struct {
+	Name     string
+	Crawler  biz.ClassCrawler
+	Graduate bool //是否支持研究生课表,不支持的策略只用于本科生
+}
+
+// CompositeCrawler 按顺序尝试多个爬虫策略,并记录每个策略的健康状态
+// 连续失败达到阈值的策略会被降级一段时间,降级期间排到最后再尝试,降级结束或成功一次后恢复
+// 本科生和研究生走的是不同的教务系统,两者的健康状态分开统计
+type CompositeCrawler struct {
+	undergraduate    []*strategyState
+	graduate         []*strategyState
+	failureThreshold int64
+	cooldown         time.Duration
+	window           int
+	now              func() time.Time
+}
+
+type strategyState struct {
+	Strategy
+	studentType string
+
+	mu                  sync.Mutex
+	results             []bool //滑动窗口内的结果,环形使用
+	latencies           []time.Duration
+	next                int
+	total               int64
+	failures            int64
+	consecutiveFailures int64
+	demotedUntil        time.Time
+	lastError           string
+	lastSuccessTime     time.Time
+}
+
+func NewCompositeCrawler(cf *conf.Server, c1 *Crawler, c2 *Crawler2) *CompositeCrawler {
+	available := map[string]Strategy{
+		StrategyAPI:  {Name: StrategyAPI, Crawler: c1, Graduate: true},
+		StrategyHTML: {Name: StrategyHTML, Crawler: c2},
+	}
+	names := []string{StrategyAPI, StrategyHTML}
+
+	var (
+		threshold int64
+		cooldown  time.Duration
+		window    int
+	)
+	if cc := cf.GetCrawler(); cc != nil {
+		if len(cc.Strategies) > 0 {
+			names = cc.Strategies
+		}
+		threshold = int64(cc.FailureThreshold)
+		cooldown = time.Duration(cc.Cooldown) * time.Second
+		window = int(cc.Window)
+	}
+
+	strategies := make([]Strategy, 0, len(names))
+	for _, name := range names {
+		s, ok := available[name]
+		if !ok {
+			classLog.GlobalLogHelper.Warnf("unknown crawler strategy %q, ignored", name)
+			continue
+		}
+		strategies = append(strategies, s)
+	}
+	return NewCompositeCrawlerWithStrategies(strategies, threshold, cooldown, window)
+}
+
+// NewCompositeCrawlerWithStrategies 使用给定的策略创建组合爬虫,非正数的参数使用默认值
+func NewCompositeCrawlerWithStrategies(strategies []Strategy, failureThreshold int64, cooldown time.Duration, window int) *CompositeCrawler {
+	if failureThreshold <= 0 {
+		failureThreshold = defaultFailureThreshold
+	}
+	if cooldown <= 0 {
+		cooldown = defaultCooldown
+	}
+	if window <= 0 {
+		window = defaultWindow
+	}
+	c := &CompositeCrawler{
+		failureThreshold: failureThreshold,
+		cooldown:         cooldown,
+		window:           window,
+		now:              time.Now,
+	}
+	for _, s := range strategies {
+		c.undergraduate = append(c.undergraduate, newStrategyState(s, studentUndergraduate))
+		if s.Graduate {
+			c.graduate = append(c.graduate, newStrategyState(s, studentGraduate))
+		}
+	}
+	return c
+}
+
+func newStrategyState(s Strategy, studentType string) *strategyState {
+	metrics.CrawlerHealthGauge.WithLabelValues(s.Name, studentType).Set(1)
+	metrics.CrawlerDemotedGauge.WithLabelValues(s.Name, studentType).Set(0)
+	return &strategyState{Strategy: s, studentType: studentType}
+}
+
+func (c *CompositeCrawler) GetClassInfosForUndergraduate(ctx context.Context, stuID, year, semester, cookie string) ([]*biz.ClassInfo, []*biz.StudentCourse, error) {
+	return c.crawl(ctx, c.undergraduate, func(craw biz.ClassCrawler) ([]*biz.ClassInfo, []*biz.StudentCourse, error) {
+		return craw.GetClassInfosForUndergraduate(ctx, stuID, year, semester, cookie)
+	})
+}
+
+func (c *CompositeCrawler) GetClassInfoForGraduateStudent(ctx context.Context, stuID, year, semester, cookie string) ([]*biz.ClassInfo, []*biz.StudentCourse, error) {
+	return c.crawl(ctx, c.graduate, func(craw biz.ClassCrawler) ([]*biz.ClassInfo, []*biz.StudentCourse, error) {
+		return craw.GetClassInfoForGraduateStudent(ctx, stuID, year, semester, cookie)
+	})
+}
+
+// crawl 依次尝试各策略,直到某个策略返回了非空的课表
+// 返回空课表的策略暂不计入结果:若之后有策略拿到了课程,则视为失败(多半是页面改版导致解析不到);
+// 若所有策略都为空,则认为该学生本学期确实没有课程,视为成功
+func (c *CompositeCrawler) crawl(ctx context.Context, strategies []*strategyState, fetch func(biz.ClassCrawler) ([]*biz.ClassInfo, []*biz.StudentCourse, error)) ([]*biz.ClassInfo, []*biz.StudentCourse, error) {
+	logh := classLog.GetLogHelperFromCtx(ctx)
+
+	type emptyResult struct {
+		s       *strategyState
+		latency time.Duration
+		scs     []*biz.StudentCourse
+	}
+	var (
+		empties []emptyResult
+		lastErr error = errcode.ErrCrawler
+	)
+
+	for _, s := range c.ordered(strategies) {
+		start := c.now()
+		infos, scs, err := fetch(s.Crawler)
+		latency := c.now().Sub(start)
+
+		if err != nil {
+			logh.Warnf("crawler strategy %s failed: %v", s.Name, err)
+			c.record(logh, s, latency, err)
+			lastErr = err
+			continue
+		}
+		if len(infos) == 0 {
+			empties = append(empties, emptyResult{s: s, latency: latency, scs: scs})
+			continue
+		}
+
+		for _, e := range empties {
+			c.record(logh, e.s, e.latency, errEmptyResult)
+		}
+		c.record(logh, s, latency, nil)
+		return infos, scs, nil
+	}
+
+	if len(empties) > 0 {
+		for _, e := range empties {
+			c.record(logh, e.s, e.latency, nil)
+		}
+		return nil, empties[0].scs, nil
+	}
+	return nil, nil, lastErr
+}
+
+// ordered 返回本次尝试的顺序:未降级的按配置顺序在前,降级中的按降级截止时间排在最后
+func (c *CompositeCrawler) ordered(strategies []*strategyState) []*strategyState {
+	type demotedState struct {
+		s     *strategyState
+		until time.Time
+	}
+	now := c.now()
+	healthy := make([]*strategyState, 0, len(strategies))
+	var demoted []demotedState
+	for _, s := range strategies {
+		s.mu.Lock()
+		until := s.demotedUntil
+		s.mu.Unlock()
+		if now.Before(until) {
+			demoted = append(demoted, demotedState{s: s, until: until})
+		} else {
+			healthy = append(healthy, s)
+		}
+	}
+	sort.SliceStable(demoted, func(i, j int) bool {
+		return demoted[i].until.Before(demoted[j].until)
+	})
+	for _, d := range demoted {
+		healthy = append(healthy, d.s)
+	}
+	return healthy
+}
+
+func (c *CompositeCrawler) record(logh *log.Helper, s *strategyState, latency time.Duration, err error) {
+	now := c.now()
+
+	s.mu.Lock()
+	if len(s.results) < c.window {
+		s.results = append(s.results, err == nil)
+		s.latencies = append(s.latencies, latency)
+	} else {
+		s.results[s.next] = err == nil
+		s.latencies[s.next] = latency
+	}
+	s.next = (s.next + 1) % c.window
+	s.total++
+
+	result := "success"
+	if err != nil {
+		result = "failure"
+		if errors.Is(err, errEmptyResult) {
+			result = "empty"
+		}
+		s.failures++
+		s.consecutiveFailures++
+		s.lastError = err.Error()
+		if s.consecutiveFailures >= c.failureThreshold && !now.Before(s.demotedUntil) {
+			s.demotedUntil = now.Add(c.cooldown)
+			logh.Warnf("crawler strategy %s(%s) demoted until %v after %d consecutive failures",
+				s.Name, s.studentType, s.demotedUntil, s.consecutiveFailures)
+		}
+	} else {
+		s.consecutiveFailures = 0
+		s.demotedUntil = time.Time{}
+		s.lastSuccessTime = now
+	}
+	rate := s.successRate()
+	demoted := now.Before(s.demotedUntil)
+	s.mu.Unlock()
+
+	metrics.CrawlerCounter.WithLabelValues(s.Name, s.studentType, result).Inc()
+	metrics.CrawlerSummary.WithLabelValues(s.Name, s.studentType).Observe(float64(latency.Milliseconds()))
+	metrics.CrawlerHealthGauge.WithLabelValues(s.Name, s.studentType).Set(rate)
+	if demoted {
+		metrics.CrawlerDemotedGauge.WithLabelValues(s.Name, s.studentType).Set(1)
+	} else {
+		metrics.CrawlerDemotedGauge.WithLabelValues(s.Name, s.studentType).Set(0)
+	}
+}
+
+// Health 返回各策略的健康状态,先本科生后研究生,各自的顺序与配置顺序一致
+func (c *CompositeCrawler) Health() []*biz.CrawlerHealth {
+	now := c.now()
+	res := make([]*biz.CrawlerHealth, 0, len(c.undergraduate)+len(c.graduate))
+	for _, s := range append(append([]*strategyState{}, c.undergraduate...), c.graduate...) {
+		s.mu.Lock()
+		h := &biz.CrawlerHealth{
+			Name:                s.Name,
+			StudentType:         s.studentType,
+			Healthy:             !now.Before(s.demotedUntil),
+			SuccessRate:         s.successRate(),
+			AvgLatency:          s.avgLatency(),
+			Total:               s.total,
+			Failures:            s.failures,
+			ConsecutiveFailures: s.consecutiveFailures,
+			LastError:           s.lastError,
+			LastSuccessTime:     s.lastSuccessTime,
+		}
+		if !h.Healthy {
+			h.DemotedUntil = s.demotedUntil
+		}
+		s.mu.Unlock()
+		res = append(res, h)
+	}
+	return res
+}
+
+// successRate 需持有锁调用,没有样本时视为1
+func (s *strategyState) successRate() float64 {
+	if len(s.results) == 0 {
+		return 1
+	}
+	var ok int
+	for _, r := range s.results {
+		if r {
+			ok++
+		}
+	}
+	return float64(ok) / float64(len(s.results))
+}
+
+// avgLatency 需持有锁调用
+func (s *strategyState) avgLatency() time.Duration {
+	if len(s.latencies) == 0 {
+		return 0
+	}
+	var sum time.Duration
+	for _, l := range s.latencies {
+		sum += l
+	}
+	return sum / time.Duration(len(s.latencies))
+}
@@ -0,0 +1,121 @@
+package crawler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/asynccnu/ccnubox-be/be-classlist/internal/biz"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeCrawler struct {
+	calls int
+	infos []*biz.ClassInfo
+	err   error
+}
+
+func (f *fakeCrawler) GetClassInfosForUndergraduate(ctx context.Context, stuID, year, semester, cookie string) ([]*biz.ClassInfo, []*biz.StudentCourse, error) {
+	f.calls++
+	return f.infos, nil, f.err
+}
+
+func (f *fakeCrawler) GetClassInfoForGraduateStudent(ctx context.Context, stuID, year, semester, cookie string) ([]*biz.ClassInfo, []*biz.StudentCourse, error) {
+	return f.GetClassInfosForUndergraduate(ctx, stuID, year, semester, cookie)
+}
+
+func TestCompositeCrawler_Fallback(t *testing.T) {
+	first := &fakeCrawler{err: errors.New("jwxt changed")}
+	second := &fakeCrawler{infos: []*biz.ClassInfo{{ID: "c1"}}}
+
+	now := time.Unix(0, 0)
+	c := NewCompositeCrawlerWithStrategies([]Strategy{{"first", first, true}, {"second", second, true}}, 2, time.Minute, 10)
+	c.now = func() time.Time { return now }
+
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		infos, _, err := c.GetClassInfosForUndergraduate(ctx, "2023214414", "2024", "1", "")
+		assert.NoError(t, err)
+		assert.Len(t, infos, 1)
+	}
+	assert.Equal(t, 2, first.calls)
+
+	health := c.Health()
+	assert.False(t, health[0].Healthy)
+	assert.Equal(t, int64(2), health[0].ConsecutiveFailures)
+	assert.Equal(t, 0.0, health[0].SuccessRate)
+	assert.True(t, health[1].Healthy)
+
+	//降级后先尝试second,first不会被调用
+	_, _, err := c.GetClassInfosForUndergraduate(ctx, "2023214414", "2024", "1", "")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, first.calls)
+
+	//冷却结束后first恢复原顺序,成功一次即恢复健康
+	now = now.Add(2 * time.Minute)
+	first.err, first.infos = nil, []*biz.ClassInfo{{ID: "c1"}}
+	_, _, err = c.GetClassInfosForUndergraduate(ctx, "2023214414", "2024", "1", "")
+	assert.NoError(t, err)
+	assert.Equal(t, 3, first.calls)
+	health = c.Health()
+	assert.True(t, health[0].Healthy)
+	assert.Equal(t, int64(0), health[0].ConsecutiveFailures)
+}
+
+func TestCompositeCrawler_EmptyResult(t *testing.T) {
+	empty := &fakeCrawler{}
+	full := &fakeCrawler{infos: []*biz.ClassInfo{{ID: "c1"}}}
+	c := NewCompositeCrawlerWithStrategies([]Strategy{{"empty", empty, true}, {"full", full, true}}, 3, time.Minute, 10)
+
+	infos, _, err := c.GetClassInfosForUndergraduate(context.Background(), "2023214414", "2024", "1", "")
+	assert.NoError(t, err)
+	assert.Len(t, infos, 1)
+	//另一个策略拿到了课程,空结果计为失败
+	assert.Equal(t, int64(1), c.Health()[0].Failures)
+
+	//所有策略都为空时视为成功
+	full.infos = nil
+	infos, _, err = c.GetClassInfosForUndergraduate(context.Background(), "2023214414", "2024", "1", "")
+	assert.NoError(t, err)
+	assert.Empty(t, infos)
+	assert.Equal(t, int64(0), c.Health()[0].ConsecutiveFailures)
+	assert.Equal(t, int64(0), c.Health()[1].Failures)
+}
+
+func TestCompositeCrawler_AllFailed(t *testing.T) {
+	want := errors.New("network down")
+	c := NewCompositeCrawlerWithStrategies([]Strategy{{"a", &fakeCrawler{err: errors.New("x")}, true}, {"b", &fakeCrawler{err: want}, true}}, 3, time.Minute, 10)
+
+	_, _, err := c.GetClassInfosForUndergraduate(context.Background(), "2023214414", "2024", "1", "")
+	assert.ErrorIs(t, err, want)
+}
+
+func TestCompositeCrawler_GraduateSeparated(t *testing.T) {
+	api := &fakeCrawler{err: errors.New("jwxt changed")}
+	html := &fakeCrawler{}
+	c := NewCompositeCrawlerWithStrategies([]Strategy{{"api", api, true}, {"html", html, false}}, 1, time.Minute, 10)
+
+	//研究生只会尝试支持研究生的策略,不会因为html返回空课表而当作成功
+	_, _, err := c.GetClassInfoForGraduateStudent(context.Background(), "2024114514", "2024", "1", "")
+	assert.Error(t, err)
+	assert.Equal(t, 1, api.calls)
+	assert.Equal(t, 0, html.calls)
+
+	//研究生的失败不影响本科生的策略状态
+	health := c.Health()
+	assert.Len(t, health, 3)
+	assert.Equal(t, "undergraduate", health[0].StudentType)
+	assert.True(t, health[0].Healthy)
+	assert.Equal(t, int64(0), health[0].Total)
+	assert.Equal(t, "api", health[2].Name)
+	assert.Equal(t, "graduate", health[2].StudentType)
+	assert.False(t, health[2].Healthy)
+
+	api.err, api.infos = nil, []*biz.ClassInfo{{ID: "c1"}}
+	infos, _, err := c.GetClassInfosForUndergraduate(context.Background(), "2023214414", "2024", "1", "")
+	assert.NoError(t, err)
+	assert.Len(t, infos, 1)
+	assert.Equal(t, 2, api.calls)
+	assert.False(t, c.Health()[2].Healthy)
+}
@@ -147,6 +147,7 @@ func extractUndergraduateData(rawJson []byte, stuID, xnm, xqm string) ([]*biz.Cl
 		info.WeekDuration = string(kb.GetStringBytes("zcd"))                     //上课的周数
 		info.Classname = string(kb.GetStringBytes("kcmc"))                       //课程名称
 		info.Credit, _ = strconv.ParseFloat(string(kb.GetStringBytes("xf")), 64) //学分
+		info.Nature = string(kb.GetStringBytes("kcxz"))                          //课程性质
 		info.Semester = xqm                                                      //学期
 		info.Year = xnm                                                          //学年
 		//添加周数
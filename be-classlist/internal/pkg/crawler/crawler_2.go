@@ -86,8 +86,9 @@ func (c *Crawler2) GetClassInfosForUndergraduate(ctx context.Context, stuID, yea
 	return infos, scs, nil
 }
 
+// GetClassInfoForGraduateStudent 本科教务页面拿不到研究生的课表,直接返回错误,避免把空课表当作成功
 func (c *Crawler2) GetClassInfoForGraduateStudent(ctx context.Context, stuID, year, semester, cookie string) ([]*biz.ClassInfo, []*biz.StudentCourse, error) {
-	return c.GetClassInfosForUndergraduate(ctx, stuID, year, semester, cookie)
+	return nil, nil, errors.New("html crawler does not support graduate students")
 }
 
 func (c *Crawler2) getys(year, semester string) string {
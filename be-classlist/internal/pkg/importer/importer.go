@@ -0,0 +1,268 @@
+package importer
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/asynccnu/ccnubox-be/be-classlist/internal/biz"
+	"github.com/asynccnu/ccnubox-be/be-classlist/internal/pkg/tool"
+	"github.com/xuri/excelize/v2"
+)
+
+const (
+	FormatExcel  = "excel"  //按模板填写的xlsx文件
+	FormatCSV    = "csv"    //按模板填写的csv文件
+	FormatWakeUp = "wakeup" //WakeUp课程表导出的备份文件
+
+	MaxWeek    = 29 //允许的最大周数
+	MaxSection = 14 //允许的最大节次
+)
+
+// TemplateHeader Excel/CSV模板的表头,列顺序固定,学分列可不填
+var TemplateHeader = []string{"课程名称", "星期", "节次", "周次", "教师", "地点", "学分"}
+
+var ErrUnknownFormat = errors.New("unknown import format")
+
+// Row 解析成功的一行课程,Line为其在源文件中的行号(从1开始)
+type Row struct {
+	Line int
+	Info *biz.ClassInfo
+}
+
+// RowError 某一行的解析错误
+type RowError struct {
+	Line int
+	Msg  string
+}
+
+// Parse 按format解析导入的课表文件,返回解析成功的课程以及每一行的错误
+// 返回的课程已填充year与semester并生成了ID,但不包含学号等信息
+func Parse(format string, content []byte, year, semester string) ([]Row, []RowError, error) {
+	var (
+		records   [][]string
+		hasHeader = true
+		err       error
+	)
+	switch format {
+	case FormatExcel:
+		records, err = readExcel(content)
+	case FormatCSV:
+		records, err = readCSV(content)
+	case FormatWakeUp:
+		//WakeUp的每条记录对应一个上课安排,行号即为安排的序号
+		records, err = readWakeUp(content)
+		hasHeader = false
+	default:
+		return nil, nil, ErrUnknownFormat
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	rows, rowErrs := parseRecords(records, hasHeader, year, semester)
+	return rows, rowErrs, nil
+}
+
+func readExcel(content []byte) ([][]string, error) {
+	f, err := excelize.OpenReader(bytes.NewReader(content))
+	if err != nil {
+		return nil, fmt.Errorf("open excel failed: %w", err)
+	}
+	defer f.Close()
+
+	sheets := f.GetSheetList()
+	if len(sheets) == 0 {
+		return nil, errors.New("excel has no sheet")
+	}
+	return f.GetRows(sheets[0])
+}
+
+func readCSV(content []byte) ([][]string, error) {
+	//去除部分软件导出时带上的BOM
+	content = bytes.TrimPrefix(content, []byte("\xef\xbb\xbf"))
+	r := csv.NewReader(bytes.NewReader(content))
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+	var records [][]string
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read csv failed: %w", err)
+		}
+		//csv会跳过空行,这里补齐以保证下标与文件行号对应
+		line, _ := r.FieldPos(0)
+		for len(records) < line-1 {
+			records = append(records, nil)
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// parseRecords 解析模板格式的记录,hasHeader为true时跳过第一行表头
+func parseRecords(records [][]string, hasHeader bool, year, semester string) ([]Row, []RowError) {
+	var (
+		rows    []Row
+		rowErrs []RowError
+	)
+	for i, record := range records {
+		line := i + 1
+		if (hasHeader && i == 0) || isBlank(record) {
+			continue
+		}
+		info, err := parseRecord(record)
+		if err != nil {
+			rowErrs = append(rowErrs, RowError{Line: line, Msg: err.Error()})
+			continue
+		}
+		info.Year, info.Semester = year, semester
+		info.JxbId = "unavailable"
+		info.UpdateID()
+		rows = append(rows, Row{Line: line, Info: info})
+	}
+	return rows, rowErrs
+}
+
+func parseRecord(record []string) (*biz.ClassInfo, error) {
+	col := func(i int) string {
+		if i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	name := col(0)
+	if name == "" {
+		return nil, errors.New("课程名称不能为空")
+	}
+	day, err := parseDay(col(1))
+	if err != nil {
+		return nil, err
+	}
+	classWhen, err := parseSections(col(2))
+	if err != nil {
+		return nil, err
+	}
+	weeks, err := ParseWeeks(col(3))
+	if err != nil {
+		return nil, err
+	}
+	var credit float64
+	if s := col(6); s != "" {
+		credit, err = strconv.ParseFloat(s, 64)
+		if err != nil || credit < 0 {
+			return nil, fmt.Errorf("学分格式错误: %q", s)
+		}
+	}
+
+	return &biz.ClassInfo{
+		Classname:    name,
+		Day:          day,
+		ClassWhen:    classWhen,
+		Weeks:        weeks,
+		WeekDuration: tool.FormatWeeks(tool.ParseWeeks(weeks)),
+		Teacher:      col(4),
+		Where:        col(5),
+		Credit:       credit,
+	}, nil
+}
+
+var dayMap = map[string]int64{
+	"一": 1, "二": 2, "三": 3, "四": 4, "五": 5, "六": 6, "日": 7, "天": 7,
+}
+
+// parseDay 支持"1"~"7"以及"星期一","周一"等写法
+func parseDay(s string) (int64, error) {
+	if d, err := strconv.ParseInt(s, 10, 64); err == nil {
+		if d < 1 || d > 7 {
+			return 0, fmt.Errorf("星期超出范围: %q", s)
+		}
+		return d, nil
+	}
+	trimmed := strings.TrimPrefix(strings.TrimPrefix(s, "星期"), "周")
+	if d, ok := dayMap[trimmed]; ok {
+		return d, nil
+	}
+	return 0, fmt.Errorf("星期格式错误: %q", s)
+}
+
+// parseSections 解析"1-2","3"等节次,返回统一的"start-end"形式
+func parseSections(s string) (string, error) {
+	s = strings.TrimSuffix(strings.TrimPrefix(s, "第"), "节")
+	bounds := strings.SplitN(s, "-", 2)
+	start, err := strconv.Atoi(strings.TrimSpace(bounds[0]))
+	if err != nil {
+		return "", fmt.Errorf("节次格式错误: %q", s)
+	}
+	end := start
+	if len(bounds) == 2 {
+		end, err = strconv.Atoi(strings.TrimSpace(bounds[1]))
+		if err != nil {
+			return "", fmt.Errorf("节次格式错误: %q", s)
+		}
+	}
+	if err := checkSections(start, end); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%d-%d", start, end), nil
+}
+
+func checkSections(start, end int) error {
+	if start < 1 || end > MaxSection || start > end {
+		return fmt.Errorf("节次超出范围: %d-%d", start, end)
+	}
+	return nil
+}
+
+// ParseWeeks 解析形如"1-16","1-16单","2-16(双)","1,3,5-7周"的周次,返回周数的位图
+func ParseWeeks(s string) (int64, error) {
+	var weeks int64
+	for _, part := range strings.FieldsFunc(s, func(r rune) bool { return r == ',' || r == '，' || r == ';' }) {
+		odd, even := strings.Contains(part, "单"), strings.Contains(part, "双")
+		part = strings.NewReplacer("周", "", "单", "", "双", "", "(", "", ")", "", "（", "", "）", "", " ", "").Replace(part)
+		if part == "" {
+			continue
+		}
+		bounds := strings.SplitN(part, "-", 2)
+		start, err := strconv.Atoi(bounds[0])
+		if err != nil {
+			return 0, fmt.Errorf("周次格式错误: %q", s)
+		}
+		end := start
+		if len(bounds) == 2 {
+			end, err = strconv.Atoi(bounds[1])
+			if err != nil {
+				return 0, fmt.Errorf("周次格式错误: %q", s)
+			}
+		}
+		if start < 1 || end > MaxWeek || start > end {
+			return 0, fmt.Errorf("周次超出范围: %q", s)
+		}
+		for w := start; w <= end; w++ {
+			if (odd && w%2 == 0) || (even && w%2 == 1) {
+				continue
+			}
+			weeks |= 1 << (w - 1)
+		}
+	}
+	if weeks == 0 {
+		return 0, fmt.Errorf("周次不能为空: %q", s)
+	}
+	return weeks, nil
+}
+
+func isBlank(record []string) bool {
+	for _, s := range record {
+		if strings.TrimSpace(s) != "" {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,110 @@
+package importer
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestParseWeeks(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    int64
+		wantErr bool
+	}{
+		{"1-3", 0b111, false},
+		{"1-6单", 0b10101, false},
+		{"2-6(双)", 0b101010, false},
+		{"1,3,5-6周", 0b110101, false},
+		{"0-3", 0, true},
+		{"1-30", 0, true},
+		{"abc", 0, true},
+		{"", 0, true},
+	}
+	for _, tt := range tests {
+		got, err := ParseWeeks(tt.in)
+		if tt.wantErr {
+			assert.Error(t, err, tt.in)
+			continue
+		}
+		assert.NoError(t, err, tt.in)
+		assert.Equal(t, tt.want, got, tt.in)
+	}
+}
+
+func TestParseCSV(t *testing.T) {
+	content := "\xef\xbb\xbf课程名称,星期,节次,周次,教师,地点,学分\n" +
+		"社团例会,星期三,9-10,1-16,,7101,\n" +
+		",1,1-2,1-16,,,\n" +
+		"英语角,8,1-2,1-16,,,\n" +
+		"\n" +
+		"羽毛球,5,3-20,1-16,,,\n" +
+		"读书会,周五,第3节,2-8双,张三,图书馆,0.5\n"
+
+	rows, rowErrs, err := Parse(FormatCSV, []byte(content), "2024", "1")
+	assert.NoError(t, err)
+	if assert.Len(t, rows, 2) {
+		assert.Equal(t, 2, rows[0].Line)
+		assert.Equal(t, "社团例会", rows[0].Info.Classname)
+		assert.Equal(t, int64(3), rows[0].Info.Day)
+		assert.Equal(t, "9-10", rows[0].Info.ClassWhen)
+		assert.Equal(t, int64(0xffff), rows[0].Info.Weeks)
+		assert.Equal(t, "2024", rows[0].Info.Year)
+		assert.NotEmpty(t, rows[0].Info.ID)
+
+		assert.Equal(t, 7, rows[1].Line)
+		assert.Equal(t, "3-3", rows[1].Info.ClassWhen)
+		assert.Equal(t, int64(0b10101010), rows[1].Info.Weeks)
+		assert.Equal(t, 0.5, rows[1].Info.Credit)
+	}
+	var lines []int
+	for _, rowErr := range rowErrs {
+		lines = append(lines, rowErr.Line)
+	}
+	assert.Equal(t, []int{3, 4, 6}, lines)
+}
+
+func TestParseExcel(t *testing.T) {
+	f := excelize.NewFile()
+	sheet := f.GetSheetName(0)
+	assert.NoError(t, f.SetSheetRow(sheet, "A1", &TemplateHeader))
+	assert.NoError(t, f.SetSheetRow(sheet, "A2", &[]interface{}{"社团例会", 3, "9-10", "1-16", "", "7101", 1}))
+	var buf bytes.Buffer
+	assert.NoError(t, f.Write(&buf))
+
+	rows, rowErrs, err := Parse(FormatExcel, buf.Bytes(), "2024", "1")
+	assert.NoError(t, err)
+	assert.Empty(t, rowErrs)
+	if assert.Len(t, rows, 1) {
+		assert.Equal(t, "7101", rows[0].Info.Where)
+		assert.Equal(t, 1.0, rows[0].Info.Credit)
+	}
+}
+
+func TestParseWakeUp(t *testing.T) {
+	content := `{"courseLen":45,"id":1,"name":"默认"}
+[{"endTime":"08:45","node":1,"startTime":"08:00","timeTable":1}]
+{"tableName":"我的课表","maxWeek":20}
+[{"color":"#ff4caf50","courseName":"高等数学","credit":4.0,"id":0,"note":"","tableId":1}]
+[{"day":1,"endWeek":16,"id":0,"room":"7101","startNode":1,"startWeek":1,"step":2,"teacher":"李四","type":1},{"day":9,"endWeek":16,"id":0,"room":"","startNode":1,"startWeek":1,"step":2,"teacher":"","type":0}]`
+
+	rows, rowErrs, err := Parse(FormatWakeUp, []byte(content), "2024", "1")
+	assert.NoError(t, err)
+	if assert.Len(t, rows, 1) {
+		assert.Equal(t, 1, rows[0].Line)
+		assert.Equal(t, "高等数学", rows[0].Info.Classname)
+		assert.Equal(t, "1-2", rows[0].Info.ClassWhen)
+		assert.Equal(t, int64(0b0101010101010101), rows[0].Info.Weeks)
+		assert.Equal(t, "李四", rows[0].Info.Teacher)
+	}
+	if assert.Len(t, rowErrs, 1) {
+		assert.Equal(t, 2, rowErrs[0].Line)
+	}
+
+	_, _, err = Parse(FormatWakeUp, []byte(`{"a":1}`), "2024", "1")
+	assert.Error(t, err)
+	_, _, err = Parse("pdf", nil, "2024", "1")
+	assert.ErrorIs(t, err, ErrUnknownFormat)
+}
@@ -0,0 +1,90 @@
+package importer
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// WakeUp课程表导出的备份文件由多行JSON组成,
+// 其中一行是课程定义数组(含courseName),另一行是上课安排数组(含startNode)
+type wakeUpCourse struct {
+	ID         int     `json:"id"`
+	CourseName string  `json:"courseName"`
+	Credit     float64 `json:"credit"`
+}
+
+type wakeUpArrangement struct {
+	ID        int    `json:"id"` //对应课程定义的id
+	Day       int    `json:"day"`
+	StartNode int    `json:"startNode"`
+	Step      int    `json:"step"`
+	StartWeek int    `json:"startWeek"`
+	EndWeek   int    `json:"endWeek"`
+	Type      int    `json:"type"` //0为每周,1为单周,2为双周
+	Teacher   string `json:"teacher"`
+	Room      string `json:"room"`
+}
+
+// readWakeUp 将WakeUp的备份文件转换为与模板列顺序一致的记录
+func readWakeUp(content []byte) ([][]string, error) {
+	var (
+		courses      []wakeUpCourse
+		arrangements []wakeUpArrangement
+	)
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	scanner.Buffer(make([]byte, 0, 64*1024), len(content)+1)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 || line[0] != '[' {
+			continue
+		}
+		switch {
+		case bytes.Contains(line, []byte(`"courseName"`)):
+			if err := json.Unmarshal(line, &courses); err != nil {
+				return nil, fmt.Errorf("decode wakeup courses failed: %w", err)
+			}
+		case bytes.Contains(line, []byte(`"startNode"`)):
+			if err := json.Unmarshal(line, &arrangements); err != nil {
+				return nil, fmt.Errorf("decode wakeup arrangements failed: %w", err)
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if courses == nil || arrangements == nil {
+		return nil, errors.New("not a wakeup schedule file")
+	}
+
+	courseMap := make(map[int]wakeUpCourse, len(courses))
+	for _, c := range courses {
+		courseMap[c.ID] = c
+	}
+
+	records := make([][]string, 0, len(arrangements))
+	for _, a := range arrangements {
+		c := courseMap[a.ID]
+		weeks := fmt.Sprintf("%d-%d", a.StartWeek, a.EndWeek)
+		switch a.Type {
+		case 1:
+			weeks += "单"
+		case 2:
+			weeks += "双"
+		}
+		records = append(records, []string{
+			c.CourseName,
+			strconv.Itoa(a.Day),
+			fmt.Sprintf("%d-%d", a.StartNode, a.StartNode+a.Step-1),
+			weeks,
+			a.Teacher,
+			a.Room,
+			strconv.FormatFloat(c.Credit, 'f', -1, 64),
+		})
+	}
+	return records, nil
+}
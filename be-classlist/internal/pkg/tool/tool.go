@@ -38,6 +38,34 @@ func ParseWeeks(weeks int64) []int {
 	}
 	return weeksList
 }
+
+// ParseClassWhen 将形如"1-2","3","1-2,5-6"的节次描述解析为具体的节次列表
+func ParseClassWhen(classWhen string) []int {
+	var sections []int
+	for _, part := range strings.Split(classWhen, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		bounds := strings.SplitN(part, "-", 2)
+		start, err := strconv.Atoi(strings.TrimSpace(bounds[0]))
+		if err != nil {
+			continue
+		}
+		end := start
+		if len(bounds) == 2 {
+			end, err = strconv.Atoi(strings.TrimSpace(bounds[1]))
+			if err != nil {
+				continue
+			}
+		}
+		for s := start; s <= end; s++ {
+			sections = append(sections, s)
+		}
+	}
+	return sections
+}
+
 func FormatWeeks(weeks []int) string {
 	if len(weeks) == 0 {
 		return ""
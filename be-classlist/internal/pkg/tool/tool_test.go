@@ -158,6 +158,28 @@ func TestParseWeeks(t *testing.T) {
 	}
 }
 
+func TestParseClassWhen(t *testing.T) {
+	tests := []struct {
+		name      string
+		classWhen string
+		want      []int
+	}{
+		{"range", "1-2", []int{1, 2}},
+		{"single", "5", []int{5}},
+		{"same start and end", "3-3", []int{3}},
+		{"multiple ranges", "1-2,5-6", []int{1, 2, 5, 6}},
+		{"invalid", "abc", nil},
+		{"empty", "", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ParseClassWhen(tt.classWhen); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseClassWhen() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestCheckIfThisWeek(t *testing.T) {
 	type args struct {
 		xnm string
@@ -3,53 +3,76 @@ package service
 import (
 	"context"
 	"fmt"
+	"sort"
 	"time"
 
+	calendarv1 "github.com/asynccnu/ccnubox-be/be-api/gen/proto/calendar/v1"
 	pb "github.com/asynccnu/ccnubox-be/be-api/gen/proto/classlist/v1" //此处改成了be-api中的,方便其他服务调用.
 	"github.com/asynccnu/ccnubox-be/be-classlist/internal/biz"
 	"github.com/asynccnu/ccnubox-be/be-classlist/internal/classLog"
 	"github.com/asynccnu/ccnubox-be/be-classlist/internal/conf"
 	"github.com/asynccnu/ccnubox-be/be-classlist/internal/errcode"
+	"github.com/asynccnu/ccnubox-be/be-classlist/internal/pkg/importer"
 	"github.com/asynccnu/ccnubox-be/be-classlist/internal/pkg/tool"
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/jinzhu/copier"
 )
 
+// SemesterProvider 校历,获取当前所在的学期
+type SemesterProvider interface {
+	GetCurrentSemester(ctx context.Context) (*calendarv1.Semester, error)
+}
+
 type ClassListService struct {
 	pb.UnimplementedClasserServer
 	clu       *biz.ClassUsecase
+	calendar  SemesterProvider
 	schoolday *conf.SchoolDay
 	logger    log.Logger
 	defaults  *conf.Defaults
 }
 
-func NewClasserService(clu *biz.ClassUsecase, day *conf.SchoolDay, logger log.Logger, defaults *conf.Defaults) *ClassListService {
+func NewClasserService(clu *biz.ClassUsecase, calendar SemesterProvider, day *conf.SchoolDay, logger log.Logger, defaults *conf.Defaults) *ClassListService {
 	return &ClassListService{
 		clu:       clu,
+		calendar:  calendar,
 		logger:    logger,
 		schoolday: day,
 		defaults:  defaults,
 	}
 }
 
+// currentSemester 优先使用校历中的当前学期,校历不可用时使用配置文件中的默认值
+func (s *ClassListService) currentSemester(ctx context.Context, hlog *log.Helper) (year, semester string) {
+	sem, err := s.calendar.GetCurrentSemester(ctx)
+	if err == nil {
+		return sem.GetYear(), sem.GetSemester()
+	}
+	hlog.Warnf("从校历获取当前学期失败: %v", err)
+	if s.defaults == nil {
+		hlog.Warn("default 参数未在配置文件中配置")
+		return "", ""
+	}
+	return s.defaults.Year, s.defaults.Semester
+}
+
 func (s *ClassListService) GetClass(ctx context.Context, req *pb.GetClassRequest) (*pb.GetClassResponse, error) {
 	valLogger := log.With(s.logger,
 		"stu_id", req.GetStuId(), "year", req.GetYear(), "semester", req.GetSemester())
 	ctx = classLog.WithLogger(ctx, valLogger)
 	hlog := log.NewHelper(valLogger)
 
-	if s.defaults == nil {
-		hlog.Warn("default 参数未在配置文件中配置")
-	}
-
-	if req.GetYear() == "" {
-		req.Year = s.defaults.Year
-		hlog.Warn(fmt.Sprintf("获取 Year 参数为空，使用默认值 %s", req.Year))
-	}
-
-	if req.GetSemester() == "" {
-		req.Semester = s.defaults.Semester
-		hlog.Warn(fmt.Sprintf("获取 Semester 参数为空，使用默认值 %s", req.Semester))
+	if req.GetYear() == "" || req.GetSemester() == "" {
+		year, semester := s.currentSemester(ctx, hlog)
+		if req.GetYear() == "" {
+			req.Year = year
+			hlog.Warn(fmt.Sprintf("获取 Year 参数为空，使用默认值 %s", req.Year))
+		}
+		if req.GetSemester() == "" {
+			req.Semester = semester
+			hlog.Warn(fmt.Sprintf("获取 Semester 参数为空，使用默认值 %s", req.Semester))
+		}
 	}
 
 	if !tool.CheckSY(req.Semester, req.Year) {
@@ -115,7 +138,7 @@ func (s *ClassListService) AddClass(ctx context.Context, req *pb.AddClassRequest
 
 	classInfo.UpdateID()
 
-	err := s.clu.AddClass(ctx, req.GetStuId(), classInfo)
+	err := s.clu.AddClass(ctx, req.GetStuId(), classInfo, req.GetForce())
 
 	if err != nil {
 		return &pb.AddClassResponse{}, err
@@ -298,7 +321,20 @@ func (s *ClassListService) GetAllClassInfo(ctx context.Context, req *pb.GetAllCl
 	}, nil
 }
 
+// GetSchoolDay 返回当前学期的上学日期和放假日期,校历不可用时使用配置文件中的日期
 func (s *ClassListService) GetSchoolDay(ctx context.Context, req *pb.GetSchoolDayReq) (*pb.GetSchoolDayResp, error) {
+	sem, err := s.calendar.GetCurrentSemester(ctx)
+	if err == nil {
+		end, perr := time.Parse("2006-01-02", sem.GetEndDate())
+		if perr == nil {
+			return &pb.GetSchoolDayResp{
+				HolidayTime: end.AddDate(0, 0, 1).Format("2006-01-02"), //学期最后一天的后一天正式放假
+				SchoolTime:  sem.GetStartDate(),
+			}, nil
+		}
+		err = perr
+	}
+	log.NewHelper(s.logger).Warnf("从校历获取当前学期失败,使用配置文件中的日期: %v", err)
 	return &pb.GetSchoolDayResp{
 		HolidayTime: s.schoolday.HolidayTime,
 		SchoolTime:  s.schoolday.SchoolTime,
@@ -347,6 +383,165 @@ func (s *ClassListService) DeleteClassNote(ctx context.Context, req *pb.DeleteCl
 	}, nil
 }
 
+func (s *ClassListService) CheckClassConflict(ctx context.Context, req *pb.CheckClassConflictReq) (*pb.CheckClassConflictResp, error) {
+	valLogger := log.With(s.logger,
+		"stu_id", req.GetStuId(), "year", req.GetYear(), "semester", req.GetSemester())
+	ctx = classLog.WithLogger(ctx, valLogger)
+	if !tool.CheckSY(req.Semester, req.Year) || req.GetWeeks() <= 0 || req.GetDay() < 1 || req.GetDay() > 7 {
+		return &pb.CheckClassConflictResp{}, errcode.ErrParam
+	}
+	var candidate = &biz.ClassInfo{
+		Day:       req.GetDay(),
+		ClassWhen: req.GetDurClass(),
+		Weeks:     req.GetWeeks(),
+		Semester:  req.GetSemester(),
+		Year:      req.GetYear(),
+	}
+	conflicts, err := s.clu.CheckConflict(ctx, req.GetStuId(), candidate, req.GetExcludeClassId())
+	if err != nil {
+		return &pb.CheckClassConflictResp{}, err
+	}
+	pbConflicts := make([]*pb.ClassConflict, 0, len(conflicts))
+	for _, conflict := range conflicts {
+		var pbClassInfo = new(pb.ClassInfo)
+		_ = copier.Copy(&pbClassInfo, &conflict.Info)
+		slots := make([]*pb.ConflictSlot, 0, len(conflict.Slots))
+		for _, slot := range conflict.Slots {
+			slots = append(slots, &pb.ConflictSlot{
+				Week:    slot.Week,
+				Day:     slot.Day,
+				Section: slot.Section,
+			})
+		}
+		pbConflicts = append(pbConflicts, &pb.ClassConflict{
+			Info:  pbClassInfo,
+			Slots: slots,
+		})
+	}
+	return &pb.CheckClassConflictResp{
+		HasConflict: len(pbConflicts) > 0,
+		Conflicts:   pbConflicts,
+	}, nil
+}
+
+func (s *ClassListService) GetTerms(ctx context.Context, req *pb.GetTermsReq) (*pb.GetTermsResp, error) {
+	valLogger := log.With(s.logger, "stu_id", req.GetStuId())
+	ctx = classLog.WithLogger(ctx, valLogger)
+	if req.GetStuId() == "" {
+		return &pb.GetTermsResp{}, errcode.ErrParam
+	}
+	terms, err := s.clu.GetTerms(ctx, req.GetStuId())
+	if err != nil {
+		return &pb.GetTermsResp{}, err
+	}
+	pbTerms := make([]*pb.TermInfo, 0, len(terms))
+	for _, term := range terms {
+		pbTerm := &pb.TermInfo{
+			Year:          term.Year,
+			Semester:      term.Semester,
+			ClassNum:      term.ClassNum,
+			AddedClassNum: term.AddedClassNum,
+		}
+		if term.LastRefreshTime != nil {
+			pbTerm.LastRefreshTime = convertToShanghaiTimeStamp(*term.LastRefreshTime)
+		}
+		pbTerms = append(pbTerms, pbTerm)
+	}
+	return &pb.GetTermsResp{Terms: pbTerms}, nil
+}
+
+func (s *ClassListService) CopyAddedClasses(ctx context.Context, req *pb.CopyAddedClassesReq) (*pb.CopyAddedClassesResp, error) {
+	valLogger := log.With(s.logger,
+		"stu_id", req.GetStuId(), "from_year", req.GetFromYear(), "from_semester", req.GetFromSemester(),
+		"to_year", req.GetToYear(), "to_semester", req.GetToSemester())
+	ctx = classLog.WithLogger(ctx, valLogger)
+	if !tool.CheckSY(req.GetFromSemester(), req.GetFromYear()) || !tool.CheckSY(req.GetToSemester(), req.GetToYear()) ||
+		(req.GetFromYear() == req.GetToYear() && req.GetFromSemester() == req.GetToSemester()) {
+		return &pb.CopyAddedClassesResp{}, errcode.ErrParam
+	}
+	copied, skipped, err := s.clu.CopyAddedClasses(ctx, req.GetStuId(), req.GetFromYear(), req.GetFromSemester(),
+		req.GetToYear(), req.GetToSemester(), req.GetWithNote(), req.GetForce())
+	if err != nil {
+		return &pb.CopyAddedClassesResp{}, err
+	}
+	pbCopied := make([]*pb.ClassInfo, 0, len(copied))
+	pbSkipped := make([]*pb.ClassInfo, 0, len(skipped))
+	_ = copier.Copy(&pbCopied, &copied)
+	_ = copier.Copy(&pbSkipped, &skipped)
+	return &pb.CopyAddedClassesResp{
+		Copied:  pbCopied,
+		Skipped: pbSkipped,
+	}, nil
+}
+
+func (s *ClassListService) ImportClasses(ctx context.Context, req *pb.ImportClassesReq) (*pb.ImportClassesResp, error) {
+	valLogger := log.With(s.logger,
+		"stu_id", req.GetStuId(), "year", req.GetYear(), "semester", req.GetSemester(), "format", req.GetFormat())
+	ctx = classLog.WithLogger(ctx, valLogger)
+	if !tool.CheckSY(req.GetSemester(), req.GetYear()) || len(req.GetContent()) == 0 {
+		return &pb.ImportClassesResp{}, errcode.ErrParam
+	}
+	rows, rowErrs, err := importer.Parse(req.GetFormat(), req.GetContent(), req.GetYear(), req.GetSemester())
+	if err != nil {
+		classLog.GetLogHelperFromCtx(ctx).Warnf("parse import file failed: %v", err)
+		return &pb.ImportClassesResp{}, errcode.ErrImportFile
+	}
+
+	pbErrs := make([]*pb.ImportRowError, 0, len(rowErrs))
+	for _, rowErr := range rowErrs {
+		pbErrs = append(pbErrs, &pb.ImportRowError{Line: int64(rowErr.Line), Msg: rowErr.Msg})
+	}
+
+	infos := make([]*biz.ClassInfo, 0, len(rows))
+	for _, row := range rows {
+		infos = append(infos, row.Info)
+	}
+	addErrs := s.clu.ImportClasses(ctx, req.GetStuId(), infos, req.GetForce())
+
+	imported := make([]*pb.ClassInfo, 0, len(rows))
+	for i, row := range rows {
+		if addErrs[i] != nil {
+			pbErrs = append(pbErrs, &pb.ImportRowError{Line: int64(row.Line), Msg: errors.FromError(addErrs[i]).GetMessage()})
+			continue
+		}
+		var pbClassInfo = new(pb.ClassInfo)
+		_ = copier.Copy(&pbClassInfo, &row.Info)
+		imported = append(imported, pbClassInfo)
+	}
+	sort.Slice(pbErrs, func(i, j int) bool { return pbErrs[i].Line < pbErrs[j].Line })
+
+	return &pb.ImportClassesResp{
+		Imported: imported,
+		Errors:   pbErrs,
+	}, nil
+}
+
+func (s *ClassListService) GetCrawlerHealth(ctx context.Context, req *pb.GetCrawlerHealthReq) (*pb.GetCrawlerHealthResp, error) {
+	healths := s.clu.GetCrawlerHealth()
+	pbHealths := make([]*pb.CrawlerHealth, 0, len(healths))
+	for _, h := range healths {
+		pbHealth := &pb.CrawlerHealth{
+			Name:                h.Name,
+			StudentType:         h.StudentType,
+			Healthy:             h.Healthy,
+			SuccessRate:         h.SuccessRate,
+			AvgLatencyMs:        h.AvgLatency.Milliseconds(),
+			Total:               h.Total,
+			Failures:            h.Failures,
+			ConsecutiveFailures: h.ConsecutiveFailures,
+			LastError:           h.LastError,
+		}
+		if !h.DemotedUntil.IsZero() {
+			pbHealth.DemotedUntil = convertToShanghaiTimeStamp(h.DemotedUntil)
+		}
+		if !h.LastSuccessTime.IsZero() {
+			pbHealth.LastSuccessTime = convertToShanghaiTimeStamp(h.LastSuccessTime)
+		}
+		pbHealths = append(pbHealths, pbHealth)
+	}
+	return &pb.GetCrawlerHealthResp{Strategies: pbHealths}, nil
+}
+
 func convertToShanghaiTimeStamp(t time.Time) int64 {
 	return tool.ToShanghaiTime(t).Unix()
 }
@@ -8,6 +8,7 @@ type Cron interface {
 
 func NewCron(
 	elecpriceController *ElecpriceController,
+	usageController *UsageController,
 ) []Cron {
-	return []Cron{elecpriceController}
+	return []Cron{elecpriceController, usageController}
 }
@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	feedv1 "github.com/asynccnu/ccnubox-be/be-api/gen/proto/feed/v1"
+	"github.com/asynccnu/ccnubox-be/be-elecprice/domain"
 	"github.com/asynccnu/ccnubox-be/be-elecprice/pkg/logger"
 	"github.com/asynccnu/ccnubox-be/be-elecprice/service"
 	"github.com/spf13/viper"
+	"math"
 	"time"
 )
 
@@ -75,7 +77,7 @@ func (r *ElecpriceController) publishMSG() error {
 				Event: &feedv1.FeedEvent{
 					Type:    "energy",
 					Title:   "电费不足提醒",
-					Content: fmt.Sprintf("您的房间%s当前的电费为:%s,低于设置阈值,请及时充费", *(msgs[i].RoomName), *(msgs[i].Remain)),
+					Content: alertContent(msgs[i]),
 				},
 			})
 		}
@@ -84,3 +86,18 @@ func (r *ElecpriceController) publishMSG() error {
 
 	return err
 }
+
+// alertContent 低于阈值时说明阈值, 能预测时附上预计还可使用的天数
+func alertContent(msg *domain.ElectricMSG) string {
+	content := fmt.Sprintf("您的房间%s当前的电费为:%s", *msg.RoomName, *msg.Remain)
+	if msg.BelowLimit {
+		content += ",低于设置阈值"
+	}
+	switch {
+	case msg.DaysLeft >= 1:
+		content += fmt.Sprintf(",预计还可使用约%d天", int(math.Floor(msg.DaysLeft)))
+	case msg.DaysLeft >= 0:
+		content += ",预计不足1天就会用完"
+	}
+	return content + ",请及时充费"
+}
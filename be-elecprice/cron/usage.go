@@ -0,0 +1,66 @@
+package cron
+
+import (
+	"context"
+	"time"
+
+	"github.com/asynccnu/ccnubox-be/be-elecprice/pkg/logger"
+	"github.com/asynccnu/ccnubox-be/be-elecprice/service"
+	"github.com/spf13/viper"
+)
+
+// UsageController 定时记录订阅房间每天的用电
+type UsageController struct {
+	elecpriceSerice service.ElecpriceService
+	stopChan        chan struct{}
+	cfg             UsageControllerConfig
+	l               logger.Logger
+}
+
+type UsageControllerConfig struct {
+	DurationTime int64 `yaml:"durationTime"`
+}
+
+func NewUsageController(
+	elecpriceSerice service.ElecpriceService,
+	l logger.Logger,
+) *UsageController {
+	var cfg UsageControllerConfig
+	if err := viper.UnmarshalKey("elecpriceUsage", &cfg); err != nil {
+		panic(err)
+	}
+	if cfg.DurationTime <= 0 {
+		cfg.DurationTime = 24
+	}
+	return &UsageController{
+		elecpriceSerice: elecpriceSerice,
+		stopChan:        make(chan struct{}),
+		cfg:             cfg,
+		l:               l,
+	}
+}
+
+func (r *UsageController) StartCronTask() {
+	go func() {
+		// 启动时先记录一次, 新订阅的房间会回填历史用电
+		r.recordUsage()
+
+		ticker := time.NewTicker(time.Duration(r.cfg.DurationTime) * time.Hour)
+		for {
+			select {
+			case <-ticker.C:
+				r.recordUsage()
+
+			case <-r.stopChan:
+				ticker.Stop()
+				return
+			}
+		}
+	}()
+}
+
+func (r *UsageController) recordUsage() {
+	if err := r.elecpriceSerice.RecordUsage(context.Background()); err != nil {
+		r.l.Error("记录用电失败!:", logger.Error(err))
+	}
+}
@@ -6,9 +6,11 @@ type Elecprice struct {
 }
 
 type ElectricMSG struct {
-	RoomName  *string
-	StudentId string // 学号
-	Remain    *string
+	RoomName   *string
+	StudentId  string // 学号
+	Remain     *string
+	BelowLimit bool    // 是否低于设置的阈值
+	DaysLeft   float64 // 预计还可使用的天数, 小于 0 表示无法预测
 }
 
 type ResultInfo struct {
@@ -72,3 +74,28 @@ type CancelStandardRequest struct {
 }
 
 type CancelStandardResponse struct{}
+
+type UsagePoint struct {
+	Date        string  // 统计周期的第一天
+	UseValue    float64 // 用电量
+	UseMoney    float64 // 电费
+	RemainMoney float64 // 周期内最后记录的剩余电费, 没有记录时为 -1
+}
+
+type UsageForecast struct {
+	RemainMoney   string
+	AvgDailyMoney float64
+	DaysLeft      float64 // 无法预测时为 -1
+	EmptyDate     string
+}
+
+type GetUsageHistoryRequest struct {
+	RoomId      string
+	Granularity string
+	Days        int64
+}
+
+type GetUsageHistoryResponse struct {
+	Points   []*UsagePoint
+	Forecast *UsageForecast
+}
@@ -111,3 +111,31 @@ func (s *ElecpriceServiceServer) CancelStandard(ctx context.Context, req *v1.Can
 
 	return &v1.CancelStandardResponse{}, err
 }
+
+func (s *ElecpriceServiceServer) GetUsageHistory(ctx context.Context, req *v1.GetUsageHistoryRequest) (*v1.GetUsageHistoryResponse, error) {
+	res, err := s.ser.GetUsageHistory(ctx, &domain.GetUsageHistoryRequest{
+		RoomId:      req.RoomId,
+		Granularity: req.Granularity,
+		Days:        req.Days,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var resp v1.GetUsageHistoryResponse
+	for _, p := range res.Points {
+		resp.Points = append(resp.Points, &v1.UsagePoint{
+			Date:        p.Date,
+			UseValue:    p.UseValue,
+			UseMoney:    p.UseMoney,
+			RemainMoney: p.RemainMoney,
+		})
+	}
+	resp.Forecast = &v1.UsageForecast{
+		RemainMoney:   res.Forecast.RemainMoney,
+		AvgDailyMoney: res.Forecast.AvgDailyMoney,
+		DaysLeft:      res.Forecast.DaysLeft,
+		EmptyDate:     res.Forecast.EmptyDate,
+	}
+	return &resp, nil
+}
@@ -6,7 +6,7 @@ import (
 )
 
 func InitTables(db *gorm.DB) error {
-	err := db.AutoMigrate(&model.ElecpriceConfig{})
+	err := db.AutoMigrate(&model.ElecpriceConfig{}, &model.ElecUsageRecord{})
 	if err != nil {
 		return err
 	}
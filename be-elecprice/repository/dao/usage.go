@@ -0,0 +1,69 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/asynccnu/ccnubox-be/be-elecprice/repository/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ElecUsageDAO 用电记录的数据库操作
+type ElecUsageDAO interface {
+	UpsertRecords(ctx context.Context, records []model.ElecUsageRecord) error
+	FindRecords(ctx context.Context, roomId string, from string, to string) ([]model.ElecUsageRecord, error)
+	LatestDate(ctx context.Context, roomId string) (string, error)
+}
+
+type elecUsageDAO struct {
+	db *gorm.DB
+}
+
+// NewElecUsageDAO 构建用电记录操作实例
+func NewElecUsageDAO(db *gorm.DB) ElecUsageDAO {
+	return &elecUsageDAO{db: db}
+}
+
+// UpsertRecords 按房间和日期写入, 已有记录时更新用电量, 剩余电费只在新记录带有时覆盖
+func (d *elecUsageDAO) UpsertRecords(ctx context.Context, records []model.ElecUsageRecord) error {
+	if len(records) == 0 {
+		return nil
+	}
+	return d.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "target_id"}, {Name: "date"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"use_value":    gorm.Expr("VALUES(use_value)"),
+			"use_money":    gorm.Expr("VALUES(use_money)"),
+			"remain_money": gorm.Expr("COALESCE(VALUES(remain_money), remain_money)"),
+			"updated_at":   gorm.Expr("VALUES(updated_at)"),
+		}),
+	}).Create(&records).Error
+}
+
+// FindRecords 查询房间在 [from, to] 内的记录, 按日期升序
+func (d *elecUsageDAO) FindRecords(ctx context.Context, roomId string, from string, to string) ([]model.ElecUsageRecord, error) {
+	var records []model.ElecUsageRecord
+	err := d.db.WithContext(ctx).
+		Where("target_id = ? and date >= ? and date <= ?", roomId, from, to).
+		Order("date ASC").
+		Find(&records).Error
+	if err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// LatestDate 房间最近一条记录的日期, 没有记录时返回空
+func (d *elecUsageDAO) LatestDate(ctx context.Context, roomId string) (string, error) {
+	var dates []string
+	err := d.db.WithContext(ctx).
+		Model(&model.ElecUsageRecord{}).
+		Where("target_id = ?", roomId).
+		Order("date DESC").
+		Limit(1).
+		Pluck("date", &dates).Error
+	if err != nil || len(dates) == 0 {
+		return "", err
+	}
+	return dates[0], nil
+}
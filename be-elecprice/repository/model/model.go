@@ -13,6 +13,16 @@ type ElecpriceConfig struct {
 	BaseModel
 }
 
+// ElecUsageRecord 房间每天的用电记录, 同一房间同一天只有一条
+type ElecUsageRecord struct {
+	TargetID    string   `gorm:"column:target_id;type:varchar(64);not null;uniqueIndex:idx_target_date"` // 房间ID
+	Date        string   `gorm:"column:date;type:char(10);not null;uniqueIndex:idx_target_date"`         // 日期, 2006-01-02
+	UseValue    float64  `gorm:"column:use_value;not null"`                                              // 当天用电量
+	UseMoney    float64  `gorm:"column:use_money;not null"`                                              // 当天电费
+	RemainMoney *float64 `gorm:"column:remain_money"`                                                    // 记录时的剩余电费, 回填的历史记录没有
+	BaseModel
+}
+
 // BaseModel 使用 Unix 时间戳替代 gorm.Model
 type BaseModel struct {
 	ID        int64          `gorm:"primaryKey;autoIncrement;column:id"` // 主键
@@ -11,6 +11,7 @@ import (
 	"github.com/asynccnu/ccnubox-be/be-elecprice/pkg/logger"
 	"github.com/asynccnu/ccnubox-be/be-elecprice/repository/dao"
 	"github.com/asynccnu/ccnubox-be/be-elecprice/repository/model"
+	"github.com/spf13/viper"
 	"net/url"
 	"strconv"
 	"sync"
@@ -27,6 +28,15 @@ var (
 	SAVE_CONFIG_ERROR = func(err error) error {
 		return errorx.New(elecpricev1.ErrorSaveConfigError("保存配置失败"), "dao", err)
 	}
+	INVALID_REQUEST = func(err error) error {
+		return errorx.New(elecpricev1.ErrorInvalidRequest("请求参数无效"), "param", err)
+	}
+	FIND_USAGE_ERROR = func(err error) error {
+		return errorx.New(elecpricev1.ErrorFindUsageError("获取用电记录失败"), "dao", err)
+	}
+	SAVE_USAGE_ERROR = func(err error) error {
+		return errorx.New(elecpricev1.ErrorSaveUsageError("保存用电记录失败"), "dao", err)
+	}
 )
 
 type ElecpriceService interface {
@@ -38,15 +48,25 @@ type ElecpriceService interface {
 	GetArchitecture(ctx context.Context, area string) (domain.ResultArchitectureInfo, error)
 	GetRoomInfo(ctx context.Context, archiID string, floor string) (map[string]string, error)
 	GetPrice(ctx context.Context, roomid string) (*domain.Prices, error)
+
+	RecordUsage(ctx context.Context) error
+	GetUsageHistory(ctx context.Context, r *domain.GetUsageHistoryRequest) (*domain.GetUsageHistoryResponse, error)
 }
 
 type elecpriceService struct {
 	elecpriceDAO dao.ElecpriceDAO
+	usageDAO     dao.ElecUsageDAO
+	usageCfg     UsageConfig
 	l            logger.Logger
 }
 
-func NewElecpriceService(elecpriceDAO dao.ElecpriceDAO, l logger.Logger) ElecpriceService {
-	return &elecpriceService{elecpriceDAO: elecpriceDAO, l: l}
+func NewElecpriceService(elecpriceDAO dao.ElecpriceDAO, usageDAO dao.ElecUsageDAO, l logger.Logger) ElecpriceService {
+	var cfg UsageConfig
+	if err := viper.UnmarshalKey("elecpriceUsage", &cfg); err != nil {
+		panic(err)
+	}
+	cfg.withDefault()
+	return &elecpriceService{elecpriceDAO: elecpriceDAO, usageDAO: usageDAO, usageCfg: cfg, l: l}
 }
 
 func (s *elecpriceService) SetStandard(ctx context.Context, r *domain.SetStandardRequest) error {
@@ -144,12 +164,23 @@ func (s *elecpriceService) GetTobePushMSG(ctx context.Context) ([]*domain.Electr
 					return
 				}
 
-				// 检查是否符合用户设定的阈值
-				if Remain < float64(cfg.Limit) {
+				// 根据最近的用电预测还能用多少天，预测失败时仍然按阈值提醒
+				forecast, err := s.forecast(ctx, cfg.TargetID, elecPrice.RemainMoney)
+				if err != nil {
+					s.l.Warn("预测剩余天数失败", logger.String("room_id", cfg.TargetID), logger.Error(err))
+					forecast = &domain.UsageForecast{DaysLeft: -1}
+				}
+
+				// 检查是否符合用户设定的阈值, 或者预计很快用完
+				belowLimit := Remain < float64(cfg.Limit)
+				runningOut := s.usageCfg.AlertDays > 0 && forecast.DaysLeft >= 0 && forecast.DaysLeft <= float64(s.usageCfg.AlertDays)
+				if belowLimit || runningOut {
 					msg := &domain.ElectricMSG{
-						RoomName:  &cfg.RoomName,
-						StudentId: cfg.StudentID,
-						Remain:    &elecPrice.RemainMoney,
+						RoomName:   &cfg.RoomName,
+						StudentId:  cfg.StudentID,
+						Remain:     &elecPrice.RemainMoney,
+						BelowLimit: belowLimit,
+						DaysLeft:   forecast.DaysLeft,
 					}
 
 					// 并发安全地添加结果
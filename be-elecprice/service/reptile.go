@@ -72,6 +72,19 @@ func matchRegexpOneEle(input, pattern string) (string, error) {
 	return matches[1], nil
 }
 
+// matchRegexpAll 按顺序返回每个匹配的第一个分组
+func matchRegexpAll(input, pattern string) []string {
+	re := regexp.MustCompile(pattern)
+	var res []string
+	for _, match := range re.FindAllStringSubmatch(input, -1) {
+		if len(match) < 2 {
+			continue
+		}
+		res = append(res, match[1])
+	}
+	return res
+}
+
 func filter(m map[string]string) map[string]string {
 	res := make(map[string]string, len(m))
 	for k, v := range m {
@@ -0,0 +1,354 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/asynccnu/ccnubox-be/be-elecprice/domain"
+	"github.com/asynccnu/ccnubox-be/be-elecprice/pkg/logger"
+	"github.com/asynccnu/ccnubox-be/be-elecprice/repository/model"
+)
+
+const dateLayout = "2006-01-02"
+
+const (
+	GranularityDay   = "day"
+	GranularityWeek  = "week"
+	GranularityMonth = "month"
+)
+
+// UsageConfig 用电记录和预测的配置
+type UsageConfig struct {
+	BackfillDays int `yaml:"backfillDays"` // 房间没有记录时回填的天数
+	ForecastDays int `yaml:"forecastDays"` // 使用最近多少天的用电预测可用天数
+	AlertDays    int `yaml:"alertDays"`    // 预计可用天数不超过该值时提醒, 为 0 时只按阈值提醒
+}
+
+func (c *UsageConfig) withDefault() {
+	if c.BackfillDays <= 0 {
+		c.BackfillDays = 30
+	}
+	if c.ForecastDays <= 0 {
+		c.ForecastDays = 7
+	}
+}
+
+// RecordUsage 记录所有订阅房间截至昨天的用电, 房间没有记录时回填最近 BackfillDays 天
+func (s *elecpriceService) RecordUsage(ctx context.Context) error {
+	rooms, err := s.subscribedRooms(ctx)
+	if err != nil {
+		return FIND_CONFIG_ERROR(err)
+	}
+
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		failed    int
+		semaphore = make(chan struct{}, 10)
+	)
+	for _, roomID := range rooms {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(roomID string) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			if err := s.recordRoomUsage(ctx, roomID); err != nil {
+				s.l.Error("记录房间用电失败", logger.String("room_id", roomID), logger.Error(err))
+				mu.Lock()
+				failed++
+				mu.Unlock()
+			}
+		}(roomID)
+	}
+	wg.Wait()
+
+	if failed > 0 {
+		return fmt.Errorf("%d/%d 个房间用电记录失败", failed, len(rooms))
+	}
+	return nil
+}
+
+// subscribedRooms 所有设置了提醒的房间, 多个学生订阅同一房间时只返回一次
+func (s *elecpriceService) subscribedRooms(ctx context.Context) ([]string, error) {
+	var (
+		rooms  []string
+		seen         = make(map[string]struct{})
+		lastID int64 = -1
+	)
+	for {
+		configs, nextID, err := s.elecpriceDAO.GetConfigsByCursor(ctx, lastID, 100)
+		if err != nil {
+			return nil, err
+		}
+		if len(configs) == 0 {
+			return rooms, nil
+		}
+		for _, cfg := range configs {
+			if _, ok := seen[cfg.TargetID]; ok {
+				continue
+			}
+			seen[cfg.TargetID] = struct{}{}
+			rooms = append(rooms, cfg.TargetID)
+		}
+		lastID = nextID
+	}
+}
+
+func (s *elecpriceService) recordRoomUsage(ctx context.Context, roomID string) error {
+	yesterday := today().AddDate(0, 0, -1)
+
+	start := yesterday.AddDate(0, 0, 1-s.usageCfg.BackfillDays)
+	latest, err := s.usageDAO.LatestDate(ctx, roomID)
+	if err != nil {
+		return FIND_USAGE_ERROR(err)
+	}
+	if latest != "" {
+		last, err := time.ParseInLocation(dateLayout, latest, time.Local)
+		if err == nil && !last.Before(start) {
+			// 最后一天可能在记录时还没有出账, 重新取一次
+			start = last
+		}
+	}
+
+	meterID, err := s.GetMeterID(ctx, roomID)
+	if err != nil {
+		return err
+	}
+	records, err := s.getMeterDayValues(ctx, meterID, start, yesterday)
+	if err != nil {
+		return INTERNET_ERROR(err)
+	}
+
+	// 当前余额记在昨天的记录上, 回填的历史记录没有余额
+	body, err := sendRequest(ctx, fmt.Sprintf("https://jnb.ccnu.edu.cn/ICBS/PurchaseWebService.asmx/getReserveHKAM?AmMeter_ID=%s", meterID))
+	if err == nil {
+		var remain string
+		if remain, err = matchRegexpOneEle(body, `<remainPower>(.*?)</remainPower>`); err == nil {
+			if v, err := strconv.ParseFloat(remain, 64); err == nil {
+				for i := range records {
+					if records[i].Date == yesterday.Format(dateLayout) {
+						records[i].RemainMoney = &v
+					}
+				}
+			}
+		}
+	}
+	if err != nil {
+		s.l.Warn("获取房间余额失败", logger.String("room_id", roomID), logger.Error(err))
+	}
+
+	for i := range records {
+		records[i].TargetID = roomID
+	}
+	if err = s.usageDAO.UpsertRecords(ctx, records); err != nil {
+		return SAVE_USAGE_ERROR(err)
+	}
+	return nil
+}
+
+// getMeterDayValues 按日期区间取电表每天的用电
+// 区间查询返回的日期和用电条数对不上时, 退回到逐天查询
+func (s *elecpriceService) getMeterDayValues(ctx context.Context, meterID string, start, end time.Time) ([]model.ElecUsageRecord, error) {
+	if start.After(end) {
+		return nil, nil
+	}
+	body, err := s.requestMeterDayValue(ctx, meterID, start, end)
+	if err != nil {
+		return nil, err
+	}
+	if start.Equal(end) {
+		return parseDayValues(body, []string{start.Format(dateLayout)})
+	}
+
+	var dates []string
+	for _, d := range matchRegexpAll(body, `<curDayTime>(.*?)</curDayTime>`) {
+		t, err := parseMeterDate(d)
+		if err != nil {
+			dates = nil
+			break
+		}
+		dates = append(dates, t.Format(dateLayout))
+	}
+	if records, err := parseDayValues(body, dates); err == nil {
+		return records, nil
+	}
+
+	var records []model.ElecUsageRecord
+	for d := start; !d.After(end); d = d.AddDate(0, 0, 1) {
+		body, err := s.requestMeterDayValue(ctx, meterID, d, d)
+		if err != nil {
+			return nil, err
+		}
+		day, err := parseDayValues(body, []string{d.Format(dateLayout)})
+		if err != nil {
+			// 当天没有出账
+			continue
+		}
+		records = append(records, day...)
+	}
+	return records, nil
+}
+
+func (s *elecpriceService) requestMeterDayValue(ctx context.Context, meterID string, start, end time.Time) (string, error) {
+	return sendRequest(ctx, fmt.Sprintf("https://jnb.ccnu.edu.cn/ICBS/PurchaseWebService.asmx/getMeterDayValue?AmMeter_ID=%s&startDate=%s&endDate=%s",
+		meterID, url.QueryEscape(start.Format("2006/1/2")), url.QueryEscape(end.Format("2006/1/2"))))
+}
+
+// parseDayValues 按顺序把用电量和电费与日期对应起来
+func parseDayValues(body string, dates []string) ([]model.ElecUsageRecord, error) {
+	values := matchRegexpAll(body, `<dayValue>(.*?)</dayValue>`)
+	moneys := matchRegexpAll(body, `<dayUseMeony>(.*?)</dayUseMeony>`)
+	if len(values) == 0 || len(values) != len(dates) || len(moneys) != len(dates) {
+		return nil, errors.New("用电记录与日期不匹配")
+	}
+
+	records := make([]model.ElecUsageRecord, 0, len(dates))
+	for i, date := range dates {
+		value, err := strconv.ParseFloat(strings.TrimSpace(values[i]), 64)
+		if err != nil {
+			return nil, fmt.Errorf("解析用电量失败: %v", err)
+		}
+		money, err := strconv.ParseFloat(strings.TrimSpace(moneys[i]), 64)
+		if err != nil {
+			return nil, fmt.Errorf("解析电费失败: %v", err)
+		}
+		records = append(records, model.ElecUsageRecord{Date: date, UseValue: value, UseMoney: money})
+	}
+	return records, nil
+}
+
+// parseMeterDate 学校系统的日期形如 2025/3/1 0:00:00
+func parseMeterDate(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if i := strings.IndexAny(s, " T"); i >= 0 {
+		s = s[:i]
+	}
+	for _, layout := range []string{"2006/1/2", dateLayout} {
+		if t, err := time.ParseInLocation(layout, s, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("无法解析日期: %s", s)
+}
+
+func (s *elecpriceService) GetUsageHistory(ctx context.Context, r *domain.GetUsageHistoryRequest) (*domain.GetUsageHistoryResponse, error) {
+	granularity := r.Granularity
+	if granularity == "" {
+		granularity = GranularityDay
+	}
+	if granularity != GranularityDay && granularity != GranularityWeek && granularity != GranularityMonth {
+		return nil, INVALID_REQUEST(fmt.Errorf("不支持的统计粒度: %s", r.Granularity))
+	}
+	days := r.Days
+	if days == 0 {
+		days = 30
+	}
+	if r.RoomId == "" || days < 0 || days > 366 {
+		return nil, INVALID_REQUEST(fmt.Errorf("room_id: %q, days: %d", r.RoomId, r.Days))
+	}
+
+	end := today().AddDate(0, 0, -1)
+	start := end.AddDate(0, 0, 1-int(days))
+	records, err := s.usageDAO.FindRecords(ctx, r.RoomId, start.Format(dateLayout), end.Format(dateLayout))
+	if err != nil {
+		return nil, FIND_USAGE_ERROR(err)
+	}
+
+	forecast := &domain.UsageForecast{DaysLeft: -1}
+	price, err := s.GetPrice(ctx, r.RoomId)
+	if err != nil {
+		// 查不到余额时仍然返回历史记录
+		s.l.Warn("获取房间电费失败", logger.String("room_id", r.RoomId), logger.Error(err))
+	} else {
+		forecast, err = s.forecast(ctx, r.RoomId, price.RemainMoney)
+		if err != nil {
+			// 预测失败时仍然返回历史记录
+			s.l.Warn("预测剩余天数失败", logger.String("room_id", r.RoomId), logger.Error(err))
+			forecast = &domain.UsageForecast{RemainMoney: price.RemainMoney, DaysLeft: -1}
+		}
+	}
+
+	return &domain.GetUsageHistoryResponse{
+		Points:   aggregateUsage(records, granularity),
+		Forecast: forecast,
+	}, nil
+}
+
+// forecast 用最近 ForecastDays 天的平均电费估算剩余电费还能用多少天
+func (s *elecpriceService) forecast(ctx context.Context, roomID string, remainMoney string) (*domain.UsageForecast, error) {
+	res := &domain.UsageForecast{RemainMoney: remainMoney, DaysLeft: -1}
+	remain, err := strconv.ParseFloat(remainMoney, 64)
+	if err != nil {
+		return res, nil
+	}
+
+	end := today().AddDate(0, 0, -1)
+	start := end.AddDate(0, 0, 1-s.usageCfg.ForecastDays)
+	records, err := s.usageDAO.FindRecords(ctx, roomID, start.Format(dateLayout), end.Format(dateLayout))
+	if err != nil {
+		return nil, FIND_USAGE_ERROR(err)
+	}
+	res.AvgDailyMoney, res.DaysLeft = estimateDaysLeft(remain, records)
+	if res.DaysLeft >= 0 {
+		res.EmptyDate = today().AddDate(0, 0, int(res.DaysLeft)).Format(dateLayout)
+	}
+	return res, nil
+}
+
+// estimateDaysLeft 返回记录的平均日电费和剩余电费还能用的天数, 没有记录或没有用电时天数为 -1
+func estimateDaysLeft(remain float64, records []model.ElecUsageRecord) (avgDailyMoney, daysLeft float64) {
+	if len(records) == 0 {
+		return 0, -1
+	}
+	var total float64
+	for _, r := range records {
+		total += r.UseMoney
+	}
+	avgDailyMoney = total / float64(len(records))
+	if avgDailyMoney <= 0 {
+		return avgDailyMoney, -1
+	}
+	return avgDailyMoney, math.Max(remain, 0) / avgDailyMoney
+}
+
+// aggregateUsage 按粒度汇总每天的记录, 周从周一开始
+func aggregateUsage(records []model.ElecUsageRecord, granularity string) []*domain.UsagePoint {
+	var points []*domain.UsagePoint
+	for _, r := range records {
+		day, err := time.ParseInLocation(dateLayout, r.Date, time.Local)
+		if err != nil {
+			continue
+		}
+		switch granularity {
+		case GranularityWeek:
+			day = day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+		case GranularityMonth:
+			day = day.AddDate(0, 0, 1-day.Day())
+		}
+		key := day.Format(dateLayout)
+
+		if len(points) == 0 || points[len(points)-1].Date != key {
+			points = append(points, &domain.UsagePoint{Date: key, RemainMoney: -1})
+		}
+		p := points[len(points)-1]
+		p.UseValue += r.UseValue
+		p.UseMoney += r.UseMoney
+		if r.RemainMoney != nil {
+			p.RemainMoney = *r.RemainMoney
+		}
+	}
+	return points
+}
+
+func today() time.Time {
+	now := time.Now()
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+}
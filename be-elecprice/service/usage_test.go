@@ -0,0 +1,155 @@
+package service
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/asynccnu/ccnubox-be/be-elecprice/domain"
+	"github.com/asynccnu/ccnubox-be/be-elecprice/repository/model"
+)
+
+func TestParseDayValues(t *testing.T) {
+	body := `<dayValue>3.5</dayValue><dayUseMeony>2.1</dayUseMeony><dayValue> 4 </dayValue><dayUseMeony> 2.4 </dayUseMeony>`
+	cases := []struct {
+		name    string
+		body    string
+		dates   []string
+		want    []model.ElecUsageRecord
+		wantErr bool
+	}{
+		{
+			name:  "按顺序对应日期",
+			body:  body,
+			dates: []string{"2025-03-01", "2025-03-02"},
+			want: []model.ElecUsageRecord{
+				{Date: "2025-03-01", UseValue: 3.5, UseMoney: 2.1},
+				{Date: "2025-03-02", UseValue: 4, UseMoney: 2.4},
+			},
+		},
+		{name: "日期条数不一致", body: body, dates: []string{"2025-03-01"}, wantErr: true},
+		{name: "没有记录", body: `<resultInfo>无数据</resultInfo>`, dates: nil, wantErr: true},
+		{name: "电费条数不一致", body: `<dayValue>3.5</dayValue>`, dates: []string{"2025-03-01"}, wantErr: true},
+		{name: "用电量不是数字", body: `<dayValue>-</dayValue><dayUseMeony>2.1</dayUseMeony>`, dates: []string{"2025-03-01"}, wantErr: true},
+		{name: "电费不是数字", body: `<dayValue>3.5</dayValue><dayUseMeony></dayUseMeony>`, dates: []string{"2025-03-01"}, wantErr: true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := parseDayValues(c.body, c.dates)
+			if (err != nil) != c.wantErr {
+				t.Fatalf("parseDayValues() err = %v, wantErr %v", err, c.wantErr)
+			}
+			if !reflect.DeepEqual(got, c.want) {
+				t.Fatalf("parseDayValues() = %+v, want %+v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestParseMeterDate(t *testing.T) {
+	cases := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{in: "2025/3/1 0:00:00", want: "2025-03-01"},
+		{in: " 2025/12/31 ", want: "2025-12-31"},
+		{in: "2025-03-01T00:00:00", want: "2025-03-01"},
+		{in: "2025-03-01", want: "2025-03-01"},
+		{in: "", wantErr: true},
+		{in: "3/1/2025", wantErr: true},
+	}
+	for _, c := range cases {
+		got, err := parseMeterDate(c.in)
+		if (err != nil) != c.wantErr {
+			t.Fatalf("parseMeterDate(%q) err = %v, wantErr %v", c.in, err, c.wantErr)
+		}
+		if err == nil && got.Format(dateLayout) != c.want {
+			t.Fatalf("parseMeterDate(%q) = %s, want %s", c.in, got.Format(dateLayout), c.want)
+		}
+	}
+}
+
+func TestAggregateUsage(t *testing.T) {
+	remain := func(v float64) *float64 { return &v }
+	// 2025-03-02 是周日, 2025-03-03 是周一
+	records := []model.ElecUsageRecord{
+		{Date: "2025-02-28", UseValue: 1, UseMoney: 0.5, RemainMoney: remain(20)},
+		{Date: "2025-03-02", UseValue: 2, UseMoney: 1},
+		{Date: "2025-03-03", UseValue: 3, UseMoney: 1.5, RemainMoney: remain(17.5)},
+		{Date: "bad date", UseValue: 100, UseMoney: 100},
+		{Date: "2025-03-04", UseValue: 4, UseMoney: 2},
+	}
+	cases := []struct {
+		granularity string
+		want        []*domain.UsagePoint
+	}{
+		{
+			granularity: GranularityDay,
+			want: []*domain.UsagePoint{
+				{Date: "2025-02-28", UseValue: 1, UseMoney: 0.5, RemainMoney: 20},
+				{Date: "2025-03-02", UseValue: 2, UseMoney: 1, RemainMoney: -1},
+				{Date: "2025-03-03", UseValue: 3, UseMoney: 1.5, RemainMoney: 17.5},
+				{Date: "2025-03-04", UseValue: 4, UseMoney: 2, RemainMoney: -1},
+			},
+		},
+		{
+			granularity: GranularityWeek,
+			want: []*domain.UsagePoint{
+				{Date: "2025-02-24", UseValue: 3, UseMoney: 1.5, RemainMoney: 20},
+				{Date: "2025-03-03", UseValue: 7, UseMoney: 3.5, RemainMoney: 17.5},
+			},
+		},
+		{
+			granularity: GranularityMonth,
+			want: []*domain.UsagePoint{
+				{Date: "2025-02-01", UseValue: 1, UseMoney: 0.5, RemainMoney: 20},
+				{Date: "2025-03-01", UseValue: 9, UseMoney: 4.5, RemainMoney: 17.5},
+			},
+		},
+	}
+	for _, c := range cases {
+		got := aggregateUsage(records, c.granularity)
+		if !reflect.DeepEqual(got, c.want) {
+			t.Fatalf("aggregateUsage(%s) = %s, want %s", c.granularity, formatPoints(got), formatPoints(c.want))
+		}
+	}
+	if got := aggregateUsage(nil, GranularityDay); len(got) != 0 {
+		t.Fatalf("aggregateUsage(nil) = %s, want empty", formatPoints(got))
+	}
+}
+
+func TestEstimateDaysLeft(t *testing.T) {
+	cases := []struct {
+		name     string
+		remain   float64
+		money    []float64
+		wantAvg  float64
+		wantDays float64
+	}{
+		{name: "按平均电费估算", remain: 30, money: []float64{2, 4, 3}, wantAvg: 3, wantDays: 10},
+		{name: "余额为负按0计算", remain: -5, money: []float64{2}, wantAvg: 2, wantDays: 0},
+		{name: "没有记录", remain: 30, money: nil, wantAvg: 0, wantDays: -1},
+		{name: "没有用电", remain: 30, money: []float64{0, 0}, wantAvg: 0, wantDays: -1},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			records := make([]model.ElecUsageRecord, 0, len(c.money))
+			for _, m := range c.money {
+				records = append(records, model.ElecUsageRecord{UseMoney: m})
+			}
+			avg, days := estimateDaysLeft(c.remain, records)
+			if avg != c.wantAvg || days != c.wantDays {
+				t.Fatalf("estimateDaysLeft() = (%v, %v), want (%v, %v)", avg, days, c.wantAvg, c.wantDays)
+			}
+		})
+	}
+}
+
+func formatPoints(points []*domain.UsagePoint) string {
+	var s string
+	for _, p := range points {
+		s += fmt.Sprintf("%+v ", *p)
+	}
+	return s
+}
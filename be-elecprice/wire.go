@@ -17,6 +17,7 @@ func InitApp() App {
 		grpc.NewElecpriceGrpcService,
 		service.NewElecpriceService,
 		dao.NewElecpriceDAO,
+		dao.NewElecUsageDAO,
 		// 第三方
 		ioc.InitEtcdClient,
 		ioc.InitDB,
@@ -24,6 +25,7 @@ func InitApp() App {
 		ioc.InitGRPCxKratosServer,
 		ioc.InitFeedClient,
 		cron.NewElecpriceController,
+		cron.NewUsageController,
 		cron.NewCron,
 		NewApp,
 	)
@@ -20,13 +20,15 @@ func InitApp() App {
 	logger := ioc.InitLogger()
 	db := ioc.InitDB(logger)
 	elecpriceDAO := dao.NewElecpriceDAO(db)
-	elecpriceService := service.NewElecpriceService(elecpriceDAO, logger)
+	elecUsageDAO := dao.NewElecUsageDAO(db)
+	elecpriceService := service.NewElecpriceService(elecpriceDAO, elecUsageDAO, logger)
 	elecpriceServiceServer := grpc.NewElecpriceGrpcService(elecpriceService)
 	client := ioc.InitEtcdClient()
 	server := ioc.InitGRPCxKratosServer(elecpriceServiceServer, client, logger)
 	feedServiceClient := ioc.InitFeedClient(client)
 	elecpriceController := cron.NewElecpriceController(feedServiceClient, elecpriceService, logger)
-	v := cron.NewCron(elecpriceController)
+	usageController := cron.NewUsageController(elecpriceService, logger)
+	v := cron.NewCron(elecpriceController, usageController)
 	app := NewApp(server, v)
 	return app
 }
@@ -18,6 +18,7 @@ type AllowList struct {
 	Muxi      bool   `json:"muxi"`
 	Holiday   bool   `json:"holiday"`
 	Energy    bool   `json:"energy"`
+	Library   bool   `json:"library"`
 }
 
 type MuxiOfficialMSG struct {
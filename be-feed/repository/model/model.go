@@ -61,6 +61,7 @@ const (
 	GradePos
 	HolidayPos
 	MuxiPos
+	LibraryPos
 )
 
 // UserFeedConfig 表示用户的 Feed 配置
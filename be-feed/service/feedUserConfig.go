@@ -26,6 +26,7 @@ var configMap = map[string]int{
 	"grade":   model.GradePos,
 	"energy":  model.EnergyPos,
 	"holiday": model.HolidayPos,
+	"library": model.LibraryPos,
 }
 
 type feedUserConfigService struct {
@@ -77,6 +78,7 @@ func (s *feedUserConfigService) ChangeAllowList(ctx context.Context, req domain.
 		"Muxi":    model.MuxiPos,
 		"Holiday": model.HolidayPos,
 		"Energy":  model.EnergyPos,
+		"Library": model.LibraryPos,
 	}
 
 	// 反射获取字段值，并修改 pushConfig
@@ -113,6 +115,7 @@ func (s *feedUserConfigService) GetFeedAllowList(ctx context.Context, studentId
 		Muxi:      s.userFeedConfigDAO.GetConfigBit(list.PushConfig, model.MuxiPos),
 		Holiday:   s.userFeedConfigDAO.GetConfigBit(list.PushConfig, model.HolidayPos),
 		Energy:    s.userFeedConfigDAO.GetConfigBit(list.PushConfig, model.EnergyPos),
+		Library:   s.userFeedConfigDAO.GetConfigBit(list.PushConfig, model.LibraryPos),
 	}, nil
 }
 
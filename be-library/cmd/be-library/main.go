@@ -5,6 +5,7 @@ import (
 	"os"
 
 	"github.com/asynccnu/ccnubox-be/be-library/internal/conf"
+	"github.com/asynccnu/ccnubox-be/be-library/internal/cron"
 	"github.com/asynccnu/ccnubox-be/be-library/pkg/logx"
 	"github.com/go-kratos/kratos/contrib/registry/etcd/v2"
 	"github.com/go-kratos/kratos/v2"
@@ -31,14 +32,14 @@ func init() {
 	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
 }
 
-func newApp(logger log.Logger, gs *grpc.Server, r *etcd.Registry) *kratos.App {
+func newApp(logger log.Logger, gs *grpc.Server, r *etcd.Registry, rt *cron.ReserveTask, remt *cron.ReminderTask, rmt *cron.RoomTask) *kratos.App {
 	return kratos.New(
 		kratos.ID(id),
 		kratos.Name(Name),
 		kratos.Version(Version),
 		kratos.Metadata(map[string]string{}),
 		kratos.Logger(logger),
-		kratos.Server(gs),
+		kratos.Server(gs, rt, remt, rmt),
 		kratos.Registrar(r),
 	)
 }
@@ -70,7 +71,7 @@ func main() {
 		"service.name", Name,
 	)
 
-	app, cleanup, err := wireApp(bc.Server, bc.Data, bc.Registry, logger)
+	app, cleanup, err := wireApp(bc.Server, bc.Data, bc.Registry, bc.Reserve, bc.Reminder, bc.Comment, bc.Discussion, bc.Crawler, bc.Room, logger)
 	if err != nil {
 		panic(err)
 	}
@@ -10,6 +10,7 @@ import (
 	"github.com/asynccnu/ccnubox-be/be-library/internal/client"
 	"github.com/asynccnu/ccnubox-be/be-library/internal/conf"
 	"github.com/asynccnu/ccnubox-be/be-library/internal/crawler"
+	"github.com/asynccnu/ccnubox-be/be-library/internal/cron"
 	"github.com/asynccnu/ccnubox-be/be-library/internal/data"
 	"github.com/asynccnu/ccnubox-be/be-library/internal/registry"
 	"github.com/asynccnu/ccnubox-be/be-library/internal/server"
@@ -21,7 +22,7 @@ import (
 )
 
 // wireApp init kratos application.
-func wireApp(*conf.Server, *conf.Data, *conf.Registry, log.Logger) (*kratos.App, func(), error) {
+func wireApp(*conf.Server, *conf.Data, *conf.Registry, *conf.Reserve, *conf.Reminder, *conf.Comment, *conf.Discussion, *conf.Crawler, *conf.Room, log.Logger) (*kratos.App, func(), error) {
 	panic(wire.Build(server.ProviderSet,
 		data.ProviderSet,
 		biz.ProviderSet,
@@ -29,6 +30,7 @@ func wireApp(*conf.Server, *conf.Data, *conf.Registry, log.Logger) (*kratos.App,
 		client.ProviderSet,
 		registry.ProviderSet,
 		crawler.ProviderSet,
+		cron.ProviderSet,
 		newApp,
 	))
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/asynccnu/ccnubox-be/be-library/internal/client"
 	"github.com/asynccnu/ccnubox-be/be-library/internal/conf"
 	"github.com/asynccnu/ccnubox-be/be-library/internal/crawler"
+	"github.com/asynccnu/ccnubox-be/be-library/internal/cron"
 	"github.com/asynccnu/ccnubox-be/be-library/internal/data"
 	"github.com/asynccnu/ccnubox-be/be-library/internal/registry"
 	"github.com/asynccnu/ccnubox-be/be-library/internal/server"
@@ -26,8 +27,8 @@ import (
 // Injectors from wire.go:
 
 // wireApp init kratos application.
-func wireApp(confServer *conf.Server, confData *conf.Data, confRegistry *conf.Registry, logger log.Logger) (*kratos.App, func(), error) {
-	cookiePool := client.NewCookiePoolProvider()
+func wireApp(confServer *conf.Server, confData *conf.Data, confRegistry *conf.Registry, reserve *conf.Reserve, reminder *conf.Reminder, comment *conf.Comment, discussion *conf.Discussion, confCrawler *conf.Crawler, room *conf.Room, logger log.Logger) (*kratos.App, func(), error) {
+	cookiePool := client.NewCookiePoolProvider(confCrawler)
 	etcdRegistry := registry.NewRegistrarServer(confRegistry, logger)
 	userServiceClient, err := client.NewClient(etcdRegistry, confRegistry, logger)
 	if err != nil {
@@ -45,15 +46,40 @@ func wireApp(confServer *conf.Server, confData *conf.Data, confRegistry *conf.Re
 	if err != nil {
 		return nil, nil, err
 	}
-	seatRepo := data.NewSeatRepo(dataData, libraryCrawler)
+	occupancyRepo := data.NewOccupancyRepo(dataData)
+	seatRepo := data.NewSeatRepo(dataData, libraryCrawler, occupancyRepo)
 	recordRepo := data.NewRecordRepo(dataData)
 	creditPointsRepo := data.NewCreditPointsRepo(dataData)
-	libraryBiz := biz.NewLibraryBiz(libraryCrawler, logger, seatRepo, recordRepo, creditPointsRepo)
+	roomRepo := data.NewRoomRepo(dataData)
+	roomCatalog := biz.NewRoomCatalog(roomRepo, seatRepo, libraryCrawler, room, logger)
+	libraryBiz := biz.NewLibraryBiz(libraryCrawler, logger, seatRepo, recordRepo, creditPointsRepo, roomCatalog)
 	assembler := data.NewAssembler()
 	commentRepo := data.NewCommentRepo(dataData, logger, assembler)
-	libraryService := service.NewLibraryService(libraryBiz, logger, commentRepo)
+	rateLimiter := data.NewRedisRateLimiter(dataData)
+	commentUsecase := biz.NewCommentUsecase(commentRepo, rateLimiter, comment, logger)
+	favoriteRepo := data.NewFavoriteRepo(dataData)
+	favouriteUsecase := biz.NewFavouriteUsecase(favoriteRepo, seatRepo, libraryCrawler, logger, roomCatalog)
+	reserveIntentRepo := data.NewReserveIntentRepo(dataData)
+	locker := data.NewRedisLocker(dataData)
+	feedServiceClient, err := client.NewFeedClient(etcdRegistry, confRegistry, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+	feedNotifier := client.NewFeedNotifier(feedServiceClient)
+	reserveAgent := biz.NewReserveAgent(reserveIntentRepo, seatRepo, libraryCrawler, locker, feedNotifier, reserve, logger, roomCatalog)
+	occupancyUsecase := biz.NewOccupancyUsecase(seatRepo, occupancyRepo, logger, roomCatalog)
+	studyGroupRepo := data.NewStudyGroupRepo(dataData)
+	discussionUsecase := biz.NewDiscussionUsecase(libraryCrawler, studyGroupRepo, feedNotifier, discussion, logger)
+	usageRepo := data.NewUsageRepo(dataData)
+	usageUsecase := biz.NewUsageUsecase(usageRepo, locker, logger)
+	libraryService := service.NewLibraryService(libraryBiz, logger, commentUsecase, favouriteUsecase, reserveAgent, occupancyUsecase, discussionUsecase, roomCatalog, usageUsecase)
 	grpcServer := server.NewGRPCServer(confServer, libraryService, logger)
-	app := newApp(logger, grpcServer, etcdRegistry)
+	reserveTask := cron.NewReserveTask(reserveAgent, logger)
+	reminderRepo := data.NewReminderRepo(dataData)
+	bizReminder := biz.NewReminder(recordRepo, libraryCrawler, reminderRepo, locker, feedNotifier, reminder, logger)
+	reminderTask := cron.NewReminderTask(bizReminder, logger)
+	roomTask := cron.NewRoomTask(roomCatalog, logger)
+	app := newApp(logger, grpcServer, etcdRegistry, reserveTask, reminderTask, roomTask)
 	return app, func() {
 	}, nil
 }
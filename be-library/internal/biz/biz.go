@@ -11,7 +11,7 @@ import (
 
 // biz = domain + usecase
 // ProviderSet is biz providers.
-var ProviderSet = wire.NewSet(NewLibraryBiz, NewWaitTime, NewCommentUsecase)
+var ProviderSet = wire.NewSet(NewLibraryBiz, NewWaitTime, NewCommentUsecase, NewFavouriteUsecase, NewReserveAgent, NewOccupancyUsecase, NewReminder, NewDiscussionUsecase, NewRoomCatalog, NewUsageUsecase)
 
 // NewWaitTime 提供等待时间配置
 func NewWaitTime(cf *conf.Server) time.Duration {
@@ -34,9 +34,3 @@ type CCNUServiceProxy interface {
 	// 从其他服务获取cookie
 	GetLibraryCookie(ctx context.Context, stuID string) (string, error)
 }
-
-type DelayQueue interface {
-	Send(key, value []byte) error
-	Consume(groupID string, f func(key, value []byte)) error
-	Close()
-}
@@ -1,22 +1,71 @@
 package biz
 
 import (
+	"context"
 	"time"
 )
 
+// CommentStatus 评论的展示状态
+type CommentStatus string
+
+const (
+	CommentVisible CommentStatus = "visible" // 正常展示
+	CommentPending CommentStatus = "pending" // 被举报次数过多，隐藏并等待审核
+	CommentHidden  CommentStatus = "hidden"  // 被管理员隐藏
+)
+
 type Comment struct {
-	ID        int       // 评论ID
-	SeatID    string    // 关联座位
-	Username  string    // 发表评论的用户
-	Content   string    // 评论内容
-	Rating    int       // 评分（1-5）
-	CreatedAt time.Time // 创建时间
+	ID          int           // 评论ID
+	SeatID      string        // 关联座位
+	Username    string        // 发表评论的用户
+	Content     string        // 评论内容
+	Rating      int           // 评分（1-5）
+	Status      CommentStatus // 展示状态
+	ReportCount int           // 被举报次数
+	CreatedAt   time.Time     // 创建时间
+}
+
+// SeatRating 座位的评分汇总，只统计正常展示的评论
+type SeatRating struct {
+	SeatID  string
+	Average float64
+	Count   int64
+}
+
+// CommentPage 一页评论及座位的评分汇总
+type CommentPage struct {
+	Comments []*Comment
+	Total    int64
+	Rating   *SeatRating
+}
+
+type CommentReport struct {
+	CommentID int
+	Reporter  string
+	Reason    string
 }
 
 type CommentRepo interface {
-	CreateComment(req *CreateCommentReq) (string, error)
-	GetCommentsBySeatID(seatID int) ([]*Comment, error)
-	DeleteComment(id int) (string, error)
+	CreateComment(ctx context.Context, comment *Comment) error
+	// GetComment 评论不存在时返回 nil
+	GetComment(ctx context.Context, id int) (*Comment, error)
+	// ListComments 按时间倒序分页返回座位下正常展示的评论
+	ListComments(ctx context.Context, seatID string, offset, limit int) ([]*Comment, int64, error)
+	// GetSeatRatings 没有评论的座位不出现在结果中
+	GetSeatRatings(ctx context.Context, seatIDs []string) ([]*SeatRating, error)
+	DeleteComment(ctx context.Context, id int) error
+	// AddReport 同一用户重复举报只记一次，返回评论当前被举报的次数
+	AddReport(ctx context.Context, report *CommentReport) (int, error)
+	// ListReportedComments 返回被举报或待审核的评论，被举报多的在前
+	ListReportedComments(ctx context.Context, offset, limit int) ([]*Comment, int64, error)
+	// UpdateCommentStatus clearReports 为真时同时清空举报记录
+	UpdateCommentStatus(ctx context.Context, id int, status CommentStatus, clearReports bool) error
+}
+
+// RateLimiter 固定窗口限流，多实例共享计数
+type RateLimiter interface {
+	// Allow 在 window 内 key 的次数未超过 limit 时返回 true 并计数
+	Allow(ctx context.Context, key string, limit int, window time.Duration) (bool, error)
 }
 
 type CreateCommentReq struct {
@@ -2,48 +2,309 @@ package biz
 
 import (
 	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
 
+	"github.com/asynccnu/ccnubox-be/be-library/internal/conf"
+	"github.com/asynccnu/ccnubox-be/be-library/internal/errcode"
 	"github.com/go-kratos/kratos/v2/log"
 )
 
-type commentUsecase struct {
-	repo CommentRepo
-	log  *log.Helper
+const (
+	commentIntervalKeyFmt = "lib:comment:rate:%s:interval"
+	commentHourlyKeyFmt   = "lib:comment:rate:%s:hourly"
+
+	defaultCommentPageSize = 20
+	maxCommentPageSize     = 100
+)
+
+// 未配置屏蔽词时使用的列表
+var defaultBannedWords = []string{"傻逼", "煞笔", "操你", "草你", "他妈的", "尼玛", "去死", "脑残", "智障", "滚蛋"}
+
+// 审核操作
+const (
+	ModerateHide    = "hide"
+	ModerateRestore = "restore"
+	ModerateDelete  = "delete"
+)
+
+// CommentConfig 评论的内容和频率限制
+type CommentConfig struct {
+	MaxLength       int
+	HourlyLimit     int
+	MinInterval     time.Duration
+	ReportThreshold int
+	BannedWords     []string
+}
+
+func NewCommentConfig(c *conf.Comment) CommentConfig {
+	cfg := CommentConfig{
+		MaxLength:       500,
+		HourlyLimit:     10,
+		MinInterval:     30 * time.Second,
+		ReportThreshold: 3,
+		BannedWords:     defaultBannedWords,
+	}
+	if c == nil {
+		return cfg
+	}
+	if c.MaxLength > 0 {
+		cfg.MaxLength = int(c.MaxLength)
+	}
+	if c.HourlyLimit > 0 {
+		cfg.HourlyLimit = int(c.HourlyLimit)
+	}
+	if d := c.MinInterval.AsDuration(); d > 0 {
+		cfg.MinInterval = d
+	}
+	if c.ReportThreshold > 0 {
+		cfg.ReportThreshold = int(c.ReportThreshold)
+	}
+	if len(c.BannedWords) > 0 {
+		cfg.BannedWords = c.BannedWords
+	}
+	return cfg
 }
 
-func NewCommentUsecase(repo CommentRepo, logger log.Logger) *commentUsecase {
-	return &commentUsecase{
-		repo: repo,
-		log:  log.NewHelper(logger),
+type CommentUsecase struct {
+	repo    CommentRepo
+	limiter RateLimiter
+	cfg     CommentConfig
+	// 归一化后的屏蔽词
+	bannedWords []string
+
+	log *log.Helper
+}
+
+func NewCommentUsecase(repo CommentRepo, limiter RateLimiter, c *conf.Comment, logger log.Logger) *CommentUsecase {
+	cfg := NewCommentConfig(c)
+	banned := make([]string, 0, len(cfg.BannedWords))
+	for _, w := range cfg.BannedWords {
+		if w = normalizeCommentText(w); w != "" {
+			banned = append(banned, w)
+		}
+	}
+	return &CommentUsecase{
+		repo:        repo,
+		limiter:     limiter,
+		cfg:         cfg,
+		bannedWords: banned,
+		log:         log.NewHelper(logger),
 	}
 }
 
-func (b *commentUsecase) CreateComment(ctx context.Context, req CreateCommentReq) (string, error) {
-	message, err := b.repo.CreateComment(&req)
-	if err != nil {
-		b.log.Errorf("created comment failed (seat_id = %s)", req.SeatID)
+func (u *CommentUsecase) CreateComment(ctx context.Context, req CreateCommentReq) (string, error) {
+	content := strings.TrimSpace(req.Content)
+	if req.SeatID == "" || req.Username == "" || content == "" ||
+		utf8.RuneCountInString(content) > u.cfg.MaxLength || req.Rating < 1 || req.Rating > 5 {
+		return "", errcode.ErrInvalidComment
+	}
+	if u.containsBannedWord(content) {
+		return "", errcode.ErrCommentBlocked
+	}
+	if err := u.checkRate(ctx, req.Username); err != nil {
 		return "", err
 	}
 
-	return message, nil
+	comment := &Comment{
+		SeatID:    req.SeatID,
+		Username:  req.Username,
+		Content:   content,
+		Rating:    req.Rating,
+		Status:    CommentVisible,
+		CreatedAt: time.Now(),
+	}
+	if err := u.repo.CreateComment(ctx, comment); err != nil {
+		u.log.Errorf("create comment(seat_id:%v username:%v) failed: %v", req.SeatID, req.Username, err)
+		return "", err
+	}
+	return "success", nil
+}
+
+// checkRate 同时限制发表间隔和每小时的数量
+func (u *CommentUsecase) checkRate(ctx context.Context, username string) error {
+	ok, err := u.limiter.Allow(ctx, fmt.Sprintf(commentIntervalKeyFmt, username), 1, u.cfg.MinInterval)
+	if err != nil {
+		u.log.Errorf("check comment interval(username:%v) failed: %v", username, err)
+		return err
+	}
+	if !ok {
+		return errcode.ErrCommentTooFrequent
+	}
+	ok, err = u.limiter.Allow(ctx, fmt.Sprintf(commentHourlyKeyFmt, username), u.cfg.HourlyLimit, time.Hour)
+	if err != nil {
+		u.log.Errorf("check comment hourly limit(username:%v) failed: %v", username, err)
+		return err
+	}
+	if !ok {
+		return errcode.ErrCommentTooFrequent
+	}
+	return nil
 }
 
-func (b *commentUsecase) GetCommentsBySeatID(seatID int) ([]*Comment, error) {
-	comments, err := b.repo.GetCommentsBySeatID(seatID)
+// GetCommentsBySeatID 返回座位的第一页评论
+func (u *CommentUsecase) GetCommentsBySeatID(ctx context.Context, seatID int) ([]*Comment, error) {
+	page, err := u.ListComments(ctx, strconv.Itoa(seatID), 1, maxCommentPageSize)
 	if err != nil {
-		b.log.Errorf("Get comments failed (seat_id = %s)", seatID)
+		return nil, err
+	}
+	return page.Comments, nil
+}
+
+func (u *CommentUsecase) ListComments(ctx context.Context, seatID string, page, pageSize int) (*CommentPage, error) {
+	offset, limit := commentPage(page, pageSize)
+	comments, total, err := u.repo.ListComments(ctx, seatID, offset, limit)
+	if err != nil {
+		u.log.Errorf("list comments(seat_id:%v) failed: %v", seatID, err)
 		return nil, err
 	}
 
-	return comments, nil
+	rating := &SeatRating{SeatID: seatID}
+	ratings, err := u.repo.GetSeatRatings(ctx, []string{seatID})
+	if err != nil {
+		u.log.Errorf("get seat rating(seat_id:%v) failed: %v", seatID, err)
+		return nil, err
+	}
+	if len(ratings) > 0 {
+		rating = ratings[0]
+	}
+	return &CommentPage{Comments: comments, Total: total, Rating: rating}, nil
 }
 
-func (b *commentUsecase) DeleteComment(id int) (string, error) {
-	message, err := b.repo.DeleteComment(id)
+func (u *CommentUsecase) GetSeatRatings(ctx context.Context, seatIDs []string) ([]*SeatRating, error) {
+	if len(seatIDs) == 0 {
+		return nil, nil
+	}
+	ratings, err := u.repo.GetSeatRatings(ctx, seatIDs)
 	if err != nil {
-		b.log.Errorf("Deleted comments failed (id = %s)", id)
-		return "", err
+		u.log.Errorf("get seat ratings failed: %v", err)
+		return nil, err
+	}
+	return ratings, nil
+}
+
+// DeleteComment 只允许删除自己的评论
+func (u *CommentUsecase) DeleteComment(ctx context.Context, id int, username string) error {
+	comment, err := u.repo.GetComment(ctx, id)
+	if err != nil {
+		u.log.Errorf("get comment(id:%v) failed: %v", id, err)
+		return err
+	}
+	if comment == nil {
+		return errcode.ErrCommentNotFound
+	}
+	if username == "" || comment.Username != username {
+		return errcode.ErrCommentForbidden
+	}
+	if err = u.repo.DeleteComment(ctx, id); err != nil {
+		u.log.Errorf("delete comment(id:%v) failed: %v", id, err)
+		return err
+	}
+	return nil
+}
+
+// ReportComment 举报次数达到阈值后评论自动隐藏，等待管理员审核
+func (u *CommentUsecase) ReportComment(ctx context.Context, id int, reporter, reason string) error {
+	if reporter == "" {
+		return errcode.ErrInvalidComment
+	}
+	comment, err := u.repo.GetComment(ctx, id)
+	if err != nil {
+		u.log.Errorf("get comment(id:%v) failed: %v", id, err)
+		return err
+	}
+	if comment == nil || comment.Status == CommentHidden {
+		return errcode.ErrCommentNotFound
+	}
+
+	count, err := u.repo.AddReport(ctx, &CommentReport{CommentID: id, Reporter: reporter, Reason: strings.TrimSpace(reason)})
+	if err != nil {
+		u.log.Errorf("report comment(id:%v reporter:%v) failed: %v", id, reporter, err)
+		return err
+	}
+	if count >= u.cfg.ReportThreshold && comment.Status == CommentVisible {
+		if err = u.repo.UpdateCommentStatus(ctx, id, CommentPending, false); err != nil {
+			u.log.Errorf("mark comment(id:%v) pending failed: %v", id, err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (u *CommentUsecase) ListReportedComments(ctx context.Context, page, pageSize int) ([]*Comment, int64, error) {
+	offset, limit := commentPage(page, pageSize)
+	comments, total, err := u.repo.ListReportedComments(ctx, offset, limit)
+	if err != nil {
+		u.log.Errorf("list reported comments failed: %v", err)
+		return nil, 0, err
 	}
+	return comments, total, nil
+}
 
-	return message, nil
+// ModerateComment 管理员审核评论，权限由调用方校验
+func (u *CommentUsecase) ModerateComment(ctx context.Context, id int, action string) error {
+	comment, err := u.repo.GetComment(ctx, id)
+	if err != nil {
+		u.log.Errorf("get comment(id:%v) failed: %v", id, err)
+		return err
+	}
+	if comment == nil {
+		return errcode.ErrCommentNotFound
+	}
+
+	switch action {
+	case ModerateHide:
+		err = u.repo.UpdateCommentStatus(ctx, id, CommentHidden, false)
+	case ModerateRestore:
+		err = u.repo.UpdateCommentStatus(ctx, id, CommentVisible, true)
+	case ModerateDelete:
+		err = u.repo.DeleteComment(ctx, id)
+	default:
+		return errcode.ErrInvalidComment
+	}
+	if err != nil {
+		u.log.Errorf("moderate comment(id:%v action:%v) failed: %v", id, action, err)
+		return err
+	}
+	return nil
+}
+
+func (u *CommentUsecase) containsBannedWord(content string) bool {
+	normalized := normalizeCommentText(content)
+	for _, w := range u.bannedWords {
+		if strings.Contains(normalized, w) {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeCommentText 转小写并去掉空白和标点，避免用 "傻 逼"、"傻*逼" 绕过屏蔽词
+func normalizeCommentText(s string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(s) {
+		if unicode.IsSpace(r) || unicode.IsPunct(r) || unicode.IsSymbol(r) {
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// commentPage 把从 1 开始的页码转成 offset 和 limit
+func commentPage(page, pageSize int) (int, int) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = defaultCommentPageSize
+	}
+	if pageSize > maxCommentPageSize {
+		pageSize = maxCommentPageSize
+	}
+	return (page - 1) * pageSize, pageSize
 }
@@ -0,0 +1,171 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/asynccnu/ccnubox-be/be-library/internal/errcode"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type fakeCommentRepo struct {
+	CommentRepo
+	comments map[int]*Comment
+	reports  map[int]map[string]bool
+	nextID   int
+}
+
+func (r *fakeCommentRepo) CreateComment(_ context.Context, c *Comment) error {
+	r.nextID++
+	c.ID = r.nextID
+	r.comments[c.ID] = c
+	return nil
+}
+
+func (r *fakeCommentRepo) GetComment(_ context.Context, id int) (*Comment, error) {
+	return r.comments[id], nil
+}
+
+func (r *fakeCommentRepo) DeleteComment(_ context.Context, id int) error {
+	delete(r.comments, id)
+	return nil
+}
+
+func (r *fakeCommentRepo) AddReport(_ context.Context, report *CommentReport) (int, error) {
+	if r.reports[report.CommentID] == nil {
+		r.reports[report.CommentID] = map[string]bool{}
+	}
+	r.reports[report.CommentID][report.Reporter] = true
+	r.comments[report.CommentID].ReportCount = len(r.reports[report.CommentID])
+	return r.comments[report.CommentID].ReportCount, nil
+}
+
+func (r *fakeCommentRepo) UpdateCommentStatus(_ context.Context, id int, status CommentStatus, clearReports bool) error {
+	r.comments[id].Status = status
+	if clearReports {
+		delete(r.reports, id)
+		r.comments[id].ReportCount = 0
+	}
+	return nil
+}
+
+type fakeRateLimiter struct {
+	counts map[string]int
+}
+
+func (l *fakeRateLimiter) Allow(_ context.Context, key string, limit int, _ time.Duration) (bool, error) {
+	l.counts[key]++
+	return l.counts[key] <= limit, nil
+}
+
+func newTestCommentUsecase() (*CommentUsecase, *fakeCommentRepo, *fakeRateLimiter) {
+	repo := &fakeCommentRepo{comments: map[int]*Comment{}, reports: map[int]map[string]bool{}}
+	limiter := &fakeRateLimiter{counts: map[string]int{}}
+	return NewCommentUsecase(repo, limiter, nil, log.NewStdLogger(os.Stdout)), repo, limiter
+}
+
+func TestCreateComment_Validation(t *testing.T) {
+	uc, repo, _ := newTestCommentUsecase()
+	ctx := context.Background()
+
+	cases := []struct {
+		req  CreateCommentReq
+		want error
+	}{
+		{CreateCommentReq{SeatID: "1", Username: "a", Content: "  ", Rating: 5}, errcode.ErrInvalidComment},
+		{CreateCommentReq{SeatID: "1", Username: "b", Content: "安静", Rating: 6}, errcode.ErrInvalidComment},
+		{CreateCommentReq{SeatID: "1", Username: "c", Content: "旁边的人是个傻 * 逼", Rating: 1}, errcode.ErrCommentBlocked},
+	}
+	for _, c := range cases {
+		if _, err := uc.CreateComment(ctx, c.req); !errors.Is(err, c.want) {
+			t.Fatalf("%+v: expected %v, got %v", c.req, c.want, err)
+		}
+	}
+	if len(repo.comments) != 0 {
+		t.Fatalf("invalid comments should not be saved: %+v", repo.comments)
+	}
+}
+
+func TestCreateComment_RateLimit(t *testing.T) {
+	uc, repo, limiter := newTestCommentUsecase()
+	ctx := context.Background()
+	req := CreateCommentReq{SeatID: "1", Username: "stu", Content: "插座好用", Rating: 5}
+
+	if _, err := uc.CreateComment(ctx, req); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := uc.CreateComment(ctx, req); !errors.Is(err, errcode.ErrCommentTooFrequent) {
+		t.Fatalf("expected ErrCommentTooFrequent, got %v", err)
+	}
+
+	// 间隔过后仍受每小时数量限制
+	limiter.counts = map[string]int{"lib:comment:rate:stu:hourly": uc.cfg.HourlyLimit}
+	if _, err := uc.CreateComment(ctx, req); !errors.Is(err, errcode.ErrCommentTooFrequent) {
+		t.Fatalf("expected hourly limit, got %v", err)
+	}
+	if len(repo.comments) != 1 {
+		t.Fatalf("expected one comment, got %d", len(repo.comments))
+	}
+}
+
+func TestDeleteComment_OwnerOnly(t *testing.T) {
+	uc, repo, _ := newTestCommentUsecase()
+	ctx := context.Background()
+	repo.comments[1] = &Comment{ID: 1, Username: "owner", Status: CommentVisible}
+
+	if err := uc.DeleteComment(ctx, 1, "other"); !errors.Is(err, errcode.ErrCommentForbidden) {
+		t.Fatalf("expected ErrCommentForbidden, got %v", err)
+	}
+	if err := uc.DeleteComment(ctx, 2, "owner"); !errors.Is(err, errcode.ErrCommentNotFound) {
+		t.Fatalf("expected ErrCommentNotFound, got %v", err)
+	}
+	if err := uc.DeleteComment(ctx, 1, "owner"); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := repo.comments[1]; ok {
+		t.Fatal("comment should be deleted")
+	}
+}
+
+func TestReportAndModerateComment(t *testing.T) {
+	uc, repo, _ := newTestCommentUsecase()
+	ctx := context.Background()
+	repo.comments[1] = &Comment{ID: 1, Username: "owner", Status: CommentVisible}
+
+	// 重复举报只记一次
+	for _, reporter := range []string{"a", "b", "b"} {
+		if err := uc.ReportComment(ctx, 1, reporter, "广告"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if repo.comments[1].Status != CommentVisible {
+		t.Fatalf("comment should stay visible below threshold: %+v", repo.comments[1])
+	}
+
+	if err := uc.ReportComment(ctx, 1, "c", "广告"); err != nil {
+		t.Fatal(err)
+	}
+	if repo.comments[1].Status != CommentPending {
+		t.Fatalf("comment should be pending after %d reports: %+v", uc.cfg.ReportThreshold, repo.comments[1])
+	}
+
+	if err := uc.ModerateComment(ctx, 1, ModerateRestore); err != nil {
+		t.Fatal(err)
+	}
+	if c := repo.comments[1]; c.Status != CommentVisible || c.ReportCount != 0 {
+		t.Fatalf("restore should clear reports: %+v", c)
+	}
+
+	if err := uc.ModerateComment(ctx, 1, "ban"); !errors.Is(err, errcode.ErrInvalidComment) {
+		t.Fatalf("expected ErrInvalidComment, got %v", err)
+	}
+	if err := uc.ModerateComment(ctx, 1, ModerateHide); err != nil {
+		t.Fatal(err)
+	}
+	if err := uc.ReportComment(ctx, 1, "d", ""); !errors.Is(err, errcode.ErrCommentNotFound) {
+		t.Fatalf("hidden comment should not be reportable, got %v", err)
+	}
+}
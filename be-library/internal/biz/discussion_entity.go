@@ -1,6 +1,9 @@
 package biz
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 type Discussion struct {
 	LabID    string
@@ -32,3 +35,41 @@ type DiscussionRepo interface {
 	GetDiscussionInfos(ctx context.Context, stuID string) ([]*Discussion, error)
 	SearchUserInfos(ctx context.Context, stuID string) (*Search, error)
 }
+
+// DiscussionSlot 研讨间的一段空闲时间，时间格式为 2006-01-02 15:04
+type DiscussionSlot struct {
+	LabID    string
+	LabName  string
+	KindID   string
+	KindName string
+	DevID    string
+	DevName  string
+	Start    string
+	End      string
+}
+
+// StudyGroup 学习小组，保存常用的研讨间成员，预约时直接使用
+type StudyGroup struct {
+	ID        uint64
+	OwnerID   string // 创建者学号
+	Name      string
+	Members   []*GroupMember // 不包括创建者
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type GroupMember struct {
+	StudentID string
+	Name      string
+	AccountID string // 预约系统中的账号 id，即 Search.ID
+}
+
+type StudyGroupRepo interface {
+	// SaveGroup ID 为 0 时新建，否则更新创建者名下的小组
+	SaveGroup(ctx context.Context, group *StudyGroup) error
+	// DeleteGroup 返回是否删除了记录
+	DeleteGroup(ctx context.Context, ownerID string, id uint64) (bool, error)
+	// GetGroup 不存在时返回 nil
+	GetGroup(ctx context.Context, ownerID string, id uint64) (*StudyGroup, error)
+	ListGroups(ctx context.Context, ownerID string) ([]*StudyGroup, error)
+}
@@ -0,0 +1,277 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/asynccnu/ccnubox-be/be-library/internal/conf"
+	"github.com/asynccnu/ccnubox-be/be-library/internal/errcode"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const maxStudyGroupNameLength = 50
+
+// DiscussionConfig 研讨间的类别和开放时间
+type DiscussionConfig struct {
+	ClassIDs   []string
+	OpenTime   string // HH:MM
+	CloseTime  string // HH:MM
+	MaxMembers int
+}
+
+func NewDiscussionConfig(c *conf.Discussion) DiscussionConfig {
+	cfg := DiscussionConfig{
+		OpenTime:  "08:00",
+		CloseTime: "22:00",
+		// 研讨间最多 4 人，不包括创建者
+		MaxMembers: 3,
+	}
+	if c == nil {
+		return cfg
+	}
+	cfg.ClassIDs = c.ClassIds
+	if _, err := time.Parse("15:04", c.OpenTime); err == nil {
+		cfg.OpenTime = c.OpenTime
+	}
+	if _, err := time.Parse("15:04", c.CloseTime); err == nil {
+		cfg.CloseTime = c.CloseTime
+	}
+	if c.MaxMembers > 0 {
+		cfg.MaxMembers = int(c.MaxMembers)
+	}
+	return cfg
+}
+
+// DiscussionUsecase 研讨间的空闲时段查找、学习小组和按小组预约
+type DiscussionUsecase struct {
+	crawler  LibraryCrawler
+	repo     StudyGroupRepo
+	notifier FeedNotifier
+	cfg      DiscussionConfig
+
+	log *log.Helper
+}
+
+func NewDiscussionUsecase(crawler LibraryCrawler, repo StudyGroupRepo, notifier FeedNotifier, c *conf.Discussion, logger log.Logger) *DiscussionUsecase {
+	return &DiscussionUsecase{
+		crawler:  crawler,
+		repo:     repo,
+		notifier: notifier,
+		cfg:      NewDiscussionConfig(c),
+		log:      log.NewHelper(logger),
+	}
+}
+
+// SearchSlots 在所有研讨间中查找 date 当天长度不小于 duration 分钟的空闲时段
+// from、to 为空时使用研讨间的开放时间，查询当天时从当前时刻开始
+func (u *DiscussionUsecase) SearchSlots(ctx context.Context, stuID, date string, duration int, classIDs []string, from, to string) ([]*DiscussionSlot, error) {
+	day, err := time.ParseInLocation("2006-01-02", date, time.Local)
+	if err != nil || duration <= 0 {
+		return nil, errcode.ErrInvalidDiscussionQuery
+	}
+	if len(classIDs) == 0 {
+		classIDs = u.cfg.ClassIDs
+	}
+	if len(classIDs) == 0 {
+		return nil, errcode.ErrInvalidDiscussionQuery
+	}
+
+	if from == "" {
+		from = u.cfg.OpenTime
+	}
+	if to == "" {
+		to = u.cfg.CloseTime
+	}
+	lower, err1 := minuteOfDay(from)
+	upper, err2 := minuteOfDay(to)
+	if err1 != nil || err2 != nil || upper-lower < duration {
+		return nil, errcode.ErrInvalidDiscussionQuery
+	}
+	if now := time.Now(); now.Format("2006-01-02") == date {
+		lower = max(lower, now.Hour()*60+now.Minute())
+	}
+
+	var (
+		rooms   []*Discussion
+		lastErr error
+		fetched bool
+	)
+	for _, classID := range classIDs {
+		list, err := u.crawler.GetDiscussion(ctx, stuID, classID, date)
+		if err != nil {
+			u.log.Warnf("get discussions(stu_id:%v class_id:%v date:%v) failed: %v", stuID, classID, date, err)
+			lastErr = err
+			continue
+		}
+		fetched = true
+		rooms = append(rooms, list...)
+	}
+	if !fetched {
+		return nil, lastErr
+	}
+
+	var out []*DiscussionSlot
+	for _, room := range rooms {
+		for _, gap := range freeGaps(room.TS, lower, upper, duration) {
+			out = append(out, &DiscussionSlot{
+				LabID:    room.LabID,
+				LabName:  room.LabName,
+				KindID:   room.KindID,
+				KindName: room.KindName,
+				DevID:    room.DevID,
+				DevName:  room.DevName,
+				Start:    formatMinute(day, gap[0]),
+				End:      formatMinute(day, gap[1]),
+			})
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		if out[i].Start != out[j].Start {
+			return out[i].Start < out[j].Start
+		}
+		return out[i].DevName < out[j].DevName
+	})
+	return out, nil
+}
+
+// SaveGroup 通过预约系统按学号查找成员，保存成员的账号 id
+func (u *DiscussionUsecase) SaveGroup(ctx context.Context, ownerID string, id uint64, name string, memberIDs []string) (*StudyGroup, error) {
+	name = strings.TrimSpace(name)
+	if ownerID == "" || name == "" || utf8.RuneCountInString(name) > maxStudyGroupNameLength {
+		return nil, errcode.ErrInvalidStudyGroup
+	}
+
+	seen := map[string]struct{}{ownerID: {}}
+	var ids []string
+	for _, sid := range memberIDs {
+		sid = strings.TrimSpace(sid)
+		if _, ok := seen[sid]; ok || sid == "" {
+			continue
+		}
+		seen[sid] = struct{}{}
+		ids = append(ids, sid)
+	}
+	if len(ids) == 0 || len(ids) > u.cfg.MaxMembers {
+		return nil, errcode.ErrInvalidStudyGroup
+	}
+
+	members := make([]*GroupMember, 0, len(ids))
+	for _, sid := range ids {
+		user, err := u.crawler.SearchUser(ctx, ownerID, sid)
+		if err != nil {
+			u.log.Warnf("search study group member(owner:%v student_id:%v) failed: %v", ownerID, sid, err)
+			return nil, errcode.ErrInvalidStudyGroup.WithCause(err)
+		}
+		members = append(members, &GroupMember{StudentID: sid, Name: user.Name, AccountID: user.ID})
+	}
+
+	group := &StudyGroup{ID: id, OwnerID: ownerID, Name: name, Members: members}
+	if err := u.repo.SaveGroup(ctx, group); err != nil {
+		u.log.Errorf("save study group(owner:%v id:%v) failed: %v", ownerID, id, err)
+		return nil, err
+	}
+	return group, nil
+}
+
+func (u *DiscussionUsecase) DeleteGroup(ctx context.Context, ownerID string, id uint64) error {
+	deleted, err := u.repo.DeleteGroup(ctx, ownerID, id)
+	if err != nil {
+		u.log.Errorf("delete study group(owner:%v id:%v) failed: %v", ownerID, id, err)
+		return err
+	}
+	if !deleted {
+		return errcode.ErrStudyGroupNotFound
+	}
+	return nil
+}
+
+func (u *DiscussionUsecase) ListGroups(ctx context.Context, ownerID string) ([]*StudyGroup, error) {
+	groups, err := u.repo.ListGroups(ctx, ownerID)
+	if err != nil {
+		u.log.Errorf("list study groups(owner:%v) failed: %v", ownerID, err)
+		return nil, err
+	}
+	return groups, nil
+}
+
+// ReserveForGroup 以小组成员预约研讨间，成功后向每个成员推送邀请，返回成功推送的人数
+func (u *DiscussionUsecase) ReserveForGroup(ctx context.Context, stuID string, groupID uint64, devID, labID, kindID, title, start, end string) (string, int, error) {
+	group, err := u.repo.GetGroup(ctx, stuID, groupID)
+	if err != nil {
+		u.log.Errorf("get study group(owner:%v id:%v) failed: %v", stuID, groupID, err)
+		return "", 0, err
+	}
+	if group == nil {
+		return "", 0, errcode.ErrStudyGroupNotFound
+	}
+
+	accounts := make([]string, 0, len(group.Members))
+	for _, m := range group.Members {
+		accounts = append(accounts, m.AccountID)
+	}
+	msg, err := u.crawler.ReserveDiscussion(ctx, stuID, devID, labID, kindID, title, start, end, accounts)
+	if err != nil {
+		u.log.Errorf("reserve discussion for group(owner:%v id:%v) failed: %v", stuID, groupID, err)
+		return "", 0, err
+	}
+
+	content := fmt.Sprintf("%s 通过学习小组「%s」为你预约了研讨间 %s ~ %s", stuID, group.Name, start, end)
+	if title != "" {
+		content += "，主题：" + title
+	}
+	invited := 0
+	for _, m := range group.Members {
+		if err = u.notifier.Notify(ctx, m.StudentID, "研讨间预约邀请", content); err != nil {
+			u.log.Warnf("notify study group member(student_id:%v) failed: %v", m.StudentID, err)
+			continue
+		}
+		invited++
+	}
+	return msg, invited, nil
+}
+
+// freeGaps 返回 [lower, upper) 内除去已预约时段后长度不小于 duration 的空闲区间，单位为当天的分钟数
+func freeGaps(ts []*DiscussionTS, lower, upper, duration int) [][2]int {
+	busy := make([][2]int, 0, len(ts))
+	for _, t := range ts {
+		s, err1 := parseRecordTime(t.Start)
+		e, err2 := parseRecordTime(t.End)
+		if err1 != nil || err2 != nil {
+			continue
+		}
+		busy = append(busy, [2]int{s.Hour()*60 + s.Minute(), e.Hour()*60 + e.Minute()})
+	}
+	sort.Slice(busy, func(i, j int) bool { return busy[i][0] < busy[j][0] })
+
+	var out [][2]int
+	cursor := lower
+	for _, b := range busy {
+		if b[0] > cursor && min(b[0], upper)-cursor >= duration {
+			out = append(out, [2]int{cursor, min(b[0], upper)})
+		}
+		cursor = max(cursor, b[1])
+		if cursor >= upper {
+			return out
+		}
+	}
+	if upper-cursor >= duration {
+		out = append(out, [2]int{cursor, upper})
+	}
+	return out
+}
+
+func minuteOfDay(hhmm string) (int, error) {
+	t, err := time.Parse("15:04", hhmm)
+	if err != nil {
+		return 0, err
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+func formatMinute(day time.Time, minute int) string {
+	return day.Add(time.Duration(minute) * time.Minute).Format("2006-01-02 15:04")
+}
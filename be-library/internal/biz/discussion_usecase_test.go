@@ -0,0 +1,132 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/asynccnu/ccnubox-be/be-library/internal/errcode"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type fakeDiscussionCrawler struct {
+	LibraryCrawler
+	rooms    map[string][]*Discussion
+	users    map[string]*Search
+	reserved []string
+}
+
+func (c *fakeDiscussionCrawler) GetDiscussion(_ context.Context, _, classID, _ string) ([]*Discussion, error) {
+	return c.rooms[classID], nil
+}
+
+func (c *fakeDiscussionCrawler) SearchUser(_ context.Context, _, studentID string) (*Search, error) {
+	if u, ok := c.users[studentID]; ok {
+		return u, nil
+	}
+	return nil, errors.New("user not found")
+}
+
+func (c *fakeDiscussionCrawler) ReserveDiscussion(_ context.Context, _, _, _, _, _, _, _ string, list []string) (string, error) {
+	c.reserved = list
+	return "预约成功", nil
+}
+
+type fakeStudyGroupRepo struct {
+	StudyGroupRepo
+	groups map[uint64]*StudyGroup
+}
+
+func (r *fakeStudyGroupRepo) SaveGroup(_ context.Context, g *StudyGroup) error {
+	if g.ID == 0 {
+		g.ID = uint64(len(r.groups) + 1)
+	}
+	r.groups[g.ID] = g
+	return nil
+}
+
+func (r *fakeStudyGroupRepo) GetGroup(_ context.Context, ownerID string, id uint64) (*StudyGroup, error) {
+	if g := r.groups[id]; g != nil && g.OwnerID == ownerID {
+		return g, nil
+	}
+	return nil, nil
+}
+
+func TestFreeGaps(t *testing.T) {
+	ts := []*DiscussionTS{
+		{Start: "2025-09-02 10:00", End: "2025-09-02 11:00"},
+		{Start: "2025-09-02 10:30", End: "2025-09-02 12:00"},
+		{Start: "2025-09-02 13:00", End: "2025-09-02 13:30"},
+	}
+	got := freeGaps(ts, 8*60, 14*60, 60)
+	want := [][2]int{{8 * 60, 10 * 60}, {12 * 60, 13 * 60}}
+	if len(got) != len(want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("expected %v, got %v", want, got)
+		}
+	}
+}
+
+func TestSearchSlots(t *testing.T) {
+	crawler := &fakeDiscussionCrawler{rooms: map[string][]*Discussion{
+		"c1": {
+			{DevID: "d2", DevName: "B", TS: []*DiscussionTS{{Start: "2025-09-02 08:00", End: "2025-09-02 21:00"}}},
+			{DevID: "d1", DevName: "A"},
+		},
+	}}
+	uc := NewDiscussionUsecase(crawler, nil, nil, nil, log.NewStdLogger(os.Stdout))
+
+	slots, err := uc.SearchSlots(context.Background(), "stu", "2025-09-02", 60, []string{"c1"}, "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(slots) != 2 || slots[0].DevID != "d1" || slots[0].Start != "2025-09-02 08:00" ||
+		slots[1].DevID != "d2" || slots[1].Start != "2025-09-02 21:00" || slots[1].End != "2025-09-02 22:00" {
+		t.Fatalf("unexpected slots: %+v %+v", slots[0], slots[len(slots)-1])
+	}
+
+	if _, err = uc.SearchSlots(context.Background(), "stu", "2025-09-02", 60, nil, "", ""); !errors.Is(err, errcode.ErrInvalidDiscussionQuery) {
+		t.Fatalf("expected ErrInvalidDiscussionQuery without class ids, got %v", err)
+	}
+}
+
+func TestReserveForGroup(t *testing.T) {
+	crawler := &fakeDiscussionCrawler{users: map[string]*Search{
+		"s1": {ID: "101", Name: "甲"},
+		"s2": {ID: "102", Name: "乙"},
+	}}
+	repo := &fakeStudyGroupRepo{groups: map[uint64]*StudyGroup{}}
+	notifier := &fakeNotifier{}
+	uc := NewDiscussionUsecase(crawler, repo, notifier, nil, log.NewStdLogger(os.Stdout))
+	ctx := context.Background()
+
+	// 创建者和重复的学号被去掉
+	group, err := uc.SaveGroup(ctx, "owner", 0, "高数", []string{"s1", "owner", "s2", "s1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(group.Members) != 2 {
+		t.Fatalf("unexpected members: %+v", group.Members)
+	}
+	if _, err = uc.SaveGroup(ctx, "owner", 0, "高数", []string{"s3"}); !errors.Is(err, errcode.ErrInvalidStudyGroup) {
+		t.Fatalf("expected ErrInvalidStudyGroup for unknown member, got %v", err)
+	}
+
+	if _, _, err = uc.ReserveForGroup(ctx, "other", group.ID, "d1", "l1", "k1", "", "2025-09-02 08:00", "2025-09-02 10:00"); !errors.Is(err, errcode.ErrStudyGroupNotFound) {
+		t.Fatalf("expected ErrStudyGroupNotFound, got %v", err)
+	}
+	_, invited, err := uc.ReserveForGroup(ctx, "owner", group.ID, "d1", "l1", "k1", "复习", "2025-09-02 08:00", "2025-09-02 10:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if invited != 2 || len(crawler.reserved) != 2 || crawler.reserved[0] != "101" || crawler.reserved[1] != "102" {
+		t.Fatalf("unexpected reservation: invited=%d accounts=%v", invited, crawler.reserved)
+	}
+	if notifier.titles[0] != "研讨间预约邀请" {
+		t.Fatalf("unexpected notifications: %v", notifier.titles)
+	}
+}
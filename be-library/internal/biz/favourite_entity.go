@@ -1,25 +1,37 @@
 package biz
 
-import "time"
+import (
+	"context"
+	"time"
+)
 
-// 收藏座位核心结构体 - 只保留必要字段
+// 收藏座位核心结构体
 type FavoriteSeat struct {
-	ID         uint64    `gorm:"primaryKey;autoIncrement" json:"id"`
-	StudentID  string    `gorm:"index:idx_student_dev,unique;not null;size:20" json:"student_id"` // 学号
-	SeatID     string    `gorm:"index:idx_student_dev,unique;not null;size:50" json:"seat_id"`    // 设备ID，唯一标识座位
-	LayerName  string    `gorm:"size:100;not null" json:"layer_name"`
-	RoomName   string    `gorm:"size:50;not null" json:"room_name"`
-	SeatName   string    `gorm:"size:100;not null" json:"dev_name"`
-	CreateTime time.Time `gorm:"index:idx_create_time;not null" json:"create_time"`
+	ID         uint64
+	StudentID  string // 学号
+	SeatID     string // 设备ID，唯一标识座位
+	RoomID     string
+	LayerName  string // 南湖分馆一楼
+	RoomName   string
+	SeatName   string // N1245
+	CreateTime time.Time
 
-	// 可选：座位状态快照（便于展示时不用再查询原始座位信息）
-	IsAvailable bool `json:"is_available"` // 当前是否可用（缓存字段）
+	// 座位状态快照，查询时根据座位缓存实时计算
+	IsAvailable bool        // 查询时间段内是否可用
+	Ts          []*TimeSlot // 当天已被占用的时间段
 }
 
-// 用于API响应的DTO
-type FavoriteSeatResponse struct {
-	Seats []FavoriteSeat `json:"favourite_seats"`
+// ReserveFavouriteResult 预约收藏座位的结果
+type ReserveFavouriteResult struct {
+	Message    string
+	Seat       *Seat
+	IsNeighbor bool // 收藏座位均被占用时预约到的邻座
 }
 
-type FavoriteRepo struct {
+type FavoriteRepo interface {
+	// AddFavourite (学号, 座位) 已存在时不重复插入
+	AddFavourite(ctx context.Context, fav *FavoriteSeat) error
+	// RemoveFavourite 返回是否删除了记录
+	RemoveFavourite(ctx context.Context, stuID, seatID string) (bool, error)
+	ListFavourites(ctx context.Context, stuID string) ([]*FavoriteSeat, error)
 }
@@ -1,18 +1,37 @@
 package biz
 
-import "github.com/go-kratos/kratos/v2/log"
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/asynccnu/ccnubox-be/be-library/internal/errcode"
+	"github.com/asynccnu/ccnubox-be/be-library/pkg/tool"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 自动预约时最多尝试的座位数，避免缓存过期时对图书馆系统连续发起大量预约请求
+const maxReserveAttempts = 3
 
 type FavouriteUsecase struct {
 	FavoriteRepo FavoriteRepo
 	SeatRepo     SeatRepo
+	crawler      LibraryCrawler
+	rooms        *RoomCatalog
 
 	log *log.Helper
 }
 
-func NewFavouriteUsecase(favouriteRepo FavoriteRepo, seatRepo SeatRepo, logger log.Logger) *FavouriteUsecase {
+func NewFavouriteUsecase(favouriteRepo FavoriteRepo, seatRepo SeatRepo, crawler LibraryCrawler, logger log.Logger, rooms *RoomCatalog) *FavouriteUsecase {
 	uc := &FavouriteUsecase{
 		FavoriteRepo: favouriteRepo,
 		SeatRepo:     seatRepo,
+		crawler:      crawler,
+		rooms:        rooms,
 
 		log: log.NewHelper(logger),
 	}
@@ -20,41 +39,281 @@ func NewFavouriteUsecase(favouriteRepo FavoriteRepo, seatRepo SeatRepo, logger l
 	return uc
 }
 
-func (u *FavouriteUsecase) AddFavourite() () {}
-func (u *FavouriteUsecase) GetFavourite() () {}
-
-
-// func (u *libraryUsecase) SeatToFavourite(seat *Seat, studentID string) *FavoriteSeat {
-// 	return &FavoriteSeat{
-// 		StudentID:  studentID,
-// 		DevID:      seat.DevID,
-// 		LabName:    seat.LabName,
-// 		KindName:   seat.KindName,
-// 		DevName:    seat.DevName,
-// 		CreateTime: time.Now(),
-// 	}
-// }
-
-// func (u *libraryUsecase) FavoriteToResponse(favourite *FavoriteSeat, includeTimeSlots bool) *FavoriteSeatResponse {
-// 	response := &FavoriteSeatResponse{
-// 		ID:          favorite.ID,
-// 		DevID:       favorite.DevID,
-// 		LabName:     favorite.LabName,
-// 		KindName:    favorite.KindName,
-// 		DevName:     favorite.DevName,
-// 		IsAvailable: favorite.IsAvailable,
-// 	}
-
-// 	// 返回实时可用状态，去查询原始座位数据
-// 	if includeTimeSlots {
-// 		if seat :=
-// 	}
-// }
-
-// func (u *libraryUsecase) AddFavourite(studentID string, seat *Seat) (*FavoriteSeat, error) {
-
-// }
-
-// // 设计为 输入 选中时间段，自动检测时间段是否可用吧？
-// func (U *libraryUsecase) calculateAvailability(timeSlot TimeSlot, devId_labId string) bool {
-// }
+// AddFavourite 收藏座位，roomID 为空时在全部房间中查找该座位
+func (u *FavouriteUsecase) AddFavourite(ctx context.Context, stuID, seatID, roomID string) error {
+	var roomIDs []string
+	if roomID != "" {
+		roomIDs = []string{roomID}
+	} else {
+		roomIDs = u.rooms.BookableRoomIDs(ctx)
+	}
+
+	rooms, err := u.SeatRepo.GetSeatInfos(ctx, stuID, roomIDs)
+	if err != nil {
+		u.log.Errorf("get seats for favourite(stu_id:%v seat_id:%v) failed: %v", stuID, seatID, err)
+		return err
+	}
+
+	var seat *Seat
+	for _, seats := range rooms {
+		if seat = findSeat(seats, seatID); seat != nil {
+			break
+		}
+	}
+	if seat == nil {
+		return errcode.ErrSeatNotFound
+	}
+
+	err = u.FavoriteRepo.AddFavourite(ctx, &FavoriteSeat{
+		StudentID:  stuID,
+		SeatID:     seat.DevID,
+		RoomID:     seat.RoomID,
+		LayerName:  seat.LabName,
+		RoomName:   seat.RoomName,
+		SeatName:   seat.DevName,
+		CreateTime: time.Now(),
+	})
+	if err != nil {
+		u.log.Errorf("add favourite(stu_id:%v seat_id:%v) failed: %v", stuID, seatID, err)
+		return err
+	}
+	return nil
+}
+
+func (u *FavouriteUsecase) RemoveFavourite(ctx context.Context, stuID, seatID string) error {
+	removed, err := u.FavoriteRepo.RemoveFavourite(ctx, stuID, seatID)
+	if err != nil {
+		u.log.Errorf("remove favourite(stu_id:%v seat_id:%v) failed: %v", stuID, seatID, err)
+		return err
+	}
+	if !removed {
+		return errcode.ErrFavouriteNotFound
+	}
+	return nil
+}
+
+// ListFavourites 返回收藏的座位，并根据座位缓存计算 [start, end) 内是否空闲
+// start 为空时查询当前时刻
+func (u *FavouriteUsecase) ListFavourites(ctx context.Context, stuID, start, end string) ([]*FavoriteSeat, error) {
+	favs, err := u.FavoriteRepo.ListFavourites(ctx, stuID)
+	if err != nil {
+		u.log.Errorf("list favourites(stu_id:%v) failed: %v", stuID, err)
+		return nil, err
+	}
+	if len(favs) == 0 {
+		return favs, nil
+	}
+
+	qStart, qEnd, err := parseQueryWindow(start, end, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	rooms, err := u.SeatRepo.GetSeatInfos(ctx, stuID, favouriteRoomIDs(favs))
+	if err != nil {
+		// 座位缓存不可用时仍返回收藏列表，只是无法给出实时状态
+		u.log.Warnf("get seats for favourites(stu_id:%v) failed: %v", stuID, err)
+		return favs, nil
+	}
+
+	for _, fav := range favs {
+		seat := findSeat(rooms[fav.RoomID], fav.SeatID)
+		if seat == nil {
+			continue
+		}
+		fav.Ts = seat.Ts
+		fav.IsAvailable = seatFree(seat, qStart, qEnd)
+	}
+	return favs, nil
+}
+
+// ReserveFavourite 预约收藏的座位，seatID 为空时按收藏顺序依次尝试
+// 收藏的座位都被占用且 fallbackNeighbor 为真时，预约同一房间内离收藏座位最近的空闲座位
+func (u *FavouriteUsecase) ReserveFavourite(ctx context.Context, stuID, start, end, seatID string, fallbackNeighbor bool) (*ReserveFavouriteResult, error) {
+	if start == "" || end == "" {
+		return nil, errors.New("start and end are required")
+	}
+	qStart, qEnd, err := parseQueryWindow(start, end, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	favs, err := u.FavoriteRepo.ListFavourites(ctx, stuID)
+	if err != nil {
+		u.log.Errorf("list favourites(stu_id:%v) failed: %v", stuID, err)
+		return nil, err
+	}
+	if seatID != "" {
+		favs = filterFavourites(favs, seatID)
+	}
+	if len(favs) == 0 {
+		return nil, errcode.ErrFavouriteNotFound
+	}
+
+	rooms, err := u.SeatRepo.GetSeatInfos(ctx, stuID, favouriteRoomIDs(favs))
+	if err != nil {
+		u.log.Errorf("get seats for favourites(stu_id:%v) failed: %v", stuID, err)
+		return nil, err
+	}
+
+	var candidates []*Seat
+	for _, fav := range favs {
+		if seat := findSeat(rooms[fav.RoomID], fav.SeatID); seat != nil && seatFree(seat, qStart, qEnd) {
+			candidates = append(candidates, seat)
+		}
+	}
+	neighborFrom := len(candidates)
+
+	if fallbackNeighbor {
+		seen := make(map[string]struct{}, len(favs))
+		for _, fav := range favs {
+			seen[fav.SeatID] = struct{}{}
+		}
+		for _, fav := range favs {
+			for _, seat := range neighborSeats(rooms[fav.RoomID], fav.SeatName) {
+				if _, ok := seen[seat.DevID]; ok || !seatFree(seat, qStart, qEnd) {
+					continue
+				}
+				seen[seat.DevID] = struct{}{}
+				candidates = append(candidates, seat)
+			}
+		}
+	}
+
+	var lastErr error
+	for i, seat := range candidates {
+		if i >= maxReserveAttempts {
+			break
+		}
+		msg, err := u.crawler.ReserveSeat(ctx, stuID, seat.DevID, start, end)
+		if err != nil {
+			// 缓存可能已过期，座位被他人抢先预约，继续尝试下一个
+			u.log.Warnf("reserve favourite(stu_id:%v seat_id:%v) failed: %v", stuID, seat.DevID, err)
+			lastErr = err
+			continue
+		}
+		return &ReserveFavouriteResult{
+			Message:    msg,
+			Seat:       seat,
+			IsNeighbor: i >= neighborFrom,
+		}, nil
+	}
+
+	if lastErr != nil {
+		return nil, lastErr
+	}
+	return nil, errcode.ErrNoAvailableSeat
+}
+
+func findSeat(seats []*Seat, seatID string) *Seat {
+	for _, seat := range seats {
+		if seat.DevID == seatID {
+			return seat
+		}
+	}
+	return nil
+}
+
+func filterFavourites(favs []*FavoriteSeat, seatID string) []*FavoriteSeat {
+	for _, fav := range favs {
+		if fav.SeatID == seatID {
+			return []*FavoriteSeat{fav}
+		}
+	}
+	return nil
+}
+
+func favouriteRoomIDs(favs []*FavoriteSeat) []string {
+	seen := make(map[string]struct{}, len(favs))
+	roomIDs := make([]string, 0, len(favs))
+	for _, fav := range favs {
+		if _, ok := seen[fav.RoomID]; ok {
+			continue
+		}
+		seen[fav.RoomID] = struct{}{}
+		roomIDs = append(roomIDs, fav.RoomID)
+	}
+	return roomIDs
+}
+
+// parseQueryWindow 把 "2006-01-02 15:04" 格式的时间段转成 HHMM，与座位缓存中的时间格式保持一致
+// start 为空时返回 now 所在的一分钟
+func parseQueryWindow(start, end string, now time.Time) (int, int, error) {
+	if start == "" {
+		hhmm := now.Hour()*100 + now.Minute()
+		return hhmm, hhmm + 1, nil
+	}
+	qStart, err := tool.ParseToUnix(start)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid start %q: %w", start, err)
+	}
+	qEnd, err := tool.ParseToUnix(end)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid end %q: %w", end, err)
+	}
+	if qEnd <= qStart {
+		return 0, 0, fmt.Errorf("end %q must be after start %q", end, start)
+	}
+	return qStart, qEnd, nil
+}
+
+// seatFree 判断座位在 [qStart, qEnd) 内没有被占用，判断方式与 FindFirstAvailableSeat 的脚本一致
+func seatFree(seat *Seat, qStart, qEnd int) bool {
+	for _, ts := range seat.Ts {
+		tsStart, err := tool.ParseToUnix(ts.Start)
+		if err != nil {
+			continue
+		}
+		tsEnd, err := tool.ParseToUnix(ts.End)
+		if err != nil {
+			continue
+		}
+		if tsStart < qEnd && tsEnd > qStart {
+			return false
+		}
+	}
+	return true
+}
+
+// neighborSeats 按座位号与 seatName 的距离从近到远排列同一房间内的座位
+func neighborSeats(seats []*Seat, seatName string) []*Seat {
+	target := seatNumber(seatName)
+	out := make([]*Seat, 0, len(seats))
+	for _, seat := range seats {
+		if seat.DevName != seatName {
+			out = append(out, seat)
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		di, dj := seatDistance(out[i].DevName, target), seatDistance(out[j].DevName, target)
+		if di != dj {
+			return di < dj
+		}
+		return out[i].DevName < out[j].DevName
+	})
+	return out
+}
+
+func seatDistance(name string, target int) int {
+	n := seatNumber(name)
+	if n < 0 || target < 0 {
+		return math.MaxInt
+	}
+	if n > target {
+		return n - target
+	}
+	return target - n
+}
+
+// seatNumber 取座位名末尾的数字，如 N1245 -> 1245，没有数字时返回 -1
+func seatNumber(name string) int {
+	i := len(name)
+	for i > 0 && name[i-1] >= '0' && name[i-1] <= '9' {
+		i--
+	}
+	n, err := strconv.Atoi(name[i:])
+	if err != nil {
+		return -1
+	}
+	return n
+}
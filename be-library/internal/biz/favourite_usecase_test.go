@@ -0,0 +1,158 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/asynccnu/ccnubox-be/be-library/internal/errcode"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type fakeFavoriteRepo struct {
+	favs []*FavoriteSeat
+}
+
+func (r *fakeFavoriteRepo) AddFavourite(_ context.Context, fav *FavoriteSeat) error {
+	r.favs = append(r.favs, fav)
+	return nil
+}
+
+func (r *fakeFavoriteRepo) RemoveFavourite(_ context.Context, _, _ string) (bool, error) {
+	return false, nil
+}
+
+func (r *fakeFavoriteRepo) ListFavourites(_ context.Context, _ string) ([]*FavoriteSeat, error) {
+	out := make([]*FavoriteSeat, 0, len(r.favs))
+	for _, f := range r.favs {
+		cp := *f
+		out = append(out, &cp)
+	}
+	return out, nil
+}
+
+type fakeSeatRepo struct {
+	rooms     map[string][]*Seat
+	refreshed []string
+}
+
+func (r *fakeSeatRepo) FindFirstAvailableSeat(context.Context, int64, int64, []string) (string, bool, error) {
+	return "", false, nil
+}
+
+func (r *fakeSeatRepo) GetSeatInfos(_ context.Context, _ string, roomIDs []string) (map[string][]*Seat, error) {
+	out := make(map[string][]*Seat, len(roomIDs))
+	for _, id := range roomIDs {
+		if seats, ok := r.rooms[id]; ok {
+			out[id] = seats
+		}
+	}
+	return out, nil
+}
+
+func (r *fakeSeatRepo) SaveRoomSeatsInRedis(_ context.Context, _ string, roomIDs []string) error {
+	r.refreshed = append(r.refreshed, roomIDs...)
+	return nil
+}
+
+// fakeCrawler 只实现预约，记录尝试过的座位
+type fakeCrawler struct {
+	LibraryCrawler
+	reserved []string
+	fail     map[string]bool
+}
+
+func (c *fakeCrawler) ReserveSeat(_ context.Context, _ string, devID, _, _ string) (string, error) {
+	c.reserved = append(c.reserved, devID)
+	if c.fail[devID] {
+		return "", errors.New("seat taken")
+	}
+	return "ok", nil
+}
+
+func busy(start, end string) []*TimeSlot {
+	return []*TimeSlot{{Start: "2025-09-02 " + start, End: "2025-09-02 " + end, Occupy: true}}
+}
+
+func newTestFavouriteUsecase(crawler *fakeCrawler) *FavouriteUsecase {
+	seats := []*Seat{
+		{RoomID: "r1", DevID: "1", DevName: "N1001", Ts: busy("08:00", "12:00")},
+		{RoomID: "r1", DevID: "2", DevName: "N1002", Ts: busy("09:00", "10:00")},
+		{RoomID: "r1", DevID: "3", DevName: "N1003"},
+		{RoomID: "r1", DevID: "9", DevName: "N1009"},
+	}
+	favRepo := &fakeFavoriteRepo{favs: []*FavoriteSeat{
+		{StudentID: "stu", SeatID: "1", RoomID: "r1", SeatName: "N1001"},
+	}}
+	seatRepo := &fakeSeatRepo{rooms: map[string][]*Seat{"r1": seats}}
+	return NewFavouriteUsecase(favRepo, seatRepo, crawler, log.NewStdLogger(os.Stdout), nil)
+}
+
+func TestListFavourites_Availability(t *testing.T) {
+	uc := newTestFavouriteUsecase(&fakeCrawler{})
+
+	favs, err := uc.ListFavourites(context.Background(), "stu", "2025-09-02 13:00", "2025-09-02 14:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(favs) != 1 || !favs[0].IsAvailable {
+		t.Fatalf("expected favourite to be free in the afternoon, got %+v", favs)
+	}
+
+	favs, err = uc.ListFavourites(context.Background(), "stu", "2025-09-02 11:00", "2025-09-02 13:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if favs[0].IsAvailable {
+		t.Fatal("expected favourite to be busy before noon")
+	}
+}
+
+func TestReserveFavourite_FallbackNeighbour(t *testing.T) {
+	ctx := context.Background()
+
+	crawler := &fakeCrawler{}
+	uc := newTestFavouriteUsecase(crawler)
+	res, err := uc.ReserveFavourite(ctx, "stu", "2025-09-02 13:00", "2025-09-02 14:00", "", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Seat.DevID != "1" || res.IsNeighbor {
+		t.Fatalf("expected favourite seat, got %+v", res)
+	}
+
+	// 收藏座位被占用且不允许邻座
+	_, err = uc.ReserveFavourite(ctx, "stu", "2025-09-02 09:00", "2025-09-02 11:00", "", false)
+	if !errors.Is(err, errcode.ErrNoAvailableSeat) {
+		t.Fatalf("expected ErrNoAvailableSeat, got %v", err)
+	}
+
+	// N1002 在 9-10 点被占用，最近的空闲邻座是 N1003
+	res, err = uc.ReserveFavourite(ctx, "stu", "2025-09-02 09:00", "2025-09-02 11:00", "", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Seat.DevID != "3" || !res.IsNeighbor {
+		t.Fatalf("expected neighbour N1003, got %+v", res.Seat)
+	}
+
+	// 邻座预约失败时继续尝试下一个
+	crawler = &fakeCrawler{fail: map[string]bool{"3": true}}
+	uc = newTestFavouriteUsecase(crawler)
+	res, err = uc.ReserveFavourite(ctx, "stu", "2025-09-02 09:00", "2025-09-02 11:00", "", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Seat.DevID != "9" {
+		t.Fatalf("expected N1009 after N1003 failed, got %+v (tried %v)", res.Seat, crawler.reserved)
+	}
+}
+
+func TestReserveFavourite_UnknownSeat(t *testing.T) {
+	uc := newTestFavouriteUsecase(&fakeCrawler{})
+	_, err := uc.ReserveFavourite(context.Background(), "stu", "2025-09-02 13:00", "2025-09-02 14:00", "42", true)
+	if !errors.Is(err, errcode.ErrFavouriteNotFound) {
+		t.Fatalf("expected ErrFavouriteNotFound, got %v", err)
+	}
+}
@@ -15,6 +15,13 @@ type LibraryBiz interface {
 	ReserveDiscussion(ctx context.Context, stuID, devID, labID, kindID, title, start, end string, list []string) (string, error)
 	CancelReserve(ctx context.Context, stuID, id string) (string, error)
 	ReserveSeatRandomly(ctx context.Context, stuID, start, end string, roomIDs []string) (string, error)
+	SwapReservation(ctx context.Context, stuID, oldID, devID, start, end string) (*SwapResult, error)
+}
+
+// SwapResult 换座的结果，NewID 在新预约未能从预约记录中找到时为空
+type SwapResult struct {
+	Message string
+	NewID   string
 }
 
 type LibraryCrawler interface {
@@ -27,4 +34,6 @@ type LibraryCrawler interface {
 	SearchUser(ctx context.Context, stuID string, studentid string) (*Search, error)
 	ReserveDiscussion(ctx context.Context, stuID string, devid, labid, kindid, title, start, end string, list []string) (string, error)
 	CancelReserve(ctx context.Context, stuID string, id string) (string, error)
+	// ListRooms 图书馆系统中当前所有自习室房间及其座位数
+	ListRooms(ctx context.Context, stuID string) ([]*Room, error)
 }
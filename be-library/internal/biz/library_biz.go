@@ -5,29 +5,35 @@ import (
 	"errors"
 	"time"
 
+	"github.com/asynccnu/ccnubox-be/be-library/internal/errcode"
 	"github.com/go-kratos/kratos/v2/log"
 )
 
+const refreshRecordsTimeout = 10 * time.Second
+
 type libraryBiz struct {
 	crawler          LibraryCrawler
 	log              *log.Helper
 	SeatRepo         SeatRepo
 	RecordRepo       RecordRepo
 	CreditPointsRepo CreditPointsRepo
+	rooms            *RoomCatalog
 }
 
-func NewLibraryBiz(crawler LibraryCrawler, logger log.Logger, seatRepo SeatRepo, recordRepo RecordRepo, creditPointsRepo CreditPointsRepo) LibraryBiz {
+func NewLibraryBiz(crawler LibraryCrawler, logger log.Logger, seatRepo SeatRepo, recordRepo RecordRepo, creditPointsRepo CreditPointsRepo, rooms *RoomCatalog) LibraryBiz {
 	return &libraryBiz{
 		crawler:          crawler,
 		log:              log.NewHelper(logger),
 		SeatRepo:         seatRepo,
 		RecordRepo:       recordRepo,
 		CreditPointsRepo: creditPointsRepo,
+		rooms:            rooms,
 	}
 }
 
+// GetSeat RoomIDs 为空时查询房间目录中全部可预约的房间
 func (b *libraryBiz) GetSeat(ctx context.Context, stuID string, RoomIDs []string) (map[string][]*Seat, error) {
-	data, err := b.SeatRepo.GetSeatInfos(ctx, stuID, RoomIDs)
+	data, err := b.SeatRepo.GetSeatInfos(ctx, stuID, b.rooms.ResolveRoomIDs(ctx, RoomIDs))
 	if err != nil {
 		b.log.Errorf("get seats from cache(stu_id:%v) failed: %v", stuID, err)
 		return nil, err
@@ -41,9 +47,25 @@ func (b *libraryBiz) ReserveSeat(ctx context.Context, stuID, devID, start, end s
 		b.log.Errorf("reserve seats(stu_id:%v) failed: %v", stuID, err)
 		return "", err
 	}
+	// 预约记录落库后才会被预约提醒跟踪
+	go b.refreshFutureRecords(context.WithoutCancel(ctx), stuID)
 	return message, nil
 }
 
+func (b *libraryBiz) refreshFutureRecords(ctx context.Context, stuID string) {
+	ctx, cancel := context.WithTimeout(ctx, refreshRecordsTimeout)
+	defer cancel()
+
+	records, err := b.crawler.GetRecord(ctx, stuID)
+	if err != nil {
+		b.log.Warnf("refresh records(stu_id:%v) failed: %v", stuID, err)
+		return
+	}
+	if err = b.RecordRepo.UpsertFutureRecords(ctx, stuID, records); err != nil {
+		b.log.Warnf("persist future records(stu_id:%v) failed: %v", stuID, err)
+	}
+}
+
 func (b *libraryBiz) GetSeatRecord(ctx context.Context, stuID string) ([]*FutureRecords, error) {
 	records, err := b.crawler.GetRecord(ctx, stuID)
 	if err != nil {
@@ -150,7 +172,7 @@ func (b *libraryBiz) ReserveSeatRandomly(ctx context.Context, stuID, start, end
 	qEnd := tEnd.Hour()*100 + tEnd.Minute()
 
 	// 查找空闲预约
-	seatDevID, isExist, err := b.SeatRepo.FindFirstAvailableSeat(ctx, int64(qStart), int64(qEnd), roomIDs)
+	seatDevID, isExist, err := b.SeatRepo.FindFirstAvailableSeat(ctx, int64(qStart), int64(qEnd), b.rooms.ResolveRoomIDs(ctx, roomIDs))
 	if err != nil {
 		return "", err
 	}
@@ -165,3 +187,86 @@ func (b *libraryBiz) ReserveSeatRandomly(ctx context.Context, stuID, start, end
 	}
 	return msg, nil
 }
+
+// SwapReservation 先预约新座位，成功后再取消原预约
+// 取消原预约失败时取消新预约作为补偿；只有按座位和时间确认了新预约才补偿，避免取消学生的其他预约
+func (b *libraryBiz) SwapReservation(ctx context.Context, stuID, oldID, devID, start, end string) (*SwapResult, error) {
+	if oldID == "" || devID == "" || start == "" || end == "" {
+		return nil, errcode.ErrInvalidSwap
+	}
+
+	before, err := b.crawler.GetRecord(ctx, stuID)
+	if err != nil {
+		b.log.Errorf("get records before swap(stu_id:%v) failed: %v", stuID, err)
+		return nil, err
+	}
+	if findRecord(before, oldID) == nil {
+		return nil, errcode.ErrReservationNotFound
+	}
+
+	msg, err := b.crawler.ReserveSeat(ctx, stuID, devID, start, end)
+	if err != nil {
+		// 原预约还在，直接返回
+		b.log.Warnf("swap reserve new seat(stu_id:%v seat_id:%v) failed: %v", stuID, devID, err)
+		return nil, err
+	}
+
+	newID := ""
+	after, err := b.crawler.GetRecord(ctx, stuID)
+	if err != nil {
+		b.log.Warnf("get records after swap(stu_id:%v) failed: %v", stuID, err)
+	} else {
+		newID = findNewRecordID(before, after, devID, start, end)
+	}
+
+	if _, err = b.crawler.CancelReserve(ctx, stuID, oldID); err != nil {
+		b.log.Errorf("swap cancel old reservation(stu_id:%v id:%v) failed: %v", stuID, oldID, err)
+		if newID == "" {
+			// 确认不了新预约时不补偿，两个预约都保留，由学生自行取消
+			return nil, errcode.ErrSwapDoubleBooked.WithCause(err)
+		}
+		if _, cerr := b.crawler.CancelReserve(ctx, stuID, newID); cerr != nil {
+			b.log.Errorf("swap compensate new reservation(stu_id:%v id:%v) failed: %v", stuID, newID, cerr)
+			return nil, errcode.ErrSwapDoubleBooked.WithCause(err)
+		}
+		return nil, errcode.ErrSwapFailed.WithCause(err)
+	}
+
+	go b.refreshFutureRecords(context.WithoutCancel(ctx), stuID)
+	return &SwapResult{Message: msg, NewID: newID}, nil
+}
+
+func findRecord(records []*FutureRecords, id string) *FutureRecords {
+	for _, r := range records {
+		if r.ID == id {
+			return r
+		}
+	}
+	return nil
+}
+
+// findNewRecordID 在预约后的记录中找出新增的那一条，座位和时间段都要一致
+// 同时新增的其他预约（比如自动预约或其他客户端）不会被当成新预约，找不到时返回空
+func findNewRecordID(before, after []*FutureRecords, devID, start, end string) string {
+	existing := make(map[string]struct{}, len(before))
+	for _, r := range before {
+		existing[r.ID] = struct{}{}
+	}
+
+	for _, r := range after {
+		if _, ok := existing[r.ID]; ok || r.ID == "" {
+			continue
+		}
+		if r.DevID == devID && sameRecordTime(r.Start, start) && sameRecordTime(r.End, end) {
+			return r.ID
+		}
+	}
+	return ""
+}
+
+// sameRecordTime 预约记录的时间可能带秒
+func sameRecordTime(a, b string) bool {
+	ta, err1 := parseRecordTime(a)
+	tb, err2 := parseRecordTime(b)
+	return err1 == nil && err2 == nil && ta.Equal(tb)
+}
@@ -0,0 +1,171 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/asynccnu/ccnubox-be/be-library/internal/errcode"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// fakeSwapCrawler 模拟图书馆的预约记录，预约成功时新增一条记录
+type fakeSwapCrawler struct {
+	LibraryCrawler
+	mu         sync.Mutex
+	records    []*FutureRecords
+	nextID     int
+	reserveErr error
+	cancelFail map[string]bool
+	cancelled  []string
+	// 预约时同时出现的其他预约，比如自动预约抢到的座位
+	concurrent *FutureRecords
+	// 新预约的记录是否带有座位 id
+	noDevID bool
+}
+
+func (c *fakeSwapCrawler) GetRecord(context.Context, string) ([]*FutureRecords, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]*FutureRecords(nil), c.records...), nil
+}
+
+func (c *fakeSwapCrawler) ReserveSeat(_ context.Context, _ string, devID, start, end string) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.reserveErr != nil {
+		return "", c.reserveErr
+	}
+	if c.concurrent != nil {
+		c.records = append(c.records, c.concurrent)
+	}
+	c.nextID++
+	record := &FutureRecords{ID: strconv.Itoa(c.nextID), DevName: devID, DevID: devID, Start: start + ":00", End: end + ":00"}
+	if c.noDevID {
+		record.DevID = ""
+	}
+	c.records = append(c.records, record)
+	return "预约成功", nil
+}
+
+func (c *fakeSwapCrawler) CancelReserve(_ context.Context, _ string, id string) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.cancelled = append(c.cancelled, id)
+	if c.cancelFail[id] {
+		return "", errors.New("cancel failed")
+	}
+	for i, r := range c.records {
+		if r.ID == id {
+			c.records = append(c.records[:i], c.records[i+1:]...)
+			break
+		}
+	}
+	return "取消成功", nil
+}
+
+func newTestSwap(crawler *fakeSwapCrawler) LibraryBiz {
+	crawler.records = []*FutureRecords{{ID: "100", DevName: "old", Start: "2025-09-02 08:00", End: "2025-09-02 12:00"}}
+	crawler.nextID = 100
+	return NewLibraryBiz(crawler, log.NewStdLogger(os.Stdout), nil, &fakeRecordRepo{}, nil, nil)
+}
+
+func TestSwapReservation(t *testing.T) {
+	crawler := &fakeSwapCrawler{}
+	b := newTestSwap(crawler)
+
+	res, err := b.SwapReservation(context.Background(), "stu", "100", "new", "2025-09-02 08:00", "2025-09-02 12:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.NewID != "101" {
+		t.Fatalf("expected new reservation 101, got %+v", res)
+	}
+	records, _ := crawler.GetRecord(context.Background(), "stu")
+	if len(records) != 1 || records[0].ID != "101" {
+		t.Fatalf("old reservation should be cancelled: %+v", records)
+	}
+
+	if _, err = b.SwapReservation(context.Background(), "stu", "100", "new", "2025-09-02 08:00", "2025-09-02 12:00"); !errors.Is(err, errcode.ErrReservationNotFound) {
+		t.Fatalf("expected ErrReservationNotFound, got %v", err)
+	}
+}
+
+func TestSwapReservation_InvalidArgs(t *testing.T) {
+	crawler := &fakeSwapCrawler{}
+	b := newTestSwap(crawler)
+
+	_, err := b.SwapReservation(context.Background(), "stu", "100", "", "2025-09-02 08:00", "2025-09-02 12:00")
+	if !errors.Is(err, errcode.ErrInvalidSwap) {
+		t.Fatalf("expected ErrInvalidSwap, got %v", err)
+	}
+	if len(crawler.records) != 1 || len(crawler.cancelled) != 0 {
+		t.Fatal("nothing should be reserved or cancelled when arguments are missing")
+	}
+}
+
+func TestSwapReservation_ReserveFails(t *testing.T) {
+	crawler := &fakeSwapCrawler{reserveErr: errors.New("seat taken")}
+	b := newTestSwap(crawler)
+
+	if _, err := b.SwapReservation(context.Background(), "stu", "100", "new", "2025-09-02 08:00", "2025-09-02 12:00"); err == nil {
+		t.Fatal("expected error")
+	}
+	if len(crawler.cancelled) != 0 {
+		t.Fatalf("old reservation must be kept when the new one fails: %v", crawler.cancelled)
+	}
+}
+
+func TestSwapReservation_CompensatesWhenCancelFails(t *testing.T) {
+	crawler := &fakeSwapCrawler{cancelFail: map[string]bool{"100": true}}
+	b := newTestSwap(crawler)
+
+	_, err := b.SwapReservation(context.Background(), "stu", "100", "new", "2025-09-02 08:00", "2025-09-02 12:00")
+	if !errors.Is(err, errcode.ErrSwapFailed) {
+		t.Fatalf("expected ErrSwapFailed, got %v", err)
+	}
+	// 取消原预约失败后取消新预约，只保留原预约
+	if len(crawler.cancelled) != 2 || crawler.cancelled[1] != "101" {
+		t.Fatalf("expected compensating cancel of 101, got %v", crawler.cancelled)
+	}
+	records, _ := crawler.GetRecord(context.Background(), "stu")
+	if len(records) != 1 || records[0].ID != "100" {
+		t.Fatalf("only the old reservation should remain: %+v", records)
+	}
+}
+
+func TestSwapReservation_NoCompensationWithoutExactMatch(t *testing.T) {
+	// 同时新增了一条同时段、不同座位的预约，补偿时不能取消它
+	for name, crawler := range map[string]*fakeSwapCrawler{
+		"other seat":   {cancelFail: map[string]bool{"100": true}, concurrent: &FutureRecords{ID: "agent", DevID: "other", Start: "2025-09-02 08:00", End: "2025-09-02 12:00"}, noDevID: true},
+		"other period": {cancelFail: map[string]bool{"100": true}, concurrent: &FutureRecords{ID: "agent", DevID: "new", Start: "2025-09-02 14:00", End: "2025-09-02 18:00"}, noDevID: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			b := newTestSwap(crawler)
+			_, err := b.SwapReservation(context.Background(), "stu", "100", "new", "2025-09-02 08:00", "2025-09-02 12:00")
+			if !errors.Is(err, errcode.ErrSwapDoubleBooked) {
+				t.Fatalf("expected ErrSwapDoubleBooked, got %v", err)
+			}
+			if len(crawler.cancelled) != 1 || crawler.cancelled[0] != "100" {
+				t.Fatalf("only the old reservation may be cancelled, got %v", crawler.cancelled)
+			}
+		})
+	}
+}
+
+func TestSwapReservation_CompensationFails(t *testing.T) {
+	crawler := &fakeSwapCrawler{cancelFail: map[string]bool{"100": true, "101": true}}
+	b := newTestSwap(crawler)
+
+	_, err := b.SwapReservation(context.Background(), "stu", "100", "new", "2025-09-02 08:00", "2025-09-02 12:00")
+	if !errors.Is(err, errcode.ErrSwapDoubleBooked) {
+		t.Fatalf("expected ErrSwapDoubleBooked, got %v", err)
+	}
+	records, _ := crawler.GetRecord(context.Background(), "stu")
+	if len(records) != 2 {
+		t.Fatalf("both reservations should remain: %+v", records)
+	}
+}
@@ -0,0 +1,41 @@
+package biz
+
+import (
+	"context"
+	"time"
+)
+
+// RoomStatistics 单个房间的占用统计
+type RoomStatistics struct {
+	RoomID   string
+	RoomName string
+	LabName  string // 楼层，如 主馆图书馆一楼
+	Stat     SeatStatistics
+}
+
+// FloorStatistics 按楼层汇总的占用统计
+type FloorStatistics struct {
+	LabName string
+	Stat    SeatStatistics
+}
+
+// HeatmapCell 某个星期几某个小时的平均占用率
+type HeatmapCell struct {
+	Weekday   int // 1-7 对应周一到周日
+	Hour      int
+	UsageRate float64
+	Samples   int64
+}
+
+// HeatmapFilter RoomID 和 LabName 都为空时统计整个图书馆
+type HeatmapFilter struct {
+	RoomID  string
+	LabName string
+	Since   time.Time
+}
+
+type OccupancyRepo interface {
+	// SaveSnapshots 保存一次刷新座位缓存时的占用快照
+	SaveSnapshots(ctx context.Context, stats []*RoomStatistics, at time.Time) error
+	GetHeatmap(ctx context.Context, filter HeatmapFilter) ([]*HeatmapCell, error)
+}
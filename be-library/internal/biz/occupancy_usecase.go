@@ -0,0 +1,152 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"github.com/asynccnu/ccnubox-be/be-library/internal/errcode"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	defaultHeatmapWeeks = 4
+	maxHeatmapWeeks     = 26
+)
+
+type OccupancyUsecase struct {
+	seatRepo SeatRepo
+	repo     OccupancyRepo
+	rooms    *RoomCatalog
+
+	log *log.Helper
+}
+
+func NewOccupancyUsecase(seatRepo SeatRepo, repo OccupancyRepo, logger log.Logger, rooms *RoomCatalog) *OccupancyUsecase {
+	return &OccupancyUsecase{
+		seatRepo: seatRepo,
+		repo:     repo,
+		rooms:    rooms,
+		log:      log.NewHelper(logger),
+	}
+}
+
+// GetOccupancy 根据座位缓存统计房间和楼层当前的占用情况，roomIDs 为空时统计房间目录中全部可预约的房间
+func (u *OccupancyUsecase) GetOccupancy(ctx context.Context, stuID string, roomIDs []string) ([]*RoomStatistics, []*FloorStatistics, error) {
+	roomIDs = u.rooms.ResolveRoomIDs(ctx, roomIDs)
+
+	rooms, err := u.seatRepo.GetSeatInfos(ctx, stuID, roomIDs)
+	if err != nil {
+		u.log.Errorf("get seats for occupancy(stu_id:%v) failed: %v", stuID, err)
+		return nil, nil, err
+	}
+
+	now := time.Now()
+	roomStats := make([]*RoomStatistics, 0, len(rooms))
+	for _, roomID := range roomIDs {
+		seats, ok := rooms[roomID]
+		if !ok || len(seats) == 0 {
+			continue
+		}
+		roomStats = append(roomStats, ComputeRoomStatistics(roomID, seats, now))
+	}
+	return roomStats, AggregateFloors(roomStats), nil
+}
+
+// GetLeastBusyRoom 返回当前占用率最低且还有空座的房间
+func (u *OccupancyUsecase) GetLeastBusyRoom(ctx context.Context, stuID string, roomIDs []string) (*RoomStatistics, error) {
+	rooms, _, err := u.GetOccupancy(ctx, stuID, roomIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	var best *RoomStatistics
+	for _, room := range rooms {
+		if room.Stat.Available+room.Stat.Partial == 0 {
+			continue
+		}
+		if best == nil ||
+			room.Stat.UsageRate < best.Stat.UsageRate ||
+			(room.Stat.UsageRate == best.Stat.UsageRate && room.Stat.Available > best.Stat.Available) {
+			best = room
+		}
+	}
+	if best == nil {
+		return nil, errcode.ErrNoAvailableSeat
+	}
+	return best, nil
+}
+
+// GetHeatmap 按星期和小时统计最近 weeks 周的平均占用率
+func (u *OccupancyUsecase) GetHeatmap(ctx context.Context, roomID, labName string, weeks int) ([]*HeatmapCell, error) {
+	if weeks <= 0 {
+		weeks = defaultHeatmapWeeks
+	}
+	if weeks > maxHeatmapWeeks {
+		weeks = maxHeatmapWeeks
+	}
+
+	cells, err := u.repo.GetHeatmap(ctx, HeatmapFilter{
+		RoomID:  roomID,
+		LabName: labName,
+		Since:   time.Now().AddDate(0, 0, -7*weeks),
+	})
+	if err != nil {
+		u.log.Errorf("get occupancy heatmap(room_id:%v lab_name:%v) failed: %v", roomID, labName, err)
+		return nil, err
+	}
+	return cells, nil
+}
+
+// ComputeRoomStatistics 统计房间在 now 时刻的占用情况
+// 当前被占用为 Busy；当前空闲但之后还有预约为 Partial；其余为 Available
+func ComputeRoomStatistics(roomID string, seats []*Seat, now time.Time) *RoomStatistics {
+	room := &RoomStatistics{RoomID: roomID}
+	if len(seats) > 0 {
+		room.RoomName = seats[0].RoomName
+		room.LabName = seats[0].LabName
+	}
+
+	current := now.Hour()*100 + now.Minute()
+	for _, seat := range seats {
+		room.Stat.Total++
+		switch {
+		case !seatFree(seat, current, current+1):
+			room.Stat.Busy++
+		case !seatFree(seat, current, 2400):
+			room.Stat.Partial++
+		default:
+			room.Stat.Available++
+		}
+	}
+	room.Stat.UsageRate = usageRate(room.Stat)
+	return room
+}
+
+// AggregateFloors 按楼层汇总房间统计，保持楼层第一次出现的顺序
+func AggregateFloors(rooms []*RoomStatistics) []*FloorStatistics {
+	index := make(map[string]*FloorStatistics)
+	var floors []*FloorStatistics
+	for _, room := range rooms {
+		floor, ok := index[room.LabName]
+		if !ok {
+			floor = &FloorStatistics{LabName: room.LabName}
+			index[room.LabName] = floor
+			floors = append(floors, floor)
+		}
+		floor.Stat.Total += room.Stat.Total
+		floor.Stat.Available += room.Stat.Available
+		floor.Stat.Partial += room.Stat.Partial
+		floor.Stat.Busy += room.Stat.Busy
+	}
+	for _, floor := range floors {
+		floor.Stat.UsageRate = usageRate(floor.Stat)
+	}
+	return floors
+}
+
+func usageRate(s SeatStatistics) float64 {
+	if s.Total == 0 {
+		return 0
+	}
+	return float64(s.Busy) / float64(s.Total)
+}
@@ -0,0 +1,71 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/asynccnu/ccnubox-be/be-library/internal/errcode"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestComputeRoomStatistics(t *testing.T) {
+	now := time.Date(2025, 9, 2, 10, 30, 0, 0, time.Local)
+	seats := []*Seat{
+		{RoomName: "一楼综合学习室", LabName: "主馆图书馆一楼", Ts: busy("10:00", "12:00")},
+		{Ts: busy("14:00", "16:00")},
+		{Ts: busy("08:00", "10:00")},
+		{},
+	}
+
+	room := ComputeRoomStatistics("r1", seats, now)
+	want := SeatStatistics{Total: 4, Available: 2, Partial: 1, Busy: 1, UsageRate: 0.25}
+	if room.Stat != want {
+		t.Fatalf("unexpected statistics: got %+v, want %+v", room.Stat, want)
+	}
+	if room.RoomName != "一楼综合学习室" || room.LabName != "主馆图书馆一楼" {
+		t.Fatalf("unexpected room names: %+v", room)
+	}
+}
+
+func TestAggregateFloors(t *testing.T) {
+	rooms := []*RoomStatistics{
+		{RoomID: "a", LabName: "一楼", Stat: SeatStatistics{Total: 10, Available: 5, Busy: 5}},
+		{RoomID: "b", LabName: "二楼", Stat: SeatStatistics{Total: 10, Available: 10}},
+		{RoomID: "c", LabName: "一楼", Stat: SeatStatistics{Total: 30, Partial: 10, Busy: 20}},
+	}
+
+	floors := AggregateFloors(rooms)
+	if len(floors) != 2 || floors[0].LabName != "一楼" || floors[1].LabName != "二楼" {
+		t.Fatalf("unexpected floors: %+v", floors)
+	}
+	want := SeatStatistics{Total: 40, Available: 5, Partial: 10, Busy: 25, UsageRate: 0.625}
+	if floors[0].Stat != want {
+		t.Fatalf("unexpected floor statistics: got %+v, want %+v", floors[0].Stat, want)
+	}
+}
+
+func TestGetLeastBusyRoom(t *testing.T) {
+	allDay := busy("00:00", "23:59")
+	seatRepo := &fakeSeatRepo{rooms: map[string][]*Seat{
+		"full":  {{Ts: allDay}, {Ts: allDay}},
+		"half":  {{Ts: allDay}, {}},
+		"quiet": {{Ts: allDay}, {}, {}, {}},
+	}}
+	uc := NewOccupancyUsecase(seatRepo, nil, log.NewStdLogger(os.Stdout), nil)
+
+	room, err := uc.GetLeastBusyRoom(context.Background(), "stu", []string{"full", "half", "quiet"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if room.RoomID != "quiet" {
+		t.Fatalf("expected quiet room, got %+v", room)
+	}
+
+	_, err = uc.GetLeastBusyRoom(context.Background(), "stu", []string{"full"})
+	if !errors.Is(err, errcode.ErrNoAvailableSeat) {
+		t.Fatalf("expected ErrNoAvailableSeat, got %v", err)
+	}
+}
@@ -10,6 +10,7 @@ type FutureRecords struct {
 	TimeDesc string
 	States   string
 	DevName  string
+	DevID    string
 	RoomID   string
 	RoomName string
 	LabName  string
@@ -18,6 +19,7 @@ type FutureRecords struct {
 type HistoryRecords struct {
 	Place      string
 	Floor      string
+	Room       string
 	Status     string
 	Date       string
 	SubmitTime string
@@ -26,6 +28,8 @@ type HistoryRecords struct {
 type RecordRepo interface {
 	UpsertFutureRecords(ctx context.Context, stuID string, list []*FutureRecords) error
 	ListFutureRecords(ctx context.Context, stuID string) ([]*FutureRecords, error)
+	// ListActiveStudents 返回在 [from, to] 内有预约的学生，时间格式为 2006-01-02 15:04
+	ListActiveStudents(ctx context.Context, from, to string) ([]string, error)
 	UpsertHistoryRecords(ctx context.Context, stuID string, list []*HistoryRecords) error
 	ListHistoryRecords(ctx context.Context, stuID string) ([]*HistoryRecords, error)
 }
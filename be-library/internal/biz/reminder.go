@@ -0,0 +1,231 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/asynccnu/ccnubox-be/be-library/internal/conf"
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const (
+	reminderLockKey = "lib:remind:lock"
+	// 提醒标记的保留时间，覆盖一次预约的全部时段
+	reminderMarkTTL = 24 * time.Hour
+	// 同时拉取预约记录的学生数，一次扫描需要在间隔内完成
+	reminderConcurrency = 8
+)
+
+// ReminderConfig 预约提醒的时机
+type ReminderConfig struct {
+	Interval     time.Duration // 扫描预约记录的间隔
+	StartLead    time.Duration // 预约开始前多久提醒
+	CheckInGrace time.Duration // 预约开始后多久内需要签到
+	CheckInLead  time.Duration // 签到截止前多久提醒
+	ExpireLead   time.Duration // 预约结束前多久提醒
+}
+
+func NewReminderConfig(c *conf.Reminder) ReminderConfig {
+	cfg := ReminderConfig{
+		Interval:     time.Minute,
+		StartLead:    15 * time.Minute,
+		CheckInGrace: 30 * time.Minute,
+		CheckInLead:  10 * time.Minute,
+		ExpireLead:   10 * time.Minute,
+	}
+	if c == nil {
+		return cfg
+	}
+	// durationpb 的 AsDuration 对 nil 返回 0
+	for dst, d := range map[*time.Duration]*durationpb.Duration{
+		&cfg.Interval:     c.Interval,
+		&cfg.StartLead:    c.StartLead,
+		&cfg.CheckInGrace: c.CheckInGrace,
+		&cfg.CheckInLead:  c.CheckInLead,
+		&cfg.ExpireLead:   c.ExpireLead,
+	} {
+		if v := d.AsDuration(); v > 0 {
+			*dst = v
+		}
+	}
+	return cfg
+}
+
+// Reminder 跟踪学生的预约记录，在开始前、签到截止前和结束前推送提醒，避免因忘记签到被扣信用分
+type Reminder struct {
+	recordRepo RecordRepo
+	crawler    LibraryCrawler
+	repo       ReminderRepo
+	locker     Locker
+	notifier   FeedNotifier
+	cfg        ReminderConfig
+
+	log *log.Helper
+}
+
+func NewReminder(recordRepo RecordRepo, crawler LibraryCrawler, repo ReminderRepo, locker Locker, notifier FeedNotifier, c *conf.Reminder, logger log.Logger) *Reminder {
+	return &Reminder{
+		recordRepo: recordRepo,
+		crawler:    crawler,
+		repo:       repo,
+		locker:     locker,
+		notifier:   notifier,
+		cfg:        NewReminderConfig(c),
+		log:        log.NewHelper(logger),
+	}
+}
+
+func (r *Reminder) Config() ReminderConfig {
+	return r.cfg
+}
+
+// RunReminders 扫描即将开始或正在进行的预约，重新拉取学生的预约状态后发送到期的提醒
+func (r *Reminder) RunReminders(ctx context.Context, now time.Time) {
+	unlock, ok, err := r.locker.TryLock(ctx, reminderLockKey, r.cfg.Interval)
+	if err != nil {
+		r.log.Errorf("lock reminder failed: %v", err)
+		return
+	}
+	if !ok {
+		// 其他实例正在扫描
+		return
+	}
+	defer unlock()
+
+	from := now.Format("2006-01-02 15:04")
+	to := now.Add(r.cfg.StartLead).Format("2006-01-02 15:04")
+	stuIDs, err := r.recordRepo.ListActiveStudents(ctx, from, to)
+	if err != nil {
+		r.log.Errorf("list active students(%s ~ %s) failed: %v", from, to, err)
+		return
+	}
+
+	var (
+		wg      sync.WaitGroup
+		sem     = make(chan struct{}, reminderConcurrency)
+		skipped int
+	)
+scan:
+	for i, stuID := range stuIDs {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			skipped = len(stuIDs) - i
+			break scan
+		}
+		wg.Add(1)
+		go func(stuID string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			r.remindStudent(ctx, stuID, now)
+		}(stuID)
+	}
+	wg.Wait()
+	if skipped > 0 {
+		r.log.Warnf("reminder scan timed out, %d of %d students skipped", skipped, len(stuIDs))
+	}
+}
+
+func (r *Reminder) remindStudent(ctx context.Context, stuID string, now time.Time) {
+	// 库里的状态可能已经过时（比如已经签到），以图书馆返回的为准
+	records, err := r.crawler.GetRecord(ctx, stuID)
+	if err != nil {
+		r.log.Warnf("get records for reminder(stu_id:%v) failed: %v", stuID, err)
+		return
+	}
+	if err = r.recordRepo.UpsertFutureRecords(ctx, stuID, records); err != nil {
+		r.log.Warnf("persist future records(stu_id:%v) failed: %v", stuID, err)
+	}
+
+	for _, record := range records {
+		for _, kind := range dueReminders(record, now, r.cfg) {
+			key := record.ID
+			if key == "" {
+				key = record.Start
+			}
+			first, err := r.repo.MarkReminded(ctx, stuID, key, kind, reminderMarkTTL)
+			if err != nil {
+				r.log.Warnf("mark reminder(stu_id:%v record:%v kind:%v) failed: %v", stuID, key, kind, err)
+				continue
+			}
+			if !first {
+				continue
+			}
+			title, content := reminderMessage(record, kind, r.cfg)
+			if err = r.notifier.Notify(ctx, stuID, title, content); err != nil {
+				r.log.Warnf("notify reminder(stu_id:%v kind:%v) failed: %v", stuID, kind, err)
+			}
+		}
+	}
+}
+
+// dueReminders 返回 now 时刻该预约需要发送的提醒
+// 未签到的预约提醒开始和签到截止，已签到的预约提醒结束
+func dueReminders(record *FutureRecords, now time.Time, cfg ReminderConfig) []ReminderKind {
+	start, err1 := parseRecordTime(record.Start)
+	end, err2 := parseRecordTime(record.End)
+	if err1 != nil || err2 != nil || !now.Before(end) || strings.Contains(record.States, "取消") {
+		return nil
+	}
+
+	within := func(at, deadline time.Time) bool {
+		return !now.Before(at) && now.Before(deadline)
+	}
+
+	if recordCheckedIn(record.States) {
+		if within(end.Add(-cfg.ExpireLead), end) {
+			return []ReminderKind{ReminderExpire}
+		}
+		return nil
+	}
+
+	var out []ReminderKind
+	if within(start.Add(-cfg.StartLead), start) {
+		out = append(out, ReminderStart)
+	}
+	deadline := start.Add(cfg.CheckInGrace)
+	if within(deadline.Add(-cfg.CheckInLead), deadline) {
+		out = append(out, ReminderCheckIn)
+	}
+	return out
+}
+
+func reminderMessage(record *FutureRecords, kind ReminderKind, cfg ReminderConfig) (string, string) {
+	place := strings.TrimSpace(record.RoomName + " " + record.DevName)
+	start, _ := parseRecordTime(record.Start)
+	end, _ := parseRecordTime(record.End)
+
+	switch kind {
+	case ReminderStart:
+		return "图书馆预约即将开始",
+			fmt.Sprintf("%s 的预约将于 %s 开始，请在 %s 前签到", place, start.Format("15:04"), start.Add(cfg.CheckInGrace).Format("15:04"))
+	case ReminderCheckIn:
+		return "图书馆预约即将违约",
+			fmt.Sprintf("%s 的预约还未签到，请在 %s 前签到，逾期将扣除信用分", place, start.Add(cfg.CheckInGrace).Format("15:04"))
+	default:
+		return "图书馆预约即将结束",
+			fmt.Sprintf("%s 的预约将于 %s 结束，请及时签退", place, end.Format("15:04"))
+	}
+}
+
+// recordCheckedIn 根据预约状态判断是否已经签到，暂离也算作已签到
+func recordCheckedIn(states string) bool {
+	for _, s := range []string{"已签到", "使用中", "暂离"} {
+		if strings.Contains(states, s) {
+			return true
+		}
+	}
+	return false
+}
+
+func parseRecordTime(s string) (time.Time, error) {
+	t, err := time.ParseInLocation("2006-01-02 15:04", s, time.Local)
+	if err == nil {
+		return t, nil
+	}
+	return time.ParseInLocation("2006-01-02 15:04:05", s, time.Local)
+}
@@ -0,0 +1,21 @@
+package biz
+
+import (
+	"context"
+	"time"
+)
+
+// ReminderKind 预约提醒的类型
+type ReminderKind string
+
+const (
+	ReminderStart   ReminderKind = "start"   // 预约即将开始
+	ReminderCheckIn ReminderKind = "checkin" // 签到即将截止
+	ReminderExpire  ReminderKind = "expire"  // 预约即将结束
+)
+
+type ReminderRepo interface {
+	// MarkReminded 标记某条预约的某类提醒已发送，返回是否为首次标记
+	// 多实例部署时保证每条提醒只发送一次
+	MarkReminded(ctx context.Context, stuID, recordKey string, kind ReminderKind, ttl time.Duration) (bool, error)
+}
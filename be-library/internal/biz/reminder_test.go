@@ -0,0 +1,144 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type fakeRecordRepo struct {
+	RecordRepo
+	active []string
+}
+
+func (r *fakeRecordRepo) ListActiveStudents(context.Context, string, string) ([]string, error) {
+	return r.active, nil
+}
+
+func (r *fakeRecordRepo) UpsertFutureRecords(context.Context, string, []*FutureRecords) error {
+	return nil
+}
+
+type fakeReminderRepo struct {
+	mu     sync.Mutex
+	marked map[string]bool
+}
+
+func (r *fakeReminderRepo) MarkReminded(_ context.Context, stuID, recordKey string, kind ReminderKind, _ time.Duration) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key := stuID + ":" + recordKey + ":" + string(kind)
+	if r.marked[key] {
+		return false, nil
+	}
+	r.marked[key] = true
+	return true, nil
+}
+
+// fakeRecordCrawler 只实现获取预约记录
+type fakeRecordCrawler struct {
+	LibraryCrawler
+	records map[string][]*FutureRecords
+	delay   time.Duration
+}
+
+func (c *fakeRecordCrawler) GetRecord(_ context.Context, stuID string) ([]*FutureRecords, error) {
+	time.Sleep(c.delay)
+	return c.records[stuID], nil
+}
+
+func TestDueReminders(t *testing.T) {
+	cfg := NewReminderConfig(nil)
+	record := &FutureRecords{Start: "2025-09-02 08:00", End: "2025-09-02 12:00", States: "预约成功"}
+	at := func(hhmm string) time.Time {
+		now, _ := time.ParseInLocation("2006-01-02 15:04", "2025-09-02 "+hhmm, time.Local)
+		return now
+	}
+
+	cases := []struct {
+		now    string
+		states string
+		want   []ReminderKind
+	}{
+		{"07:40", "预约成功", nil},
+		{"07:50", "预约成功", []ReminderKind{ReminderStart}},
+		{"08:10", "预约成功", nil},
+		{"08:25", "预约成功", []ReminderKind{ReminderCheckIn}},
+		{"08:25", "预约成功,已签到", nil},
+		{"08:35", "预约成功", nil},
+		{"11:55", "预约成功,已签到", []ReminderKind{ReminderExpire}},
+		{"11:55", "暂离", []ReminderKind{ReminderExpire}},
+		{"12:00", "预约成功,已签到", nil},
+		{"07:50", "已取消", nil},
+	}
+	for _, c := range cases {
+		record.States = c.states
+		got := dueReminders(record, at(c.now), cfg)
+		if len(got) != len(c.want) {
+			t.Fatalf("at %s with %q: got %v, want %v", c.now, c.states, got, c.want)
+		}
+		for i := range got {
+			if got[i] != c.want[i] {
+				t.Fatalf("at %s with %q: got %v, want %v", c.now, c.states, got, c.want)
+			}
+		}
+	}
+}
+
+func TestRunReminders(t *testing.T) {
+	now := time.Date(2025, 9, 2, 7, 50, 0, 0, time.Local)
+	crawler := &fakeRecordCrawler{records: map[string][]*FutureRecords{
+		"stu1": {{ID: "1", Start: "2025-09-02 08:00", End: "2025-09-02 12:00", States: "预约成功", RoomName: "一楼综合学习室", DevName: "N1001"}},
+		"stu2": {{ID: "2", Start: "2025-09-02 10:00", End: "2025-09-02 12:00", States: "预约成功"}},
+	}}
+	notifier := &fakeNotifier{}
+	locker := &fakeLocker{held: map[string]bool{}}
+	reminder := NewReminder(&fakeRecordRepo{active: []string{"stu1", "stu2"}}, crawler,
+		&fakeReminderRepo{marked: map[string]bool{}}, locker, notifier, nil, log.NewStdLogger(os.Stdout))
+
+	reminder.RunReminders(context.Background(), now)
+	if len(notifier.titles) != 1 || notifier.titles[0] != "图书馆预约即将开始" {
+		t.Fatalf("unexpected notifications: %v", notifier.titles)
+	}
+
+	// 同一条提醒只发送一次
+	reminder.RunReminders(context.Background(), now.Add(time.Minute))
+	if len(notifier.titles) != 1 {
+		t.Fatalf("reminder sent twice: %v", notifier.titles)
+	}
+
+	// 其他实例正在扫描时跳过
+	locker.held[reminderLockKey] = true
+	reminder.RunReminders(context.Background(), now.Add(35*time.Minute))
+	if len(notifier.titles) != 1 {
+		t.Fatalf("reminder should not run while locked: %v", notifier.titles)
+	}
+}
+
+// 每个学生拉取记录都很慢时，一次扫描仍然要在间隔内提醒到所有学生
+func TestRunReminders_ManyStudents(t *testing.T) {
+	now := time.Date(2025, 9, 2, 7, 50, 0, 0, time.Local)
+	crawler := &fakeRecordCrawler{records: map[string][]*FutureRecords{}, delay: 30 * time.Millisecond}
+	var stuIDs []string
+	for i := 0; i < 40; i++ {
+		stuID := fmt.Sprintf("stu%d", i)
+		stuIDs = append(stuIDs, stuID)
+		crawler.records[stuID] = []*FutureRecords{{ID: stuID, Start: "2025-09-02 08:00", End: "2025-09-02 12:00", States: "预约成功"}}
+	}
+	notifier := &fakeNotifier{}
+	reminder := NewReminder(&fakeRecordRepo{active: stuIDs}, crawler,
+		&fakeReminderRepo{marked: map[string]bool{}}, &fakeLocker{held: map[string]bool{}}, notifier, nil, log.NewStdLogger(os.Stdout))
+
+	// 逐个拉取需要 1.2s
+	ctx, cancel := context.WithTimeout(context.Background(), 600*time.Millisecond)
+	defer cancel()
+	reminder.RunReminders(ctx, now)
+	if len(notifier.titles) != len(stuIDs) {
+		t.Fatalf("expected %d reminders, got %d", len(stuIDs), len(notifier.titles))
+	}
+}
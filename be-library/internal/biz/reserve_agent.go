@@ -0,0 +1,325 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/asynccnu/ccnubox-be/be-library/internal/conf"
+	"github.com/asynccnu/ccnubox-be/be-library/internal/errcode"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	reserveLockKeyFmt = "lib:reserve:lock:%s"
+	// 锁的过期时间需要覆盖一次完整的预约尝试
+	reserveLockTTL = 2 * time.Minute
+	// 每轮对单个意向最多尝试的座位数
+	maxIntentAttempts = 5
+	// 推送消息的类型，与 be-feed 的推送配置对应
+	FeedTypeLibrary = "library"
+)
+
+// ReserveAgentConfig 自动预约的执行时机
+type ReserveAgentConfig struct {
+	OpenTime      string // 每天开放预约的时刻 HH:MM
+	DaysAhead     int    // 开放预约的日期距今天的天数
+	Rounds        int    // 开放时刻后的尝试轮数
+	RoundInterval time.Duration
+}
+
+func NewReserveAgentConfig(c *conf.Reserve) ReserveAgentConfig {
+	cfg := ReserveAgentConfig{
+		OpenTime:      "18:00",
+		DaysAhead:     1,
+		Rounds:        3,
+		RoundInterval: 10 * time.Second,
+	}
+	if c == nil {
+		return cfg
+	}
+	if _, err := time.Parse("15:04", c.OpenTime); err == nil {
+		cfg.OpenTime = c.OpenTime
+	}
+	if c.DaysAhead >= 0 {
+		cfg.DaysAhead = int(c.DaysAhead)
+	}
+	if c.Rounds > 0 {
+		cfg.Rounds = int(c.Rounds)
+	}
+	if c.RoundInterval != nil && c.RoundInterval.AsDuration() > 0 {
+		cfg.RoundInterval = c.RoundInterval.AsDuration()
+	}
+	return cfg
+}
+
+// ReserveAgent 管理学生的预约意向，并在开放预约时按优先级自动预约
+type ReserveAgent struct {
+	repo     ReserveIntentRepo
+	seatRepo SeatRepo
+	crawler  LibraryCrawler
+	locker   Locker
+	notifier FeedNotifier
+	cfg      ReserveAgentConfig
+	rooms    *RoomCatalog
+
+	log *log.Helper
+}
+
+func NewReserveAgent(repo ReserveIntentRepo, seatRepo SeatRepo, crawler LibraryCrawler, locker Locker, notifier FeedNotifier, c *conf.Reserve, logger log.Logger, rooms *RoomCatalog) *ReserveAgent {
+	return &ReserveAgent{
+		repo:     repo,
+		seatRepo: seatRepo,
+		crawler:  crawler,
+		locker:   locker,
+		notifier: notifier,
+		cfg:      NewReserveAgentConfig(c),
+		rooms:    rooms,
+		log:      log.NewHelper(logger),
+	}
+}
+
+func (a *ReserveAgent) Config() ReserveAgentConfig {
+	return a.cfg
+}
+
+func (a *ReserveAgent) SaveIntent(ctx context.Context, intent *ReserveIntent) (*ReserveIntent, error) {
+	if err := validateIntent(intent); err != nil {
+		return nil, err
+	}
+	if err := a.repo.SaveIntent(ctx, intent); err != nil {
+		a.log.Errorf("save reserve intent(stu_id:%v id:%v) failed: %v", intent.StuID, intent.ID, err)
+		return nil, err
+	}
+	return intent, nil
+}
+
+func (a *ReserveAgent) DeleteIntent(ctx context.Context, stuID string, id uint64) error {
+	deleted, err := a.repo.DeleteIntent(ctx, stuID, id)
+	if err != nil {
+		a.log.Errorf("delete reserve intent(stu_id:%v id:%v) failed: %v", stuID, id, err)
+		return err
+	}
+	if !deleted {
+		return errcode.ErrIntentNotFound
+	}
+	return nil
+}
+
+func (a *ReserveAgent) ListIntents(ctx context.Context, stuID string) ([]*ReserveIntent, error) {
+	intents, err := a.repo.ListIntents(ctx, stuID)
+	if err != nil {
+		a.log.Errorf("list reserve intents(stu_id:%v) failed: %v", stuID, err)
+		return nil, err
+	}
+	return intents, nil
+}
+
+// RunDueIntents 在开放预约的时刻执行，为目标日期星期匹配的意向预约座位
+// 未成功的意向在间隔 RoundInterval 后重试，共 Rounds 轮
+func (a *ReserveAgent) RunDueIntents(ctx context.Context, now time.Time) {
+	date := now.AddDate(0, 0, a.cfg.DaysAhead)
+
+	intents, err := a.repo.ListEnabledIntents(ctx)
+	if err != nil {
+		a.log.Errorf("list enabled reserve intents failed: %v", err)
+		return
+	}
+	pending := dueIntents(intents, date)
+	a.log.Infof("run reserve intents for %s: %d due", date.Format("2006-01-02"), len(pending))
+
+	for round := 0; round < a.cfg.Rounds && len(pending) > 0; round++ {
+		if round > 0 {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(a.cfg.RoundInterval):
+			}
+		}
+
+		lastRound := round == a.cfg.Rounds-1
+		next := pending[:0]
+		for _, intent := range pending {
+			if !a.runIntent(ctx, intent, date, lastRound) {
+				next = append(next, intent)
+			}
+		}
+		pending = next
+	}
+}
+
+// runIntent 执行一次预约尝试，返回该意向今天是否已经处理完毕
+func (a *ReserveAgent) runIntent(ctx context.Context, intent *ReserveIntent, date time.Time, lastRound bool) bool {
+	unlock, ok, err := a.locker.TryLock(ctx, fmt.Sprintf(reserveLockKeyFmt, intent.StuID), reserveLockTTL)
+	if err != nil {
+		a.log.Errorf("lock reserve intent(stu_id:%v) failed: %v", intent.StuID, err)
+		return lastRound
+	}
+	if !ok {
+		// 其他实例正在为该学生预约，下一轮再看
+		return lastRound
+	}
+	defer unlock()
+
+	dateStr := date.Format("2006-01-02")
+
+	// 拿到锁后重新读取，其他实例可能已经处理完了
+	latest, err := a.repo.GetIntent(ctx, intent.ID)
+	if err != nil {
+		a.log.Errorf("get reserve intent(id:%v) failed: %v", intent.ID, err)
+		return lastRound
+	}
+	if latest == nil || !latest.Enabled || latest.LastRunDate == dateStr {
+		return true
+	}
+
+	start := dateStr + " " + latest.Start
+	end := dateStr + " " + latest.End
+
+	var lastErr error
+	for i, seat := range a.candidateSeats(ctx, latest, date) {
+		if i >= maxIntentAttempts {
+			break
+		}
+		msg, err := a.crawler.ReserveSeat(ctx, latest.StuID, seat.DevID, start, end)
+		if err != nil {
+			a.log.Warnf("auto reserve(stu_id:%v seat_id:%v) failed: %v", latest.StuID, seat.DevID, err)
+			lastErr = err
+			continue
+		}
+
+		seatName := seat.DevName
+		if seatName == "" {
+			seatName = seat.DevID
+		}
+		a.finish(ctx, latest, dateStr, msg, seatName)
+		a.notify(ctx, latest.StuID, "图书馆自动预约成功",
+			fmt.Sprintf("已为你预约 %s %s-%s 的座位 %s %s", dateStr, latest.Start, latest.End, seat.RoomName, seatName))
+		return true
+	}
+
+	if !lastRound {
+		return false
+	}
+
+	reason := "没有空闲的座位"
+	if lastErr != nil {
+		reason = lastErr.Error()
+	}
+	a.finish(ctx, latest, dateStr, "failed: "+reason, "")
+	a.notify(ctx, latest.StuID, "图书馆自动预约失败",
+		fmt.Sprintf("%s %s-%s 的自动预约未成功：%s", dateStr, latest.Start, latest.End, reason))
+	return true
+}
+
+func (a *ReserveAgent) finish(ctx context.Context, intent *ReserveIntent, date, result, seat string) {
+	if err := a.repo.MarkIntentRun(ctx, intent.ID, date, result, seat); err != nil {
+		a.log.Errorf("mark reserve intent(id:%v) run failed: %v", intent.ID, err)
+	}
+}
+
+func (a *ReserveAgent) notify(ctx context.Context, stuID, title, content string) {
+	if err := a.notifier.Notify(ctx, stuID, title, content); err != nil {
+		a.log.Warnf("notify reserve result(stu_id:%v) failed: %v", stuID, err)
+	}
+}
+
+// candidateSeats 按优先级排列候选座位：先是指定的座位，再是优先房间内的其他座位
+// 座位缓存只有当天的占用情况，目标日期为当天时才按占用过滤
+func (a *ReserveAgent) candidateSeats(ctx context.Context, intent *ReserveIntent, date time.Time) []*Seat {
+	roomIDs := a.rooms.ResolveRoomIDs(ctx, intent.RoomIDs)
+
+	rooms, err := a.seatRepo.GetSeatInfos(ctx, intent.StuID, roomIDs)
+	if err != nil {
+		// 拿不到座位列表时仍然可以直接尝试指定的座位
+		a.log.Warnf("get seats for reserve intent(stu_id:%v) failed: %v", intent.StuID, err)
+		rooms = nil
+	}
+
+	filter := func(*Seat) bool { return true }
+	if dateStr := date.Format("2006-01-02"); dateStr == time.Now().Format("2006-01-02") {
+		qStart, qEnd, err := parseQueryWindow(dateStr+" "+intent.Start, dateStr+" "+intent.End, date)
+		if err == nil {
+			filter = func(s *Seat) bool { return seatFree(s, qStart, qEnd) }
+		}
+	}
+
+	seen := make(map[string]struct{})
+	var out []*Seat
+	for _, id := range intent.SeatIDs {
+		var seat *Seat
+		for _, seats := range rooms {
+			if seat = findSeat(seats, id); seat != nil {
+				break
+			}
+		}
+		if seat == nil {
+			seat = &Seat{DevID: id}
+		} else if !filter(seat) {
+			continue
+		}
+		seen[id] = struct{}{}
+		out = append(out, seat)
+	}
+
+	for _, roomID := range roomIDs {
+		seats := append([]*Seat(nil), rooms[roomID]...)
+		sort.Slice(seats, func(i, j int) bool { return seats[i].DevName < seats[j].DevName })
+		for _, seat := range seats {
+			if _, ok := seen[seat.DevID]; ok || !filter(seat) {
+				continue
+			}
+			seen[seat.DevID] = struct{}{}
+			out = append(out, seat)
+		}
+	}
+	return out
+}
+
+// dueIntents 过滤出在 date 当天需要执行且还未执行过的意向
+func dueIntents(intents []*ReserveIntent, date time.Time) []*ReserveIntent {
+	weekday := isoWeekday(date)
+	dateStr := date.Format("2006-01-02")
+	var out []*ReserveIntent
+	for _, intent := range intents {
+		if !intent.Enabled || intent.LastRunDate == dateStr {
+			continue
+		}
+		for _, w := range intent.Weekdays {
+			if w == weekday {
+				out = append(out, intent)
+				break
+			}
+		}
+	}
+	return out
+}
+
+// isoWeekday 周一为 1，周日为 7
+func isoWeekday(t time.Time) int {
+	if t.Weekday() == time.Sunday {
+		return 7
+	}
+	return int(t.Weekday())
+}
+
+func validateIntent(intent *ReserveIntent) error {
+	if intent == nil || intent.StuID == "" || len(intent.Weekdays) == 0 {
+		return errcode.ErrInvalidIntent
+	}
+	for _, w := range intent.Weekdays {
+		if w < 1 || w > 7 {
+			return errcode.ErrInvalidIntent
+		}
+	}
+	start, err := time.Parse("15:04", intent.Start)
+	if err != nil {
+		return errcode.ErrInvalidIntent
+	}
+	end, err := time.Parse("15:04", intent.End)
+	if err != nil || !end.After(start) {
+		return errcode.ErrInvalidIntent
+	}
+	return nil
+}
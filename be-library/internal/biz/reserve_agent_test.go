@@ -0,0 +1,188 @@
+package biz
+
+import (
+	"context"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/asynccnu/ccnubox-be/be-library/internal/conf"
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+type fakeIntentRepo struct {
+	intents map[uint64]*ReserveIntent
+}
+
+func (r *fakeIntentRepo) SaveIntent(_ context.Context, intent *ReserveIntent) error {
+	r.intents[intent.ID] = intent
+	return nil
+}
+
+func (r *fakeIntentRepo) DeleteIntent(_ context.Context, _ string, id uint64) (bool, error) {
+	_, ok := r.intents[id]
+	delete(r.intents, id)
+	return ok, nil
+}
+
+func (r *fakeIntentRepo) GetIntent(_ context.Context, id uint64) (*ReserveIntent, error) {
+	return r.intents[id], nil
+}
+
+func (r *fakeIntentRepo) ListIntents(_ context.Context, _ string) ([]*ReserveIntent, error) {
+	return r.ListEnabledIntents(context.Background())
+}
+
+func (r *fakeIntentRepo) ListEnabledIntents(context.Context) ([]*ReserveIntent, error) {
+	var out []*ReserveIntent
+	for _, i := range r.intents {
+		out = append(out, i)
+	}
+	return out, nil
+}
+
+func (r *fakeIntentRepo) MarkIntentRun(_ context.Context, id uint64, date, result, seat string) error {
+	r.intents[id].LastRunDate = date
+	r.intents[id].LastResult = result
+	r.intents[id].LastSeat = seat
+	return nil
+}
+
+type fakeLocker struct {
+	held map[string]bool
+}
+
+func (l *fakeLocker) TryLock(_ context.Context, key string, _ time.Duration) (func(), bool, error) {
+	if l.held[key] {
+		return nil, false, nil
+	}
+	l.held[key] = true
+	return func() { delete(l.held, key) }, true, nil
+}
+
+type fakeNotifier struct {
+	mu     sync.Mutex
+	titles []string
+}
+
+func (n *fakeNotifier) Notify(_ context.Context, _, title, _ string) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.titles = append(n.titles, title)
+	return nil
+}
+
+func newTestReserveAgent(intent *ReserveIntent, crawler *fakeCrawler, locker *fakeLocker, notifier *fakeNotifier) (*ReserveAgent, *fakeIntentRepo) {
+	repo := &fakeIntentRepo{intents: map[uint64]*ReserveIntent{intent.ID: intent}}
+	seatRepo := &fakeSeatRepo{rooms: map[string][]*Seat{
+		"r1": {
+			{RoomID: "r1", DevID: "12", DevName: "N1012"},
+			{RoomID: "r1", DevID: "11", DevName: "N1011"},
+		},
+		"r2": {
+			{RoomID: "r2", DevID: "21", DevName: "N2021"},
+		},
+	}}
+	cfg := &conf.Reserve{OpenTime: "18:00", DaysAhead: 1, Rounds: 2, RoundInterval: durationpb.New(time.Millisecond)}
+	return NewReserveAgent(repo, seatRepo, crawler, locker, notifier, cfg, log.NewStdLogger(os.Stdout), nil), repo
+}
+
+func TestRunDueIntents_PriorityFallback(t *testing.T) {
+	// 2025-09-01 是周一，开放预约的是周二
+	now := time.Date(2025, 9, 1, 18, 0, 0, 0, time.Local)
+	intent := &ReserveIntent{
+		ID: 1, StuID: "stu", Weekdays: []int{2}, Start: "08:00", End: "12:00",
+		RoomIDs: []string{"r2", "r1"}, SeatIDs: []string{"11"}, Enabled: true,
+	}
+	crawler := &fakeCrawler{fail: map[string]bool{"11": true}}
+	notifier := &fakeNotifier{}
+	agent, repo := newTestReserveAgent(intent, crawler, &fakeLocker{held: map[string]bool{}}, notifier)
+
+	agent.RunDueIntents(context.Background(), now)
+
+	// 指定的座位失败后按房间优先级尝试 r2
+	if len(crawler.reserved) != 2 || crawler.reserved[0] != "11" || crawler.reserved[1] != "21" {
+		t.Fatalf("unexpected reserve order: %v", crawler.reserved)
+	}
+	got := repo.intents[1]
+	if got.LastRunDate != "2025-09-02" || got.LastSeat != "N2021" {
+		t.Fatalf("unexpected run record: %+v", got)
+	}
+	if len(notifier.titles) != 1 || notifier.titles[0] != "图书馆自动预约成功" {
+		t.Fatalf("unexpected notifications: %v", notifier.titles)
+	}
+
+	// 同一天不会重复执行
+	agent.RunDueIntents(context.Background(), now)
+	if len(crawler.reserved) != 2 {
+		t.Fatalf("intent ran twice: %v", crawler.reserved)
+	}
+}
+
+func TestRunDueIntents_WeekdayAndLock(t *testing.T) {
+	now := time.Date(2025, 9, 1, 18, 0, 0, 0, time.Local)
+	intent := &ReserveIntent{
+		ID: 1, StuID: "stu", Weekdays: []int{3}, Start: "08:00", End: "12:00", Enabled: true,
+	}
+	crawler := &fakeCrawler{}
+	locker := &fakeLocker{held: map[string]bool{}}
+	agent, _ := newTestReserveAgent(intent, crawler, locker, &fakeNotifier{})
+
+	agent.RunDueIntents(context.Background(), now)
+	if len(crawler.reserved) != 0 {
+		t.Fatalf("intent for wednesday should not run on tuesday's booking: %v", crawler.reserved)
+	}
+
+	// 其他实例持有该学生的锁时不执行
+	intent.Weekdays = []int{2}
+	locker.held["lib:reserve:lock:stu"] = true
+	agent.RunDueIntents(context.Background(), now)
+	if len(crawler.reserved) != 0 {
+		t.Fatalf("intent should not run while locked: %v", crawler.reserved)
+	}
+}
+
+func TestRunDueIntents_NotifyFailure(t *testing.T) {
+	now := time.Date(2025, 9, 1, 18, 0, 0, 0, time.Local)
+	intent := &ReserveIntent{
+		ID: 1, StuID: "stu", Weekdays: []int{2}, Start: "08:00", End: "12:00",
+		RoomIDs: []string{"r2"}, Enabled: true,
+	}
+	crawler := &fakeCrawler{fail: map[string]bool{"21": true}}
+	notifier := &fakeNotifier{}
+	agent, repo := newTestReserveAgent(intent, crawler, &fakeLocker{held: map[string]bool{}}, notifier)
+
+	agent.RunDueIntents(context.Background(), now)
+
+	// 两轮都失败，只在最后一轮通知
+	if len(crawler.reserved) != 2 {
+		t.Fatalf("expected one attempt per round, got %v", crawler.reserved)
+	}
+	if len(notifier.titles) != 1 || notifier.titles[0] != "图书馆自动预约失败" {
+		t.Fatalf("unexpected notifications: %v", notifier.titles)
+	}
+	if repo.intents[1].LastRunDate != "2025-09-02" {
+		t.Fatalf("failed run should still be recorded: %+v", repo.intents[1])
+	}
+}
+
+func TestValidateIntent(t *testing.T) {
+	valid := &ReserveIntent{StuID: "stu", Weekdays: []int{1, 5}, Start: "08:00", End: "12:00"}
+	if err := validateIntent(valid); err != nil {
+		t.Fatalf("expected valid intent, got %v", err)
+	}
+
+	invalid := []*ReserveIntent{
+		{StuID: "stu", Start: "08:00", End: "12:00"},
+		{StuID: "stu", Weekdays: []int{8}, Start: "08:00", End: "12:00"},
+		{StuID: "stu", Weekdays: []int{1}, Start: "12:00", End: "08:00"},
+		{StuID: "stu", Weekdays: []int{1}, Start: "8点", End: "12:00"},
+	}
+	for _, intent := range invalid {
+		if err := validateIntent(intent); err == nil {
+			t.Fatalf("expected %+v to be invalid", intent)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package biz
+
+import (
+	"context"
+	"time"
+)
+
+// ReserveIntent 学生登记的长期预约意向，如 "每个工作日 08:00-12:00，优先房间 X/Y，座位 A/B/C"
+// 在图书馆开放预约的时刻由后台自动执行
+type ReserveIntent struct {
+	ID       uint64
+	StuID    string
+	Weekdays []int    // 1-7 对应周一到周日
+	Start    string   // HH:MM
+	End      string   // HH:MM
+	RoomIDs  []string // 优先房间，按优先级排序
+	SeatIDs  []string // 优先座位 devID，按优先级排序
+	Enabled  bool
+
+	// 最近一次执行的情况
+	LastRunDate string // 预约的日期 2006-01-02，用于避免同一天重复执行
+	LastResult  string
+	LastSeat    string // 预约到的座位名
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type ReserveIntentRepo interface {
+	// SaveIntent ID 为 0 时新建，否则更新该学生名下的意向
+	SaveIntent(ctx context.Context, intent *ReserveIntent) error
+	// DeleteIntent 返回是否删除了记录
+	DeleteIntent(ctx context.Context, stuID string, id uint64) (bool, error)
+	GetIntent(ctx context.Context, id uint64) (*ReserveIntent, error)
+	ListIntents(ctx context.Context, stuID string) ([]*ReserveIntent, error)
+	// ListEnabledIntents 返回所有启用的意向，由调用方按星期过滤
+	ListEnabledIntents(ctx context.Context) ([]*ReserveIntent, error)
+	// MarkIntentRun 记录执行结果
+	MarkIntentRun(ctx context.Context, id uint64, date, result, seat string) error
+}
+
+// Locker 分布式锁，多实例部署时保证同一学生同一时刻只有一个预约任务在执行
+type Locker interface {
+	// TryLock 获取成功时返回释放函数
+	TryLock(ctx context.Context, key string, ttl time.Duration) (unlock func(), ok bool, err error)
+}
+
+// FeedNotifier 通过 be-feed 向学生推送消息
+type FeedNotifier interface {
+	Notify(ctx context.Context, stuID, title, content string) error
+}
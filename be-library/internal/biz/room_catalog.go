@@ -0,0 +1,275 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/asynccnu/ccnubox-be/be-library/internal/conf"
+	"github.com/asynccnu/ccnubox-be/be-library/internal/errcode"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 房间目录在内存中的缓存时间，修改后立即失效
+const roomCacheTTL = time.Minute
+
+// RoomConfig 房间目录的定期任务
+type RoomConfig struct {
+	RefreshInterval  time.Duration // 按目录刷新座位缓存的间隔，为 0 时不定期刷新
+	DiscoverInterval time.Duration // 发现新房间的间隔，为 0 时不自动发现
+	StuID            string        // 定期任务访问图书馆系统使用的学号
+	OpenTime         string        // 新房间的开放时刻
+	CloseTime        string        // 新房间的关闭时刻
+}
+
+func NewRoomConfig(c *conf.Room) RoomConfig {
+	cfg := RoomConfig{
+		OpenTime:  "08:00",
+		CloseTime: "22:00",
+	}
+	if c == nil {
+		return cfg
+	}
+	cfg.RefreshInterval = c.RefreshInterval.AsDuration()
+	cfg.DiscoverInterval = c.DiscoverInterval.AsDuration()
+	cfg.StuID = c.StuId
+	if _, err := time.Parse("15:04", c.OpenTime); err == nil {
+		cfg.OpenTime = c.OpenTime
+	}
+	if _, err := time.Parse("15:04", c.CloseTime); err == nil {
+		cfg.CloseTime = c.CloseTime
+	}
+	return cfg
+}
+
+// RoomCatalog 房间目录，替代写死的房间列表，房间可以在运行时新增或关闭
+// 为 nil 时所有方法使用初始的房间列表
+type RoomCatalog struct {
+	repo     RoomRepo
+	seatRepo SeatRepo
+	crawler  LibraryCrawler
+	cfg      RoomConfig
+
+	mu       sync.Mutex
+	cached   []*Room
+	cachedAt time.Time
+
+	log *log.Helper
+}
+
+func NewRoomCatalog(repo RoomRepo, seatRepo SeatRepo, crawler LibraryCrawler, c *conf.Room, logger log.Logger) *RoomCatalog {
+	return &RoomCatalog{
+		repo:     repo,
+		seatRepo: seatRepo,
+		crawler:  crawler,
+		cfg:      NewRoomConfig(c),
+		log:      log.NewHelper(logger),
+	}
+}
+
+func (c *RoomCatalog) Config() RoomConfig {
+	return c.cfg
+}
+
+// rooms 返回全部房间，目录为空时写入初始房间
+func (c *RoomCatalog) rooms(ctx context.Context) ([]*Room, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.cached != nil && time.Since(c.cachedAt) < roomCacheTTL {
+		return c.cached, nil
+	}
+
+	rooms, err := c.repo.ListRooms(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	if len(rooms) == 0 {
+		rooms = DefaultRooms(c.cfg.OpenTime, c.cfg.CloseTime)
+		if err = c.repo.SaveRooms(ctx, rooms); err != nil {
+			return nil, err
+		}
+		c.log.Infof("room catalogue seeded with %d default rooms", len(rooms))
+	}
+	c.cached, c.cachedAt = rooms, time.Now()
+	return rooms, nil
+}
+
+func (c *RoomCatalog) invalidate() {
+	c.mu.Lock()
+	c.cached = nil
+	c.mu.Unlock()
+}
+
+// BookableRoomIDs 可预约的房间，作为默认的房间列表；目录不可用时使用初始房间
+func (c *RoomCatalog) BookableRoomIDs(ctx context.Context) []string {
+	if c == nil {
+		return DefaultRoomIDs()
+	}
+	rooms, err := c.rooms(ctx)
+	if err != nil {
+		c.log.Warnf("load room catalogue failed, fall back to default rooms: %v", err)
+		return DefaultRoomIDs()
+	}
+	ids := make([]string, 0, len(rooms))
+	for _, r := range rooms {
+		if r.Bookable {
+			ids = append(ids, r.ID)
+		}
+	}
+	return ids
+}
+
+// ResolveRoomIDs 为空时返回全部可预约的房间，否则去掉已关闭的房间，目录中没有的房间保留
+func (c *RoomCatalog) ResolveRoomIDs(ctx context.Context, roomIDs []string) []string {
+	if len(roomIDs) == 0 {
+		return c.BookableRoomIDs(ctx)
+	}
+	if c == nil {
+		return roomIDs
+	}
+	rooms, err := c.rooms(ctx)
+	if err != nil {
+		c.log.Warnf("load room catalogue failed: %v", err)
+		return roomIDs
+	}
+	closed := make(map[string]bool)
+	for _, r := range rooms {
+		if !r.Bookable {
+			closed[r.ID] = true
+		}
+	}
+	out := make([]string, 0, len(roomIDs))
+	for _, id := range roomIDs {
+		if !closed[id] {
+			out = append(out, id)
+		}
+	}
+	return out
+}
+
+func (c *RoomCatalog) ListRooms(ctx context.Context, includeClosed bool) ([]*Room, error) {
+	rooms, err := c.rooms(ctx)
+	if err != nil {
+		c.log.Errorf("list rooms failed: %v", err)
+		return nil, err
+	}
+	if includeClosed {
+		return rooms, nil
+	}
+	out := make([]*Room, 0, len(rooms))
+	for _, r := range rooms {
+		if r.Bookable {
+			out = append(out, r)
+		}
+	}
+	return out, nil
+}
+
+// SaveRoom 新增或修改房间，分馆和楼层为空时根据楼层名推断
+func (c *RoomCatalog) SaveRoom(ctx context.Context, room *Room) (*Room, error) {
+	room.ID = strings.TrimSpace(room.ID)
+	room.Name = strings.TrimSpace(room.Name)
+	if room.ID == "" || room.Name == "" || room.SeatCount < 0 || room.Floor < 0 {
+		return nil, errcode.ErrInvalidRoom
+	}
+	if room.OpenTime == "" {
+		room.OpenTime = c.cfg.OpenTime
+	}
+	if room.CloseTime == "" {
+		room.CloseTime = c.cfg.CloseTime
+	}
+	open, err1 := minuteOfDay(room.OpenTime)
+	closeAt, err2 := minuteOfDay(room.CloseTime)
+	if err1 != nil || err2 != nil || open >= closeAt {
+		return nil, errcode.ErrInvalidRoom
+	}
+	branch, floor := parseLabName(room.LabName)
+	if room.Branch == "" {
+		room.Branch = branch
+	}
+	if room.Floor == 0 {
+		room.Floor = floor
+	}
+
+	if err := c.repo.SaveRooms(ctx, []*Room{room}); err != nil {
+		c.log.Errorf("save room(id:%v) failed: %v", room.ID, err)
+		return nil, err
+	}
+	c.invalidate()
+	return room, nil
+}
+
+func (c *RoomCatalog) DeleteRoom(ctx context.Context, id string) error {
+	deleted, err := c.repo.DeleteRoom(ctx, id)
+	if err != nil {
+		c.log.Errorf("delete room(id:%v) failed: %v", id, err)
+		return err
+	}
+	if !deleted {
+		return errcode.ErrRoomNotFound
+	}
+	c.invalidate()
+	return nil
+}
+
+// DiscoverRooms 从图书馆系统拉取房间，新增目录中没有的房间，更新已有房间的名称和座位数
+// 已有房间的开放时间和是否可预约由管理员维护，不会被覆盖
+func (c *RoomCatalog) DiscoverRooms(ctx context.Context, stuID string) ([]*Room, int, error) {
+	found, err := c.crawler.ListRooms(ctx, stuID)
+	if err != nil {
+		c.log.Errorf("discover rooms(stu_id:%v) failed: %v", stuID, err)
+		return nil, 0, err
+	}
+	existing, err := c.rooms(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	byID := make(map[string]*Room, len(existing))
+	for _, r := range existing {
+		byID[r.ID] = r
+	}
+
+	var added, changed []*Room
+	for _, f := range found {
+		old, ok := byID[f.ID]
+		if !ok {
+			room := NewDiscoveredRoom(f.ID, f.Name, f.LabName, c.cfg.OpenTime, c.cfg.CloseTime)
+			room.SeatCount = f.SeatCount
+			added = append(added, room)
+			changed = append(changed, room)
+			continue
+		}
+		if old.Name != f.Name || old.LabName != f.LabName || old.SeatCount != f.SeatCount {
+			room := *old
+			room.Name, room.LabName, room.SeatCount = f.Name, f.LabName, f.SeatCount
+			changed = append(changed, &room)
+		}
+	}
+	if len(changed) > 0 {
+		if err = c.repo.SaveRooms(ctx, changed); err != nil {
+			c.log.Errorf("save discovered rooms failed: %v", err)
+			return nil, 0, err
+		}
+		c.invalidate()
+	}
+	if len(added) > 0 {
+		c.log.Infof("discovered %d new rooms", len(added))
+	}
+	return added, len(found), nil
+}
+
+// RunDiscovery 使用配置的学号发现新房间，供定时任务调用
+func (c *RoomCatalog) RunDiscovery(ctx context.Context) {
+	if _, _, err := c.DiscoverRooms(ctx, c.cfg.StuID); err != nil {
+		c.log.Warnf("scheduled room discovery failed: %v", err)
+	}
+}
+
+// RefreshSeats 按目录刷新所有可预约房间的座位缓存，供定时任务调用
+func (c *RoomCatalog) RefreshSeats(ctx context.Context) {
+	roomIDs := c.BookableRoomIDs(ctx)
+	if err := c.seatRepo.SaveRoomSeatsInRedis(ctx, c.cfg.StuID, roomIDs); err != nil {
+		c.log.Warnf("refresh seats of %d rooms failed: %v", len(roomIDs), err)
+	}
+}
@@ -0,0 +1,157 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"os"
+	"sort"
+	"testing"
+
+	"github.com/asynccnu/ccnubox-be/be-library/internal/conf"
+	"github.com/asynccnu/ccnubox-be/be-library/internal/errcode"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type fakeRoomRepo struct {
+	rooms map[string]*Room
+	saves int
+}
+
+func (r *fakeRoomRepo) ListRooms(_ context.Context, includeClosed bool) ([]*Room, error) {
+	out := make([]*Room, 0, len(r.rooms))
+	for _, room := range r.rooms {
+		if includeClosed || room.Bookable {
+			cp := *room
+			out = append(out, &cp)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	return out, nil
+}
+
+func (r *fakeRoomRepo) GetRoom(_ context.Context, id string) (*Room, error) {
+	return r.rooms[id], nil
+}
+
+func (r *fakeRoomRepo) SaveRooms(_ context.Context, rooms []*Room) error {
+	r.saves++
+	for _, room := range rooms {
+		cp := *room
+		r.rooms[room.ID] = &cp
+	}
+	return nil
+}
+
+func (r *fakeRoomRepo) DeleteRoom(_ context.Context, id string) (bool, error) {
+	_, ok := r.rooms[id]
+	delete(r.rooms, id)
+	return ok, nil
+}
+
+type fakeRoomCrawler struct {
+	LibraryCrawler
+	rooms []*Room
+}
+
+func (c *fakeRoomCrawler) ListRooms(context.Context, string) ([]*Room, error) {
+	return c.rooms, nil
+}
+
+func newTestCatalog(repo *fakeRoomRepo, crawler LibraryCrawler, seatRepo SeatRepo) *RoomCatalog {
+	return NewRoomCatalog(repo, seatRepo, crawler, &conf.Room{StuId: "stu", OpenTime: "08:00", CloseTime: "22:00"}, log.NewStdLogger(os.Stdout))
+}
+
+func TestParseLabName(t *testing.T) {
+	cases := []struct {
+		lab    string
+		branch string
+		floor  int
+	}{
+		{"主馆图书馆一楼", "主馆", 1},
+		{"南湖分馆二楼", "南湖分馆", 2},
+		{"主馆图书馆十楼", "主馆", 10},
+		{"主馆图书馆十二楼", "主馆", 12},
+		{"自习区", "自习区", 0},
+	}
+	for _, c := range cases {
+		branch, floor := parseLabName(c.lab)
+		if branch != c.branch || floor != c.floor {
+			t.Errorf("parseLabName(%q) = (%q, %d), want (%q, %d)", c.lab, branch, floor, c.branch, c.floor)
+		}
+	}
+}
+
+func TestRoomCatalog_SeedsDefaultsAndSkipsClosedRooms(t *testing.T) {
+	repo := &fakeRoomRepo{rooms: map[string]*Room{}}
+	catalog := newTestCatalog(repo, nil, nil)
+	ctx := context.Background()
+
+	if ids := catalog.BookableRoomIDs(ctx); len(ids) != len(DefaultRoomIDs()) {
+		t.Fatalf("expected %d seeded rooms, got %d", len(DefaultRoomIDs()), len(ids))
+	}
+	if r := repo.rooms["100455820"]; r == nil || r.Branch != "主馆" || r.Floor != 1 || r.OpenTime != "08:00" {
+		t.Fatalf("unexpected seeded room: %+v", r)
+	}
+
+	closed := *repo.rooms["100455820"]
+	closed.Bookable = false
+	if _, err := catalog.SaveRoom(ctx, &closed); err != nil {
+		t.Fatal(err)
+	}
+	if ids := catalog.ResolveRoomIDs(ctx, []string{"100455820", "100455822", "unknown"}); len(ids) != 2 || ids[0] != "100455822" || ids[1] != "unknown" {
+		t.Fatalf("closed room should be dropped, got %v", ids)
+	}
+	for _, id := range catalog.BookableRoomIDs(ctx) {
+		if id == "100455820" {
+			t.Fatal("closed room should not be bookable")
+		}
+	}
+
+	if err := catalog.DeleteRoom(ctx, "missing"); !errors.Is(err, errcode.ErrRoomNotFound) {
+		t.Fatalf("expected ErrRoomNotFound, got %v", err)
+	}
+	if _, err := catalog.SaveRoom(ctx, &Room{ID: "x", Name: "x", OpenTime: "22:00", CloseTime: "08:00"}); !errors.Is(err, errcode.ErrInvalidRoom) {
+		t.Fatalf("expected ErrInvalidRoom, got %v", err)
+	}
+}
+
+func TestRoomCatalog_NilFallsBackToDefaults(t *testing.T) {
+	var catalog *RoomCatalog
+	ctx := context.Background()
+	if ids := catalog.ResolveRoomIDs(ctx, nil); len(ids) != len(DefaultRoomIDs()) {
+		t.Fatalf("expected default rooms, got %v", ids)
+	}
+	if ids := catalog.ResolveRoomIDs(ctx, []string{"r1"}); len(ids) != 1 || ids[0] != "r1" {
+		t.Fatalf("expected requested rooms, got %v", ids)
+	}
+}
+
+func TestRoomCatalog_DiscoverRooms(t *testing.T) {
+	repo := &fakeRoomRepo{rooms: map[string]*Room{
+		"r1": {ID: "r1", Name: "旧名", LabName: "主馆图书馆一楼", OpenTime: "09:00", CloseTime: "21:00", Bookable: false},
+	}}
+	crawler := &fakeRoomCrawler{rooms: []*Room{
+		{ID: "r1", Name: "一楼综合学习室", LabName: "主馆图书馆一楼", SeatCount: 100},
+		{ID: "r2", Name: "南湖分馆三楼卡座区", LabName: "南湖分馆三楼", SeatCount: 40},
+	}}
+	seatRepo := &fakeSeatRepo{}
+	catalog := newTestCatalog(repo, crawler, seatRepo)
+	ctx := context.Background()
+
+	added, total, err := catalog.DiscoverRooms(ctx, "stu")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 2 || len(added) != 1 || added[0].ID != "r2" || added[0].Branch != "南湖分馆" || added[0].Floor != 3 || added[0].SeatCount != 40 {
+		t.Fatalf("unexpected discovery result: total=%d added=%+v", total, added)
+	}
+	// 已有房间只更新名称和座位数，管理员设置的开放时间和关闭状态保留
+	if r := repo.rooms["r1"]; r.Name != "一楼综合学习室" || r.SeatCount != 100 || r.OpenTime != "09:00" || r.Bookable {
+		t.Fatalf("unexpected existing room: %+v", r)
+	}
+
+	catalog.RefreshSeats(ctx)
+	if len(seatRepo.refreshed) != 1 || seatRepo.refreshed[0] != "r2" {
+		t.Fatalf("only bookable rooms should be refreshed, got %v", seatRepo.refreshed)
+	}
+}
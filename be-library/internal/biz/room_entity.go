@@ -0,0 +1,121 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+// Room 房间目录中的一个房间，座位缓存刷新和默认的房间列表都来自房间目录
+type Room struct {
+	ID        string // room_id
+	Name      string // 一楼综合学习室
+	LabName   string // 主馆图书馆一楼
+	Branch    string // 主馆、南湖分馆
+	Floor     int
+	OpenTime  string // HH:MM
+	CloseTime string // HH:MM
+	SeatCount int
+	Bookable  bool // 关闭的房间不出现在默认房间列表中，也不刷新座位缓存
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// defaultRooms 房间目录为空时写入的初始房间
+var defaultRooms = []struct{ id, lab, name string }{
+	{"100455820", "主馆图书馆一楼", "一楼综合学习室"},
+	{"100455822", "主馆图书馆二楼", "二楼借阅室（一）"},
+	{"100671994", "主馆图书馆二楼", "二楼借阅室（二）"},
+	{"100455824", "主馆图书馆三楼", "三楼借阅室（三）"},
+	{"100455826", "主馆图书馆四楼", "四楼自主学习中心"},
+	{"100455828", "主馆图书馆五楼", "五楼借阅室（四）"},
+	{"100746476", "主馆图书馆五楼", "五楼借阅室（五）"},
+	{"100746204", "主馆图书馆六楼", "六楼阅览室（一）"},
+	{"100455830", "主馆图书馆六楼", "六楼外文借阅室"},
+	{"100455832", "主馆图书馆七楼", "七楼阅览室（二）"},
+	{"100746480", "主馆图书馆七楼", "七楼阅览室（三）"},
+	{"100455834", "主馆图书馆九楼", "九楼阅览室"},
+	{"101699179", "南湖分馆一楼", "南湖分馆一楼开敞座位区"},
+	{"101699187", "南湖分馆一楼", "南湖分馆一楼中庭开敞座位区"},
+	{"101699189", "南湖分馆二楼", "南湖分馆二楼开敞座位区"},
+	{"101699191", "南湖分馆二楼", "南湖分馆二楼卡座区"},
+}
+
+// DefaultRooms 初始的房间目录
+func DefaultRooms(openTime, closeTime string) []*Room {
+	rooms := make([]*Room, 0, len(defaultRooms))
+	for _, r := range defaultRooms {
+		rooms = append(rooms, NewDiscoveredRoom(r.id, r.name, r.lab, openTime, closeTime))
+	}
+	return rooms
+}
+
+// DefaultRoomIDs 初始房间目录中的房间 id，房间目录不可用时使用
+func DefaultRoomIDs() []string {
+	ids := make([]string, 0, len(defaultRooms))
+	for _, r := range defaultRooms {
+		ids = append(ids, r.id)
+	}
+	return ids
+}
+
+// NewDiscoveredRoom 根据图书馆系统中的楼层名推断分馆和楼层
+func NewDiscoveredRoom(id, name, labName, openTime, closeTime string) *Room {
+	branch, floor := parseLabName(labName)
+	return &Room{
+		ID:        id,
+		Name:      name,
+		LabName:   labName,
+		Branch:    branch,
+		Floor:     floor,
+		OpenTime:  openTime,
+		CloseTime: closeTime,
+		Bookable:  true,
+	}
+}
+
+var chineseDigits = map[rune]int{'一': 1, '二': 2, '三': 3, '四': 4, '五': 5, '六': 6, '七': 7, '八': 8, '九': 9}
+
+// parseLabName 主馆图书馆一楼 -> (主馆, 1)，南湖分馆二楼 -> (南湖分馆, 2)，无法识别的部分返回原名和 0
+func parseLabName(labName string) (string, int) {
+	branch := labName
+	if i := strings.Index(labName, "馆"); i >= 0 {
+		branch = labName[:i+len("馆")]
+	}
+	return branch, parseFloor(labName)
+}
+
+// parseFloor 只识别一楼到十九楼
+func parseFloor(labName string) int {
+	rest, ok := strings.CutSuffix(labName, "楼")
+	if !ok {
+		return 0
+	}
+	runes := []rune(rest)
+	n := len(runes)
+	if n == 0 {
+		return 0
+	}
+	if runes[n-1] == '十' {
+		return 10
+	}
+	d, ok := chineseDigits[runes[n-1]]
+	if !ok {
+		return 0
+	}
+	if n >= 2 && runes[n-2] == '十' {
+		return 10 + d
+	}
+	return d
+}
+
+type RoomRepo interface {
+	// ListRooms 按分馆、楼层排序，includeClosed 为 false 时只返回可预约的房间
+	ListRooms(ctx context.Context, includeClosed bool) ([]*Room, error)
+	// GetRoom 不存在时返回 nil
+	GetRoom(ctx context.Context, id string) (*Room, error)
+	// SaveRooms 按 id 新增或覆盖
+	SaveRooms(ctx context.Context, rooms []*Room) error
+	// DeleteRoom 返回是否删除了记录
+	DeleteRoom(ctx context.Context, id string) (bool, error)
+}
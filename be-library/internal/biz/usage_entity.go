@@ -0,0 +1,137 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+// UsageOutcome 一条历史预约在使用统计中的结果
+type UsageOutcome int
+
+const (
+	UsagePending   UsageOutcome = iota // 还未签到或无法识别的状态，不计入统计
+	UsageOnTime                        // 按时签到
+	UsageLate                          // 迟到签到
+	UsageNoShow                        // 违约
+	UsageCancelled                     // 取消
+)
+
+// classifyHistory 根据预约系统中的状态文字判断结果，先判断取消和违约，避免“未签到”被当作签到
+func classifyHistory(status string) UsageOutcome {
+	containsAny := func(keys ...string) bool {
+		for _, k := range keys {
+			if strings.Contains(status, k) {
+				return true
+			}
+		}
+		return false
+	}
+	switch {
+	case containsAny("取消"):
+		return UsageCancelled
+	case containsAny("违约", "未签到", "爽约", "未到"):
+		return UsageNoShow
+	case containsAny("迟到"):
+		return UsageLate
+	case containsAny("已签到", "使用中", "暂离", "已完成", "已结束"):
+		return UsageOnTime
+	default:
+		return UsagePending
+	}
+}
+
+// UsageDay 学生某天在某个房间的使用统计，由历史预约逐条累加
+type UsageDay struct {
+	Date      string // 2006-01-02
+	Room      string // 房间名，历史记录中没有房间时为楼层
+	Floor     string
+	Minutes   int // 签到（包括迟到）的预约时长
+	OnTime    int
+	Late      int
+	NoShow    int
+	Cancelled int
+}
+
+// Sessions 签到（包括迟到）的次数
+func (d *UsageDay) Sessions() int {
+	return d.OnTime + d.Late
+}
+
+// CountableHistory 库中的历史预约，CountedStatus、CountedRoom 为已经计入统计时的状态和房间
+type CountableHistory struct {
+	HistoryRecords
+	CountedStatus string
+	CountedRoom   string
+}
+
+type UsageRepo interface {
+	// ListUncountedHistory 返回状态与已计入统计的状态不同的历史预约，包括从未计入的，以及已计入后房间发生变化的
+	ListUncountedHistory(ctx context.Context, stuID string) ([]*CountableHistory, error)
+	// ApplyUsage 在同一事务中把 deltas 累加到每天的统计，并把 counted 的当前状态和 CountedRoom 记为已计入
+	ApplyUsage(ctx context.Context, stuID string, deltas []*UsageDay, counted []*CountableHistory) error
+	// ListUsageDays 按日期升序返回学生全部的每日统计
+	ListUsageDays(ctx context.Context, stuID string) ([]*UsageDay, error)
+}
+
+// UsageQuery 使用统计的查询参数，学期起止为零值时使用当前学期
+type UsageQuery struct {
+	Weeks         int
+	Months        int
+	SemesterStart time.Time
+	SemesterEnd   time.Time
+}
+
+type UsagePeriod struct {
+	Period   string // 2025-W36 或 2025-09
+	Hours    float64
+	Sessions int
+}
+
+type FavouriteRoom struct {
+	Room     string
+	Floor    string
+	Hours    float64
+	Sessions int
+}
+
+// AttendanceStat 比例按签到、迟到、违约三类计算，不包括取消的预约
+type AttendanceStat struct {
+	OnTime     int
+	Late       int
+	NoShow     int
+	Cancelled  int
+	OnTimeRate float64
+	LateRate   float64
+	NoShowRate float64
+}
+
+type StudyStreak struct {
+	Current      int // 截至今天（今天还没有学习时截至昨天）连续学习的天数
+	Longest      int
+	LongestStart string
+	LongestEnd   string
+}
+
+type SemesterReview struct {
+	SemesterStart   string
+	SemesterEnd     string
+	TotalHours      float64
+	Sessions        int
+	StudyDays       int
+	TopRoom         string
+	BusiestWeekday  int // 1-7 对应周一到周日，没有记录时为 0
+	BusiestDay      string
+	BusiestDayHours float64
+	LongestStreak   int
+	Attendance      AttendanceStat
+}
+
+type UsageAnalytics struct {
+	Weekly         []*UsagePeriod
+	Monthly        []*UsagePeriod
+	FavouriteRooms []*FavouriteRoom
+	Attendance     AttendanceStat
+	Streak         StudyStreak
+	Review         SemesterReview
+}
@@ -0,0 +1,381 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/asynccnu/ccnubox-be/be-library/internal/errcode"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	usageLockKeyFmt = "lib:usage:lock:%s"
+	usageLockTTL    = 30 * time.Second
+
+	defaultUsageWeeks   = 8
+	maxUsageWeeks       = 26
+	defaultUsageMonths  = 6
+	maxUsageMonths      = 12
+	favouriteRoomsLimit = 5
+)
+
+// UsageUsecase 根据库中保存的历史预约统计学生的使用情况
+// 历史预约在查看预约历史时落库，统计时只把新增或状态变化的记录累加到每日统计，不会重新爬取
+type UsageUsecase struct {
+	repo   UsageRepo
+	locker Locker
+
+	log *log.Helper
+}
+
+func NewUsageUsecase(repo UsageRepo, locker Locker, logger log.Logger) *UsageUsecase {
+	return &UsageUsecase{
+		repo:   repo,
+		locker: locker,
+		log:    log.NewHelper(logger),
+	}
+}
+
+// Refresh 把新增或状态变化的历史预约累加到每日统计
+// 状态变化时先从计入时的房间减去按旧状态计入的部分，再按新状态计入当前的房间
+func (u *UsageUsecase) Refresh(ctx context.Context, stuID string) error {
+	unlock, ok, err := u.locker.TryLock(ctx, fmt.Sprintf(usageLockKeyFmt, stuID), usageLockTTL)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		// 其他请求正在累加，这次使用已有的统计
+		return nil
+	}
+	defer unlock()
+
+	records, err := u.repo.ListUncountedHistory(ctx, stuID)
+	if err != nil {
+		return err
+	}
+	if len(records) == 0 {
+		return nil
+	}
+
+	deltas := make(map[[2]string]*UsageDay)
+	delta := func(day, room, floor string) *UsageDay {
+		key := [2]string{day, room}
+		d, exists := deltas[key]
+		if !exists {
+			d = &UsageDay{Date: day, Room: room, Floor: floor}
+			deltas[key] = d
+		}
+		return d
+	}
+	for _, r := range records {
+		room := r.Room
+		if room == "" {
+			room = r.Floor
+		}
+		countedRoom := r.CountedRoom
+		if countedRoom == "" {
+			// 旧数据没有记录计入时的房间
+			countedRoom = room
+		}
+		r.CountedRoom = room
+		day, minutes, ok := parseHistorySpan(r.Date)
+		if !ok {
+			continue
+		}
+		if r.CountedStatus != "" {
+			addOutcome(delta(day, countedRoom, r.Floor), classifyHistory(r.CountedStatus), minutes, -1)
+		}
+		addOutcome(delta(day, room, r.Floor), classifyHistory(r.Status), minutes, 1)
+	}
+
+	list := make([]*UsageDay, 0, len(deltas))
+	for _, d := range deltas {
+		if d.Minutes != 0 || d.OnTime != 0 || d.Late != 0 || d.NoShow != 0 || d.Cancelled != 0 {
+			list = append(list, d)
+		}
+	}
+	return u.repo.ApplyUsage(ctx, stuID, list, records)
+}
+
+func addOutcome(d *UsageDay, outcome UsageOutcome, minutes, sign int) {
+	switch outcome {
+	case UsageOnTime:
+		d.OnTime += sign
+		d.Minutes += sign * minutes
+	case UsageLate:
+		d.Late += sign
+		d.Minutes += sign * minutes
+	case UsageNoShow:
+		d.NoShow += sign
+	case UsageCancelled:
+		d.Cancelled += sign
+	}
+}
+
+// parseHistorySpan 解析历史预约的时间 2025-09-02 08:00-10:00，时间段无法识别时时长为 0
+func parseHistorySpan(s string) (string, int, bool) {
+	day, rest, _ := strings.Cut(strings.TrimSpace(s), " ")
+	if _, err := time.Parse("2006-01-02", day); err != nil {
+		return "", 0, false
+	}
+	startStr, endStr, found := strings.Cut(rest, "-")
+	if !found {
+		return day, 0, true
+	}
+	start, err1 := minuteOfDay(strings.TrimSpace(startStr))
+	end, err2 := minuteOfDay(strings.TrimSpace(endStr))
+	if err1 != nil || err2 != nil || end <= start {
+		return day, 0, true
+	}
+	return day, end - start, true
+}
+
+// GetAnalytics 累加新的历史预约后计算使用统计，累加失败时使用已有的统计
+func (u *UsageUsecase) GetAnalytics(ctx context.Context, stuID string, q UsageQuery, now time.Time) (*UsageAnalytics, error) {
+	if q.SemesterStart.IsZero() != q.SemesterEnd.IsZero() || q.SemesterEnd.Before(q.SemesterStart) {
+		return nil, errcode.ErrInvalidUsageQuery
+	}
+	if err := u.Refresh(ctx, stuID); err != nil {
+		u.log.Warnf("refresh usage(stu_id:%v) failed: %v", stuID, err)
+	}
+	days, err := u.repo.ListUsageDays(ctx, stuID)
+	if err != nil {
+		u.log.Errorf("list usage days(stu_id:%v) failed: %v", stuID, err)
+		return nil, err
+	}
+	return BuildUsageAnalytics(days, q, now), nil
+}
+
+// BuildUsageAnalytics 根据每日统计计算周、月时长、常去的房间、签到情况、连续学习天数和学期回顾
+func BuildUsageAnalytics(days []*UsageDay, q UsageQuery, now time.Time) *UsageAnalytics {
+	weeks := clampDefault(q.Weeks, defaultUsageWeeks, maxUsageWeeks)
+	months := clampDefault(q.Months, defaultUsageMonths, maxUsageMonths)
+	semStart, semEnd := q.SemesterStart, q.SemesterEnd
+	if semStart.IsZero() {
+		semStart, semEnd = semesterRange(now)
+	}
+	today := dateOf(now)
+
+	weekly := make([]*UsagePeriod, weeks)
+	monday := today.AddDate(0, 0, 1-isoWeekday(today))
+	for i := range weekly {
+		start := monday.AddDate(0, 0, -7*(weeks-1-i))
+		year, week := start.ISOWeek()
+		weekly[i] = &UsagePeriod{Period: fmt.Sprintf("%d-W%02d", year, week)}
+	}
+	monthly := make([]*UsagePeriod, months)
+	firstOfMonth := time.Date(today.Year(), today.Month(), 1, 0, 0, 0, 0, today.Location())
+	for i := range monthly {
+		monthly[i] = &UsagePeriod{Period: firstOfMonth.AddDate(0, -(months - 1 - i), 0).Format("2006-01")}
+	}
+	periodMinutes := make(map[string]int)
+	periodSessions := make(map[string]int)
+
+	rooms := make(map[string]*FavouriteRoom)
+	roomMinutes := make(map[string]int)
+	studied := make(map[string]bool)
+	var all, semester AttendanceStat
+	var semMinutes, semSessions int
+	semRoomMinutes := make(map[string]int)
+	semDayMinutes := make(map[string]int)
+	weekdayMinutes := make(map[int]int)
+
+	for _, d := range days {
+		date, err := time.ParseInLocation("2006-01-02", d.Date, now.Location())
+		if err != nil {
+			continue
+		}
+		addAttendance(&all, d)
+		if d.Sessions() > 0 {
+			studied[d.Date] = true
+		}
+
+		year, week := date.ISOWeek()
+		for _, key := range []string{fmt.Sprintf("%d-W%02d", year, week), date.Format("2006-01")} {
+			periodMinutes[key] += d.Minutes
+			periodSessions[key] += d.Sessions()
+		}
+
+		if d.Sessions() > 0 {
+			r, ok := rooms[d.Room]
+			if !ok {
+				r = &FavouriteRoom{Room: d.Room, Floor: d.Floor}
+				rooms[d.Room] = r
+			}
+			r.Sessions += d.Sessions()
+			roomMinutes[d.Room] += d.Minutes
+		}
+
+		if date.Before(semStart) || date.After(semEnd) {
+			continue
+		}
+		addAttendance(&semester, d)
+		semMinutes += d.Minutes
+		semSessions += d.Sessions()
+		semRoomMinutes[d.Room] += d.Minutes
+		if d.Sessions() > 0 {
+			semDayMinutes[d.Date] += d.Minutes
+			weekdayMinutes[isoWeekday(date)] += d.Minutes
+		}
+	}
+
+	for _, p := range append(weekly, monthly...) {
+		p.Hours = minutesToHours(periodMinutes[p.Period])
+		p.Sessions = periodSessions[p.Period]
+	}
+
+	favourites := make([]*FavouriteRoom, 0, len(rooms))
+	for name, r := range rooms {
+		r.Hours = minutesToHours(roomMinutes[name])
+		favourites = append(favourites, r)
+	}
+	sort.Slice(favourites, func(i, j int) bool {
+		a, b := favourites[i], favourites[j]
+		if roomMinutes[a.Room] != roomMinutes[b.Room] {
+			return roomMinutes[a.Room] > roomMinutes[b.Room]
+		}
+		if a.Sessions != b.Sessions {
+			return a.Sessions > b.Sessions
+		}
+		return a.Room < b.Room
+	})
+	if len(favourites) > favouriteRoomsLimit {
+		favourites = favourites[:favouriteRoomsLimit]
+	}
+
+	studiedDates := make([]string, 0, len(studied))
+	for date := range studied {
+		studiedDates = append(studiedDates, date)
+	}
+	semStudied := make([]string, 0, len(semDayMinutes))
+	for date := range semDayMinutes {
+		semStudied = append(semStudied, date)
+	}
+
+	review := SemesterReview{
+		SemesterStart: semStart.Format("2006-01-02"),
+		SemesterEnd:   semEnd.Format("2006-01-02"),
+		TotalHours:    minutesToHours(semMinutes),
+		Sessions:      semSessions,
+		StudyDays:     len(semDayMinutes),
+		TopRoom:       maxKey(semRoomMinutes),
+		LongestStreak: buildStreak(semStudied, today).Longest,
+		Attendance:    finishAttendance(semester),
+	}
+	if busiest := maxKey(semDayMinutes); busiest != "" {
+		review.BusiestDay = busiest
+		review.BusiestDayHours = minutesToHours(semDayMinutes[busiest])
+	}
+	for weekday := 1; weekday <= 7; weekday++ {
+		if weekdayMinutes[weekday] > 0 && (review.BusiestWeekday == 0 || weekdayMinutes[weekday] > weekdayMinutes[review.BusiestWeekday]) {
+			review.BusiestWeekday = weekday
+		}
+	}
+
+	return &UsageAnalytics{
+		Weekly:         weekly,
+		Monthly:        monthly,
+		FavouriteRooms: favourites,
+		Attendance:     finishAttendance(all),
+		Streak:         buildStreak(studiedDates, today),
+		Review:         review,
+	}
+}
+
+func addAttendance(a *AttendanceStat, d *UsageDay) {
+	a.OnTime += d.OnTime
+	a.Late += d.Late
+	a.NoShow += d.NoShow
+	a.Cancelled += d.Cancelled
+}
+
+func finishAttendance(a AttendanceStat) AttendanceStat {
+	if total := a.OnTime + a.Late + a.NoShow; total > 0 {
+		a.OnTimeRate = roundRate(float64(a.OnTime) / float64(total))
+		a.LateRate = roundRate(float64(a.Late) / float64(total))
+		a.NoShowRate = roundRate(float64(a.NoShow) / float64(total))
+	}
+	return a
+}
+
+// buildStreak dates 为有签到记录的日期，不重复，可以无序
+func buildStreak(dates []string, today time.Time) StudyStreak {
+	sort.Strings(dates)
+	var s StudyStreak
+	var runStart string
+	run := 0
+	var prev time.Time
+	for _, date := range dates {
+		day, err := time.ParseInLocation("2006-01-02", date, today.Location())
+		if err != nil {
+			continue
+		}
+		if run > 0 && day.Equal(prev.AddDate(0, 0, 1)) {
+			run++
+		} else {
+			run, runStart = 1, date
+		}
+		prev = day
+		if run > s.Longest {
+			s.Longest, s.LongestStart, s.LongestEnd = run, runStart, date
+		}
+	}
+	// 最后一段截止到今天或昨天时才算作当前的连续天数
+	if run > 0 && (prev.Equal(today) || prev.Equal(today.AddDate(0, 0, -1))) {
+		s.Current = run
+	}
+	return s
+}
+
+// semesterRange 2-7 月为春季学期，8 月到次年 1 月为秋季学期
+func semesterRange(now time.Time) (time.Time, time.Time) {
+	loc := now.Location()
+	year := now.Year()
+	switch {
+	case now.Month() >= time.February && now.Month() <= time.July:
+		return time.Date(year, time.February, 1, 0, 0, 0, 0, loc), time.Date(year, time.July, 31, 0, 0, 0, 0, loc)
+	case now.Month() == time.January:
+		year--
+	}
+	return time.Date(year, time.August, 1, 0, 0, 0, 0, loc), time.Date(year+1, time.January, 31, 0, 0, 0, 0, loc)
+}
+
+func dateOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+func clampDefault(v, def, max int) int {
+	if v <= 0 {
+		return def
+	}
+	if v > max {
+		return max
+	}
+	return v
+}
+
+// maxKey 值最大的键，相同时取较小的键，全部不大于 0 时返回空
+func maxKey(m map[string]int) string {
+	best := ""
+	for k, v := range m {
+		if v <= 0 {
+			continue
+		}
+		if best == "" || v > m[best] || (v == m[best] && k < best) {
+			best = k
+		}
+	}
+	return best
+}
+
+func minutesToHours(minutes int) float64 {
+	return math.Round(float64(minutes)/60*100) / 100
+}
+
+func roundRate(r float64) float64 {
+	return math.Round(r*10000) / 10000
+}
@@ -0,0 +1,219 @@
+package biz
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// fakeUsageRepo 保存历史预约和已计入的状态，按 date+room 累加每日统计
+type fakeUsageRepo struct {
+	history []*CountableHistory
+	days    map[[2]string]*UsageDay
+}
+
+func (r *fakeUsageRepo) ListUncountedHistory(context.Context, string) ([]*CountableHistory, error) {
+	var out []*CountableHistory
+	for _, h := range r.history {
+		room := h.Room
+		if room == "" {
+			room = h.Floor
+		}
+		if h.CountedStatus != h.Status || (h.CountedStatus != "" && h.CountedRoom != room) {
+			cp := *h
+			out = append(out, &cp)
+		}
+	}
+	return out, nil
+}
+
+func (r *fakeUsageRepo) ApplyUsage(_ context.Context, _ string, deltas []*UsageDay, counted []*CountableHistory) error {
+	for _, d := range deltas {
+		key := [2]string{d.Date, d.Room}
+		day, ok := r.days[key]
+		if !ok {
+			day = &UsageDay{Date: d.Date, Room: d.Room, Floor: d.Floor}
+			r.days[key] = day
+		}
+		day.Minutes += d.Minutes
+		day.OnTime += d.OnTime
+		day.Late += d.Late
+		day.NoShow += d.NoShow
+		day.Cancelled += d.Cancelled
+	}
+	for _, c := range counted {
+		for _, h := range r.history {
+			if h.SubmitTime == c.SubmitTime {
+				h.CountedStatus = c.Status
+				h.CountedRoom = c.CountedRoom
+			}
+		}
+	}
+	return nil
+}
+
+func (r *fakeUsageRepo) ListUsageDays(context.Context, string) ([]*UsageDay, error) {
+	out := make([]*UsageDay, 0, len(r.days))
+	for _, d := range r.days {
+		cp := *d
+		out = append(out, &cp)
+	}
+	return out, nil
+}
+
+func (r *fakeUsageRepo) add(submit, date, room, status string) {
+	r.history = append(r.history, &CountableHistory{HistoryRecords: HistoryRecords{
+		Place: "A001", Floor: "主馆图书馆一楼", Room: room, Status: status, Date: date, SubmitTime: submit,
+	}})
+}
+
+func (r *fakeUsageRepo) setStatus(submit, status string) {
+	for _, h := range r.history {
+		if h.SubmitTime == submit {
+			h.Status = status
+		}
+	}
+}
+
+func (r *fakeUsageRepo) setRoom(submit, room string) {
+	for _, h := range r.history {
+		if h.SubmitTime == submit {
+			h.Room = room
+		}
+	}
+}
+
+func TestClassifyHistory(t *testing.T) {
+	cases := map[string]UsageOutcome{
+		"预约成功,已签到": UsageOnTime,
+		"暂离":       UsageOnTime,
+		"迟到签到":     UsageLate,
+		"未签到":      UsageNoShow,
+		"违约":       UsageNoShow,
+		"已取消":      UsageCancelled,
+		"预约成功":     UsagePending,
+	}
+	for status, want := range cases {
+		if got := classifyHistory(status); got != want {
+			t.Errorf("classifyHistory(%q) = %v, want %v", status, got, want)
+		}
+	}
+}
+
+func TestUsageUsecase_IncrementalAnalytics(t *testing.T) {
+	repo := &fakeUsageRepo{days: map[[2]string]*UsageDay{}}
+	uc := NewUsageUsecase(repo, &fakeLocker{held: map[string]bool{}}, log.NewStdLogger(os.Stdout))
+	ctx := context.Background()
+	now := time.Date(2025, 10, 15, 20, 0, 0, 0, time.Local)
+
+	repo.add("1", "2025-10-13 08:00-10:00", "自习室A", "预约成功,已签到")
+	repo.add("2", "2025-10-14 14:00-15:30", "自习室B", "迟到签到")
+	repo.add("3", "2025-10-14 08:00-10:00", "自习室A", "未签到")
+	repo.add("4", "2025-10-10 08:00-10:00", "自习室A", "已取消")
+	repo.add("5", "2025-10-15 18:00-21:00", "自习室A", "预约成功")
+
+	a, err := uc.GetAnalytics(ctx, "stu", UsageQuery{Weeks: 2, Months: 1}, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.Attendance.OnTime != 1 || a.Attendance.Late != 1 || a.Attendance.NoShow != 1 || a.Attendance.Cancelled != 1 || a.Attendance.NoShowRate != 0.3333 {
+		t.Fatalf("unexpected attendance: %+v", a.Attendance)
+	}
+	if len(a.Weekly) != 2 || a.Weekly[1].Period != "2025-W42" || a.Weekly[1].Hours != 3.5 || a.Weekly[1].Sessions != 2 || a.Weekly[0].Hours != 0 {
+		t.Fatalf("unexpected weekly: %+v %+v", a.Weekly[0], a.Weekly[1])
+	}
+	if len(a.FavouriteRooms) != 2 || a.FavouriteRooms[0].Room != "自习室A" || a.FavouriteRooms[0].Hours != 2 {
+		t.Fatalf("unexpected favourite rooms: %+v", a.FavouriteRooms)
+	}
+	if a.Streak.Current != 2 || a.Streak.Longest != 2 || a.Streak.LongestStart != "2025-10-13" {
+		t.Fatalf("unexpected streak: %+v", a.Streak)
+	}
+
+	// 状态变化后只累加差值：签到计入，已签到改为违约时扣除之前计入的时长
+	repo.setStatus("5", "预约成功,已签到")
+	repo.setStatus("1", "违约")
+	a, err = uc.GetAnalytics(ctx, "stu", UsageQuery{Months: 1}, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.Monthly[0].Period != "2025-10" || a.Monthly[0].Hours != 4.5 || a.Attendance.OnTime != 1 || a.Attendance.NoShow != 2 {
+		t.Fatalf("unexpected analytics after status change: %+v %+v", a.Monthly[0], a.Attendance)
+	}
+	r := a.Review
+	if r.SemesterStart != "2025-08-01" || r.SemesterEnd != "2026-01-31" || r.StudyDays != 2 || r.TopRoom != "自习室A" ||
+		r.BusiestDay != "2025-10-15" || r.BusiestDayHours != 3 || r.BusiestWeekday != 3 || r.LongestStreak != 2 {
+		t.Fatalf("unexpected review: %+v", r)
+	}
+	if a.Streak.Current != 2 || a.Streak.LongestStart != "2025-10-14" {
+		t.Fatalf("unexpected streak after status change: %+v", a.Streak)
+	}
+
+	// 没有变化时不会重复累加
+	if _, err = uc.GetAnalytics(ctx, "stu", UsageQuery{}, now); err != nil {
+		t.Fatal(err)
+	}
+	if d := repo.days[[2]string{"2025-10-15", "自习室A"}]; d.Minutes != 180 || d.OnTime != 1 {
+		t.Fatalf("usage should be counted once: %+v", d)
+	}
+}
+
+func TestUsageUsecase_RoomCorrected(t *testing.T) {
+	repo := &fakeUsageRepo{days: map[[2]string]*UsageDay{}}
+	uc := NewUsageUsecase(repo, &fakeLocker{held: map[string]bool{}}, log.NewStdLogger(os.Stdout))
+	ctx := context.Background()
+
+	// 房间名为空时按楼层计入，之后补上房间名并改为违约
+	repo.add("1", "2025-10-13 08:00-10:00", "", "预约成功,已签到")
+	if err := uc.Refresh(ctx, "stu"); err != nil {
+		t.Fatal(err)
+	}
+	repo.setRoom("1", "自习室A")
+	repo.setStatus("1", "违约")
+	if err := uc.Refresh(ctx, "stu"); err != nil {
+		t.Fatal(err)
+	}
+
+	if d := repo.days[[2]string{"2025-10-13", "主馆图书馆一楼"}]; d.Minutes != 0 || d.OnTime != 0 {
+		t.Fatalf("sign-in should be subtracted from the floor it was counted under: %+v", d)
+	}
+	if d := repo.days[[2]string{"2025-10-13", "自习室A"}]; d.Minutes != 0 || d.OnTime != 0 || d.NoShow != 1 {
+		t.Fatalf("no-show should be counted under the corrected room: %+v", d)
+	}
+}
+
+func TestUsageUsecase_OnlyRoomChanged(t *testing.T) {
+	repo := &fakeUsageRepo{days: map[[2]string]*UsageDay{}}
+	uc := NewUsageUsecase(repo, &fakeLocker{held: map[string]bool{}}, log.NewStdLogger(os.Stdout))
+	ctx := context.Background()
+
+	// 状态不变，只补上了房间名，也要把记录挪到新的房间下
+	repo.add("1", "2025-10-13 08:00-10:00", "", "预约成功,已签到")
+	if err := uc.Refresh(ctx, "stu"); err != nil {
+		t.Fatal(err)
+	}
+	repo.setRoom("1", "自习室A")
+	if err := uc.Refresh(ctx, "stu"); err != nil {
+		t.Fatal(err)
+	}
+
+	if d := repo.days[[2]string{"2025-10-13", "主馆图书馆一楼"}]; d.Minutes != 0 || d.OnTime != 0 {
+		t.Fatalf("sign-in should be moved out of the floor: %+v", d)
+	}
+	if d := repo.days[[2]string{"2025-10-13", "自习室A"}]; d.Minutes != 120 || d.OnTime != 1 {
+		t.Fatalf("sign-in should be counted under the new room: %+v", d)
+	}
+}
+
+func TestUsageUsecase_InvalidSemester(t *testing.T) {
+	uc := NewUsageUsecase(&fakeUsageRepo{days: map[[2]string]*UsageDay{}}, &fakeLocker{held: map[string]bool{}}, log.NewStdLogger(os.Stdout))
+	start := time.Date(2025, 9, 1, 0, 0, 0, 0, time.Local)
+	if _, err := uc.GetAnalytics(context.Background(), "stu", UsageQuery{SemesterStart: start}, time.Now()); err == nil {
+		t.Fatal("expected error when only the start is set")
+	}
+	if _, err := uc.GetAnalytics(context.Background(), "stu", UsageQuery{SemesterStart: start, SemesterEnd: start.AddDate(0, 0, -1)}, time.Now()); err == nil {
+		t.Fatal("expected error when the end is before the start")
+	}
+}
@@ -1,18 +1,26 @@
 package client
 
 import (
+	"strings"
 	"time"
 
+	"github.com/asynccnu/ccnubox-be/be-library/internal/conf"
 	"github.com/google/wire"
 )
 
-// NewCookiePoolProvider 创建CookiePool的wire provider
-func NewCookiePoolProvider() *CookiePool {
-	return NewCookiePool(30 * time.Minute)
+// NewCookiePoolProvider 创建CookiePool的wire provider，未配置地址时使用华师图书馆
+func NewCookiePoolProvider(c *conf.Crawler) *CookiePool {
+	baseURL := DefaultLibraryURL
+	if c != nil && c.BaseUrl != "" {
+		baseURL = strings.TrimRight(c.BaseUrl, "/")
+	}
+	return NewCookiePool(30*time.Minute, baseURL)
 }
 
 var ProviderSet = wire.NewSet(
 	NewClient,
 	NewCCNUServiceProxy,
+	NewFeedClient,
+	NewFeedNotifier,
 	NewCookiePoolProvider, // 新增CookiePool provider
 )
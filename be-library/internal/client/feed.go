@@ -0,0 +1,53 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	feedv1 "github.com/asynccnu/ccnubox-be/be-api/gen/proto/feed/v1"
+	"github.com/asynccnu/ccnubox-be/be-library/internal/biz"
+	"github.com/asynccnu/ccnubox-be/be-library/internal/conf"
+	"github.com/go-kratos/kratos/contrib/registry/etcd/v2"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/middleware/tracing"
+	"github.com/go-kratos/kratos/v2/transport/grpc"
+)
+
+type FeedNotifier struct {
+	fc feedv1.FeedServiceClient
+}
+
+func NewFeedNotifier(fc feedv1.FeedServiceClient) biz.FeedNotifier {
+	return &FeedNotifier{fc: fc}
+}
+
+func (f *FeedNotifier) Notify(ctx context.Context, stuID, title, content string) error {
+	_, err := f.fc.PublicFeedEvent(ctx, &feedv1.PublicFeedEventReq{
+		StudentId: stuID,
+		Event: &feedv1.FeedEvent{
+			Type:    biz.FeedTypeLibrary,
+			Title:   title,
+			Content: content,
+		},
+	})
+	return err
+}
+
+func NewFeedClient(r *etcd.Registry, cf *conf.Registry, logger log.Logger) (feedv1.FeedServiceClient, error) {
+	conn, err := grpc.DialInsecure(
+		context.Background(),
+		grpc.WithEndpoint(cf.Feedsvc),
+		grpc.WithDiscovery(r),
+		grpc.WithTimeout(10*time.Second),
+		grpc.WithMiddleware(
+			tracing.Client(),
+			recovery.Recovery(),
+		),
+	)
+	if err != nil {
+		log.NewHelper(logger).WithContext(context.Background()).Errorw("kind", "grpc-client", "reason", "GRPC_CLIENT_INIT_ERROR", "err", err)
+		return nil, err
+	}
+	return feedv1.NewFeedServiceClient(conn), nil
+}
@@ -10,13 +10,17 @@ import (
 	"time"
 )
 
+// DefaultLibraryURL 华师图书馆预约系统的地址
+const DefaultLibraryURL = "http://kjyy.ccnu.edu.cn"
+
 // CookieClient 封装带cookiejar的HTTP客户端
 type CookieClient struct {
-	client *http.Client
+	client  *http.Client
+	baseURL string
 }
 
-// NewCookieClient 创建带cookiejar的HTTP客户端
-func NewCookieClient(cookieString string) (*CookieClient, error) {
+// NewCookieClient 创建带cookiejar的HTTP客户端，cookie 设置到 baseURL 对应的域名
+func NewCookieClient(baseURL, cookieString string) (*CookieClient, error) {
 	jar, err := cookiejar.New(nil)
 	if err != nil {
 		return nil, err
@@ -34,7 +38,8 @@ func NewCookieClient(cookieString string) (*CookieClient, error) {
 	}
 
 	cc := &CookieClient{
-		client: client,
+		client:  client,
+		baseURL: baseURL,
 	}
 
 	// 解析并设置初始cookie到华师图书馆域名
@@ -51,8 +56,7 @@ func (cc *CookieClient) setCookiesFromString(cookieString string) error {
 		return nil
 	}
 
-	// 华师图书馆的基础URL
-	baseURL, err := url.Parse("http://kjyy.ccnu.edu.cn")
+	baseURL, err := url.Parse(cc.baseURL)
 	if err != nil {
 		return err
 	}
@@ -92,20 +96,22 @@ func (cc *CookieClient) DoWithContext(ctx context.Context, req *http.Request) (*
 
 // GetCookies 获取当前的cookies
 func (cc *CookieClient) GetCookies() []*http.Cookie {
-	baseURL, _ := url.Parse("http://kjyy.ccnu.edu.cn")
+	baseURL, _ := url.Parse(cc.baseURL)
 	return cc.client.Jar.Cookies(baseURL)
 }
 
 // CookiePool 管理CookieClient实例池
 type CookiePool struct {
-	pool   sync.Map // map[string]*CookieClient
-	expiry time.Duration
+	pool    sync.Map // map[string]*CookieClient
+	expiry  time.Duration
+	baseURL string
 }
 
 // NewCookiePool 创建新的CookiePool
-func NewCookiePool(expiry time.Duration) *CookiePool {
+func NewCookiePool(expiry time.Duration, baseURL string) *CookiePool {
 	pool := &CookiePool{
-		expiry: expiry,
+		expiry:  expiry,
+		baseURL: baseURL,
 	}
 
 	// 启动清理goroutine
@@ -119,7 +125,7 @@ func (cp *CookiePool) GetClient(cookieString string) (*CookieClient, error) {
 		return client.(*CookieClient), nil
 	}
 
-	client, err := NewCookieClient(cookieString)
+	client, err := NewCookieClient(cp.baseURL, cookieString)
 	if err != nil {
 		return nil, err
 	}
@@ -128,6 +134,11 @@ func (cp *CookiePool) GetClient(cookieString string) (*CookieClient, error) {
 	return client, nil
 }
 
+// BaseURL 图书馆预约系统的地址
+func (cp *CookiePool) BaseURL() string {
+	return cp.baseURL
+}
+
 // cleanup 定期清理过期的客户端
 func (cp *CookiePool) cleanup() {
 	ticker := time.NewTicker(cp.expiry)
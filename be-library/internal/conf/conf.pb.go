@@ -28,6 +28,12 @@ type Bootstrap struct {
 	Data          *Data                  `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 	Registry      *Registry              `protobuf:"bytes,3,opt,name=registry,proto3" json:"registry,omitempty"`
 	Zaplog        *ZapLogConfigs         `protobuf:"bytes,4,opt,name=zaplog,proto3" json:"zaplog,omitempty"`
+	Reserve       *Reserve               `protobuf:"bytes,5,opt,name=reserve,proto3" json:"reserve,omitempty"`
+	Reminder      *Reminder              `protobuf:"bytes,6,opt,name=reminder,proto3" json:"reminder,omitempty"`
+	Comment       *Comment               `protobuf:"bytes,7,opt,name=comment,proto3" json:"comment,omitempty"`
+	Discussion    *Discussion            `protobuf:"bytes,8,opt,name=discussion,proto3" json:"discussion,omitempty"`
+	Crawler       *Crawler               `protobuf:"bytes,9,opt,name=crawler,proto3" json:"crawler,omitempty"`
+	Room          *Room                  `protobuf:"bytes,10,opt,name=room,proto3" json:"room,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -90,6 +96,48 @@ func (x *Bootstrap) GetZaplog() *ZapLogConfigs {
 	return nil
 }
 
+func (x *Bootstrap) GetReserve() *Reserve {
+	if x != nil {
+		return x.Reserve
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetReminder() *Reminder {
+	if x != nil {
+		return x.Reminder
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetComment() *Comment {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetDiscussion() *Discussion {
+	if x != nil {
+		return x.Discussion
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetCrawler() *Crawler {
+	if x != nil {
+		return x.Crawler
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetRoom() *Room {
+	if x != nil {
+		return x.Room
+	}
+	return nil
+}
+
 type Server struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Grpc          *Server_GRPC           `protobuf:"bytes,1,opt,name=grpc,proto3" json:"grpc,omitempty"`
@@ -306,6 +354,7 @@ type Registry struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Etcd          *Etcd                  `protobuf:"bytes,1,opt,name=etcd,proto3" json:"etcd,omitempty"`
 	Usersvc       string                 `protobuf:"bytes,2,opt,name=usersvc,proto3" json:"usersvc,omitempty"`
+	Feedsvc       string                 `protobuf:"bytes,3,opt,name=feedsvc,proto3" json:"feedsvc,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -354,6 +403,426 @@ func (x *Registry) GetUsersvc() string {
 	return ""
 }
 
+func (x *Registry) GetFeedsvc() string {
+	if x != nil {
+		return x.Feedsvc
+	}
+	return ""
+}
+
+// 自动预约配置
+type Reserve struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OpenTime      string                 `protobuf:"bytes,1,opt,name=open_time,json=openTime,proto3" json:"open_time,omitempty"`                // 每天开放预约的时刻 HH:MM
+	DaysAhead     int32                  `protobuf:"varint,2,opt,name=days_ahead,json=daysAhead,proto3" json:"days_ahead,omitempty"`            // 开放预约的日期距今天的天数,0 表示当天
+	Rounds        int32                  `protobuf:"varint,3,opt,name=rounds,proto3" json:"rounds,omitempty"`                                   // 开放时刻后的尝试轮数
+	RoundInterval *durationpb.Duration   `protobuf:"bytes,4,opt,name=round_interval,json=roundInterval,proto3" json:"round_interval,omitempty"` // 每轮之间的间隔
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reserve) Reset() {
+	*x = Reserve{}
+	mi := &file_conf_conf_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reserve) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reserve) ProtoMessage() {}
+
+func (x *Reserve) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reserve.ProtoReflect.Descriptor instead.
+func (*Reserve) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Reserve) GetOpenTime() string {
+	if x != nil {
+		return x.OpenTime
+	}
+	return ""
+}
+
+func (x *Reserve) GetDaysAhead() int32 {
+	if x != nil {
+		return x.DaysAhead
+	}
+	return 0
+}
+
+func (x *Reserve) GetRounds() int32 {
+	if x != nil {
+		return x.Rounds
+	}
+	return 0
+}
+
+func (x *Reserve) GetRoundInterval() *durationpb.Duration {
+	if x != nil {
+		return x.RoundInterval
+	}
+	return nil
+}
+
+// 预约提醒配置,时长为 0 时使用默认值
+type Reminder struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Interval      *durationpb.Duration   `protobuf:"bytes,1,opt,name=interval,proto3" json:"interval,omitempty"`                               // 扫描预约记录的间隔
+	StartLead     *durationpb.Duration   `protobuf:"bytes,2,opt,name=start_lead,json=startLead,proto3" json:"start_lead,omitempty"`            // 预约开始前多久提醒
+	CheckInGrace  *durationpb.Duration   `protobuf:"bytes,3,opt,name=check_in_grace,json=checkInGrace,proto3" json:"check_in_grace,omitempty"` // 预约开始后多久内需要签到
+	CheckInLead   *durationpb.Duration   `protobuf:"bytes,4,opt,name=check_in_lead,json=checkInLead,proto3" json:"check_in_lead,omitempty"`    // 签到截止前多久提醒
+	ExpireLead    *durationpb.Duration   `protobuf:"bytes,5,opt,name=expire_lead,json=expireLead,proto3" json:"expire_lead,omitempty"`         // 预约结束前多久提醒
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reminder) Reset() {
+	*x = Reminder{}
+	mi := &file_conf_conf_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reminder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reminder) ProtoMessage() {}
+
+func (x *Reminder) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reminder.ProtoReflect.Descriptor instead.
+func (*Reminder) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Reminder) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *Reminder) GetStartLead() *durationpb.Duration {
+	if x != nil {
+		return x.StartLead
+	}
+	return nil
+}
+
+func (x *Reminder) GetCheckInGrace() *durationpb.Duration {
+	if x != nil {
+		return x.CheckInGrace
+	}
+	return nil
+}
+
+func (x *Reminder) GetCheckInLead() *durationpb.Duration {
+	if x != nil {
+		return x.CheckInLead
+	}
+	return nil
+}
+
+func (x *Reminder) GetExpireLead() *durationpb.Duration {
+	if x != nil {
+		return x.ExpireLead
+	}
+	return nil
+}
+
+// 座位评论配置,为 0 时使用默认值
+type Comment struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	MaxLength       int32                  `protobuf:"varint,1,opt,name=max_length,json=maxLength,proto3" json:"max_length,omitempty"`                   // 评论内容的最大字数
+	HourlyLimit     int32                  `protobuf:"varint,2,opt,name=hourly_limit,json=hourlyLimit,proto3" json:"hourly_limit,omitempty"`             // 每个用户每小时最多发表的评论数
+	MinInterval     *durationpb.Duration   `protobuf:"bytes,3,opt,name=min_interval,json=minInterval,proto3" json:"min_interval,omitempty"`              // 同一用户两次发表评论的最小间隔
+	ReportThreshold int32                  `protobuf:"varint,4,opt,name=report_threshold,json=reportThreshold,proto3" json:"report_threshold,omitempty"` // 被举报多少次后自动隐藏等待审核
+	BannedWords     []string               `protobuf:"bytes,5,rep,name=banned_words,json=bannedWords,proto3" json:"banned_words,omitempty"`              // 屏蔽词,为空时使用内置的列表
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Comment) Reset() {
+	*x = Comment{}
+	mi := &file_conf_conf_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Comment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Comment) ProtoMessage() {}
+
+func (x *Comment) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
+func (*Comment) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Comment) GetMaxLength() int32 {
+	if x != nil {
+		return x.MaxLength
+	}
+	return 0
+}
+
+func (x *Comment) GetHourlyLimit() int32 {
+	if x != nil {
+		return x.HourlyLimit
+	}
+	return 0
+}
+
+func (x *Comment) GetMinInterval() *durationpb.Duration {
+	if x != nil {
+		return x.MinInterval
+	}
+	return nil
+}
+
+func (x *Comment) GetReportThreshold() int32 {
+	if x != nil {
+		return x.ReportThreshold
+	}
+	return 0
+}
+
+func (x *Comment) GetBannedWords() []string {
+	if x != nil {
+		return x.BannedWords
+	}
+	return nil
+}
+
+// 研讨间配置
+type Discussion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClassIds      []string               `protobuf:"bytes,1,rep,name=class_ids,json=classIds,proto3" json:"class_ids,omitempty"`        // 研讨间类别 class_id,查找空闲时段时遍历
+	OpenTime      string                 `protobuf:"bytes,2,opt,name=open_time,json=openTime,proto3" json:"open_time,omitempty"`        // 研讨间开放时刻 HH:MM
+	CloseTime     string                 `protobuf:"bytes,3,opt,name=close_time,json=closeTime,proto3" json:"close_time,omitempty"`     // 研讨间关闭时刻 HH:MM
+	MaxMembers    int32                  `protobuf:"varint,4,opt,name=max_members,json=maxMembers,proto3" json:"max_members,omitempty"` // 学习小组最多的成员数,不包括创建者
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Discussion) Reset() {
+	*x = Discussion{}
+	mi := &file_conf_conf_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Discussion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Discussion) ProtoMessage() {}
+
+func (x *Discussion) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Discussion.ProtoReflect.Descriptor instead.
+func (*Discussion) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Discussion) GetClassIds() []string {
+	if x != nil {
+		return x.ClassIds
+	}
+	return nil
+}
+
+func (x *Discussion) GetOpenTime() string {
+	if x != nil {
+		return x.OpenTime
+	}
+	return ""
+}
+
+func (x *Discussion) GetCloseTime() string {
+	if x != nil {
+		return x.CloseTime
+	}
+	return ""
+}
+
+func (x *Discussion) GetMaxMembers() int32 {
+	if x != nil {
+		return x.MaxMembers
+	}
+	return 0
+}
+
+// 房间目录配置,定期任务需要配置 stu_id 才会执行
+type Room struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	RefreshInterval  *durationpb.Duration   `protobuf:"bytes,1,opt,name=refresh_interval,json=refreshInterval,proto3" json:"refresh_interval,omitempty"`    // 按目录刷新座位缓存的间隔,为 0 时不定期刷新
+	DiscoverInterval *durationpb.Duration   `protobuf:"bytes,2,opt,name=discover_interval,json=discoverInterval,proto3" json:"discover_interval,omitempty"` // 从图书馆系统发现新房间的间隔,为 0 时不自动发现
+	StuId            string                 `protobuf:"bytes,3,opt,name=stu_id,json=stuId,proto3" json:"stu_id,omitempty"`                                  // 定期任务访问图书馆系统使用的学号
+	OpenTime         string                 `protobuf:"bytes,4,opt,name=open_time,json=openTime,proto3" json:"open_time,omitempty"`                         // 新发现房间的开放时刻 HH:MM
+	CloseTime        string                 `protobuf:"bytes,5,opt,name=close_time,json=closeTime,proto3" json:"close_time,omitempty"`                      // 新发现房间的关闭时刻 HH:MM
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Room) Reset() {
+	*x = Room{}
+	mi := &file_conf_conf_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Room) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Room) ProtoMessage() {}
+
+func (x *Room) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Room.ProtoReflect.Descriptor instead.
+func (*Room) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Room) GetRefreshInterval() *durationpb.Duration {
+	if x != nil {
+		return x.RefreshInterval
+	}
+	return nil
+}
+
+func (x *Room) GetDiscoverInterval() *durationpb.Duration {
+	if x != nil {
+		return x.DiscoverInterval
+	}
+	return nil
+}
+
+func (x *Room) GetStuId() string {
+	if x != nil {
+		return x.StuId
+	}
+	return ""
+}
+
+func (x *Room) GetOpenTime() string {
+	if x != nil {
+		return x.OpenTime
+	}
+	return ""
+}
+
+func (x *Room) GetCloseTime() string {
+	if x != nil {
+		return x.CloseTime
+	}
+	return ""
+}
+
+type Crawler struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BaseUrl       string                 `protobuf:"bytes,1,opt,name=base_url,json=baseUrl,proto3" json:"base_url,omitempty"` // 图书馆预约系统的地址,为空时使用 http://kjyy.ccnu.edu.cn
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Crawler) Reset() {
+	*x = Crawler{}
+	mi := &file_conf_conf_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Crawler) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Crawler) ProtoMessage() {}
+
+func (x *Crawler) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Crawler.ProtoReflect.Descriptor instead.
+func (*Crawler) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Crawler) GetBaseUrl() string {
+	if x != nil {
+		return x.BaseUrl
+	}
+	return ""
+}
+
 type Etcd struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Addr          string                 `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
@@ -365,7 +834,7 @@ type Etcd struct {
 
 func (x *Etcd) Reset() {
 	*x = Etcd{}
-	mi := &file_conf_conf_proto_msgTypes[5]
+	mi := &file_conf_conf_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -377,7 +846,7 @@ func (x *Etcd) String() string {
 func (*Etcd) ProtoMessage() {}
 
 func (x *Etcd) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[5]
+	mi := &file_conf_conf_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -390,7 +859,7 @@ func (x *Etcd) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Etcd.ProtoReflect.Descriptor instead.
 func (*Etcd) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{5}
+	return file_conf_conf_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *Etcd) GetAddr() string {
@@ -425,7 +894,7 @@ type Server_GRPC struct {
 
 func (x *Server_GRPC) Reset() {
 	*x = Server_GRPC{}
-	mi := &file_conf_conf_proto_msgTypes[6]
+	mi := &file_conf_conf_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -437,7 +906,7 @@ func (x *Server_GRPC) String() string {
 func (*Server_GRPC) ProtoMessage() {}
 
 func (x *Server_GRPC) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[6]
+	mi := &file_conf_conf_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -483,7 +952,7 @@ type Data_Database struct {
 
 func (x *Data_Database) Reset() {
 	*x = Data_Database{}
-	mi := &file_conf_conf_proto_msgTypes[7]
+	mi := &file_conf_conf_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -495,7 +964,7 @@ func (x *Data_Database) String() string {
 func (*Data_Database) ProtoMessage() {}
 
 func (x *Data_Database) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[7]
+	mi := &file_conf_conf_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -532,7 +1001,7 @@ type Data_Redis struct {
 
 func (x *Data_Redis) Reset() {
 	*x = Data_Redis{}
-	mi := &file_conf_conf_proto_msgTypes[8]
+	mi := &file_conf_conf_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -544,7 +1013,7 @@ func (x *Data_Redis) String() string {
 func (*Data_Redis) ProtoMessage() {}
 
 func (x *Data_Redis) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[8]
+	mi := &file_conf_conf_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -607,12 +1076,21 @@ var File_conf_conf_proto protoreflect.FileDescriptor
 const file_conf_conf_proto_rawDesc = "" +
 	"\n" +
 	"\x0fconf/conf.proto\x12\n" +
-	"kratos.api\x1a\x1egoogle/protobuf/duration.proto\"\xc2\x01\n" +
+	"kratos.api\x1a\x1egoogle/protobuf/duration.proto\"\xdf\x03\n" +
 	"\tBootstrap\x12*\n" +
 	"\x06server\x18\x01 \x01(\v2\x12.kratos.api.ServerR\x06server\x12$\n" +
 	"\x04data\x18\x02 \x01(\v2\x10.kratos.api.DataR\x04data\x120\n" +
 	"\bregistry\x18\x03 \x01(\v2\x14.kratos.api.RegistryR\bregistry\x121\n" +
-	"\x06zaplog\x18\x04 \x01(\v2\x19.kratos.api.ZapLogConfigsR\x06zaplog\"\xb4\x01\n" +
+	"\x06zaplog\x18\x04 \x01(\v2\x19.kratos.api.ZapLogConfigsR\x06zaplog\x12-\n" +
+	"\areserve\x18\x05 \x01(\v2\x13.kratos.api.ReserveR\areserve\x120\n" +
+	"\breminder\x18\x06 \x01(\v2\x14.kratos.api.ReminderR\breminder\x12-\n" +
+	"\acomment\x18\a \x01(\v2\x13.kratos.api.CommentR\acomment\x126\n" +
+	"\n" +
+	"discussion\x18\b \x01(\v2\x16.kratos.api.DiscussionR\n" +
+	"discussion\x12-\n" +
+	"\acrawler\x18\t \x01(\v2\x13.kratos.api.CrawlerR\acrawler\x12$\n" +
+	"\x04room\x18\n" +
+	" \x01(\v2\x10.kratos.api.RoomR\x04room\"\xb4\x01\n" +
 	"\x06Server\x12+\n" +
 	"\x04grpc\x18\x01 \x01(\v2\x17.kratos.api.Server.GRPCR\x04grpc\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x1ai\n" +
@@ -643,10 +1121,49 @@ const file_conf_conf_proto_rawDesc = "" +
 	"\vlog_max_age\x18\a \x01(\x05R\tlogMaxAge\x12!\n" +
 	"\flog_compress\x18\b \x01(\bR\vlogCompress\x12\x1d\n" +
 	"\n" +
-	"log_stdout\x18\t \x01(\bR\tlogStdout\"J\n" +
+	"log_stdout\x18\t \x01(\bR\tlogStdout\"d\n" +
 	"\bRegistry\x12$\n" +
 	"\x04etcd\x18\x01 \x01(\v2\x10.kratos.api.EtcdR\x04etcd\x12\x18\n" +
-	"\ausersvc\x18\x02 \x01(\tR\ausersvc\"R\n" +
+	"\ausersvc\x18\x02 \x01(\tR\ausersvc\x12\x18\n" +
+	"\afeedsvc\x18\x03 \x01(\tR\afeedsvc\"\x9f\x01\n" +
+	"\aReserve\x12\x1b\n" +
+	"\topen_time\x18\x01 \x01(\tR\bopenTime\x12\x1d\n" +
+	"\n" +
+	"days_ahead\x18\x02 \x01(\x05R\tdaysAhead\x12\x16\n" +
+	"\x06rounds\x18\x03 \x01(\x05R\x06rounds\x12@\n" +
+	"\x0eround_interval\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\rroundInterval\"\xb7\x02\n" +
+	"\bReminder\x125\n" +
+	"\binterval\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\binterval\x128\n" +
+	"\n" +
+	"start_lead\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\tstartLead\x12?\n" +
+	"\x0echeck_in_grace\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\fcheckInGrace\x12=\n" +
+	"\rcheck_in_lead\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\vcheckInLead\x12:\n" +
+	"\vexpire_lead\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"expireLead\"\xd7\x01\n" +
+	"\aComment\x12\x1d\n" +
+	"\n" +
+	"max_length\x18\x01 \x01(\x05R\tmaxLength\x12!\n" +
+	"\fhourly_limit\x18\x02 \x01(\x05R\vhourlyLimit\x12<\n" +
+	"\fmin_interval\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\vminInterval\x12)\n" +
+	"\x10report_threshold\x18\x04 \x01(\x05R\x0freportThreshold\x12!\n" +
+	"\fbanned_words\x18\x05 \x03(\tR\vbannedWords\"\x86\x01\n" +
+	"\n" +
+	"Discussion\x12\x1b\n" +
+	"\tclass_ids\x18\x01 \x03(\tR\bclassIds\x12\x1b\n" +
+	"\topen_time\x18\x02 \x01(\tR\bopenTime\x12\x1d\n" +
+	"\n" +
+	"close_time\x18\x03 \x01(\tR\tcloseTime\x12\x1f\n" +
+	"\vmax_members\x18\x04 \x01(\x05R\n" +
+	"maxMembers\"\xe7\x01\n" +
+	"\x04Room\x12D\n" +
+	"\x10refresh_interval\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\x0frefreshInterval\x12F\n" +
+	"\x11discover_interval\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x10discoverInterval\x12\x15\n" +
+	"\x06stu_id\x18\x03 \x01(\tR\x05stuId\x12\x1b\n" +
+	"\topen_time\x18\x04 \x01(\tR\bopenTime\x12\x1d\n" +
+	"\n" +
+	"close_time\x18\x05 \x01(\tR\tcloseTime\"$\n" +
+	"\aCrawler\x12\x19\n" +
+	"\bbase_url\x18\x01 \x01(\tR\abaseUrl\"R\n" +
 	"\x04Etcd\x12\x12\n" +
 	"\x04addr\x18\x01 \x01(\tR\x04addr\x12\x1a\n" +
 	"\busername\x18\x02 \x01(\tR\busername\x12\x1a\n" +
@@ -664,37 +1181,58 @@ func file_conf_conf_proto_rawDescGZIP() []byte {
 	return file_conf_conf_proto_rawDescData
 }
 
-var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_conf_conf_proto_goTypes = []any{
 	(*Bootstrap)(nil),           // 0: kratos.api.Bootstrap
 	(*Server)(nil),              // 1: kratos.api.Server
 	(*Data)(nil),                // 2: kratos.api.Data
 	(*ZapLogConfigs)(nil),       // 3: kratos.api.ZapLogConfigs
 	(*Registry)(nil),            // 4: kratos.api.Registry
-	(*Etcd)(nil),                // 5: kratos.api.Etcd
-	(*Server_GRPC)(nil),         // 6: kratos.api.Server.GRPC
-	(*Data_Database)(nil),       // 7: kratos.api.Data.Database
-	(*Data_Redis)(nil),          // 8: kratos.api.Data.Redis
-	(*durationpb.Duration)(nil), // 9: google.protobuf.Duration
+	(*Reserve)(nil),             // 5: kratos.api.Reserve
+	(*Reminder)(nil),            // 6: kratos.api.Reminder
+	(*Comment)(nil),             // 7: kratos.api.Comment
+	(*Discussion)(nil),          // 8: kratos.api.Discussion
+	(*Room)(nil),                // 9: kratos.api.Room
+	(*Crawler)(nil),             // 10: kratos.api.Crawler
+	(*Etcd)(nil),                // 11: kratos.api.Etcd
+	(*Server_GRPC)(nil),         // 12: kratos.api.Server.GRPC
+	(*Data_Database)(nil),       // 13: kratos.api.Data.Database
+	(*Data_Redis)(nil),          // 14: kratos.api.Data.Redis
+	(*durationpb.Duration)(nil), // 15: google.protobuf.Duration
 }
 var file_conf_conf_proto_depIdxs = []int32{
 	1,  // 0: kratos.api.Bootstrap.server:type_name -> kratos.api.Server
 	2,  // 1: kratos.api.Bootstrap.data:type_name -> kratos.api.Data
 	4,  // 2: kratos.api.Bootstrap.registry:type_name -> kratos.api.Registry
 	3,  // 3: kratos.api.Bootstrap.zaplog:type_name -> kratos.api.ZapLogConfigs
-	6,  // 4: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
-	7,  // 5: kratos.api.Data.database:type_name -> kratos.api.Data.Database
-	8,  // 6: kratos.api.Data.redis:type_name -> kratos.api.Data.Redis
-	5,  // 7: kratos.api.Registry.etcd:type_name -> kratos.api.Etcd
-	9,  // 8: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
-	9,  // 9: kratos.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
-	9,  // 10: kratos.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
-	9,  // 11: kratos.api.Data.Redis.ttl:type_name -> google.protobuf.Duration
-	12, // [12:12] is the sub-list for method output_type
-	12, // [12:12] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	5,  // 4: kratos.api.Bootstrap.reserve:type_name -> kratos.api.Reserve
+	6,  // 5: kratos.api.Bootstrap.reminder:type_name -> kratos.api.Reminder
+	7,  // 6: kratos.api.Bootstrap.comment:type_name -> kratos.api.Comment
+	8,  // 7: kratos.api.Bootstrap.discussion:type_name -> kratos.api.Discussion
+	10, // 8: kratos.api.Bootstrap.crawler:type_name -> kratos.api.Crawler
+	9,  // 9: kratos.api.Bootstrap.room:type_name -> kratos.api.Room
+	12, // 10: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
+	13, // 11: kratos.api.Data.database:type_name -> kratos.api.Data.Database
+	14, // 12: kratos.api.Data.redis:type_name -> kratos.api.Data.Redis
+	11, // 13: kratos.api.Registry.etcd:type_name -> kratos.api.Etcd
+	15, // 14: kratos.api.Reserve.round_interval:type_name -> google.protobuf.Duration
+	15, // 15: kratos.api.Reminder.interval:type_name -> google.protobuf.Duration
+	15, // 16: kratos.api.Reminder.start_lead:type_name -> google.protobuf.Duration
+	15, // 17: kratos.api.Reminder.check_in_grace:type_name -> google.protobuf.Duration
+	15, // 18: kratos.api.Reminder.check_in_lead:type_name -> google.protobuf.Duration
+	15, // 19: kratos.api.Reminder.expire_lead:type_name -> google.protobuf.Duration
+	15, // 20: kratos.api.Comment.min_interval:type_name -> google.protobuf.Duration
+	15, // 21: kratos.api.Room.refresh_interval:type_name -> google.protobuf.Duration
+	15, // 22: kratos.api.Room.discover_interval:type_name -> google.protobuf.Duration
+	15, // 23: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
+	15, // 24: kratos.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
+	15, // 25: kratos.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
+	15, // 26: kratos.api.Data.Redis.ttl:type_name -> google.protobuf.Duration
+	27, // [27:27] is the sub-list for method output_type
+	27, // [27:27] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
 }
 
 func init() { file_conf_conf_proto_init() }
@@ -708,7 +1246,7 @@ func file_conf_conf_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_conf_conf_proto_rawDesc), len(file_conf_conf_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
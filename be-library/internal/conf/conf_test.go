@@ -0,0 +1,35 @@
+package conf
+
+import (
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/config/file"
+)
+
+// 随仓库提供的配置都需要能被服务加载,Duration 只接受秒的写法
+func TestShippedConfigs(t *testing.T) {
+	for _, path := range []string{
+		"../../configs/config example.yaml",
+		"../../../deployment/docker/configs/be-library.yaml",
+	} {
+		t.Run(path, func(t *testing.T) {
+			c := config.New(config.WithSource(file.NewSource(path)))
+			defer c.Close()
+			if err := c.Load(); err != nil {
+				t.Fatal(err)
+			}
+
+			var bc Bootstrap
+			if err := c.Scan(&bc); err != nil {
+				t.Fatal(err)
+			}
+			if bc.Reminder.GetInterval().AsDuration() <= 0 || bc.Reminder.GetCheckInGrace().AsDuration() <= 0 {
+				t.Fatalf("reminder intervals are not loaded: %v", bc.Reminder)
+			}
+			if bc.Room.GetRefreshInterval().AsDuration() <= 0 || bc.Room.GetDiscoverInterval().AsDuration() <= 0 {
+				t.Fatalf("room intervals are not loaded: %v", bc.Room)
+			}
+		})
+	}
+}
@@ -0,0 +1,558 @@
+// Package fake 模拟华师图书馆预约系统(IC 空间管理系统)的接口，用于离线测试爬虫和依赖爬虫的业务逻辑。
+//
+// Server 同时实现了 biz.CCNUServiceProxy，可以直接作为获取 cookie 的服务传给爬虫:
+//
+//	srv := fake.NewServer()
+//	defer srv.Close()
+//	pool := client.NewCookiePool(time.Minute, srv.URL())
+//	c := crawler.NewLibraryCrawler(logger, pool, srv, time.Second)
+package fake
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// SessionCookie 预约系统的会话 cookie
+	SessionCookie = "ASP.NET_SessionId"
+
+	timeLayout = "2006-01-02 15:04"
+
+	statusReserved  = "预约成功"
+	statusCancelled = "已取消"
+)
+
+// Room 自习室及其座位
+type Room struct {
+	ID      string
+	LabName string
+	Name    string
+	Seats   []Seat
+}
+
+type Seat struct {
+	DevID   string
+	DevName string
+}
+
+// DiscussionRoom 研讨间，按 ClassID 查询
+type DiscussionRoom struct {
+	ClassID  string
+	LabID    string
+	LabName  string
+	KindID   string
+	KindName string
+	DevID    string
+	DevName  string
+}
+
+// Account 预约系统中的用户，可以被搜索并加入研讨间预约
+type Account struct {
+	ID        string
+	StudentID string
+	Name      string
+}
+
+type CreditRecord struct {
+	Title    string
+	Subtitle string
+	Location string
+}
+
+// Reservation 座位或研讨间的预约
+type Reservation struct {
+	ID         string
+	Owner      string // 预约人学号
+	DevID      string
+	Start      time.Time
+	End        time.Time
+	Title      string
+	Members    []string // 研讨间成员的账号 id
+	Status     string
+	SubmitTime time.Time
+}
+
+// device 座位或研讨间，研讨间的房间为研讨间类别
+type device struct {
+	name     string
+	roomID   string
+	roomName string
+	labName  string
+	seat     bool
+}
+
+type credit struct {
+	remain  int
+	total   int
+	records []CreditRecord
+}
+
+// Server 模拟的预约系统，所有方法都可以并发调用
+type Server struct {
+	srv *httptest.Server
+
+	mu           sync.Mutex
+	rooms        []*Room
+	discussions  []*DiscussionRoom
+	devices      map[string]*device
+	accounts     map[string]*Account // 学号 -> 账号
+	sessions     map[string]string   // 会话 -> 学号
+	cookies      map[string]string   // 学号 -> 最近签发的会话
+	reservations []*Reservation
+	credits      map[string]*credit
+	nextID       int
+}
+
+func NewServer() *Server {
+	s := &Server{
+		devices:  map[string]*device{},
+		accounts: map[string]*Account{},
+		sessions: map[string]string{},
+		cookies:  map[string]string{},
+		credits:  map[string]*credit{},
+		nextID:   100000,
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/ClientWeb/pro/ajax/device.aspx", s.handleDevice)
+	mux.HandleFunc("/ClientWeb/pro/ajax/reserve.aspx", s.handleReserve)
+	mux.HandleFunc("/ClientWeb/pro/ajax/data/searchAccount.aspx", s.handleSearchAccount)
+	mux.HandleFunc("/clientweb/m/a/resvlist.aspx", s.handleHistory)
+	mux.HandleFunc("/clientweb/m/a/credit.aspx", s.handleCredit)
+	s.srv = httptest.NewServer(mux)
+	return s
+}
+
+// URL 模拟服务的地址，作为爬虫的 base_url
+func (s *Server) URL() string {
+	return s.srv.URL
+}
+
+func (s *Server) Close() {
+	s.srv.Close()
+}
+
+func (s *Server) AddRoom(room Room) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rooms = append(s.rooms, &room)
+	for _, seat := range room.Seats {
+		s.devices[seat.DevID] = &device{name: seat.DevName, roomID: room.ID, roomName: room.Name, labName: room.LabName, seat: true}
+	}
+}
+
+func (s *Server) AddDiscussionRoom(room DiscussionRoom) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.discussions = append(s.discussions, &room)
+	s.devices[room.DevID] = &device{name: room.DevName, roomID: room.LabID, roomName: room.KindName, labName: room.LabName}
+}
+
+func (s *Server) AddAccount(account Account) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.accounts[account.StudentID] = &account
+}
+
+// SetCredit 设置学生的信誉分，未设置时为 300/300
+func (s *Server) SetCredit(stuID string, remain, total int, records ...CreditRecord) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.credits[stuID] = &credit{remain: remain, total: total, records: records}
+}
+
+// GetLibraryCookie 返回最近签发给该学生的 cookie，没有时登录，实现 biz.CCNUServiceProxy
+func (s *Server) GetLibraryCookie(_ context.Context, stuID string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	token, ok := s.cookies[stuID]
+	if !ok {
+		token = s.login(stuID)
+	}
+	return SessionCookie + "=" + token, nil
+}
+
+// Login 重新登录，之后 GetLibraryCookie 返回新的 cookie
+func (s *Server) Login(stuID string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return SessionCookie + "=" + s.login(stuID)
+}
+
+// ExpireSession 使该学生的会话失效，GetLibraryCookie 仍返回旧的 cookie，模拟缓存的 cookie 过期
+func (s *Server) ExpireSession(stuID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for token, owner := range s.sessions {
+		if owner == stuID {
+			delete(s.sessions, token)
+		}
+	}
+}
+
+// Reserve 直接添加一条预约，用于构造被占用的座位
+func (s *Server) Reserve(stuID, devID, start, end string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	r, msg := s.reserve(stuID, devID, start, end, "", nil)
+	if r == nil {
+		return "", fmt.Errorf("%s", msg)
+	}
+	return r.ID, nil
+}
+
+// Cancel 直接取消一条预约
+func (s *Server) Cancel(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, res := range s.reservations {
+		if res.ID == id {
+			res.Status = statusCancelled
+		}
+	}
+}
+
+// Reservations 返回该学生未取消的预约，按开始时间排序
+func (s *Server) Reservations(stuID string) []Reservation {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var out []Reservation
+	for _, r := range s.activeReservations(stuID) {
+		out = append(out, *r)
+	}
+	return out
+}
+
+func (s *Server) login(stuID string) string {
+	s.nextID++
+	token := "session-" + strconv.Itoa(s.nextID)
+	s.sessions[token] = stuID
+	s.cookies[stuID] = token
+	return token
+}
+
+// session 返回请求对应的学号，会话无效时返回 false
+func (s *Server) session(r *http.Request) (string, bool) {
+	cookie, err := r.Cookie(SessionCookie)
+	if err != nil {
+		return "", false
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stuID, ok := s.sessions[cookie.Value]
+	return stuID, ok
+}
+
+func (s *Server) handleDevice(w http.ResponseWriter, r *http.Request) {
+	if _, ok := s.session(r); !ok {
+		writeRet(w, -1, "未登录或登录超时")
+		return
+	}
+
+	q := r.URL.Query()
+	if q.Get("act") != "get_rsv_sta" {
+		writeRet(w, 0, "未知操作")
+		return
+	}
+	date := q.Get("date")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var data []map[string]any
+	switch q.Get("classkind") {
+	case "8":
+		// 不带 room_id 时返回所有房间
+		roomID := q.Get("room_id")
+		for _, room := range s.rooms {
+			if roomID != "" && room.ID != roomID {
+				continue
+			}
+			for _, seat := range room.Seats {
+				data = append(data, map[string]any{
+					"labName":  room.LabName,
+					"roomName": room.Name,
+					"roomId":   room.ID,
+					"devId":    seat.DevID,
+					"devName":  seat.DevName,
+					"ts":       s.timeSlots(seat.DevID, date),
+				})
+			}
+		}
+	case "1":
+		classID := q.Get("class_id")
+		for _, room := range s.discussions {
+			if room.ClassID != classID {
+				continue
+			}
+			data = append(data, map[string]any{
+				"labId":    room.LabID,
+				"labName":  room.LabName,
+				"kindId":   room.KindID,
+				"kindName": room.KindName,
+				"devId":    room.DevID,
+				"devName":  room.DevName,
+				"ts":       s.timeSlots(room.DevID, date),
+			})
+		}
+	}
+	writeJSON(w, map[string]any{"ret": 1, "act": "get_rsv_sta", "msg": "ok", "data": data})
+}
+
+func (s *Server) handleReserve(w http.ResponseWriter, r *http.Request) {
+	stuID, ok := s.session(r)
+	if !ok {
+		writeRet(w, -1, "未登录或登录超时")
+		return
+	}
+
+	q := r.URL.Query()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch q.Get("act") {
+	case "set_resv":
+		var members []string
+		if list := strings.TrimPrefix(q.Get("mb_list"), "$"); list != "" {
+			members = strings.Split(list, ",")
+		}
+		dev := s.devices[q.Get("dev_id")]
+		if dev != nil && !dev.seat {
+			minUser, _ := strconv.Atoi(q.Get("min_user"))
+			maxUser, _ := strconv.Atoi(q.Get("max_user"))
+			if n := len(members) + 1; n < minUser || (maxUser > 0 && n > maxUser) {
+				writeRet(w, 0, "参与人数不符合要求")
+				return
+			}
+			if !s.knownAccounts(members) {
+				writeRet(w, 0, "成员不存在")
+				return
+			}
+		}
+		if _, msg := s.reserve(stuID, q.Get("dev_id"), q.Get("start"), q.Get("end"), q.Get("test_name"), members); msg != "" {
+			writeRet(w, 0, msg)
+			return
+		}
+		writeRet(w, 1, "操作成功")
+	case "get_my_resv":
+		var data []map[string]any
+		for _, res := range s.activeReservations(stuID) {
+			dev := s.devices[res.DevID]
+			data = append(data, map[string]any{
+				"id":       res.ID,
+				"owner":    s.ownerName(res.Owner),
+				"start":    res.Start.Format(timeLayout),
+				"end":      res.End.Format(timeLayout),
+				"timeDesc": res.Start.Format("01-02 15:04") + "-" + res.End.Format("15:04"),
+				"states":   "<span class='text-primary'>" + res.Status + "</span>",
+				"devName":  dev.name,
+				"devId":    res.DevID,
+				"roomId":   dev.roomID,
+				"roomName": dev.roomName,
+				"labName":  dev.labName,
+			})
+		}
+		writeJSON(w, map[string]any{"ret": 1, "act": "get_my_resv", "msg": "ok", "data": data})
+	case "del_resv":
+		for _, res := range s.reservations {
+			if res.ID == q.Get("id") && res.Owner == stuID && res.Status == statusReserved {
+				res.Status = statusCancelled
+				writeRet(w, 1, "操作成功")
+				return
+			}
+		}
+		writeRet(w, 0, "预约不存在或已取消")
+	default:
+		writeRet(w, 0, "未知操作")
+	}
+}
+
+func (s *Server) handleSearchAccount(w http.ResponseWriter, r *http.Request) {
+	if _, ok := s.session(r); !ok {
+		writeRet(w, -1, "未登录或登录超时")
+		return
+	}
+
+	term := r.URL.Query().Get("term")
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data := []map[string]string{}
+	if a, ok := s.accounts[term]; ok {
+		data = append(data, map[string]string{
+			"id":    a.ID,
+			"Pid":   a.StudentID,
+			"name":  a.Name,
+			"label": fmt.Sprintf("%s(%s)", a.Name, a.StudentID),
+		})
+	}
+	writeJSON(w, data)
+}
+
+func (s *Server) handleHistory(w http.ResponseWriter, r *http.Request) {
+	stuID, ok := s.session(r)
+	if !ok {
+		writeLoginPage(w)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var b strings.Builder
+	b.WriteString("<html><body><ul>")
+	for _, res := range s.reservations {
+		if res.Owner != stuID {
+			continue
+		}
+		dev := s.devices[res.DevID]
+		fmt.Fprintf(&b, `<li class="item-content"><div class="item-title">%s</div><div class="item-after">%s</div>`+
+			`<div class="item-subtitle">%s</div><div class="item-text">%s, %s, %s</div></li>`,
+			html.EscapeString(dev.name), res.Status,
+			res.Start.Format(timeLayout)+"-"+res.End.Format("15:04"),
+			html.EscapeString(dev.labName), html.EscapeString(dev.roomName), res.SubmitTime.Format("2006-01-02 15:04:05"))
+	}
+	b.WriteString("</ul></body></html>")
+	writeHTML(w, b.String())
+}
+
+func (s *Server) handleCredit(w http.ResponseWriter, r *http.Request) {
+	stuID, ok := s.session(r)
+	if !ok {
+		writeLoginPage(w)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c := s.credits[stuID]
+	if c == nil {
+		c = &credit{remain: 300, total: 300}
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "<html><body><table><tbody><tr><td>个人预约制度</td><td>%d</td><td>%d</td></tr></tbody></table>", c.remain, c.total)
+	b.WriteString(`<ul id="my_resv_list">`)
+	for _, rec := range c.records {
+		fmt.Fprintf(&b, `<li><div class="item-title">%s</div><div class="item-subtitle">%s</div><div class="item-text">%s</div></li>`,
+			html.EscapeString(rec.Title), html.EscapeString(rec.Subtitle), html.EscapeString(rec.Location))
+	}
+	b.WriteString("</ul></body></html>")
+	writeHTML(w, b.String())
+}
+
+// reserve 检查时间和冲突后添加预约，失败时返回原因
+func (s *Server) reserve(stuID, devID, start, end, title string, members []string) (*Reservation, string) {
+	dev := s.devices[devID]
+	if dev == nil {
+		return nil, "设备不存在"
+	}
+	st, err1 := time.ParseInLocation(timeLayout, start, time.Local)
+	et, err2 := time.ParseInLocation(timeLayout, end, time.Local)
+	if err1 != nil || err2 != nil || !et.After(st) {
+		return nil, "预约时间参数错误"
+	}
+
+	for _, res := range s.reservations {
+		if res.DevID == devID && res.Status == statusReserved && res.Start.Before(et) && st.Before(res.End) {
+			return nil, "该时间段已被预约"
+		}
+	}
+
+	s.nextID++
+	res := &Reservation{
+		ID:         strconv.Itoa(s.nextID),
+		Owner:      stuID,
+		DevID:      devID,
+		Start:      st,
+		End:        et,
+		Title:      title,
+		Members:    members,
+		Status:     statusReserved,
+		SubmitTime: time.Now(),
+	}
+	s.reservations = append(s.reservations, res)
+	return res, ""
+}
+
+func (s *Server) activeReservations(stuID string) []*Reservation {
+	var out []*Reservation
+	for _, res := range s.reservations {
+		if res.Owner == stuID && res.Status == statusReserved {
+			out = append(out, res)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Start.Before(out[j].Start) })
+	return out
+}
+
+// timeSlots 设备在 date 当天被占用的时间段
+func (s *Server) timeSlots(devID, date string) []map[string]any {
+	ts := []map[string]any{}
+	for _, res := range s.reservations {
+		if res.DevID != devID || res.Status != statusReserved || res.Start.Format("2006-01-02") != date {
+			continue
+		}
+		ts = append(ts, map[string]any{
+			"start":  res.Start.Format(timeLayout),
+			"end":    res.End.Format(timeLayout),
+			"state":  "undo",
+			"title":  res.Title,
+			"owner":  s.ownerName(res.Owner),
+			"occupy": true,
+		})
+	}
+	return ts
+}
+
+func (s *Server) knownAccounts(ids []string) bool {
+	for _, id := range ids {
+		found := false
+		for _, a := range s.accounts {
+			if a.ID == id {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Server) ownerName(stuID string) string {
+	if a, ok := s.accounts[stuID]; ok {
+		return a.Name
+	}
+	return stuID
+}
+
+func writeRet(w http.ResponseWriter, ret int, msg string) {
+	writeJSON(w, map[string]any{"ret": ret, "msg": msg, "data": nil})
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeHTML(w http.ResponseWriter, body string) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	_, _ = w.Write([]byte(body))
+}
+
+// writeLoginPage 会话失效时页面跳转到登录页，页面中没有任何记录
+func writeLoginPage(w http.ResponseWriter) {
+	writeHTML(w, "<html><body><form id=\"login\">请先登录</form></body></html>")
+}
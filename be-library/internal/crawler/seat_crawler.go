@@ -22,16 +22,13 @@ import (
 	"github.com/tidwall/gjson"
 )
 
-// 定义全局URL常量
-const (
-	BaseDomain = "http://kjyy.ccnu.edu.cn"
-)
-
 // API端点路径
 var (
 	DeviceAPIPath     = "/ClientWeb/pro/ajax/device.aspx"
 	ReserveAPIPath    = "/ClientWeb/pro/ajax/reserve.aspx"
 	SearchAccountPath = "/ClientWeb/pro/ajax/data/searchAccount.aspx"
+	HistoryPagePath   = "/clientweb/m/a/resvlist.aspx"
+	CreditPagePath    = "/clientweb/m/a/credit.aspx"
 )
 
 // Crawler 主爬虫结构体
@@ -40,15 +37,17 @@ type Crawler struct {
 	cookiePool *client.CookiePool
 	ccnu       biz.CCNUServiceProxy
 	waitTime   time.Duration
+	baseURL    string
 }
 
-// NewLibraryCrawler 创建新的图书馆爬虫
+// NewLibraryCrawler 创建新的图书馆爬虫，请求发往 cookiePool 配置的预约系统地址
 func NewLibraryCrawler(logger log.Logger, cookiePool *client.CookiePool, ccnu biz.CCNUServiceProxy, waitTime time.Duration) biz.LibraryCrawler {
 	return &Crawler{
 		log:        log.NewHelper(logger),
 		cookiePool: cookiePool,
 		ccnu:       ccnu,
 		waitTime:   waitTime,
+		baseURL:    cookiePool.BaseURL(),
 	}
 }
 
@@ -69,8 +68,8 @@ func (c *Crawler) getClient(ctx context.Context, stuID string) (*client.CookieCl
 }
 
 // buildURL 构建带参数的URL
-func buildURL(path string, params url.Values) (string, error) {
-	baseURL := BaseDomain + path
+func (c *Crawler) buildURL(path string, params url.Values) (string, error) {
+	baseURL := c.baseURL + path
 
 	// 创建URL对象
 	u, err := url.Parse(baseURL)
@@ -84,6 +83,14 @@ func buildURL(path string, params url.Values) (string, error) {
 	return u.String(), nil
 }
 
+// checkRet 接口返回 ret 小于 0 (如登录失效)时返回错误，避免把失败当作没有数据
+func checkRet(body []byte) error {
+	if ret := gjson.GetBytes(body, "ret"); ret.Exists() && ret.Int() < 0 {
+		return fmt.Errorf("%s", gjson.GetBytes(body, "msg").String())
+	}
+	return nil
+}
+
 // doRequest 通用HTTP请求函数
 func (c *Crawler) doRequest(ctx context.Context, client *client.CookieClient, method, url string, body io.Reader) (*http.Response, error) {
 	return tool.Retry(func() (*http.Response, error) {
@@ -138,17 +145,19 @@ func (c *Crawler) GetSeatInfos(ctx context.Context, stuID string, roomIDs []stri
 	return results, nil
 }
 
-// getSeatInfos 获取指定房间的座位信息
+// getSeatInfos 获取指定房间的座位信息，roomid 为空时返回所有房间的座位
 func (c *Crawler) getSeatInfos(ctx context.Context, client *client.CookieClient, roomid string) ([]*biz.Seat, error) {
 	date := time.Now().Format("2006-01-02")
 
 	params := url.Values{}
 	params.Add("classkind", "8")
-	params.Add("room_id", roomid)
+	if roomid != "" {
+		params.Add("room_id", roomid)
+	}
 	params.Add("date", date)
 	params.Add("act", "get_rsv_sta")
 
-	fullURL, err := buildURL(DeviceAPIPath, params)
+	fullURL, err := c.buildURL(DeviceAPIPath, params)
 	if err != nil {
 		return nil, errcode.ErrCrawler
 	}
@@ -164,6 +173,10 @@ func (c *Crawler) getSeatInfos(ctx context.Context, client *client.CookieClient,
 		return nil, err
 	}
 
+	if err = checkRet(body); err != nil {
+		return nil, err
+	}
+
 	// 用 gjson 解析
 	data := gjson.GetBytes(body, "data")
 	if !data.Exists() {
@@ -202,6 +215,37 @@ func (c *Crawler) getSeatInfos(ctx context.Context, client *client.CookieClient,
 	return result, nil
 }
 
+// ListRooms 拉取所有房间的座位，按房间汇总出房间列表和座位数
+func (c *Crawler) ListRooms(ctx context.Context, stuID string) ([]*biz.Room, error) {
+	cli, err := c.getClient(ctx, stuID)
+	if err != nil {
+		c.log.Errorf("Error getting client(stu_id:%v): %v", stuID, err)
+		return nil, err
+	}
+
+	seats, err := c.getSeatInfos(ctx, cli, "")
+	if err != nil {
+		c.log.Errorf("获取全部房间失败: %v", err)
+		return nil, err
+	}
+
+	var rooms []*biz.Room
+	byID := make(map[string]*biz.Room)
+	for _, seat := range seats {
+		if seat.RoomID == "" {
+			continue
+		}
+		room, ok := byID[seat.RoomID]
+		if !ok {
+			room = &biz.Room{ID: seat.RoomID, Name: seat.RoomName, LabName: seat.LabName}
+			byID[seat.RoomID] = room
+			rooms = append(rooms, room)
+		}
+		room.SeatCount++
+	}
+	return rooms, nil
+}
+
 // test
 func (c *Crawler) GetLibraryCookie(stuid string) (string, error) {
 	return c.ccnu.GetLibraryCookie(context.Background(), stuid)
@@ -221,7 +265,7 @@ func (c *Crawler) ReserveSeat(ctx context.Context, stuID string, devid, start, e
 	params.Add("end", end)
 	params.Add("act", "set_resv")
 
-	fullURL, err := buildURL(ReserveAPIPath, params)
+	fullURL, err := c.buildURL(ReserveAPIPath, params)
 	if err != nil {
 		return "", errcode.ErrCrawler
 	}
@@ -243,7 +287,7 @@ func (c *Crawler) ReserveSeat(ctx context.Context, stuID string, devid, start, e
 	}
 
 	if ReserveResp.Ret != 1 {
-		return "", fmt.Errorf(ReserveResp.Msg)
+		return "", fmt.Errorf("%s", ReserveResp.Msg)
 	}
 
 	return ReserveResp.Msg, nil
@@ -260,7 +304,7 @@ func (c *Crawler) GetRecord(ctx context.Context, stuID string) ([]*biz.FutureRec
 	params := url.Values{}
 	params.Add("act", "get_my_resv")
 
-	fullURL, err := buildURL(ReserveAPIPath, params)
+	fullURL, err := c.buildURL(ReserveAPIPath, params)
 	if err != nil {
 		return nil, errcode.ErrCrawler
 	}
@@ -276,6 +320,10 @@ func (c *Crawler) GetRecord(ctx context.Context, stuID string) ([]*biz.FutureRec
 		return nil, err
 	}
 
+	if err = checkRet(body); err != nil {
+		return nil, err
+	}
+
 	// 用 gjson 解析
 	data := gjson.GetBytes(body, "data")
 	if !data.Exists() {
@@ -311,6 +359,7 @@ func (c *Crawler) GetRecord(ctx context.Context, stuID string) ([]*biz.FutureRec
 			TimeDesc: item.Get("timeDesc").String(),
 			States:   strings.Join(plainStates, ","),
 			DevName:  item.Get("devName").String(),
+			DevID:    item.Get("devId").String(),
 			RoomID:   item.Get("roomId").String(),
 			RoomName: item.Get("roomName").String(),
 			LabName:  item.Get("labName").String(),
@@ -330,7 +379,7 @@ func (c *Crawler) GetHistory(ctx context.Context, stuID string) ([]*biz.HistoryR
 		return nil, err
 	}
 
-	fullURL := "http://kjyy.ccnu.edu.cn/clientweb/m/a/resvlist.aspx"
+	fullURL := c.baseURL + HistoryPagePath
 
 	resp, err := c.doRequest(ctx, cli, "GET", fullURL, nil)
 	if err != nil {
@@ -351,15 +400,17 @@ func (c *Crawler) GetHistory(ctx context.Context, stuID string) ([]*biz.HistoryR
 		date := item.Find(".item-subtitle").Text()
 		submitText := item.Find(".item-text").Text()
 		submitParts := strings.Split(submitText, ",")
-		if len(submitParts) >= 2 {
+		if len(submitParts) >= 3 {
 			floor := submitParts[0]
 			floor = strings.TrimSpace(floor)
+			room := strings.TrimSpace(submitParts[1])
 			submitTime := submitParts[2]
 			submitTime = strings.TrimSpace(submitTime)
 
 			records = append(records, &biz.HistoryRecords{
 				Place:      place,
 				Floor:      floor,
+				Room:       room,
 				Status:     status,
 				Date:       date,
 				SubmitTime: submitTime,
@@ -378,7 +429,7 @@ func (c *Crawler) GetCreditPoint(ctx context.Context, stuID string) (*biz.Credit
 		return nil, err
 	}
 
-	fullURL := "http://kjyy.ccnu.edu.cn/clientweb/m/a/credit.aspx"
+	fullURL := c.baseURL + CreditPagePath
 
 	resp, err := c.doRequest(ctx, cli, "GET", fullURL, nil)
 	if err != nil {
@@ -435,7 +486,7 @@ func (c *Crawler) GetDiscussion(ctx context.Context, stuID string, classid, date
 	params.Add("date", date)
 	params.Add("act", "get_rsv_sta")
 
-	fullURL, err := buildURL(DeviceAPIPath, params)
+	fullURL, err := c.buildURL(DeviceAPIPath, params)
 	if err != nil {
 		return nil, errcode.ErrCrawler
 	}
@@ -451,6 +502,10 @@ func (c *Crawler) GetDiscussion(ctx context.Context, stuID string, classid, date
 		return nil, err
 	}
 
+	if err = checkRet(body); err != nil {
+		return nil, err
+	}
+
 	data := gjson.GetBytes(body, "data")
 	if !data.Exists() {
 		return nil, nil
@@ -499,7 +554,7 @@ func (c *Crawler) SearchUser(ctx context.Context, stuID string, studentid string
 	params := url.Values{}
 	params.Add("term", studentid)
 
-	fullURL, err := buildURL(SearchAccountPath, params)
+	fullURL, err := c.buildURL(SearchAccountPath, params)
 	if err != nil {
 		return nil, errcode.ErrCrawler
 	}
@@ -519,6 +574,9 @@ func (c *Crawler) SearchUser(ctx context.Context, stuID string, studentid string
 	if err := json.Unmarshal(body, &search); err != nil {
 		return nil, err
 	}
+	if len(search) == 0 {
+		return nil, fmt.Errorf("user %s not found", studentid)
+	}
 
 	return search[0], nil
 }
@@ -545,7 +603,7 @@ func (c *Crawler) ReserveDiscussion(ctx context.Context, stuID string, devid, la
 	params.Add("end", end)
 	params.Add("act", "set_resv")
 
-	fullURL, err := buildURL(ReserveAPIPath, params)
+	fullURL, err := c.buildURL(ReserveAPIPath, params)
 	if err != nil {
 		return "", errcode.ErrCrawler
 	}
@@ -567,7 +625,7 @@ func (c *Crawler) ReserveDiscussion(ctx context.Context, stuID string, devid, la
 	}
 
 	if ReserveResp.Ret != 1 {
-		return "", fmt.Errorf(ReserveResp.Msg)
+		return "", fmt.Errorf("%s", ReserveResp.Msg)
 	}
 
 	return ReserveResp.Msg, nil
@@ -585,7 +643,7 @@ func (c *Crawler) CancelReserve(ctx context.Context, stuID string, id string) (s
 	params.Add("act", "del_resv")
 	params.Add("id", id)
 
-	fullURL, err := buildURL(ReserveAPIPath, params)
+	fullURL, err := c.buildURL(ReserveAPIPath, params)
 	if err != nil {
 		return "", errcode.ErrCrawler
 	}
@@ -612,7 +670,7 @@ func (c *Crawler) CancelReserve(ctx context.Context, stuID string, id string) (s
 		if CancelResp.Ret == 1 {
 			return CancelResp.Msg, nil
 		}
-		return "", fmt.Errorf(CancelResp.Msg)
+		return "", fmt.Errorf("%s", CancelResp.Msg)
 	}
 
 	return CancelResp.Msg, nil
@@ -0,0 +1,186 @@
+package crawler
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/asynccnu/ccnubox-be/be-library/internal/biz"
+	"github.com/asynccnu/ccnubox-be/be-library/internal/client"
+	"github.com/asynccnu/ccnubox-be/be-library/internal/crawler/fake"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func newTestCrawler(t *testing.T) (biz.LibraryCrawler, *fake.Server) {
+	srv := fake.NewServer()
+	t.Cleanup(srv.Close)
+	srv.AddRoom(fake.Room{ID: "r1", LabName: "一楼", Name: "自习室A", Seats: []fake.Seat{
+		{DevID: "s1", DevName: "A001"},
+		{DevID: "s2", DevName: "A002"},
+	}})
+	srv.AddDiscussionRoom(fake.DiscussionRoom{ClassID: "c1", LabID: "l1", LabName: "二楼", KindID: "k1", KindName: "研讨间", DevID: "d1", DevName: "研讨间201"})
+	srv.AddAccount(fake.Account{ID: "1001", StudentID: "stu", Name: "张三"})
+	srv.AddAccount(fake.Account{ID: "1002", StudentID: "m1", Name: "李四"})
+	srv.AddAccount(fake.Account{ID: "1003", StudentID: "m2", Name: "王五"})
+
+	pool := client.NewCookiePool(time.Minute, srv.URL())
+	return NewLibraryCrawler(log.NewStdLogger(os.Stdout), pool, srv, time.Second), srv
+}
+
+func TestCrawler_ReserveAndCancelSeat(t *testing.T) {
+	c, _ := newTestCrawler(t)
+	ctx := context.Background()
+	today := time.Now().Format("2006-01-02")
+
+	if _, err := c.ReserveSeat(ctx, "stu", "s1", today+" 08:00", today+" 10:00"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.ReserveSeat(ctx, "other", "s1", today+" 09:00", today+" 11:00"); err == nil {
+		t.Fatal("expected conflict when the seat is taken")
+	}
+
+	seats, err := c.GetSeatInfos(ctx, "stu", []string{"r1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(seats["r1"]) != 2 || len(seats["r1"][0].Ts) != 1 || seats["r1"][0].Ts[0].Start != today+" 08:00" || len(seats["r1"][1].Ts) != 0 {
+		t.Fatalf("unexpected seats: %+v", seats["r1"])
+	}
+
+	records, err := c.GetRecord(ctx, "stu")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 1 || records[0].DevName != "A001" || records[0].States != "预约成功" || records[0].RoomName != "自习室A" {
+		t.Fatalf("unexpected records: %+v", records)
+	}
+
+	if _, err = c.CancelReserve(ctx, "stu", records[0].ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = c.CancelReserve(ctx, "stu", records[0].ID); err == nil {
+		t.Fatal("expected error when cancelling twice")
+	}
+	if records, _ = c.GetRecord(ctx, "stu"); len(records) != 0 {
+		t.Fatalf("reservation should be cancelled: %+v", records)
+	}
+}
+
+func TestCrawler_HistoryAndCreditPoint(t *testing.T) {
+	c, srv := newTestCrawler(t)
+	ctx := context.Background()
+	srv.SetCredit("stu", 280, 300, fake.CreditRecord{Title: "未签到", Subtitle: "-20", Location: "自习室A"})
+	if _, err := srv.Reserve("stu", "s2", "2025-09-02 08:00", "2025-09-02 10:00"); err != nil {
+		t.Fatal(err)
+	}
+
+	history, err := c.GetHistory(ctx, "stu")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history) != 1 || history[0].Place != "A002" || history[0].Floor != "一楼" || history[0].Room != "自习室A" || history[0].Status != "预约成功" || history[0].SubmitTime == "" {
+		t.Fatalf("unexpected history: %+v", history)
+	}
+
+	points, err := c.GetCreditPoint(ctx, "stu")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if points.Summary.Remain != "280" || points.Summary.Total != "300" || len(points.Records) != 1 || points.Records[0].Title != "未签到" {
+		t.Fatalf("unexpected credit points: %+v %+v", points.Summary, points.Records)
+	}
+}
+
+func TestCrawler_Discussion(t *testing.T) {
+	c, _ := newTestCrawler(t)
+	ctx := context.Background()
+
+	user, err := c.SearchUser(ctx, "stu", "m1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if user.ID != "1002" || user.Name != "李四" {
+		t.Fatalf("unexpected user: %+v", user)
+	}
+	if _, err = c.SearchUser(ctx, "stu", "nobody"); err == nil {
+		t.Fatal("expected error for unknown user")
+	}
+
+	if _, err = c.ReserveDiscussion(ctx, "stu", "d1", "l1", "k1", "复习", "2025-09-02 08:00", "2025-09-02 10:00", []string{"1002"}); err == nil {
+		t.Fatal("expected error when there are too few members")
+	}
+	if _, err = c.ReserveDiscussion(ctx, "stu", "d1", "l1", "k1", "复习", "2025-09-02 08:00", "2025-09-02 10:00", []string{"1002", "1003"}); err != nil {
+		t.Fatal(err)
+	}
+
+	rooms, err := c.GetDiscussion(ctx, "stu", "c1", "2025-09-02")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rooms) != 1 || len(rooms[0].TS) != 1 || rooms[0].TS[0].Title != "复习" || rooms[0].TS[0].Owner != "张三" {
+		t.Fatalf("unexpected discussions: %+v", rooms)
+	}
+}
+
+func TestCrawler_SessionExpired(t *testing.T) {
+	c, srv := newTestCrawler(t)
+	ctx := context.Background()
+
+	if _, err := c.GetRecord(ctx, "stu"); err != nil {
+		t.Fatal(err)
+	}
+	srv.ExpireSession("stu")
+	if _, err := c.GetRecord(ctx, "stu"); err == nil {
+		t.Fatal("expected error with an expired cookie")
+	}
+	if _, err := c.ReserveSeat(ctx, "stu", "s1", "2025-09-02 08:00", "2025-09-02 10:00"); err == nil {
+		t.Fatal("expected reserve to fail with an expired cookie")
+	}
+
+	srv.Login("stu")
+	if _, err := c.GetRecord(ctx, "stu"); err != nil {
+		t.Fatalf("expected success after login, got %v", err)
+	}
+}
+
+type discardRecordRepo struct {
+	biz.RecordRepo
+}
+
+func (discardRecordRepo) UpsertFutureRecords(context.Context, string, []*biz.FutureRecords) error {
+	return nil
+}
+
+func TestLibraryBiz_SwapReservation(t *testing.T) {
+	c, srv := newTestCrawler(t)
+	ctx := context.Background()
+	b := biz.NewLibraryBiz(c, log.NewStdLogger(os.Stdout), nil, discardRecordRepo{}, nil, nil)
+
+	oldID, err := srv.Reserve("stu", "s1", "2025-09-02 08:00", "2025-09-02 12:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := b.SwapReservation(ctx, "stu", oldID, "s2", "2025-09-02 08:00", "2025-09-02 12:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	records := srv.Reservations("stu")
+	if len(records) != 1 || records[0].ID != res.NewID || records[0].DevID != "s2" {
+		t.Fatalf("expected only the new reservation, got %+v (new id %v)", records, res.NewID)
+	}
+}
+
+func TestCrawler_ListRooms(t *testing.T) {
+	c, srv := newTestCrawler(t)
+	srv.AddRoom(fake.Room{ID: "r2", LabName: "二楼", Name: "自习室B", Seats: []fake.Seat{{DevID: "s3", DevName: "B001"}}})
+
+	rooms, err := c.ListRooms(context.Background(), "stu")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rooms) != 2 || rooms[0].ID != "r1" || rooms[0].SeatCount != 2 || rooms[1].ID != "r2" || rooms[1].Name != "自习室B" || rooms[1].SeatCount != 1 {
+		t.Fatalf("unexpected rooms: %+v", rooms)
+	}
+}
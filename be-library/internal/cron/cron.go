@@ -1 +1,155 @@
 package cron
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/asynccnu/ccnubox-be/be-library/internal/biz"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/wire"
+	"github.com/robfig/cron/v3"
+)
+
+var ProviderSet = wire.NewSet(NewReserveTask, NewReminderTask, NewRoomTask)
+
+// 单次执行所有意向的最长时间
+const reserveRunTimeout = 10 * time.Minute
+
+// ReserveTask 在图书馆开放预约的时刻执行学生的自动预约意向
+// 实现了 transport.Server，随 kratos 应用一起启动和停止
+type ReserveTask struct {
+	agent *biz.ReserveAgent
+	c     *cron.Cron
+	log   *log.Helper
+}
+
+func NewReserveTask(agent *biz.ReserveAgent, logger log.Logger) *ReserveTask {
+	return &ReserveTask{
+		agent: agent,
+		c:     cron.New(),
+		log:   log.NewHelper(logger),
+	}
+}
+
+func (t *ReserveTask) Start(context.Context) error {
+	spec, err := dailySpec(t.agent.Config().OpenTime)
+	if err != nil {
+		return err
+	}
+	_, err = t.c.AddFunc(spec, func() {
+		ctx, cancel := context.WithTimeout(context.Background(), reserveRunTimeout)
+		defer cancel()
+		t.agent.RunDueIntents(ctx, time.Now())
+	})
+	if err != nil {
+		return err
+	}
+	t.log.Infof("reserve task scheduled at %s", t.agent.Config().OpenTime)
+	t.c.Start()
+	return nil
+}
+
+func (t *ReserveTask) Stop(context.Context) error {
+	<-t.c.Stop().Done()
+	return nil
+}
+
+// ReminderTask 定期扫描学生的预约记录，发送开始、签到截止和结束提醒
+type ReminderTask struct {
+	reminder *biz.Reminder
+	c        *cron.Cron
+	log      *log.Helper
+}
+
+func NewReminderTask(reminder *biz.Reminder, logger log.Logger) *ReminderTask {
+	return &ReminderTask{
+		reminder: reminder,
+		// 上一次扫描还没结束时跳过本次
+		c:   cron.New(cron.WithChain(cron.SkipIfStillRunning(cron.DiscardLogger))),
+		log: log.NewHelper(logger),
+	}
+}
+
+func (t *ReminderTask) Start(context.Context) error {
+	interval := t.reminder.Config().Interval
+	_, err := t.c.AddFunc(fmt.Sprintf("@every %s", interval), func() {
+		ctx, cancel := context.WithTimeout(context.Background(), interval)
+		defer cancel()
+		t.reminder.RunReminders(ctx, time.Now())
+	})
+	if err != nil {
+		return err
+	}
+	t.log.Infof("reminder task scheduled every %s", interval)
+	t.c.Start()
+	return nil
+}
+
+func (t *ReminderTask) Stop(context.Context) error {
+	<-t.c.Stop().Done()
+	return nil
+}
+
+// RoomTask 按房间目录定期刷新座位缓存，并从图书馆系统发现新房间
+// 没有配置学号或间隔为 0 时不执行对应的任务
+type RoomTask struct {
+	catalog *biz.RoomCatalog
+	c       *cron.Cron
+	log     *log.Helper
+}
+
+func NewRoomTask(catalog *biz.RoomCatalog, logger log.Logger) *RoomTask {
+	return &RoomTask{
+		catalog: catalog,
+		c:       cron.New(cron.WithChain(cron.SkipIfStillRunning(cron.DiscardLogger))),
+		log:     log.NewHelper(logger),
+	}
+}
+
+func (t *RoomTask) Start(context.Context) error {
+	cfg := t.catalog.Config()
+	if cfg.StuID == "" {
+		t.log.Warnf("room task disabled: room.stu_id is not set, seats are refreshed on demand and new rooms are only found by DiscoverRooms")
+		return nil
+	}
+	jobs := []struct {
+		name     string
+		interval time.Duration
+		run      func(context.Context)
+	}{
+		{"seat refresh", cfg.RefreshInterval, t.catalog.RefreshSeats},
+		{"room discovery", cfg.DiscoverInterval, t.catalog.RunDiscovery},
+	}
+	for _, job := range jobs {
+		if job.interval <= 0 {
+			continue
+		}
+		interval, run := job.interval, job.run
+		_, err := t.c.AddFunc(fmt.Sprintf("@every %s", interval), func() {
+			ctx, cancel := context.WithTimeout(context.Background(), interval)
+			defer cancel()
+			run(ctx)
+		})
+		if err != nil {
+			return err
+		}
+		t.log.Infof("room %s scheduled every %s", job.name, interval)
+	}
+	t.c.Start()
+	return nil
+}
+
+func (t *RoomTask) Stop(context.Context) error {
+	<-t.c.Stop().Done()
+	return nil
+}
+
+// dailySpec 把 HH:MM 转成每天执行的 cron 表达式
+func dailySpec(hhmm string) (string, error) {
+	at, err := time.Parse("15:04", hhmm)
+	if err != nil {
+		return "", fmt.Errorf("invalid open time %q: %w", hhmm, err)
+	}
+	return fmt.Sprintf("%d %d * * *", at.Minute(), at.Hour()), nil
+}
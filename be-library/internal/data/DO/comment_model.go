@@ -3,10 +3,25 @@ package DO
 import "time"
 
 type Comment struct {
-	ID        int       `gorm:"primaryKey;autoIncrement" json:"id"` // 评论ID
-	SeatID    string    `gorm:"index;not null" json:"seat_id"`      // 关联座位
-	Username  string    `gorm:"index;not null" json:"user_id"`      // 发表评论的用户
-	Content   string    `gorm:"type:text;not null" json:"content"`  // 评论内容
-	Rating    int       `gorm:"not null" json:"rating"`             // 评分（1-5）
-	CreatedAt time.Time `gorm:"autoCreateTime" json:"created_at"`   // 创建时间
+	ID          int       `gorm:"primaryKey;autoIncrement" json:"id"`                   // 评论ID
+	SeatID      string    `gorm:"index;not null" json:"seat_id"`                        // 关联座位
+	Username    string    `gorm:"index;not null" json:"user_id"`                        // 发表评论的用户
+	Content     string    `gorm:"type:text;not null" json:"content"`                    // 评论内容
+	Rating      int       `gorm:"not null" json:"rating"`                               // 评分（1-5）
+	Status      string    `gorm:"size:16;not null;default:visible;index" json:"status"` // 展示状态
+	ReportCount int       `gorm:"not null;default:0" json:"report_count"`               // 被举报次数
+	CreatedAt   time.Time `gorm:"autoCreateTime" json:"created_at"`                     // 创建时间
+}
+
+// CommentReport 评论的举报记录，(评论, 举报人) 唯一
+type CommentReport struct {
+	ID        uint64    `gorm:"primaryKey;autoIncrement" json:"id"`
+	CommentID int       `gorm:"index:idx_comment_reporter,unique;not null" json:"comment_id"`
+	Reporter  string    `gorm:"index:idx_comment_reporter,unique;not null;size:20" json:"reporter"`
+	Reason    string    `gorm:"size:255" json:"reason"`
+	CreatedAt time.Time `gorm:"autoCreateTime" json:"created_at"`
+}
+
+func (CommentReport) TableName() string {
+	return "lib_comment_reports"
 }
@@ -0,0 +1,19 @@
+package DO
+
+import "time"
+
+// FavoriteSeat 收藏座位，(学号, 座位) 唯一
+type FavoriteSeat struct {
+	ID         uint64    `gorm:"primaryKey;autoIncrement" json:"id"`
+	StudentID  string    `gorm:"index:idx_student_dev,unique;not null;size:20" json:"student_id"` // 学号
+	SeatID     string    `gorm:"index:idx_student_dev,unique;not null;size:50" json:"seat_id"`    // 设备ID，唯一标识座位
+	RoomID     string    `gorm:"size:100;not null" json:"room_id"`
+	LayerName  string    `gorm:"size:100;not null" json:"layer_name"`
+	RoomName   string    `gorm:"size:150;not null" json:"room_name"`
+	SeatName   string    `gorm:"size:100;not null" json:"dev_name"`
+	CreateTime time.Time `gorm:"index:idx_create_time;not null" json:"create_time"`
+}
+
+func (FavoriteSeat) TableName() string {
+	return "lib_favourite_seats"
+}
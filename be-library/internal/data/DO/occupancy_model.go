@@ -0,0 +1,23 @@
+package DO
+
+import "time"
+
+// OccupancySnapshot 房间占用快照，用于按星期和小时统计热力图
+type OccupancySnapshot struct {
+	ID        uint64    `gorm:"primaryKey;autoIncrement"`
+	RoomID    string    `gorm:"column:room_id;size:100;not null;index:idx_occupancy_room_time,priority:1"`
+	RoomName  string    `gorm:"column:room_name;size:150"`
+	LabName   string    `gorm:"column:lab_name;size:100;index:idx_occupancy_lab"`
+	Total     int64     `gorm:"column:total;not null"`
+	Available int64     `gorm:"column:available;not null"`
+	Partial   int64     `gorm:"column:partial;not null"`
+	Busy      int64     `gorm:"column:busy;not null"`
+	UsageRate float64   `gorm:"column:usage_rate;not null"`
+	Weekday   int       `gorm:"column:weekday;not null"` // 1-7 对应周一到周日
+	Hour      int       `gorm:"column:hour;not null"`
+	TakenAt   time.Time `gorm:"column:taken_at;not null;index:idx_occupancy_room_time,priority:2;index:idx_occupancy_time"`
+}
+
+func (OccupancySnapshot) TableName() string {
+	return "lib_occupancy_snapshots"
+}
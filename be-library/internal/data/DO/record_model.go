@@ -22,9 +22,14 @@ type HistoryRecord struct {
 	StuID      string `gorm:"column:stu_id;size:20;not null;primaryKey"`
 	Place      string `gorm:"column:place;size:100;not null"`
 	Floor      string `gorm:"column:floor;size:50"`
+	Room       string `gorm:"column:room_name;size:150"`
 	Status     string `gorm:"column:status;size:20"`
 	Date       string `gorm:"column:date;size:20;not null"`
 	SubmitTime string `gorm:"column:submit_time;size:32;not null;primaryKey"`
+	// CountedStatus 已计入使用统计时的状态，与 Status 不同时需要重新计入
+	CountedStatus string `gorm:"column:counted_status;size:20;not null;default:''"`
+	// CountedRoom 计入使用统计时的房间，状态变化时从这个房间的统计中扣除
+	CountedRoom string `gorm:"column:counted_room;size:150;not null;default:''"`
 }
 
 func (HistoryRecord) TableName() string {
@@ -0,0 +1,24 @@
+package DO
+
+import "time"
+
+// ReserveIntent 自动预约意向
+type ReserveIntent struct {
+	ID          uint64    `gorm:"primaryKey;autoIncrement"`
+	StuID       string    `gorm:"column:stu_id;size:20;not null;index:idx_reserve_intent_stu"`
+	Weekdays    []int     `gorm:"column:weekdays;serializer:json;type:varchar(64);not null"`
+	Start       string    `gorm:"column:start;size:8;not null"`
+	End         string    `gorm:"column:end;size:8;not null"`
+	RoomIDs     []string  `gorm:"column:room_ids;serializer:json;type:text"`
+	SeatIDs     []string  `gorm:"column:seat_ids;serializer:json;type:text"`
+	Enabled     bool      `gorm:"column:enabled;not null;index:idx_reserve_intent_enabled"`
+	LastRunDate string    `gorm:"column:last_run_date;size:10"`
+	LastResult  string    `gorm:"column:last_result;size:255"`
+	LastSeat    string    `gorm:"column:last_seat;size:50"`
+	CreatedAt   time.Time `gorm:"column:created_at"`
+	UpdatedAt   time.Time `gorm:"column:updated_at"`
+}
+
+func (ReserveIntent) TableName() string {
+	return "lib_reserve_intents"
+}
@@ -0,0 +1,22 @@
+package DO
+
+import "time"
+
+// Room 房间目录
+type Room struct {
+	ID        string    `gorm:"primaryKey;column:id;size:20"`
+	Name      string    `gorm:"column:name;size:64;not null"`
+	LabName   string    `gorm:"column:lab_name;size:64"`
+	Branch    string    `gorm:"column:branch;size:32;index:idx_room_branch_floor"`
+	Floor     int       `gorm:"column:floor;index:idx_room_branch_floor"`
+	OpenTime  string    `gorm:"column:open_time;size:5"`
+	CloseTime string    `gorm:"column:close_time;size:5"`
+	SeatCount int       `gorm:"column:seat_count"`
+	Bookable  bool      `gorm:"column:bookable;not null"`
+	CreatedAt time.Time `gorm:"column:created_at"`
+	UpdatedAt time.Time `gorm:"column:updated_at"`
+}
+
+func (Room) TableName() string {
+	return "lib_rooms"
+}
@@ -0,0 +1,23 @@
+package DO
+
+import "time"
+
+// StudyGroup 学习小组
+type StudyGroup struct {
+	ID        uint64         `gorm:"primaryKey;autoIncrement"`
+	OwnerID   string         `gorm:"column:owner_id;size:20;not null;index:idx_study_group_owner"`
+	Name      string         `gorm:"column:name;size:64;not null"`
+	Members   []*GroupMember `gorm:"column:members;serializer:json;type:text"`
+	CreatedAt time.Time      `gorm:"column:created_at"`
+	UpdatedAt time.Time      `gorm:"column:updated_at"`
+}
+
+func (StudyGroup) TableName() string {
+	return "lib_study_groups"
+}
+
+type GroupMember struct {
+	StudentID string `json:"student_id"`
+	Name      string `json:"name"`
+	AccountID string `json:"account_id"`
+}
@@ -0,0 +1,18 @@
+package DO
+
+// UsageDay 学生每天在每个房间的使用统计，由历史预约累加
+type UsageDay struct {
+	StuID     string `gorm:"column:stu_id;size:20;not null;primaryKey"`
+	Date      string `gorm:"column:date;size:10;not null;primaryKey"`
+	Room      string `gorm:"column:room;size:150;not null;primaryKey"`
+	Floor     string `gorm:"column:floor;size:50"`
+	Minutes   int    `gorm:"column:minutes;not null"`
+	OnTime    int    `gorm:"column:on_time;not null"`
+	Late      int    `gorm:"column:late;not null"`
+	NoShow    int    `gorm:"column:no_show;not null"`
+	Cancelled int    `gorm:"column:cancelled;not null"`
+}
+
+func (UsageDay) TableName() string {
+	return "lib_usage_days"
+}
@@ -18,12 +18,14 @@ func (a *Assembler) ConvertDOCommentBiz(comments []*DO.Comment) []*biz.Comment {
 	result := make([]*biz.Comment, 0, len(comments))
 	for _, comment := range comments {
 		result = append(result, &biz.Comment{
-			ID:        comment.ID,
-			SeatID:    comment.SeatID,
-			Username:  comment.Username,
-			Content:   comment.Content,
-			Rating:    comment.Rating,
-			CreatedAt: comment.CreatedAt,
+			ID:          comment.ID,
+			SeatID:      comment.SeatID,
+			Username:    comment.Username,
+			Content:     comment.Content,
+			Rating:      comment.Rating,
+			Status:      biz.CommentStatus(comment.Status),
+			ReportCount: comment.ReportCount,
+			CreatedAt:   comment.CreatedAt,
 		})
 	}
 	return result
@@ -1,11 +1,14 @@
 package data
 
 import (
-	"time"
+	"context"
+	"errors"
 
 	"github.com/asynccnu/ccnubox-be/be-library/internal/biz"
 	"github.com/asynccnu/ccnubox-be/be-library/internal/data/DO"
 	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type CommentRepo struct {
@@ -22,35 +25,127 @@ func NewCommentRepo(data *Data, logger log.Logger, conv *Assembler) biz.CommentR
 	}
 }
 
-func (r CommentRepo) CreateComment(req *biz.CreateCommentReq) (string, error) {
-	comment := DO.Comment{
-		SeatID:    req.SeatID,
-		Content:   req.Content,
-		Rating:    req.Rating,
-		Username:  req.Username,
-		CreatedAt: time.Now(),
+func (r CommentRepo) CreateComment(ctx context.Context, comment *biz.Comment) error {
+	do := DO.Comment{
+		SeatID:    comment.SeatID,
+		Content:   comment.Content,
+		Rating:    comment.Rating,
+		Username:  comment.Username,
+		Status:    string(comment.Status),
+		CreatedAt: comment.CreatedAt,
 	}
 
-	err := r.data.db.Create(&comment).Error
-	if err != nil {
-		return "", err
+	if err := r.data.db.WithContext(ctx).Create(&do).Error; err != nil {
+		return err
 	}
+	comment.ID = do.ID
+	return nil
+}
 
-	return "success", err
+func (r CommentRepo) GetComment(ctx context.Context, id int) (*biz.Comment, error) {
+	var do DO.Comment
+	err := r.data.db.WithContext(ctx).First(&do, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return r.conv.ConvertDOCommentBiz([]*DO.Comment{&do})[0], nil
 }
 
-func (r CommentRepo) GetCommentsBySeatID(seatID int) ([]*biz.Comment, error) {
+func (r CommentRepo) ListComments(ctx context.Context, seatID string, offset, limit int) ([]*biz.Comment, int64, error) {
+	db := r.data.db.WithContext(ctx).Model(&DO.Comment{}).
+		Where("seat_id = ? AND status = ?", seatID, biz.CommentVisible)
+
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
 	var comments []*DO.Comment
-	err := r.data.db.Where("seat_id = ?", seatID).Order("created_at desc").Find(&comments).Error
-	result := r.conv.ConvertDOCommentBiz(comments)
-	return result, err
+	if err := db.Order("created_at desc, id desc").Offset(offset).Limit(limit).Find(&comments).Error; err != nil {
+		return nil, 0, err
+	}
+	return r.conv.ConvertDOCommentBiz(comments), total, nil
 }
 
-func (r CommentRepo) DeleteComment(id int) (string, error) {
-	err := r.data.db.Delete(&DO.Comment{}, id).Error
-	if err != nil {
-		return "", nil
+func (r CommentRepo) GetSeatRatings(ctx context.Context, seatIDs []string) ([]*biz.SeatRating, error) {
+	var rows []struct {
+		SeatID  string
+		Average float64
+		Count   int64
+	}
+	if err := r.data.db.WithContext(ctx).Model(&DO.Comment{}).
+		Select("seat_id, AVG(rating) AS average, COUNT(*) AS count").
+		Where("seat_id IN ? AND status = ?", seatIDs, biz.CommentVisible).
+		Group("seat_id").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	out := make([]*biz.SeatRating, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, &biz.SeatRating{SeatID: row.SeatID, Average: row.Average, Count: row.Count})
 	}
+	return out, nil
+}
+
+// DeleteComment 同时删除评论的举报记录
+func (r CommentRepo) DeleteComment(ctx context.Context, id int) error {
+	return r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("comment_id = ?", id).Delete(&DO.CommentReport{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&DO.Comment{}, id).Error
+	})
+}
+
+// AddReport 依赖 idx_comment_reporter 唯一索引去重，并把举报次数同步到评论上
+func (r CommentRepo) AddReport(ctx context.Context, report *biz.CommentReport) (int, error) {
+	var count int64
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		do := DO.CommentReport{
+			CommentID: report.CommentID,
+			Reporter:  report.Reporter,
+			Reason:    report.Reason,
+		}
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&do).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&DO.CommentReport{}).Where("comment_id = ?", report.CommentID).Count(&count).Error; err != nil {
+			return err
+		}
+		return tx.Model(&DO.Comment{}).Where("id = ?", report.CommentID).Update("report_count", count).Error
+	})
+	return int(count), err
+}
+
+func (r CommentRepo) ListReportedComments(ctx context.Context, offset, limit int) ([]*biz.Comment, int64, error) {
+	db := r.data.db.WithContext(ctx).Model(&DO.Comment{}).
+		Where("report_count > 0 OR status = ?", biz.CommentPending)
+
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var comments []*DO.Comment
+	if err := db.Order("report_count desc, id desc").Offset(offset).Limit(limit).Find(&comments).Error; err != nil {
+		return nil, 0, err
+	}
+	return r.conv.ConvertDOCommentBiz(comments), total, nil
+}
 
-	return "success", err
+func (r CommentRepo) UpdateCommentStatus(ctx context.Context, id int, status biz.CommentStatus, clearReports bool) error {
+	return r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		updates := map[string]any{"status": string(status)}
+		if clearReports {
+			if err := tx.Where("comment_id = ?", id).Delete(&DO.CommentReport{}).Error; err != nil {
+				return err
+			}
+			updates["report_count"] = 0
+		}
+		return tx.Model(&DO.Comment{}).Where("id = ?", id).Updates(updates).Error
+	})
 }
@@ -1,6 +1,8 @@
 package data
 
 import (
+	"time"
+
 	"github.com/asynccnu/ccnubox-be/be-library/internal/biz"
 	"github.com/asynccnu/ccnubox-be/be-library/internal/data/DO"
 )
@@ -122,6 +124,7 @@ func ConvertDOHistoryRecordsBiz(dos []DO.HistoryRecord) []*biz.HistoryRecords {
 		out = append(out, &biz.HistoryRecords{
 			Place:      d.Place,
 			Floor:      d.Floor,
+			Room:       d.Room,
 			Status:     d.Status,
 			Date:       d.Date,
 			SubmitTime: d.SubmitTime,
@@ -137,6 +140,7 @@ func ConvertBizHistoryRecordsDO(stuID string, list []*biz.HistoryRecords) []DO.H
 			StuID:      stuID,
 			Place:      it.Place,
 			Floor:      it.Floor,
+			Room:       it.Room,
 			Status:     it.Status,
 			Date:       it.Date,
 			SubmitTime: it.SubmitTime,
@@ -145,6 +149,33 @@ func ConvertBizHistoryRecordsDO(stuID string, list []*biz.HistoryRecords) []DO.H
 	return dos
 }
 
+func ConvertDOUsageDayBiz(d *DO.UsageDay) *biz.UsageDay {
+	return &biz.UsageDay{
+		Date:      d.Date,
+		Room:      d.Room,
+		Floor:     d.Floor,
+		Minutes:   d.Minutes,
+		OnTime:    d.OnTime,
+		Late:      d.Late,
+		NoShow:    d.NoShow,
+		Cancelled: d.Cancelled,
+	}
+}
+
+func ConvertBizUsageDayDO(stuID string, d *biz.UsageDay) *DO.UsageDay {
+	return &DO.UsageDay{
+		StuID:     stuID,
+		Date:      d.Date,
+		Room:      d.Room,
+		Floor:     d.Floor,
+		Minutes:   d.Minutes,
+		OnTime:    d.OnTime,
+		Late:      d.Late,
+		NoShow:    d.NoShow,
+		Cancelled: d.Cancelled,
+	}
+}
+
 func ConvertDOCreditPointsBiz(summary *DO.CreditSummary, records []DO.CreditRecord) *biz.CreditPoints {
 	if summary == nil {
 		return &biz.CreditPoints{Summary: nil, Records: nil}
@@ -242,3 +273,175 @@ func ConvertBizDiscussionDO(list []*biz.Discussion) []*DO.Discussion {
 	}
 	return out
 }
+
+func ConvertBizFavoriteSeatDO(f *biz.FavoriteSeat) *DO.FavoriteSeat {
+	return &DO.FavoriteSeat{
+		ID:         f.ID,
+		StudentID:  f.StudentID,
+		SeatID:     f.SeatID,
+		RoomID:     f.RoomID,
+		LayerName:  f.LayerName,
+		RoomName:   f.RoomName,
+		SeatName:   f.SeatName,
+		CreateTime: f.CreateTime,
+	}
+}
+
+func ConvertDOFavoriteSeatsBiz(dos []*DO.FavoriteSeat) []*biz.FavoriteSeat {
+	out := make([]*biz.FavoriteSeat, 0, len(dos))
+	for _, d := range dos {
+		out = append(out, &biz.FavoriteSeat{
+			ID:         d.ID,
+			StudentID:  d.StudentID,
+			SeatID:     d.SeatID,
+			RoomID:     d.RoomID,
+			LayerName:  d.LayerName,
+			RoomName:   d.RoomName,
+			SeatName:   d.SeatName,
+			CreateTime: d.CreateTime,
+		})
+	}
+	return out
+}
+
+func ConvertBizReserveIntentDO(i *biz.ReserveIntent) *DO.ReserveIntent {
+	return &DO.ReserveIntent{
+		ID:          i.ID,
+		StuID:       i.StuID,
+		Weekdays:    i.Weekdays,
+		Start:       i.Start,
+		End:         i.End,
+		RoomIDs:     i.RoomIDs,
+		SeatIDs:     i.SeatIDs,
+		Enabled:     i.Enabled,
+		LastRunDate: i.LastRunDate,
+		LastResult:  i.LastResult,
+		LastSeat:    i.LastSeat,
+		CreatedAt:   i.CreatedAt,
+		UpdatedAt:   i.UpdatedAt,
+	}
+}
+
+func ConvertDOReserveIntentBiz(d *DO.ReserveIntent) *biz.ReserveIntent {
+	return &biz.ReserveIntent{
+		ID:          d.ID,
+		StuID:       d.StuID,
+		Weekdays:    d.Weekdays,
+		Start:       d.Start,
+		End:         d.End,
+		RoomIDs:     d.RoomIDs,
+		SeatIDs:     d.SeatIDs,
+		Enabled:     d.Enabled,
+		LastRunDate: d.LastRunDate,
+		LastResult:  d.LastResult,
+		LastSeat:    d.LastSeat,
+		CreatedAt:   d.CreatedAt,
+		UpdatedAt:   d.UpdatedAt,
+	}
+}
+
+func ConvertDOReserveIntentsBiz(dos []*DO.ReserveIntent) []*biz.ReserveIntent {
+	out := make([]*biz.ReserveIntent, 0, len(dos))
+	for _, d := range dos {
+		out = append(out, ConvertDOReserveIntentBiz(d))
+	}
+	return out
+}
+
+func ConvertBizStudyGroupDO(g *biz.StudyGroup) *DO.StudyGroup {
+	members := make([]*DO.GroupMember, 0, len(g.Members))
+	for _, m := range g.Members {
+		members = append(members, &DO.GroupMember{StudentID: m.StudentID, Name: m.Name, AccountID: m.AccountID})
+	}
+	return &DO.StudyGroup{
+		ID:        g.ID,
+		OwnerID:   g.OwnerID,
+		Name:      g.Name,
+		Members:   members,
+		CreatedAt: g.CreatedAt,
+		UpdatedAt: g.UpdatedAt,
+	}
+}
+
+func ConvertDOStudyGroupBiz(d *DO.StudyGroup) *biz.StudyGroup {
+	members := make([]*biz.GroupMember, 0, len(d.Members))
+	for _, m := range d.Members {
+		members = append(members, &biz.GroupMember{StudentID: m.StudentID, Name: m.Name, AccountID: m.AccountID})
+	}
+	return &biz.StudyGroup{
+		ID:        d.ID,
+		OwnerID:   d.OwnerID,
+		Name:      d.Name,
+		Members:   members,
+		CreatedAt: d.CreatedAt,
+		UpdatedAt: d.UpdatedAt,
+	}
+}
+
+func ConvertDOStudyGroupsBiz(dos []*DO.StudyGroup) []*biz.StudyGroup {
+	out := make([]*biz.StudyGroup, 0, len(dos))
+	for _, d := range dos {
+		out = append(out, ConvertDOStudyGroupBiz(d))
+	}
+	return out
+}
+
+func ConvertBizRoomDO(r *biz.Room) *DO.Room {
+	return &DO.Room{
+		ID:        r.ID,
+		Name:      r.Name,
+		LabName:   r.LabName,
+		Branch:    r.Branch,
+		Floor:     r.Floor,
+		OpenTime:  r.OpenTime,
+		CloseTime: r.CloseTime,
+		SeatCount: r.SeatCount,
+		Bookable:  r.Bookable,
+		CreatedAt: r.CreatedAt,
+		UpdatedAt: r.UpdatedAt,
+	}
+}
+
+func ConvertDORoomBiz(d *DO.Room) *biz.Room {
+	return &biz.Room{
+		ID:        d.ID,
+		Name:      d.Name,
+		LabName:   d.LabName,
+		Branch:    d.Branch,
+		Floor:     d.Floor,
+		OpenTime:  d.OpenTime,
+		CloseTime: d.CloseTime,
+		SeatCount: d.SeatCount,
+		Bookable:  d.Bookable,
+		CreatedAt: d.CreatedAt,
+		UpdatedAt: d.UpdatedAt,
+	}
+}
+
+func ConvertDORoomsBiz(dos []*DO.Room) []*biz.Room {
+	out := make([]*biz.Room, 0, len(dos))
+	for _, d := range dos {
+		out = append(out, ConvertDORoomBiz(d))
+	}
+	return out
+}
+
+func ConvertBizRoomStatisticsDO(s *biz.RoomStatistics, at time.Time) *DO.OccupancySnapshot {
+	weekday := int(at.Weekday())
+	if weekday == 0 {
+		weekday = 7
+	}
+	return &DO.OccupancySnapshot{
+		RoomID:    s.RoomID,
+		RoomName:  s.RoomName,
+		LabName:   s.LabName,
+		Total:     s.Stat.Total,
+		Available: s.Stat.Available,
+		Partial:   s.Stat.Partial,
+		Busy:      s.Stat.Busy,
+		UsageRate: s.Stat.UsageRate,
+		Weekday:   weekday,
+		Hour:      at.Hour(),
+		TakenAt:   at,
+	}
+}
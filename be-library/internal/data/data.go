@@ -17,7 +17,7 @@ import (
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewData, NewDB, NewRedisDB, NewAssembler, NewSeatRepo, NewCommentRepo, NewRecordRepo, NewCreditPointsRepo)
+var ProviderSet = wire.NewSet(NewData, NewDB, NewRedisDB, NewAssembler, NewSeatRepo, NewCommentRepo, NewRecordRepo, NewCreditPointsRepo, NewFavoriteRepo, NewReserveIntentRepo, NewRedisLocker, NewOccupancyRepo, NewReminderRepo, NewRedisRateLimiter, NewStudyGroupRepo, NewRoomRepo, NewUsageRepo)
 
 // Data 做CURD时使用该框架
 type Data struct {
@@ -54,13 +54,21 @@ func NewDB(c *conf.Data) (*gorm.DB, error) {
 		return nil, fmt.Errorf("failed to connect database: %w", err)
 	}
 
-	if err = db.AutoMigrate(&DO.Seat{}, &DO.TimeSlot{}, &DO.Comment{}, &DO.FutureRecord{}, &DO.HistoryRecord{}, &DO.CreditSummary{}, &DO.CreditRecord{}); err != nil {
-		return nil, fmt.Errorf("auto migrate failed: %w", err)
+	if err = AutoMigrate(db); err != nil {
+		return nil, err
 	}
 
 	return db, nil
 }
 
+// AutoMigrate 建表，测试中也用它给 sqlite 建表
+func AutoMigrate(db *gorm.DB) error {
+	if err := db.AutoMigrate(&DO.Seat{}, &DO.TimeSlot{}, &DO.Comment{}, &DO.FutureRecord{}, &DO.HistoryRecord{}, &DO.CreditSummary{}, &DO.CreditRecord{}, &DO.FavoriteSeat{}, &DO.ReserveIntent{}, &DO.OccupancySnapshot{}, &DO.CommentReport{}, &DO.StudyGroup{}, &DO.Room{}, &DO.UsageDay{}); err != nil {
+		return fmt.Errorf("auto migrate failed: %w", err)
+	}
+	return nil
+}
+
 // NewRedisDB 连接redis
 func NewRedisDB(c *conf.Data, logger log.Logger) *redis.Client {
 	rdb := redis.NewClient(&redis.Options{
@@ -1,9 +1,49 @@
 package data
 
-// type FavoriteRepo struct {
-// }
+import (
+	"context"
 
-// type FavoriteRepo interface {
+	"github.com/asynccnu/ccnubox-be/be-library/internal/biz"
+	"github.com/asynccnu/ccnubox-be/be-library/internal/data/DO"
+	"gorm.io/gorm/clause"
+)
 
-// }
+type favoriteRepo struct {
+	data *Data
+}
 
+func NewFavoriteRepo(data *Data) biz.FavoriteRepo {
+	return &favoriteRepo{
+		data: data,
+	}
+}
+
+// AddFavourite 依赖 idx_student_dev 唯一索引去重，重复收藏直接忽略
+func (r *favoriteRepo) AddFavourite(ctx context.Context, fav *biz.FavoriteSeat) error {
+	do := ConvertBizFavoriteSeatDO(fav)
+	return r.data.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(do).Error
+}
+
+func (r *favoriteRepo) RemoveFavourite(ctx context.Context, stuID, seatID string) (bool, error) {
+	res := r.data.db.WithContext(ctx).
+		Where("student_id = ? AND seat_id = ?", stuID, seatID).
+		Delete(&DO.FavoriteSeat{})
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected > 0, nil
+}
+
+// ListFavourites 按收藏时间先后返回
+func (r *favoriteRepo) ListFavourites(ctx context.Context, stuID string) ([]*biz.FavoriteSeat, error) {
+	var dos []*DO.FavoriteSeat
+	if err := r.data.db.WithContext(ctx).
+		Where("student_id = ?", stuID).
+		Order("create_time ASC, id ASC").
+		Find(&dos).Error; err != nil {
+		return nil, err
+	}
+	return ConvertDOFavoriteSeatsBiz(dos), nil
+}
@@ -0,0 +1,45 @@
+package data
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"github.com/asynccnu/ccnubox-be/be-library/internal/biz"
+	"github.com/redis/go-redis/v9"
+)
+
+// unlockScript 只有持有者才能释放锁，避免锁过期后误删其他实例的锁
+var unlockScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('DEL', KEYS[1])
+end
+return 0
+`)
+
+type redisLocker struct {
+	data *Data
+}
+
+func NewRedisLocker(data *Data) biz.Locker {
+	return &redisLocker{data: data}
+}
+
+func (l *redisLocker) TryLock(ctx context.Context, key string, ttl time.Duration) (func(), bool, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return nil, false, err
+	}
+	token := hex.EncodeToString(buf)
+	ok, err := l.data.redis.SetNX(ctx, key, token, ttl).Result()
+	if err != nil || !ok {
+		return nil, false, err
+	}
+	return func() {
+		// 释放锁不受调用方 ctx 取消的影响
+		if err := unlockScript.Run(context.Background(), l.data.redis, []string{key}, token).Err(); err != nil {
+			l.data.log.Warnf("release lock(%s) failed: %v", key, err)
+		}
+	}, true, nil
+}
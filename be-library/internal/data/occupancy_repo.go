@@ -0,0 +1,103 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/asynccnu/ccnubox-be/be-library/internal/biz"
+	"github.com/asynccnu/ccnubox-be/be-library/internal/data/DO"
+)
+
+const (
+	cacheKeyOccupancySnapFmt = "lib:room:%s:occupancy_snap"
+	// 座位缓存刷新很频繁，每个房间每隔一段时间才记录一次快照
+	occupancySnapshotInterval = 10 * time.Minute
+	// 快照保留时间
+	occupancySnapshotRetention = 26 * 7 * 24 * time.Hour
+)
+
+type occupancyRepo struct {
+	data *Data
+}
+
+func NewOccupancyRepo(data *Data) biz.OccupancyRepo {
+	return &occupancyRepo{
+		data: data,
+	}
+}
+
+// SaveSnapshots 每个房间先用 SetNX 抢占这一时段的快照，写库失败时释放，下一次刷新还能重新记录
+func (r *occupancyRepo) SaveSnapshots(ctx context.Context, stats []*biz.RoomStatistics, at time.Time) error {
+	dos := make([]*DO.OccupancySnapshot, 0, len(stats))
+	keys := make([]string, 0, len(stats))
+	for _, s := range stats {
+		key := fmt.Sprintf(cacheKeyOccupancySnapFmt, s.RoomID)
+		ok, err := r.data.redis.SetNX(ctx, key, at.UnixMilli(), occupancySnapshotInterval).Result()
+		if err != nil {
+			r.releaseSnapshotKeys(ctx, keys)
+			return err
+		}
+		if !ok {
+			continue
+		}
+		keys = append(keys, key)
+		dos = append(dos, ConvertBizRoomStatisticsDO(s, at))
+	}
+	if len(dos) == 0 {
+		return nil
+	}
+
+	db := r.data.db.WithContext(ctx)
+	if err := db.Create(&dos).Error; err != nil {
+		r.releaseSnapshotKeys(ctx, keys)
+		return err
+	}
+	// 随快照一起清理过期数据
+	return db.Where("taken_at < ?", at.Add(-occupancySnapshotRetention)).Delete(&DO.OccupancySnapshot{}).Error
+}
+
+func (r *occupancyRepo) releaseSnapshotKeys(ctx context.Context, keys []string) {
+	if len(keys) == 0 {
+		return
+	}
+	if err := r.data.redis.Del(ctx, keys...).Err(); err != nil {
+		r.data.log.Warnf("release occupancy snapshot keys %v err: %v", keys, err)
+	}
+}
+
+func (r *occupancyRepo) GetHeatmap(ctx context.Context, filter biz.HeatmapFilter) ([]*biz.HeatmapCell, error) {
+	type row struct {
+		Weekday int
+		Hour    int
+		Busy    int64
+		Total   int64
+		Samples int64
+	}
+
+	query := r.data.db.WithContext(ctx).
+		Model(&DO.OccupancySnapshot{}).
+		Select("weekday, hour, SUM(busy) AS busy, SUM(total) AS total, COUNT(*) AS samples").
+		Where("taken_at >= ?", filter.Since)
+	if filter.RoomID != "" {
+		query = query.Where("room_id = ?", filter.RoomID)
+	}
+	if filter.LabName != "" {
+		query = query.Where("lab_name = ?", filter.LabName)
+	}
+
+	var rows []row
+	if err := query.Group("weekday, hour").Order("weekday, hour").Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	cells := make([]*biz.HeatmapCell, 0, len(rows))
+	for _, rw := range rows {
+		cell := &biz.HeatmapCell{Weekday: rw.Weekday, Hour: rw.Hour, Samples: rw.Samples}
+		if rw.Total > 0 {
+			cell.UsageRate = float64(rw.Busy) / float64(rw.Total)
+		}
+		cells = append(cells, cell)
+	}
+	return cells, nil
+}
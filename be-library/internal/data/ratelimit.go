@@ -0,0 +1,39 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/asynccnu/ccnubox-be/be-library/internal/biz"
+	"github.com/redis/go-redis/v9"
+)
+
+// allowScript 固定窗口计数，第一次计数时设置过期时间
+// KEYS: 计数 key
+// ARGV: 上限, 窗口(ms)
+var allowScript = redis.NewScript(`
+local n = redis.call('INCR', KEYS[1])
+if n == 1 then
+	redis.call('PEXPIRE', KEYS[1], ARGV[2])
+end
+if n > tonumber(ARGV[1]) then
+	return 0
+end
+return 1
+`)
+
+type redisRateLimiter struct {
+	data *Data
+}
+
+func NewRedisRateLimiter(data *Data) biz.RateLimiter {
+	return &redisRateLimiter{data: data}
+}
+
+func (l *redisRateLimiter) Allow(ctx context.Context, key string, limit int, window time.Duration) (bool, error) {
+	ok, err := allowScript.Run(ctx, l.data.redis, []string{key}, limit, window.Milliseconds()).Int()
+	if err != nil {
+		return false, err
+	}
+	return ok == 1, nil
+}
@@ -119,6 +119,19 @@ func (r *recordRepo) ListFutureRecords(ctx context.Context, stuID string) ([]*bi
 	return out, nil
 }
 
+// ListActiveStudents 时间以 2006-01-02 15:04 格式存储，可以直接按字符串比较
+func (r *recordRepo) ListActiveStudents(ctx context.Context, from, to string) ([]string, error) {
+	var stuIDs []string
+	if err := r.data.db.WithContext(ctx).
+		Model(&DO.FutureRecord{}).
+		Where("`start` <= ? AND `end` >= ?", to, from).
+		Distinct().
+		Pluck("stu_id", &stuIDs).Error; err != nil {
+		return nil, err
+	}
+	return stuIDs, nil
+}
+
 // 历史预约缓存
 func (r *recordRepo) historyRecordKey(stuID string) string {
 	return fmt.Sprintf("%s%s", historyRecordKeyPrefix, stuID)
@@ -167,7 +180,7 @@ func (r *recordRepo) UpsertHistoryRecords(ctx context.Context, stuID string, lis
 				{Name: "submit_time"},
 			},
 			DoUpdates: clause.AssignmentColumns([]string{
-				"place", "floor", "status", "date",
+				"place", "floor", "room_name", "status", "date",
 			}),
 		}).Create(&dos).Error; err != nil {
 		return err
@@ -0,0 +1,26 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/asynccnu/ccnubox-be/be-library/internal/biz"
+)
+
+const reminderKeyFmt = "lib:remind:%s:%s:%s"
+
+type reminderRepo struct {
+	data *Data
+}
+
+func NewReminderRepo(data *Data) biz.ReminderRepo {
+	return &reminderRepo{
+		data: data,
+	}
+}
+
+func (r *reminderRepo) MarkReminded(ctx context.Context, stuID, recordKey string, kind biz.ReminderKind, ttl time.Duration) (bool, error) {
+	key := fmt.Sprintf(reminderKeyFmt, stuID, recordKey, kind)
+	return r.data.redis.SetNX(ctx, key, time.Now().Unix(), ttl).Result()
+}
@@ -0,0 +1,113 @@
+package data
+
+import (
+	"context"
+	"errors"
+
+	"github.com/asynccnu/ccnubox-be/be-library/internal/biz"
+	"github.com/asynccnu/ccnubox-be/be-library/internal/data/DO"
+	"github.com/asynccnu/ccnubox-be/be-library/internal/errcode"
+	"gorm.io/gorm"
+)
+
+type reserveIntentRepo struct {
+	data *Data
+}
+
+func NewReserveIntentRepo(data *Data) biz.ReserveIntentRepo {
+	return &reserveIntentRepo{
+		data: data,
+	}
+}
+
+// SaveIntent 更新时只允许修改自己的意向，执行记录保持不变
+func (r *reserveIntentRepo) SaveIntent(ctx context.Context, intent *biz.ReserveIntent) error {
+	db := r.data.db.WithContext(ctx)
+	do := ConvertBizReserveIntentDO(intent)
+
+	if intent.ID == 0 {
+		if err := db.Create(do).Error; err != nil {
+			return err
+		}
+		*intent = *ConvertDOReserveIntentBiz(do)
+		return nil
+	}
+
+	var existing DO.ReserveIntent
+	err := db.Where("id = ? AND stu_id = ?", intent.ID, intent.StuID).First(&existing).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return errcode.ErrIntentNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	do.LastRunDate = existing.LastRunDate
+	do.LastResult = existing.LastResult
+	do.LastSeat = existing.LastSeat
+	do.CreatedAt = existing.CreatedAt
+	if err = db.Select("*").Updates(do).Error; err != nil {
+		return err
+	}
+	*intent = *ConvertDOReserveIntentBiz(do)
+	return nil
+}
+
+func (r *reserveIntentRepo) DeleteIntent(ctx context.Context, stuID string, id uint64) (bool, error) {
+	res := r.data.db.WithContext(ctx).
+		Where("id = ? AND stu_id = ?", id, stuID).
+		Delete(&DO.ReserveIntent{})
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected > 0, nil
+}
+
+// GetIntent 不存在时返回 nil
+func (r *reserveIntentRepo) GetIntent(ctx context.Context, id uint64) (*biz.ReserveIntent, error) {
+	var do DO.ReserveIntent
+	err := r.data.db.WithContext(ctx).Where("id = ?", id).First(&do).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return ConvertDOReserveIntentBiz(&do), nil
+}
+
+func (r *reserveIntentRepo) ListIntents(ctx context.Context, stuID string) ([]*biz.ReserveIntent, error) {
+	var dos []*DO.ReserveIntent
+	if err := r.data.db.WithContext(ctx).
+		Where("stu_id = ?", stuID).
+		Order("id ASC").
+		Find(&dos).Error; err != nil {
+		return nil, err
+	}
+	return ConvertDOReserveIntentsBiz(dos), nil
+}
+
+func (r *reserveIntentRepo) ListEnabledIntents(ctx context.Context) ([]*biz.ReserveIntent, error) {
+	var dos []*DO.ReserveIntent
+	if err := r.data.db.WithContext(ctx).
+		Where("enabled = ?", true).
+		Order("id ASC").
+		Find(&dos).Error; err != nil {
+		return nil, err
+	}
+	return ConvertDOReserveIntentsBiz(dos), nil
+}
+
+func (r *reserveIntentRepo) MarkIntentRun(ctx context.Context, id uint64, date, result, seat string) error {
+	if len(result) > 255 {
+		result = result[:255]
+	}
+	return r.data.db.WithContext(ctx).
+		Model(&DO.ReserveIntent{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"last_run_date": date,
+			"last_result":   result,
+			"last_seat":     seat,
+		}).Error
+}
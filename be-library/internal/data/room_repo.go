@@ -0,0 +1,83 @@
+package data
+
+import (
+	"context"
+	"errors"
+
+	"github.com/asynccnu/ccnubox-be/be-library/internal/biz"
+	"github.com/asynccnu/ccnubox-be/be-library/internal/data/DO"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type roomRepo struct {
+	data *Data
+}
+
+func NewRoomRepo(data *Data) biz.RoomRepo {
+	return &roomRepo{
+		data: data,
+	}
+}
+
+func (r *roomRepo) ListRooms(ctx context.Context, includeClosed bool) ([]*biz.Room, error) {
+	db := r.data.db.WithContext(ctx)
+	if !includeClosed {
+		db = db.Where("bookable = ?", true)
+	}
+	var dos []*DO.Room
+	if err := db.Order("branch ASC, floor ASC, id ASC").Find(&dos).Error; err != nil {
+		return nil, err
+	}
+	return ConvertDORoomsBiz(dos), nil
+}
+
+// GetRoom 不存在时返回 nil
+func (r *roomRepo) GetRoom(ctx context.Context, id string) (*biz.Room, error) {
+	var do DO.Room
+	err := r.data.db.WithContext(ctx).Where("id = ?", id).First(&do).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return ConvertDORoomBiz(&do), nil
+}
+
+// SaveRooms id 冲突时覆盖除创建时间外的所有字段
+func (r *roomRepo) SaveRooms(ctx context.Context, rooms []*biz.Room) error {
+	if len(rooms) == 0 {
+		return nil
+	}
+	dos := make([]*DO.Room, 0, len(rooms))
+	for _, room := range rooms {
+		dos = append(dos, ConvertBizRoomDO(room))
+	}
+	err := r.data.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "id"}},
+			DoUpdates: clause.AssignmentColumns([]string{
+				"name", "lab_name", "branch", "floor", "open_time", "close_time", "seat_count", "bookable", "updated_at",
+			}),
+		}).
+		Create(&dos).Error
+	if err != nil {
+		return err
+	}
+	for i, do := range dos {
+		rooms[i].UpdatedAt = do.UpdatedAt
+		if rooms[i].CreatedAt.IsZero() {
+			rooms[i].CreatedAt = do.CreatedAt
+		}
+	}
+	return nil
+}
+
+func (r *roomRepo) DeleteRoom(ctx context.Context, id string) (bool, error) {
+	res := r.data.db.WithContext(ctx).Where("id = ?", id).Delete(&DO.Room{})
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected > 0, nil
+}
@@ -25,15 +25,17 @@ const (
 )
 
 type SeatRepo struct {
-	data    *Data
-	sf      singleflight.Group
-	crawler biz.LibraryCrawler
+	data      *Data
+	sf        singleflight.Group
+	crawler   biz.LibraryCrawler
+	occupancy biz.OccupancyRepo
 }
 
-func NewSeatRepo(data *Data, crawler biz.LibraryCrawler) biz.SeatRepo {
+func NewSeatRepo(data *Data, crawler biz.LibraryCrawler, occupancy biz.OccupancyRepo) biz.SeatRepo {
 	return &SeatRepo{
-		data:    data,
-		crawler: crawler,
+		data:      data,
+		crawler:   crawler,
+		occupancy: occupancy,
 	}
 }
 
@@ -62,9 +64,14 @@ func (r *SeatRepo) SaveRoomSeatsInRedis(ctx context.Context, stuID string, roomI
 		return err
 	}
 	ts := time.Now()
+	stats := make([]*biz.RoomStatistics, 0, len(allSeats))
 
 	// 按房间存储 房间里的所有座位数据
 	for roomId, seats := range allSeats {
+		if len(seats) > 0 {
+			stats = append(stats, biz.ComputeRoomStatistics(roomId, seats, ts))
+		}
+
 		tskey := r.cacheRoomUpdateTsKey(roomId)
 		key := r.cacheRoomSeatsKey(roomId)
 		// seatID : seatJson
@@ -123,6 +130,11 @@ func (r *SeatRepo) SaveRoomSeatsInRedis(ctx context.Context, stuID string, roomI
 	}
 
 	r.data.log.Infof("All seats saved in Redis successfully")
+
+	// 记录占用快照，失败不影响座位缓存
+	if err = r.occupancy.SaveSnapshots(ctx, stats, ts); err != nil {
+		r.data.log.Warnf("save occupancy snapshots failed: %v", err)
+	}
 	return nil
 }
 
@@ -244,7 +256,7 @@ func (r *SeatRepo) FindFirstAvailableSeat(ctx context.Context, start, end int64,
 // GetSeatInfos 按楼层查缓存
 func (r *SeatRepo) GetSeatInfos(ctx context.Context, stuID string, roomIDs []string) (map[string][]*biz.Seat, error) {
 	now := time.Now()
-	result := make(map[string][]*biz.Seat, len(biz.RoomIDs))
+	result := make(map[string][]*biz.Seat, len(roomIDs))
 
 	// 是否需要后台刷新
 	needRefresh := false
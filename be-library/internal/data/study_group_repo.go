@@ -0,0 +1,85 @@
+package data
+
+import (
+	"context"
+	"errors"
+
+	"github.com/asynccnu/ccnubox-be/be-library/internal/biz"
+	"github.com/asynccnu/ccnubox-be/be-library/internal/data/DO"
+	"github.com/asynccnu/ccnubox-be/be-library/internal/errcode"
+	"gorm.io/gorm"
+)
+
+type studyGroupRepo struct {
+	data *Data
+}
+
+func NewStudyGroupRepo(data *Data) biz.StudyGroupRepo {
+	return &studyGroupRepo{
+		data: data,
+	}
+}
+
+// SaveGroup 更新时只允许修改自己的小组
+func (r *studyGroupRepo) SaveGroup(ctx context.Context, group *biz.StudyGroup) error {
+	db := r.data.db.WithContext(ctx)
+	do := ConvertBizStudyGroupDO(group)
+
+	if group.ID == 0 {
+		if err := db.Create(do).Error; err != nil {
+			return err
+		}
+		*group = *ConvertDOStudyGroupBiz(do)
+		return nil
+	}
+
+	var existing DO.StudyGroup
+	err := db.Where("id = ? AND owner_id = ?", group.ID, group.OwnerID).First(&existing).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return errcode.ErrStudyGroupNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	do.CreatedAt = existing.CreatedAt
+	if err = db.Select("*").Updates(do).Error; err != nil {
+		return err
+	}
+	*group = *ConvertDOStudyGroupBiz(do)
+	return nil
+}
+
+func (r *studyGroupRepo) DeleteGroup(ctx context.Context, ownerID string, id uint64) (bool, error) {
+	res := r.data.db.WithContext(ctx).
+		Where("id = ? AND owner_id = ?", id, ownerID).
+		Delete(&DO.StudyGroup{})
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected > 0, nil
+}
+
+// GetGroup 不存在时返回 nil
+func (r *studyGroupRepo) GetGroup(ctx context.Context, ownerID string, id uint64) (*biz.StudyGroup, error) {
+	var do DO.StudyGroup
+	err := r.data.db.WithContext(ctx).Where("id = ? AND owner_id = ?", id, ownerID).First(&do).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return ConvertDOStudyGroupBiz(&do), nil
+}
+
+func (r *studyGroupRepo) ListGroups(ctx context.Context, ownerID string) ([]*biz.StudyGroup, error) {
+	var dos []*DO.StudyGroup
+	if err := r.data.db.WithContext(ctx).
+		Where("owner_id = ?", ownerID).
+		Order("id ASC").
+		Find(&dos).Error; err != nil {
+		return nil, err
+	}
+	return ConvertDOStudyGroupsBiz(dos), nil
+}
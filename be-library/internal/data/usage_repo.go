@@ -0,0 +1,80 @@
+package data
+
+import (
+	"context"
+
+	"github.com/asynccnu/ccnubox-be/be-library/internal/biz"
+	"github.com/asynccnu/ccnubox-be/be-library/internal/data/DO"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type usageRepo struct {
+	data *Data
+}
+
+func NewUsageRepo(data *Data) biz.UsageRepo {
+	return &usageRepo{
+		data: data,
+	}
+}
+
+// ListUncountedHistory 查出状态变化过，或者已计入但房间变化过的记录(房间名为空时按楼层计入)
+func (r *usageRepo) ListUncountedHistory(ctx context.Context, stuID string) ([]*biz.CountableHistory, error) {
+	var dos []DO.HistoryRecord
+	if err := r.data.db.WithContext(ctx).
+		Where("stu_id = ? AND (counted_status <> status OR (counted_status <> '' AND counted_room <> COALESCE(NULLIF(room_name, ''), floor)))", stuID).
+		Find(&dos).Error; err != nil {
+		return nil, err
+	}
+	out := make([]*biz.CountableHistory, 0, len(dos))
+	for i, rec := range ConvertDOHistoryRecordsBiz(dos) {
+		out = append(out, &biz.CountableHistory{HistoryRecords: *rec, CountedStatus: dos[i].CountedStatus, CountedRoom: dos[i].CountedRoom})
+	}
+	return out, nil
+}
+
+// ApplyUsage 按 stu_id+date+room 累加，已计入的状态记为读取时的状态，房间记为计入时的房间
+// 读取后状态又发生变化的记录会在下一次统计时重新计入
+func (r *usageRepo) ApplyUsage(ctx context.Context, stuID string, deltas []*biz.UsageDay, counted []*biz.CountableHistory) error {
+	return r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, d := range deltas {
+			do := ConvertBizUsageDayDO(stuID, d)
+			if err := tx.Clauses(clause.OnConflict{
+				Columns: []clause.Column{{Name: "stu_id"}, {Name: "date"}, {Name: "room"}},
+				DoUpdates: clause.Assignments(map[string]interface{}{
+					"minutes":   gorm.Expr("minutes + ?", do.Minutes),
+					"on_time":   gorm.Expr("on_time + ?", do.OnTime),
+					"late":      gorm.Expr("late + ?", do.Late),
+					"no_show":   gorm.Expr("no_show + ?", do.NoShow),
+					"cancelled": gorm.Expr("cancelled + ?", do.Cancelled),
+				}),
+			}).Create(do).Error; err != nil {
+				return err
+			}
+		}
+		for _, rec := range counted {
+			if err := tx.Model(&DO.HistoryRecord{}).
+				Where("stu_id = ? AND submit_time = ?", stuID, rec.SubmitTime).
+				Updates(map[string]interface{}{"counted_status": rec.Status, "counted_room": rec.CountedRoom}).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *usageRepo) ListUsageDays(ctx context.Context, stuID string) ([]*biz.UsageDay, error) {
+	var dos []*DO.UsageDay
+	if err := r.data.db.WithContext(ctx).
+		Where("stu_id = ?", stuID).
+		Order("date ASC, room ASC").
+		Find(&dos).Error; err != nil {
+		return nil, err
+	}
+	out := make([]*biz.UsageDay, 0, len(dos))
+	for _, d := range dos {
+		out = append(out, ConvertDOUsageDayBiz(d))
+	}
+	return out, nil
+}
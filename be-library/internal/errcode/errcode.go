@@ -6,6 +6,26 @@ import (
 )
 
 var (
-	ErrCrawler   = errors.New(456, v1.ErrorReason_Crawler_Error.String(), "爬虫失败")
-	ErrCCNULogin = errors.New(457, v1.ErrorReason_CCNULogin_Error.String(), "请求user登录服务错误")
+	ErrCrawler                = errors.New(456, v1.ErrorReason_Crawler_Error.String(), "爬虫失败")
+	ErrCCNULogin              = errors.New(457, v1.ErrorReason_CCNULogin_Error.String(), "请求user登录服务错误")
+	ErrSeatNotFound           = errors.New(404, v1.ErrorReason_Seat_Not_Found.String(), "座位不存在")
+	ErrFavouriteNotFound      = errors.New(404, v1.ErrorReason_Favourite_Not_Found.String(), "收藏的座位不存在")
+	ErrNoAvailableSeat        = errors.New(409, v1.ErrorReason_No_Available_Seat.String(), "没有空闲的座位")
+	ErrInvalidIntent          = errors.New(400, v1.ErrorReason_Invalid_Reserve_Intent.String(), "预约意向参数错误")
+	ErrIntentNotFound         = errors.New(404, v1.ErrorReason_Reserve_Intent_Not_Found.String(), "预约意向不存在")
+	ErrInvalidComment         = errors.New(400, v1.ErrorReason_Invalid_Comment.String(), "评论参数错误")
+	ErrCommentNotFound        = errors.New(404, v1.ErrorReason_Comment_Not_Found.String(), "评论不存在")
+	ErrCommentForbidden       = errors.New(403, v1.ErrorReason_Comment_Forbidden.String(), "只能删除自己的评论")
+	ErrCommentTooFrequent     = errors.New(429, v1.ErrorReason_Comment_Rate_Limited.String(), "评论过于频繁,请稍后再试")
+	ErrCommentBlocked         = errors.New(400, v1.ErrorReason_Comment_Blocked.String(), "评论包含不当内容")
+	ErrReservationNotFound    = errors.New(404, v1.ErrorReason_Reservation_Not_Found.String(), "预约不存在")
+	ErrSwapFailed             = errors.New(409, v1.ErrorReason_Swap_Failed.String(), "换座失败,原预约已保留")
+	ErrSwapDoubleBooked       = errors.New(409, v1.ErrorReason_Swap_Double_Booked.String(), "换座未完成,新旧两个预约都还在,请取消不需要的预约")
+	ErrInvalidSwap            = errors.New(400, v1.ErrorReason_Invalid_Swap_Request.String(), "换座参数错误")
+	ErrInvalidDiscussionQuery = errors.New(400, v1.ErrorReason_Invalid_Discussion_Query.String(), "研讨间查询参数错误")
+	ErrInvalidStudyGroup      = errors.New(400, v1.ErrorReason_Invalid_Study_Group.String(), "学习小组参数错误")
+	ErrStudyGroupNotFound     = errors.New(404, v1.ErrorReason_Study_Group_Not_Found.String(), "学习小组不存在")
+	ErrInvalidRoom            = errors.New(400, v1.ErrorReason_Invalid_Room.String(), "房间参数错误")
+	ErrRoomNotFound           = errors.New(404, v1.ErrorReason_Room_Not_Found.String(), "房间不存在")
+	ErrInvalidUsageQuery      = errors.New(400, v1.ErrorReason_Invalid_Usage_Query.String(), "使用统计参数错误")
 )
@@ -157,19 +157,240 @@ func (c *Assembler) ConvertMessages(data []*biz.Comment) *pb.GetCommentResp {
 		return &pb.GetCommentResp{}
 	}
 
+	return &pb.GetCommentResp{
+		Comment: c.ConvertComments(data),
+	}
+}
+
+func (c *Assembler) ConvertComments(data []*biz.Comment) []*pb.Comment {
 	result := make([]*pb.Comment, 0, len(data))
 	for _, r := range data {
 		result = append(result, &pb.Comment{
-			Id:        int64(r.ID),
-			SeatId:    r.SeatID,
-			Username:  r.Username,
-			Content:   r.Content,
-			Rating:    int64(r.Rating),
-			CreatedAt: r.CreatedAt.String(),
+			Id:          int64(r.ID),
+			SeatId:      r.SeatID,
+			Username:    r.Username,
+			Content:     r.Content,
+			Rating:      int64(r.Rating),
+			CreatedAt:   r.CreatedAt.String(),
+			Status:      string(r.Status),
+			ReportCount: int64(r.ReportCount),
 		})
 	}
+	return result
+}
 
-	return &pb.GetCommentResp{
-		Comment: result,
+func (c *Assembler) ConvertSeatRating(r *biz.SeatRating) *pb.SeatRating {
+	if r == nil {
+		return nil
+	}
+	return &pb.SeatRating{
+		SeatId:  r.SeatID,
+		Average: r.Average,
+		Count:   r.Count,
+	}
+}
+
+func (a *Assembler) ConvertFavouriteSeats(src []*biz.FavoriteSeat) []*pb.FavouriteSeat {
+	if len(src) == 0 {
+		return nil
+	}
+	result := make([]*pb.FavouriteSeat, 0, len(src))
+	for _, f := range src {
+		result = append(result, &pb.FavouriteSeat{
+			DevId:       f.SeatID,
+			DevName:     f.SeatName,
+			RoomId:      f.RoomID,
+			RoomName:    f.RoomName,
+			LabName:     f.LayerName,
+			CreateTime:  f.CreateTime.Format("2006-01-02 15:04:05"),
+			IsAvailable: f.IsAvailable,
+			Ts:          a.ConvertTimeSlots(f.Ts),
+		})
+	}
+	return result
+}
+
+func (a *Assembler) ConvertReserveIntent(src *biz.ReserveIntent) *pb.ReserveIntent {
+	weekdays := make([]int32, 0, len(src.Weekdays))
+	for _, w := range src.Weekdays {
+		weekdays = append(weekdays, int32(w))
+	}
+	return &pb.ReserveIntent{
+		Id:          src.ID,
+		Weekdays:    weekdays,
+		Start:       src.Start,
+		End:         src.End,
+		RoomIds:     src.RoomIDs,
+		SeatIds:     src.SeatIDs,
+		Enabled:     src.Enabled,
+		LastRunDate: src.LastRunDate,
+		LastResult:  src.LastResult,
+		LastSeat:    src.LastSeat,
+	}
+}
+
+func (a *Assembler) ConvertReserveIntentBiz(stuID string, src *pb.ReserveIntent) *biz.ReserveIntent {
+	if src == nil {
+		return &biz.ReserveIntent{StuID: stuID}
+	}
+	weekdays := make([]int, 0, len(src.Weekdays))
+	for _, w := range src.Weekdays {
+		weekdays = append(weekdays, int(w))
+	}
+	return &biz.ReserveIntent{
+		ID:       src.Id,
+		StuID:    stuID,
+		Weekdays: weekdays,
+		Start:    src.Start,
+		End:      src.End,
+		RoomIDs:  src.RoomIds,
+		SeatIDs:  src.SeatIds,
+		Enabled:  src.Enabled,
+	}
+}
+
+func (a *Assembler) ConvertSeatStatistics(s biz.SeatStatistics) *pb.SeatStatistics {
+	return &pb.SeatStatistics{
+		Total:     s.Total,
+		Available: s.Available,
+		Partial:   s.Partial,
+		Busy:      s.Busy,
+		UsageRate: s.UsageRate,
+	}
+}
+
+func (a *Assembler) ConvertRoomOccupancy(r *biz.RoomStatistics) *pb.RoomOccupancy {
+	return &pb.RoomOccupancy{
+		RoomId:   r.RoomID,
+		RoomName: r.RoomName,
+		LabName:  r.LabName,
+		Stat:     a.ConvertSeatStatistics(r.Stat),
+	}
+}
+
+func (a *Assembler) ConvertDiscussionSlots(src []*biz.DiscussionSlot) []*pb.DiscussionSlot {
+	result := make([]*pb.DiscussionSlot, 0, len(src))
+	for _, s := range src {
+		result = append(result, &pb.DiscussionSlot{
+			LabId:    s.LabID,
+			LabName:  s.LabName,
+			KindId:   s.KindID,
+			KindName: s.KindName,
+			DevId:    s.DevID,
+			DevName:  s.DevName,
+			Start:    s.Start,
+			End:      s.End,
+		})
+	}
+	return result
+}
+
+func (a *Assembler) ConvertStudyGroup(src *biz.StudyGroup) *pb.StudyGroup {
+	members := make([]*pb.GroupMember, 0, len(src.Members))
+	for _, m := range src.Members {
+		members = append(members, &pb.GroupMember{
+			StudentId: m.StudentID,
+			Name:      m.Name,
+			AccountId: m.AccountID,
+		})
+	}
+	return &pb.StudyGroup{
+		Id:        src.ID,
+		Name:      src.Name,
+		Members:   members,
+		CreatedAt: src.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func (a *Assembler) ConvertRoom(src *biz.Room) *pb.Room {
+	var updatedAt string
+	if !src.UpdatedAt.IsZero() {
+		updatedAt = src.UpdatedAt.Format("2006-01-02 15:04:05")
+	}
+	return &pb.Room{
+		Id:        src.ID,
+		Name:      src.Name,
+		LabName:   src.LabName,
+		Branch:    src.Branch,
+		Floor:     int32(src.Floor),
+		OpenTime:  src.OpenTime,
+		CloseTime: src.CloseTime,
+		SeatCount: int32(src.SeatCount),
+		Bookable:  src.Bookable,
+		UpdatedAt: updatedAt,
+	}
+}
+
+func (a *Assembler) ConvertRooms(src []*biz.Room) []*pb.Room {
+	result := make([]*pb.Room, 0, len(src))
+	for _, r := range src {
+		result = append(result, a.ConvertRoom(r))
+	}
+	return result
+}
+
+func (a *Assembler) ConvertRoomBiz(src *pb.Room) *biz.Room {
+	return &biz.Room{
+		ID:        src.Id,
+		Name:      src.Name,
+		LabName:   src.LabName,
+		Branch:    src.Branch,
+		Floor:     int(src.Floor),
+		OpenTime:  src.OpenTime,
+		CloseTime: src.CloseTime,
+		SeatCount: int(src.SeatCount),
+		Bookable:  src.Bookable,
+	}
+}
+
+func (a *Assembler) ConvertUsageAnalytics(src *biz.UsageAnalytics) *pb.GetUsageAnalyticsResponse {
+	periods := func(list []*biz.UsagePeriod) []*pb.UsagePeriod {
+		out := make([]*pb.UsagePeriod, 0, len(list))
+		for _, p := range list {
+			out = append(out, &pb.UsagePeriod{Period: p.Period, Hours: p.Hours, Sessions: int32(p.Sessions)})
+		}
+		return out
+	}
+	rooms := make([]*pb.FavouriteRoom, 0, len(src.FavouriteRooms))
+	for _, r := range src.FavouriteRooms {
+		rooms = append(rooms, &pb.FavouriteRoom{Room: r.Room, Floor: r.Floor, Hours: r.Hours, Sessions: int32(r.Sessions)})
+	}
+	review := src.Review
+	return &pb.GetUsageAnalyticsResponse{
+		Weekly:         periods(src.Weekly),
+		Monthly:        periods(src.Monthly),
+		FavouriteRooms: rooms,
+		Attendance:     a.ConvertAttendanceStat(src.Attendance),
+		Streak: &pb.StudyStreak{
+			Current:      int32(src.Streak.Current),
+			Longest:      int32(src.Streak.Longest),
+			LongestStart: src.Streak.LongestStart,
+			LongestEnd:   src.Streak.LongestEnd,
+		},
+		Review: &pb.SemesterReview{
+			SemesterStart:   review.SemesterStart,
+			SemesterEnd:     review.SemesterEnd,
+			TotalHours:      review.TotalHours,
+			Sessions:        int32(review.Sessions),
+			StudyDays:       int32(review.StudyDays),
+			TopRoom:         review.TopRoom,
+			BusiestWeekday:  int32(review.BusiestWeekday),
+			BusiestDay:      review.BusiestDay,
+			BusiestDayHours: review.BusiestDayHours,
+			LongestStreak:   int32(review.LongestStreak),
+			Attendance:      a.ConvertAttendanceStat(review.Attendance),
+		},
+	}
+}
+
+func (a *Assembler) ConvertAttendanceStat(src biz.AttendanceStat) *pb.AttendanceStat {
+	return &pb.AttendanceStat{
+		OnTime:     int32(src.OnTime),
+		Late:       int32(src.Late),
+		NoShow:     int32(src.NoShow),
+		Cancelled:  int32(src.Cancelled),
+		OnTimeRate: src.OnTimeRate,
+		LateRate:   src.LateRate,
+		NoShowRate: src.NoShowRate,
 	}
 }
@@ -2,26 +2,40 @@ package service
 
 import (
 	"context"
+	"time"
 
 	pb "github.com/asynccnu/ccnubox-be/be-api/gen/proto/library/v1"
 	"github.com/asynccnu/ccnubox-be/be-library/internal/biz"
+	"github.com/asynccnu/ccnubox-be/be-library/internal/errcode"
 	"github.com/go-kratos/kratos/v2/log"
 )
 
 type LibraryService struct {
 	pb.UnimplementedLibraryServer
-	biz     biz.LibraryBiz
-	log     *log.Helper
-	conv    *Assembler
-	comment biz.CommentRepo
+	biz        biz.LibraryBiz
+	log        *log.Helper
+	conv       *Assembler
+	comment    *biz.CommentUsecase
+	favourite  *biz.FavouriteUsecase
+	agent      *biz.ReserveAgent
+	occupancy  *biz.OccupancyUsecase
+	discussion *biz.DiscussionUsecase
+	rooms      *biz.RoomCatalog
+	usage      *biz.UsageUsecase
 }
 
-func NewLibraryService(biz biz.LibraryBiz, logger log.Logger, comment biz.CommentRepo) *LibraryService {
+func NewLibraryService(biz biz.LibraryBiz, logger log.Logger, comment *biz.CommentUsecase, favourite *biz.FavouriteUsecase, agent *biz.ReserveAgent, occupancy *biz.OccupancyUsecase, discussion *biz.DiscussionUsecase, rooms *biz.RoomCatalog, usage *biz.UsageUsecase) *LibraryService {
 	return &LibraryService{
-		biz:     biz,
-		log:     log.NewHelper(logger),
-		conv:    NewAssembler(),
-		comment: comment,
+		biz:        biz,
+		log:        log.NewHelper(logger),
+		conv:       NewAssembler(),
+		comment:    comment,
+		favourite:  favourite,
+		agent:      agent,
+		occupancy:  occupancy,
+		discussion: discussion,
+		rooms:      rooms,
+		usage:      usage,
 	}
 }
 
@@ -107,6 +121,56 @@ func (ls *LibraryService) ReserveDiscussion(ctx context.Context, req *pb.Reserve
 	return &pb.ReserveDiscussionResponse{Message: msg}, nil
 }
 
+func (ls *LibraryService) SearchDiscussionSlots(ctx context.Context, req *pb.SearchDiscussionSlotsRequest) (*pb.SearchDiscussionSlotsResponse, error) {
+	slots, err := ls.discussion.SearchSlots(ctx, req.StuId, req.Date, int(req.Duration), req.ClassIds, req.From, req.To)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SearchDiscussionSlotsResponse{
+		Slots: ls.conv.ConvertDiscussionSlots(slots),
+	}, nil
+}
+
+func (ls *LibraryService) SaveStudyGroup(ctx context.Context, req *pb.SaveStudyGroupRequest) (*pb.SaveStudyGroupResponse, error) {
+	group, err := ls.discussion.SaveGroup(ctx, req.StuId, req.Id, req.Name, req.MemberIds)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SaveStudyGroupResponse{
+		Group: ls.conv.ConvertStudyGroup(group),
+	}, nil
+}
+
+func (ls *LibraryService) DeleteStudyGroup(ctx context.Context, req *pb.DeleteStudyGroupRequest) (*pb.Resp, error) {
+	if err := ls.discussion.DeleteGroup(ctx, req.StuId, req.Id); err != nil {
+		return nil, err
+	}
+	return &pb.Resp{Message: "success"}, nil
+}
+
+func (ls *LibraryService) ListStudyGroups(ctx context.Context, req *pb.ListStudyGroupsRequest) (*pb.ListStudyGroupsResponse, error) {
+	groups, err := ls.discussion.ListGroups(ctx, req.StuId)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*pb.StudyGroup, 0, len(groups))
+	for _, g := range groups {
+		result = append(result, ls.conv.ConvertStudyGroup(g))
+	}
+	return &pb.ListStudyGroupsResponse{Groups: result}, nil
+}
+
+func (ls *LibraryService) ReserveDiscussionForGroup(ctx context.Context, req *pb.ReserveDiscussionForGroupRequest) (*pb.ReserveDiscussionForGroupResponse, error) {
+	msg, invited, err := ls.discussion.ReserveForGroup(ctx, req.StuId, req.GroupId, req.DevId, req.LabId, req.KindId, req.Title, req.Start, req.End)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ReserveDiscussionForGroupResponse{
+		Message: msg,
+		Invited: int32(invited),
+	}, nil
+}
+
 func (ls *LibraryService) CancelReserve(ctx context.Context, req *pb.CancelReserveRequest) (*pb.CancelReserveResponse, error) {
 	msg, err := ls.biz.CancelReserve(ctx, req.StuId, req.Id)
 	if err != nil {
@@ -123,8 +187,16 @@ func (ls *LibraryService) ReserveSeatRandomly(ctx context.Context, req *pb.Reser
 	return &pb.ReserveSeatRandomlyResponse{Message: msg}, nil
 }
 
+func (ls *LibraryService) SwapReservation(ctx context.Context, req *pb.SwapReservationRequest) (*pb.SwapReservationResponse, error) {
+	res, err := ls.biz.SwapReservation(ctx, req.StuId, req.OldId, req.DevId, req.Start, req.End)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SwapReservationResponse{Message: res.Message, NewId: res.NewID}, nil
+}
+
 func (ls *LibraryService) CreateComment(ctx context.Context, req *pb.CreateCommentReq) (*pb.Resp, error) {
-	msg, err := ls.comment.CreateComment(&biz.CreateCommentReq{
+	msg, err := ls.comment.CreateComment(ctx, biz.CreateCommentReq{
 		SeatID:   req.SeatId,
 		Content:  req.Content,
 		Rating:   int(req.Rating),
@@ -141,17 +213,245 @@ func (ls *LibraryService) CreateComment(ctx context.Context, req *pb.CreateComme
 }
 
 func (ls *LibraryService) GetComments(ctx context.Context, req *pb.ID) (*pb.GetCommentResp, error) {
-	comments, err := ls.comment.GetCommentsBySeatID(int(req.Id))
+	comments, err := ls.comment.GetCommentsBySeatID(ctx, int(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	return ls.conv.ConvertMessages(comments), nil
+}
 
-	result := ls.conv.ConvertMessages(comments)
+func (ls *LibraryService) DeleteComment(ctx context.Context, req *pb.DeleteCommentReq) (*pb.Resp, error) {
+	if err := ls.comment.DeleteComment(ctx, int(req.Id), req.Username); err != nil {
+		return nil, err
+	}
+	return &pb.Resp{Message: "success"}, nil
+}
 
-	return result, err
+func (ls *LibraryService) ListComments(ctx context.Context, req *pb.ListCommentsRequest) (*pb.ListCommentsResponse, error) {
+	page, err := ls.comment.ListComments(ctx, req.SeatId, int(req.Page), int(req.PageSize))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListCommentsResponse{
+		Comments: ls.conv.ConvertComments(page.Comments),
+		Total:    page.Total,
+		Rating:   ls.conv.ConvertSeatRating(page.Rating),
+	}, nil
 }
 
-func (ls *LibraryService) DeleteComment(ctx context.Context, req *pb.ID) (*pb.Resp, error) {
-	msg, err := ls.comment.DeleteComment(int(req.Id))
+func (ls *LibraryService) GetSeatRatings(ctx context.Context, req *pb.GetSeatRatingsRequest) (*pb.GetSeatRatingsResponse, error) {
+	ratings, err := ls.comment.GetSeatRatings(ctx, req.SeatIds)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*pb.SeatRating, 0, len(ratings))
+	for _, r := range ratings {
+		out = append(out, ls.conv.ConvertSeatRating(r))
+	}
+	return &pb.GetSeatRatingsResponse{Ratings: out}, nil
+}
 
-	return &pb.Resp{
-		Message: msg,
-	}, err
+func (ls *LibraryService) ReportComment(ctx context.Context, req *pb.ReportCommentRequest) (*pb.Resp, error) {
+	if err := ls.comment.ReportComment(ctx, int(req.Id), req.Reporter, req.Reason); err != nil {
+		return nil, err
+	}
+	return &pb.Resp{Message: "success"}, nil
+}
+
+func (ls *LibraryService) ListReportedComments(ctx context.Context, req *pb.ListReportedCommentsRequest) (*pb.ListReportedCommentsResponse, error) {
+	comments, total, err := ls.comment.ListReportedComments(ctx, int(req.Page), int(req.PageSize))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListReportedCommentsResponse{
+		Comments: ls.conv.ConvertComments(comments),
+		Total:    total,
+	}, nil
+}
+
+func (ls *LibraryService) ModerateComment(ctx context.Context, req *pb.ModerateCommentRequest) (*pb.Resp, error) {
+	if err := ls.comment.ModerateComment(ctx, int(req.Id), req.Action); err != nil {
+		return nil, err
+	}
+	return &pb.Resp{Message: "success"}, nil
+}
+
+func (ls *LibraryService) AddFavourite(ctx context.Context, req *pb.AddFavouriteRequest) (*pb.Resp, error) {
+	if err := ls.favourite.AddFavourite(ctx, req.StuId, req.DevId, req.RoomId); err != nil {
+		return nil, err
+	}
+	return &pb.Resp{Message: "success"}, nil
+}
+
+func (ls *LibraryService) RemoveFavourite(ctx context.Context, req *pb.RemoveFavouriteRequest) (*pb.Resp, error) {
+	if err := ls.favourite.RemoveFavourite(ctx, req.StuId, req.DevId); err != nil {
+		return nil, err
+	}
+	return &pb.Resp{Message: "success"}, nil
+}
+
+func (ls *LibraryService) ListFavourites(ctx context.Context, req *pb.ListFavouritesRequest) (*pb.ListFavouritesResponse, error) {
+	favs, err := ls.favourite.ListFavourites(ctx, req.StuId, req.Start, req.End)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListFavouritesResponse{
+		Seats: ls.conv.ConvertFavouriteSeats(favs),
+	}, nil
+}
+
+func (ls *LibraryService) ReserveFavourite(ctx context.Context, req *pb.ReserveFavouriteRequest) (*pb.ReserveFavouriteResponse, error) {
+	res, err := ls.favourite.ReserveFavourite(ctx, req.StuId, req.Start, req.End, req.DevId, req.FallbackNeighbour)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ReserveFavouriteResponse{
+		Message:     res.Message,
+		DevId:       res.Seat.DevID,
+		DevName:     res.Seat.DevName,
+		RoomId:      res.Seat.RoomID,
+		IsNeighbour: res.IsNeighbor,
+	}, nil
+}
+
+func (ls *LibraryService) SaveReserveIntent(ctx context.Context, req *pb.SaveReserveIntentRequest) (*pb.SaveReserveIntentResponse, error) {
+	intent := ls.conv.ConvertReserveIntentBiz(req.StuId, req.Intent)
+	saved, err := ls.agent.SaveIntent(ctx, intent)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SaveReserveIntentResponse{
+		Intent: ls.conv.ConvertReserveIntent(saved),
+	}, nil
+}
+
+func (ls *LibraryService) DeleteReserveIntent(ctx context.Context, req *pb.DeleteReserveIntentRequest) (*pb.Resp, error) {
+	if err := ls.agent.DeleteIntent(ctx, req.StuId, req.Id); err != nil {
+		return nil, err
+	}
+	return &pb.Resp{Message: "success"}, nil
+}
+
+func (ls *LibraryService) ListReserveIntents(ctx context.Context, req *pb.ListReserveIntentsRequest) (*pb.ListReserveIntentsResponse, error) {
+	intents, err := ls.agent.ListIntents(ctx, req.StuId)
+	if err != nil {
+		return nil, err
+	}
+	cfg := ls.agent.Config()
+	result := make([]*pb.ReserveIntent, 0, len(intents))
+	for _, intent := range intents {
+		result = append(result, ls.conv.ConvertReserveIntent(intent))
+	}
+	return &pb.ListReserveIntentsResponse{
+		Intents:   result,
+		OpenTime:  cfg.OpenTime,
+		DaysAhead: int32(cfg.DaysAhead),
+	}, nil
+}
+
+func (ls *LibraryService) GetOccupancy(ctx context.Context, req *pb.GetOccupancyRequest) (*pb.GetOccupancyResponse, error) {
+	rooms, floors, err := ls.occupancy.GetOccupancy(ctx, req.StuId, req.RoomIds)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.GetOccupancyResponse{
+		Rooms:  make([]*pb.RoomOccupancy, 0, len(rooms)),
+		Floors: make([]*pb.FloorOccupancy, 0, len(floors)),
+	}
+	for _, room := range rooms {
+		resp.Rooms = append(resp.Rooms, ls.conv.ConvertRoomOccupancy(room))
+	}
+	for _, floor := range floors {
+		resp.Floors = append(resp.Floors, &pb.FloorOccupancy{
+			LabName: floor.LabName,
+			Stat:    ls.conv.ConvertSeatStatistics(floor.Stat),
+		})
+	}
+	return resp, nil
+}
+
+func (ls *LibraryService) GetOccupancyHeatmap(ctx context.Context, req *pb.GetOccupancyHeatmapRequest) (*pb.GetOccupancyHeatmapResponse, error) {
+	cells, err := ls.occupancy.GetHeatmap(ctx, req.RoomId, req.LabName, int(req.Weeks))
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*pb.HeatmapCell, 0, len(cells))
+	for _, c := range cells {
+		result = append(result, &pb.HeatmapCell{
+			Weekday:   int32(c.Weekday),
+			Hour:      int32(c.Hour),
+			UsageRate: c.UsageRate,
+			Samples:   c.Samples,
+		})
+	}
+	return &pb.GetOccupancyHeatmapResponse{Cells: result}, nil
+}
+
+func (ls *LibraryService) GetLeastBusyRoom(ctx context.Context, req *pb.GetLeastBusyRoomRequest) (*pb.GetLeastBusyRoomResponse, error) {
+	room, err := ls.occupancy.GetLeastBusyRoom(ctx, req.StuId, req.RoomIds)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetLeastBusyRoomResponse{
+		Room: ls.conv.ConvertRoomOccupancy(room),
+	}, nil
+}
+
+func (ls *LibraryService) ListRooms(ctx context.Context, req *pb.ListRoomsRequest) (*pb.ListRoomsResponse, error) {
+	rooms, err := ls.rooms.ListRooms(ctx, req.IncludeClosed)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListRoomsResponse{Rooms: ls.conv.ConvertRooms(rooms)}, nil
+}
+
+func (ls *LibraryService) SaveRoom(ctx context.Context, req *pb.SaveRoomRequest) (*pb.SaveRoomResponse, error) {
+	if req.Room == nil {
+		return nil, errcode.ErrInvalidRoom
+	}
+	room, err := ls.rooms.SaveRoom(ctx, ls.conv.ConvertRoomBiz(req.Room))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SaveRoomResponse{Room: ls.conv.ConvertRoom(room)}, nil
+}
+
+func (ls *LibraryService) DeleteRoom(ctx context.Context, req *pb.DeleteRoomRequest) (*pb.Resp, error) {
+	if err := ls.rooms.DeleteRoom(ctx, req.Id); err != nil {
+		return nil, err
+	}
+	return &pb.Resp{Message: "success"}, nil
+}
+
+func (ls *LibraryService) DiscoverRooms(ctx context.Context, req *pb.DiscoverRoomsRequest) (*pb.DiscoverRoomsResponse, error) {
+	added, total, err := ls.rooms.DiscoverRooms(ctx, req.StuId)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DiscoverRoomsResponse{
+		Added: ls.conv.ConvertRooms(added),
+		Total: int32(total),
+	}, nil
+}
+
+func (ls *LibraryService) GetUsageAnalytics(ctx context.Context, req *pb.GetUsageAnalyticsRequest) (*pb.GetUsageAnalyticsResponse, error) {
+	q := biz.UsageQuery{Weeks: int(req.Weeks), Months: int(req.Months)}
+	for _, d := range []struct {
+		src string
+		dst *time.Time
+	}{{req.SemesterStart, &q.SemesterStart}, {req.SemesterEnd, &q.SemesterEnd}} {
+		if d.src == "" {
+			continue
+		}
+		t, err := time.ParseInLocation("2006-01-02", d.src, time.Local)
+		if err != nil {
+			return nil, errcode.ErrInvalidUsageQuery
+		}
+		*d.dst = t
+	}
+	analytics, err := ls.usage.GetAnalytics(ctx, req.StuId, q, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return ls.conv.ConvertUsageAnalytics(analytics), nil
 }
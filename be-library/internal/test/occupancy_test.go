@@ -0,0 +1,56 @@
+package test
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/asynccnu/ccnubox-be/be-library/internal/biz"
+	"github.com/asynccnu/ccnubox-be/be-library/internal/conf"
+	"github.com/asynccnu/ccnubox-be/be-library/internal/data"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// 写库失败时不能占着这一时段的快照，否则要等 10 分钟后才会再记录
+func TestSaveSnapshots_RetryAfterCreateFailed(t *testing.T) {
+	ctx := context.Background()
+	// 没有迁移过的库，写快照一定失败
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	mr := miniredis.RunT(t)
+	d, err := data.NewData(&conf.Data{}, log.NewStdLogger(os.Stdout), db, redis.NewClient(&redis.Options{Addr: mr.Addr()}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	occupancy := data.NewOccupancyRepo(d)
+
+	stats := []*biz.RoomStatistics{{RoomID: "101699179", RoomName: "自习室A", LabName: "主馆图书馆一楼"}}
+	at := time.Now()
+	if err = occupancy.SaveSnapshots(ctx, stats, at); err == nil {
+		t.Fatal("expected error when the table does not exist")
+	}
+	if mr.Exists("lib:room:101699179:occupancy_snap") {
+		t.Fatal("snapshot key should be released after the insert failed")
+	}
+
+	if err = data.AutoMigrate(db); err != nil {
+		t.Fatal(err)
+	}
+	if err = occupancy.SaveSnapshots(ctx, stats, at); err != nil {
+		t.Fatal(err)
+	}
+	var n int64
+	if err = db.Table("lib_occupancy_snapshots").Count(&n).Error; err != nil {
+		t.Fatal(err)
+	}
+	if n != 1 {
+		t.Fatalf("expected 1 snapshot, got %d", n)
+	}
+}
@@ -2,90 +2,89 @@ package test
 
 import (
 	"context"
-	"flag"
 	"fmt"
 	"os"
 	"testing"
+	"time"
 
+	"github.com/alicebob/miniredis/v2"
 	"github.com/asynccnu/ccnubox-be/be-library/internal/biz"
 	"github.com/asynccnu/ccnubox-be/be-library/internal/client"
 	"github.com/asynccnu/ccnubox-be/be-library/internal/conf"
 	"github.com/asynccnu/ccnubox-be/be-library/internal/crawler"
+	"github.com/asynccnu/ccnubox-be/be-library/internal/crawler/fake"
 	"github.com/asynccnu/ccnubox-be/be-library/internal/data"
-	"github.com/asynccnu/ccnubox-be/be-library/internal/registry"
-	"github.com/go-kratos/kratos/v2/config"
-	"github.com/go-kratos/kratos/v2/config/file"
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
 )
 
-var confPath string
-
-func init() {
-	flag.StringVar(&confPath, "conf", "../../configs", "config path, eg: -conf config.yaml")
-}
-
 // 全局 repo
 var repo *data.SeatRepo
 var bizz biz.LibraryBiz
-var libraryCrawler crawler.Crawler
+var records biz.RecordRepo
+var usageRepo biz.UsageRepo
+var usage *biz.UsageUsecase
 
-// TestMain 在所有测试前初始化依赖
-func TestMain(m *testing.M) {
-	flag.Parse()
+// 模拟的预约系统，每个房间两个座位，不需要真实的账号
+var library *fake.Server
 
-	c := config.New(
-		config.WithSource(file.NewSource(confPath)),
-	)
-	defer c.Close()
+const stuID = "2023000000"
 
-	if err := c.Load(); err != nil {
+// TestMain 在所有测试前初始化依赖
+func TestMain(m *testing.M) {
+	// 用内存 sqlite 和 miniredis 代替 MySQL 和 Redis，不依赖外部服务
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
 		panic(err)
 	}
-
-	var bc conf.Bootstrap
-	if err := c.Scan(&bc); err != nil {
+	if err = data.AutoMigrate(db); err != nil {
 		panic(err)
 	}
-
-	// 初始化 DB + Redis
-	db, err := data.NewDB(bc.Data)
+	mr, err := miniredis.Run()
 	if err != nil {
 		panic(err)
 	}
-	rdb := data.NewRedisDB(bc.Data, log.NewStdLogger(os.Stdout))
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
 
-	confServer := bc.Server
-	confRegistry := bc.Registry
 	logger := log.NewStdLogger(os.Stdout)
 
-	cookiePool := client.NewCookiePoolProvider()
-	etcdRegistry := registry.NewRegistrarServer(confRegistry, logger)
-	userServiceClient, err := client.NewClient(etcdRegistry, confRegistry, logger)
-	if err != nil {
-		panic(err)
+	library = fake.NewServer()
+	for _, roomID := range biz.DefaultRoomIDs() {
+		library.AddRoom(fake.Room{ID: roomID, LabName: "主馆", Name: roomID, Seats: []fake.Seat{
+			{DevID: roomID + "01", DevName: "A01"},
+			{DevID: roomID + "02", DevName: "A02"},
+		}})
 	}
-	ccnuServiceProxy := client.NewCCNUServiceProxy(userServiceClient)
-	duration := biz.NewWaitTime(confServer)
-	libraryCrawler := crawler.NewLibraryCrawler(logger, cookiePool, ccnuServiceProxy, duration)
 
-	d, err := data.NewData(bc.Data, log.NewStdLogger(os.Stdout), db, rdb)
+	cookiePool := client.NewCookiePool(30*time.Minute, library.URL())
+	libraryCrawler := crawler.NewLibraryCrawler(logger, cookiePool, library, biz.NewWaitTime(&conf.Server{Grpc: &conf.Server_GRPC{}}))
+
+	d, err := data.NewData(&conf.Data{Redis: &conf.Data_Redis{Ttl: durationpb.New(10 * time.Minute)}}, log.NewStdLogger(os.Stdout), db, rdb)
 	if err != nil {
 		panic(err)
 	}
 
-	repo = data.NewSeatRepo(d, libraryCrawler).(*data.SeatRepo)
-	bizz = biz.NewLibraryBiz(libraryCrawler, log.NewStdLogger(os.Stdout), repo, nil, nil)
+	repo = data.NewSeatRepo(d, libraryCrawler, data.NewOccupancyRepo(d)).(*data.SeatRepo)
+	records = data.NewRecordRepo(d)
+	bizz = biz.NewLibraryBiz(libraryCrawler, log.NewStdLogger(os.Stdout), repo, records, nil, nil)
+	usageRepo = data.NewUsageRepo(d)
+	usage = biz.NewUsageUsecase(usageRepo, data.NewRedisLocker(d), logger)
 
 	// 执行测试
-	m.Run()
+	code := m.Run()
+	library.Close()
+	mr.Close()
+	os.Exit(code)
 }
 
 // 10s -> 8s
 func TestSaveRoomSeatsInRedis(t *testing.T) {
-	stuID := ""
 	ctx := context.Background()
 
-	err := repo.SaveRoomSeatsInRedis(ctx, stuID, biz.RoomIDs)
+	err := repo.SaveRoomSeatsInRedis(ctx, stuID, biz.DefaultRoomIDs())
 	if err != nil {
 		panic(err)
 	}
@@ -93,9 +92,8 @@ func TestSaveRoomSeatsInRedis(t *testing.T) {
 
 func TestGetSeat(t *testing.T) {
 	ctx := context.Background()
-	stuID := ""
 
-	seats, err := repo.GetSeatInfos(ctx, stuID, biz.RoomIDs)
+	seats, err := repo.GetSeatInfos(ctx, stuID, biz.DefaultRoomIDs())
 	if err != nil {
 		panic(err)
 	}
@@ -108,21 +106,45 @@ func TestGetSeat(t *testing.T) {
 func TestFindFirstAvailbleSeat(t *testing.T) {
 	roomIDs := []string{"100455824"}
 	ctx := context.Background()
+	today := time.Now().Format("2006-01-02")
+
+	// 第一个座位 20:00-21:00 被占用
+	id, err := library.Reserve("other", "10045582401", today+" 20:00", today+" 21:00")
+	if err != nil {
+		panic(err)
+	}
+	defer library.Cancel(id)
+
+	if err = repo.SaveRoomSeatsInRedis(ctx, stuID, roomIDs); err != nil {
+		panic(err)
+	}
 	devid, _, err := repo.FindFirstAvailableSeat(ctx, 2000, 2100, roomIDs)
 	if err != nil {
 		panic(err)
 	}
-	fmt.Println(devid)
+	if devid != "10045582402" {
+		t.Fatalf("expected the free seat 10045582402, got %s", devid)
+	}
 }
 
 func TestReserveSeatRandomly(t *testing.T) {
-	id := ""
-	start := "2025-10-16 20:00"
-	end := "2025-10-16 21:00"
+	today := time.Now().Format("2006-01-02")
+	start := today + " 20:00"
+	end := today + " 21:00"
 	ctx := context.Background()
-	msg, err := bizz.ReserveSeatRandomly(ctx, id, start, end, biz.RoomIDs)
+
+	if err := repo.SaveRoomSeatsInRedis(ctx, stuID, biz.DefaultRoomIDs()); err != nil {
+		panic(err)
+	}
+	msg, err := bizz.ReserveSeatRandomly(ctx, stuID, start, end, biz.DefaultRoomIDs())
 	if err != nil {
 		panic(err)
 	}
 	fmt.Println(msg)
-}
\ No newline at end of file
+
+	records := library.Reservations(stuID)
+	if len(records) != 1 {
+		t.Fatalf("expected one reservation, got %+v", records)
+	}
+	library.Cancel(records[0].ID)
+}
@@ -0,0 +1,49 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/asynccnu/ccnubox-be/be-library/internal/biz"
+)
+
+// 只有房间变化的记录也要重新计入，从旧房间扣除并计入新房间
+func TestUsageRefresh_OnlyRoomChanged(t *testing.T) {
+	ctx := context.Background()
+	const stu = "2023000001"
+	rec := &biz.HistoryRecords{Place: "A001", Floor: "主馆图书馆一楼", Status: "预约成功,已签到", Date: "2025-10-13 08:00-10:00", SubmitTime: "2025-10-12 20:00"}
+
+	if err := records.UpsertHistoryRecords(ctx, stu, []*biz.HistoryRecords{rec}); err != nil {
+		t.Fatal(err)
+	}
+	if err := usage.Refresh(ctx, stu); err != nil {
+		t.Fatal(err)
+	}
+
+	rec.Room = "自习室A"
+	if err := records.UpsertHistoryRecords(ctx, stu, []*biz.HistoryRecords{rec}); err != nil {
+		t.Fatal(err)
+	}
+	if err := usage.Refresh(ctx, stu); err != nil {
+		t.Fatal(err)
+	}
+	// 已经挪过房间的记录不会重复计入
+	if err := usage.Refresh(ctx, stu); err != nil {
+		t.Fatal(err)
+	}
+
+	days, err := usageRepo.ListUsageDays(ctx, stu)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := make(map[string]*biz.UsageDay, len(days))
+	for _, d := range days {
+		got[d.Room] = d
+	}
+	if d := got["主馆图书馆一楼"]; d == nil || d.Minutes != 0 || d.OnTime != 0 {
+		t.Fatalf("sign-in should be moved out of the floor: %+v", d)
+	}
+	if d := got["自习室A"]; d == nil || d.Minutes != 120 || d.OnTime != 1 {
+		t.Fatalf("sign-in should be counted under the new room: %+v", d)
+	}
+}
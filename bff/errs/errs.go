@@ -54,6 +54,22 @@ var (
 	Del_CALENDAR_ERROR = func(err error) error {
 		return errorx.New(http.StatusInternalServerError, INTERNAL_SERVER_ERROR_CODE, "删除日历失败!", "Calendar", err)
 	}
+
+	GET_SEMESTER_ERROR = func(err error) error {
+		return errorx.New(http.StatusInternalServerError, INTERNAL_SERVER_ERROR_CODE, "获取校历失败!", "Calendar", err)
+	}
+
+	SAVE_SEMESTER_ERROR = func(err error) error {
+		return errorx.New(http.StatusInternalServerError, INTERNAL_SERVER_ERROR_CODE, "保存学期失败!", "Calendar", err)
+	}
+
+	DEL_SEMESTER_ERROR = func(err error) error {
+		return errorx.New(http.StatusInternalServerError, INTERNAL_SERVER_ERROR_CODE, "删除学期失败!", "Calendar", err)
+	}
+
+	SEMESTER_NOT_FOUND_ERROR = func(err error) error {
+		return errorx.New(http.StatusNotFound, BAD_ENTITY_ERROR_CODE, "校历中没有对应的学期!", "Calendar", err)
+	}
 )
 
 // InfoSum
@@ -130,6 +146,39 @@ var (
 	SEARCH_CLASS_ERROR = func(err error) error {
 		return errorx.New(http.StatusInternalServerError, INTERNAL_SERVER_ERROR_CODE, "搜索课程失败!", "Class", err)
 	}
+
+	SUGGEST_CLASS_ERROR = func(err error) error {
+		return errorx.New(http.StatusInternalServerError, INTERNAL_SERVER_ERROR_CODE, "获取搜索联想词失败!", "Class", err)
+	}
+
+	CHECK_CLASS_CONFLICT_ERROR = func(err error) error {
+		return errorx.New(http.StatusInternalServerError, INTERNAL_SERVER_ERROR_CODE, "检查课程冲突失败!", "Class", err)
+	}
+	GET_TERMS_ERROR = func(err error) error {
+		return errorx.New(http.StatusInternalServerError, INTERNAL_SERVER_ERROR_CODE, "获取学期列表失败!", "Class", err)
+	}
+	COPY_ADDED_CLASSES_ERROR = func(err error) error {
+		return errorx.New(http.StatusInternalServerError, INTERNAL_SERVER_ERROR_CODE, "复制课程失败!", "Class", err)
+	}
+	IMPORT_CLASSES_ERROR = func(err error) error {
+		return errorx.New(http.StatusInternalServerError, INTERNAL_SERVER_ERROR_CODE, "导入课表失败!", "Class", err)
+	}
+	GET_CRAWLER_HEALTH_ERROR = func(err error) error {
+		return errorx.New(http.StatusInternalServerError, INTERNAL_SERVER_ERROR_CODE, "获取爬虫健康状态失败!", "Class", err)
+	}
+)
+
+// Classroom
+var (
+	GET_CLASSROOM_SCHEDULE_ERROR = func(err error) error {
+		return errorx.New(http.StatusInternalServerError, INTERNAL_SERVER_ERROR_CODE, "获取教室占用情况失败!", "Classroom", err)
+	}
+	SAVE_CLASSROOM_META_ERROR = func(err error) error {
+		return errorx.New(http.StatusInternalServerError, INTERNAL_SERVER_ERROR_CODE, "保存教室信息失败!", "Classroom", err)
+	}
+	DELETE_CLASSROOM_META_ERROR = func(err error) error {
+		return errorx.New(http.StatusInternalServerError, INTERNAL_SERVER_ERROR_CODE, "删除教室信息失败!", "Classroom", err)
+	}
 )
 
 var (
@@ -148,6 +197,10 @@ var (
 	ELECPRICE_CANCEL_STANDARD_ERROR = func(err error) error {
 		return errorx.New(http.StatusInternalServerError, INTERNAL_SERVER_ERROR_CODE, "取消电费提醒标准失败!", "elecprice", err)
 	}
+
+	ELECPRICE_GET_USAGE_HISTORY_ERROR = func(err error) error {
+		return errorx.New(http.StatusInternalServerError, INTERNAL_SERVER_ERROR_CODE, "获取用电历史失败!", "elecprice", err)
+	}
 )
 
 // Feed
@@ -344,6 +397,14 @@ var (
 		return errorx.New(http.StatusInternalServerError, INTERNAL_SERVER_ERROR_CODE, "取消座位失败!", "Library", err)
 	}
 
+	SWAP_RESERVATION_ERROR = func(err error) error {
+		return errorx.New(http.StatusInternalServerError, INTERNAL_SERVER_ERROR_CODE, "换座失败!", "Library", err)
+	}
+
+	SWAP_DOUBLE_BOOKED_ERROR = func(err error) error {
+		return errorx.New(http.StatusConflict, BAD_ENTITY_ERROR_CODE, "换座未完成,新旧两个预约都还在,请取消不需要的预约!", "Library", err)
+	}
+
 	GET_CREDIT_POINTS_ERROR = func(err error) error {
 		return errorx.New(http.StatusInternalServerError, INTERNAL_SERVER_ERROR_CODE, "获取信誉分失败!", "Library", err)
 	}
@@ -360,6 +421,26 @@ var (
 		return errorx.New(http.StatusInternalServerError, INTERNAL_SERVER_ERROR_CODE, "预约研讨间失败!", "Library", err)
 	}
 
+	SEARCH_DISCUSSION_SLOTS_ERROR = func(err error) error {
+		return errorx.New(http.StatusInternalServerError, INTERNAL_SERVER_ERROR_CODE, "查找研讨间空闲时段失败!", "Library", err)
+	}
+
+	SAVE_STUDY_GROUP_ERROR = func(err error) error {
+		return errorx.New(http.StatusInternalServerError, INTERNAL_SERVER_ERROR_CODE, "保存学习小组失败!", "Library", err)
+	}
+
+	DELETE_STUDY_GROUP_ERROR = func(err error) error {
+		return errorx.New(http.StatusInternalServerError, INTERNAL_SERVER_ERROR_CODE, "删除学习小组失败!", "Library", err)
+	}
+
+	GET_STUDY_GROUP_ERROR = func(err error) error {
+		return errorx.New(http.StatusInternalServerError, INTERNAL_SERVER_ERROR_CODE, "获取学习小组失败!", "Library", err)
+	}
+
+	RESERVE_DISCUSSION_FOR_GROUP_ERROR = func(err error) error {
+		return errorx.New(http.StatusInternalServerError, INTERNAL_SERVER_ERROR_CODE, "按学习小组预约研讨间失败!", "Library", err)
+	}
+
 	CANCEL_DISCUSSION_ERROR = func(err error) error {
 		return errorx.New(http.StatusInternalServerError, INTERNAL_SERVER_ERROR_CODE, "取消研讨间失败!", "Library", err)
 	}
@@ -375,6 +456,78 @@ var (
 	DELETE_COMMENT_ERROR = func(err error) error {
 		return errorx.New(http.StatusInternalServerError, INTERNAL_SERVER_ERROR_CODE, "删除评论失败!", "Library", err)
 	}
+
+	ADD_FAVOURITE_ERROR = func(err error) error {
+		return errorx.New(http.StatusInternalServerError, INTERNAL_SERVER_ERROR_CODE, "收藏座位失败!", "Library", err)
+	}
+
+	REMOVE_FAVOURITE_ERROR = func(err error) error {
+		return errorx.New(http.StatusInternalServerError, INTERNAL_SERVER_ERROR_CODE, "取消收藏失败!", "Library", err)
+	}
+
+	GET_FAVOURITE_ERROR = func(err error) error {
+		return errorx.New(http.StatusInternalServerError, INTERNAL_SERVER_ERROR_CODE, "获取收藏座位失败!", "Library", err)
+	}
+
+	RESERVE_FAVOURITE_ERROR = func(err error) error {
+		return errorx.New(http.StatusInternalServerError, INTERNAL_SERVER_ERROR_CODE, "预约收藏座位失败!", "Library", err)
+	}
+
+	SAVE_RESERVE_INTENT_ERROR = func(err error) error {
+		return errorx.New(http.StatusInternalServerError, INTERNAL_SERVER_ERROR_CODE, "保存自动预约失败!", "Library", err)
+	}
+
+	DELETE_RESERVE_INTENT_ERROR = func(err error) error {
+		return errorx.New(http.StatusInternalServerError, INTERNAL_SERVER_ERROR_CODE, "删除自动预约失败!", "Library", err)
+	}
+
+	GET_RESERVE_INTENT_ERROR = func(err error) error {
+		return errorx.New(http.StatusInternalServerError, INTERNAL_SERVER_ERROR_CODE, "获取自动预约失败!", "Library", err)
+	}
+
+	GET_OCCUPANCY_ERROR = func(err error) error {
+		return errorx.New(http.StatusInternalServerError, INTERNAL_SERVER_ERROR_CODE, "获取座位占用统计失败!", "Library", err)
+	}
+
+	GET_OCCUPANCY_HEATMAP_ERROR = func(err error) error {
+		return errorx.New(http.StatusInternalServerError, INTERNAL_SERVER_ERROR_CODE, "获取座位占用热力图失败!", "Library", err)
+	}
+
+	GET_LEAST_BUSY_ROOM_ERROR = func(err error) error {
+		return errorx.New(http.StatusInternalServerError, INTERNAL_SERVER_ERROR_CODE, "获取最空闲的房间失败!", "Library", err)
+	}
+
+	LIST_ROOMS_ERROR = func(err error) error {
+		return errorx.New(http.StatusInternalServerError, INTERNAL_SERVER_ERROR_CODE, "获取房间列表失败!", "Library", err)
+	}
+
+	SAVE_ROOM_ERROR = func(err error) error {
+		return errorx.New(http.StatusInternalServerError, INTERNAL_SERVER_ERROR_CODE, "保存房间失败!", "Library", err)
+	}
+
+	DELETE_ROOM_ERROR = func(err error) error {
+		return errorx.New(http.StatusInternalServerError, INTERNAL_SERVER_ERROR_CODE, "删除房间失败!", "Library", err)
+	}
+
+	DISCOVER_ROOMS_ERROR = func(err error) error {
+		return errorx.New(http.StatusInternalServerError, INTERNAL_SERVER_ERROR_CODE, "发现新房间失败!", "Library", err)
+	}
+
+	GET_USAGE_ANALYTICS_ERROR = func(err error) error {
+		return errorx.New(http.StatusInternalServerError, INTERNAL_SERVER_ERROR_CODE, "获取使用统计失败!", "Library", err)
+	}
+
+	GET_SEAT_RATING_ERROR = func(err error) error {
+		return errorx.New(http.StatusInternalServerError, INTERNAL_SERVER_ERROR_CODE, "获取座位评分失败!", "Library", err)
+	}
+
+	REPORT_COMMENT_ERROR = func(err error) error {
+		return errorx.New(http.StatusInternalServerError, INTERNAL_SERVER_ERROR_CODE, "举报评论失败!", "Library", err)
+	}
+
+	MODERATE_COMMENT_ERROR = func(err error) error {
+		return errorx.New(http.StatusInternalServerError, INTERNAL_SERVER_ERROR_CODE, "审核评论失败!", "Library", err)
+	}
 )
 
 // swag
@@ -167,7 +167,15 @@ func InitClassHandler(client1 classlistv1.ClasserClient, client2 cs.ClassService
 }
 
 func InitClassRoomHandler(client cs.FreeClassroomSvcClient) *classroom.ClassRoomHandler {
-	return classroom.NewClassRoomHandler(client)
+	var administrators []string
+	err := viper.UnmarshalKey("administrators", &administrators)
+	if err != nil {
+		panic(err)
+	}
+	return classroom.NewClassRoomHandler(client,
+		slice.ToMapV(administrators, func(element string) (string, struct{}) {
+			return element, struct{}{}
+		}))
 }
 func InitGradeHandler(l logger.Logger, gradeClient gradev1.GradeServiceClient, counterServiceClient counterv1.CounterServiceClient) *grade.GradeHandler {
 	var administrators []string
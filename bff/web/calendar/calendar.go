@@ -26,6 +26,10 @@ func (h *CalendarHandler) RegisterRoutes(s *gin.RouterGroup, authMiddleware gin.
 	sg.GET("/getCalendars", ginx.Wrap(h.GetCalendars))
 	sg.POST("/saveCalendar", authMiddleware, ginx.WrapClaimsAndReq(h.SaveCalendar))
 	sg.POST("/delCalendar", authMiddleware, ginx.WrapClaimsAndReq(h.DelCalendar))
+	sg.GET("/getSemesters", ginx.Wrap(h.GetSemesters))
+	sg.GET("/getSemesterDay", ginx.WrapReq(h.GetSemesterDay))
+	sg.POST("/saveSemester", authMiddleware, ginx.WrapClaimsAndReq(h.SaveSemester))
+	sg.POST("/delSemester", authMiddleware, ginx.WrapClaimsAndReq(h.DelSemester))
 }
 
 // GetCalendar  获取日历列表
@@ -105,6 +109,117 @@ func (h *CalendarHandler) DelCalendar(ctx *gin.Context, req DelCalendarRequest,
 	}, nil
 }
 
+// GetSemesters 获取校历中的所有学期
+// @Summary 获取校历
+// @Description 获取校历中所有学期的起止时间、节假日和调休
+// @Tags calendar
+// @Produce json
+// @Success 200 {object} web.Response{data=GetSemestersResponse} "成功"
+// @Router /calendar/getSemesters [get]
+func (h *CalendarHandler) GetSemesters(ctx *gin.Context) (web.Response, error) {
+	res, err := h.calendarClient.GetSemesters(ctx, &calendarv1.GetSemestersRequest{})
+	if err != nil {
+		return web.Response{}, errs.GET_SEMESTER_ERROR(err)
+	}
+
+	var resp GetSemestersResponse
+	err = copier.Copy(&resp, &res)
+	if err != nil {
+		return web.Response{}, errs.TYPE_CHANGE_ERROR(err)
+	}
+	return web.Response{
+		Msg:  "Success",
+		Data: resp,
+	}, nil
+}
+
+// GetSemesterDay 查询某一天是第几周
+// @Summary 查询某一天是第几周
+// @Description 查询某一天所在的学期、周次、星期,以及是否放假或调休,不传日期时查询今天
+// @Tags calendar
+// @Produce json
+// @Param date query string false "日期,形如2025-03-01"
+// @Success 200 {object} web.Response{data=GetSemesterDayResponse} "成功"
+// @Router /calendar/getSemesterDay [get]
+func (h *CalendarHandler) GetSemesterDay(ctx *gin.Context, req GetSemesterDayRequest) (web.Response, error) {
+	res, err := h.calendarClient.GetSemesterDay(ctx, &calendarv1.GetSemesterDayRequest{Date: req.Date})
+	switch {
+	case err == nil:
+	case calendarv1.IsInvalidSemesterError(err):
+		return web.Response{}, errs.INVALID_PARAM_VALUE_ERROR(err)
+	case calendarv1.IsSemesterNotFound(err):
+		return web.Response{}, errs.SEMESTER_NOT_FOUND_ERROR(err)
+	default:
+		return web.Response{}, errs.GET_SEMESTER_ERROR(err)
+	}
+
+	var resp GetSemesterDayResponse
+	err = copier.Copy(&resp, &res)
+	if err != nil {
+		return web.Response{}, errs.TYPE_CHANGE_ERROR(err)
+	}
+	return web.Response{
+		Msg:  "Success",
+		Data: resp,
+	}, nil
+}
+
+// SaveSemester 保存学期
+// @Summary 保存学期
+// @Description 新增或修改校历中的学期,包括起止时间、节假日和调休,修改后立即生效
+// @Tags calendar
+// @Accept json
+// @Produce json
+// @Param request body SaveSemesterRequest true "保存学期请求参数"
+// @Success 200 {object} web.Response "成功"
+// @Router /calendar/saveSemester [post]
+func (h *CalendarHandler) SaveSemester(ctx *gin.Context, req SaveSemesterRequest, uc ijwt.UserClaims) (web.Response, error) {
+	if !h.isAdmin(uc.StudentId) {
+		return web.Response{}, errs.ROLE_ERROR(fmt.Errorf("没有访问权限: %s", uc.StudentId))
+	}
+
+	var semester calendarv1.Semester
+	err := copier.Copy(&semester, &req.Semester)
+	if err != nil {
+		return web.Response{}, errs.TYPE_CHANGE_ERROR(err)
+	}
+
+	_, err = h.calendarClient.SaveSemester(ctx, &calendarv1.SaveSemesterRequest{Semester: &semester})
+	switch {
+	case err == nil:
+	case calendarv1.IsInvalidSemesterError(err):
+		return web.Response{}, errs.INVALID_PARAM_VALUE_ERROR(err)
+	default:
+		return web.Response{}, errs.SAVE_SEMESTER_ERROR(err)
+	}
+	return web.Response{
+		Msg: "Success",
+	}, nil
+}
+
+// DelSemester 删除学期
+// @Summary 删除学期
+// @Description 删除校历中的学期
+// @Tags calendar
+// @Accept json
+// @Produce json
+// @Param request body DelSemesterRequest true "删除学期请求参数"
+// @Success 200 {object} web.Response "成功"
+// @Router /calendar/delSemester [post]
+func (h *CalendarHandler) DelSemester(ctx *gin.Context, req DelSemesterRequest, uc ijwt.UserClaims) (web.Response, error) {
+	if !h.isAdmin(uc.StudentId) {
+		return web.Response{}, errs.ROLE_ERROR(fmt.Errorf("没有访问权限: %s", uc.StudentId))
+	}
+
+	_, err := h.calendarClient.DelSemester(ctx, &calendarv1.DelSemesterRequest{Year: req.Year, Semester: req.Semester})
+	if err != nil {
+		return web.Response{}, errs.DEL_SEMESTER_ERROR(err)
+	}
+	return web.Response{
+		Msg: "Success",
+	}, nil
+}
+
 func (h *CalendarHandler) isAdmin(studentId string) bool {
 	_, exists := h.Administrators[studentId]
 	return exists
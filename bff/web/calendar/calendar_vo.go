@@ -17,3 +17,54 @@ type Calendar struct {
 	Link string `json:"link"  binding:"required"`
 	Year int64  `json:"year"  binding:"required"`
 }
+
+// 日期的格式均为"2006-01-02"
+type Holiday struct {
+	Name      string `json:"name"`
+	StartDate string `json:"start_date" binding:"required"`
+	EndDate   string `json:"end_date" binding:"required"` //包含这一天
+}
+
+// MakeupDay 调休,在date这一天按照replace_date那一天的课表上课
+type MakeupDay struct {
+	Name        string `json:"name"`
+	Date        string `json:"date" binding:"required"`
+	ReplaceDate string `json:"replace_date" binding:"required"`
+}
+
+type Semester struct {
+	Year       string      `json:"year" binding:"required"`       //学年 "2024"代表"2024-2025学年"
+	Semester   string      `json:"semester" binding:"required"`   //学期 "1"、"2"、"3"
+	StartDate  string      `json:"start_date" binding:"required"` //正式上学的第一天,这一周为第一周
+	EndDate    string      `json:"end_date" binding:"required"`   //学期的最后一天
+	Holidays   []Holiday   `json:"holidays"`
+	MakeupDays []MakeupDay `json:"makeup_days"`
+}
+
+type GetSemestersResponse struct {
+	Semesters []Semester `json:"semesters"`
+}
+
+type SaveSemesterRequest struct {
+	Semester
+}
+
+type DelSemesterRequest struct {
+	Year     string `json:"year" binding:"required"`
+	Semester string `json:"semester" binding:"required"`
+}
+
+type GetSemesterDayRequest struct {
+	Date string `form:"date"` //为空时查询今天
+}
+
+type GetSemesterDayResponse struct {
+	Semester    Semester `json:"semester"`     //所在的学期,在假期中时为假期前的学期
+	InSemester  bool     `json:"in_semester"`  //是否在学期的起止时间内
+	Week        int64    `json:"week"`         //第几周,不在学期内时为0
+	Weekday     int64    `json:"weekday"`      //按星期几的课表上课,调休时为被替换的那一天
+	IsHoliday   bool     `json:"is_holiday"`   //是否放假
+	HolidayName string   `json:"holiday_name"` //节假日名称
+	IsMakeup    bool     `json:"is_makeup"`    //是否为调休
+	MakeupName  string   `json:"makeup_name"`
+}
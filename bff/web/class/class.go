@@ -2,6 +2,8 @@ package class
 
 import (
 	"errors"
+	"fmt"
+	"io"
 	"time"
 
 	cs "github.com/asynccnu/ccnubox-be/be-api/gen/proto/classService/v1"
@@ -40,10 +42,16 @@ func (c *ClassHandler) RegisterRoutes(s *gin.RouterGroup, authMiddleware gin.Han
 	sg.PUT("/update", authMiddleware, ginx.WrapClaimsAndReq(c.UpdateClass))
 	sg.GET("/getRecycle", authMiddleware, ginx.WrapClaimsAndReq(c.GetRecycleBinClassInfos))
 	sg.PUT("/recover", authMiddleware, ginx.WrapClaimsAndReq(c.RecoverClass))
-	sg.GET("/search", authMiddleware, ginx.WrapReq(c.SearchClass))
+	sg.GET("/search", authMiddleware, ginx.WrapClaimsAndReq(c.SearchClass))
+	sg.GET("/search/suggest", authMiddleware, ginx.WrapReq(c.SuggestClass))
 	sg.GET("/day/get", ginx.Wrap(c.GetSchoolDay))
 	sg.POST("/note/insert", authMiddleware, ginx.WrapClaimsAndReq(c.InsertClassNote))
 	sg.POST("/note/delete", authMiddleware, ginx.WrapClaimsAndReq(c.DeleteClassNote))
+	sg.POST("/conflict/check", authMiddleware, ginx.WrapClaimsAndReq(c.CheckClassConflict))
+	sg.GET("/terms", authMiddleware, ginx.WrapClaims(c.GetTerms))
+	sg.POST("/terms/copy", authMiddleware, ginx.WrapClaimsAndReq(c.CopyAddedClasses))
+	sg.POST("/import", authMiddleware, ginx.WrapClaimsAndReq(c.ImportClasses))
+	sg.GET("/crawler/health", authMiddleware, ginx.WrapClaims(c.GetCrawlerHealth))
 }
 
 // GetClassList 获取课表
@@ -87,6 +95,7 @@ func (c *ClassHandler) GetClassList(ctx *gin.Context, req GetClassListRequest, u
 			Year:         class.Info.Year,
 			Note:         class.Info.Note,
 			IsOfficial:   class.Info.IsOfficial,
+			Nature:       class.Info.Nature,
 		})
 	}
 
@@ -126,6 +135,7 @@ func (c *ClassHandler) AddClass(ctx *gin.Context, req AddClassRequest, uc ijwt.U
 		Year:     req.Year,
 		Day:      req.Day,
 		Credit:   req.Credit,
+		Force:    req.Force,
 	}
 
 	_, err := c.ClassServiceClinet.AddClass(ctx, preq)
@@ -258,24 +268,43 @@ func (c *ClassHandler) RecoverClass(ctx *gin.Context, req RecoverClassRequest, u
 
 // SearchClass 查询课程
 // @Summary 搜索课程
-// @Description 根据关键词[教师或者课程名]搜索课程,**注意,但当返回的结果数量大于page_size时,代表还有下一页**,最开始请求的是第一页
+// @Description 根据关键词[教师或者课程名]搜索课程,支持按星期、节次、周次、地点、学分、课程性质筛选以及避开自己的课表,
+// @Description **注意,但当返回的结果数量大于page_size时,代表还有下一页**,最开始请求的是第一页,
+// @Description 深度翻页时可以把返回的next_cursor作为下一次请求的cursor
 // @Tags class
 // @Produce json
 // @Param Authorization header string true "Bearer Token"
 // @Param request query SearchRequest true "查询课程请求参数"
 // @Success 200 {object} web.Response{data=SearchClassResp} "成功搜索到课程"
 // @Router /class/search [get]
-func (c *ClassHandler) SearchClass(ctx *gin.Context, req SearchRequest) (web.Response, error) {
-	if req.Page <= 0 || req.PageSize <= 0 {
+func (c *ClassHandler) SearchClass(ctx *gin.Context, req SearchRequest, uc ijwt.UserClaims) (web.Response, error) {
+	if req.PageSize <= 0 || (req.Page <= 0 && req.Cursor == "") {
 		return web.Response{}, errs.INVALID_PARAM_VALUE_ERROR(errors.New("page or pageSize must be greater than 0"))
 	}
 
+	filter := &cs.SearchFilter{
+		Days:         req.Days,
+		SectionStart: req.SectionStart,
+		SectionEnd:   req.SectionEnd,
+		Week:         req.Week,
+		WherePrefix:  req.WherePrefix,
+		MinCredit:    req.MinCredit,
+		MaxCredit:    req.MaxCredit,
+		Natures:      req.Natures,
+		FitFreeTime:  req.FitFreeTime,
+	}
+
 	classes, err := c.ClassServiceClinet.SearchClass(ctx, &cs.SearchRequest{
 		Year:           req.Year,
 		Semester:       req.Semester,
 		SearchKeyWords: req.SearchKeyWords,
 		Page:           int32(req.Page),
 		PageSize:       int32(req.PageSize),
+		Filter:         filter,
+		Sort:           cs.SearchSort(req.Sort),
+		Cursor:         req.Cursor,
+		WithFacets:     req.WithFacets,
+		StuId:          uc.StudentId,
 	})
 
 	if err != nil {
@@ -298,10 +327,20 @@ func (c *ClassHandler) SearchClass(ctx *gin.Context, req SearchRequest) (web.Res
 			Weeks:        convertWeekFromIntToArray(class.Weeks),
 			Semester:     class.Semester,
 			Year:         class.Year,
+			Nature:       class.Nature,
 		})
 	}
 
 	resp.ClassInfos = respClasses
+	resp.NextCursor = classes.NextCursor
+	resp.Total = classes.Total
+	for _, facet := range classes.Facets {
+		f := &SearchFacet{Field: facet.Field, Buckets: make([]*FacetBucket, 0, len(facet.Buckets))}
+		for _, b := range facet.Buckets {
+			f.Buckets = append(f.Buckets, &FacetBucket{Value: b.Value, Count: b.Count})
+		}
+		resp.Facets = append(resp.Facets, f)
+	}
 
 	return web.Response{
 		Msg:  "Success",
@@ -309,6 +348,40 @@ func (c *ClassHandler) SearchClass(ctx *gin.Context, req SearchRequest) (web.Res
 	}, nil
 }
 
+// SuggestClass 搜索联想词
+// @Summary 搜索联想词
+// @Description 根据已输入的内容联想课程名称和教师,支持汉字、拼音全拼和首字母,比如"gs"可以联想到"高等数学"
+// @Tags class
+// @Produce json
+// @Param Authorization header string true "Bearer Token"
+// @Param request query SuggestClassReq true "联想词请求参数"
+// @Success 200 {object} web.Response{data=SuggestClassResp} "成功"
+// @Router /class/search/suggest [get]
+func (c *ClassHandler) SuggestClass(ctx *gin.Context, req SuggestClassReq) (web.Response, error) {
+	res, err := c.ClassServiceClinet.SuggestClass(ctx, &cs.SuggestRequest{
+		Prefix:   req.Prefix,
+		Year:     req.Year,
+		Semester: req.Semester,
+		Size:     req.Size,
+	})
+	if err != nil {
+		return web.Response{}, errs.SUGGEST_CLASS_ERROR(err)
+	}
+
+	suggestions := make([]*Suggestion, 0, len(res.Suggestions))
+	for _, sg := range res.Suggestions {
+		suggestions = append(suggestions, &Suggestion{
+			Text:  sg.Text,
+			Type:  sg.Type,
+			Count: sg.Count,
+		})
+	}
+	return web.Response{
+		Msg:  "Success",
+		Data: SuggestClassResp{Suggestions: suggestions},
+	}, nil
+}
+
 // GetSchoolDay 获取当前周
 // @Summary 获取当前周
 // @Description 获取当前周
@@ -399,6 +472,264 @@ func (c *ClassHandler) DeleteClassNote(ctx *gin.Context, req DeleteClassNoteReq,
 	}, nil
 }
 
+// CheckClassConflict 检查课程时间冲突
+// @Summary 检查课程时间冲突
+// @Description 检查待添加的课程是否与课表中已有课程在(周,星期,节次)上重叠
+// @Tags class
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer Token"
+// @Param request body CheckClassConflictReq true "检查课程冲突请求"
+// @Success 200 {object} web.Response{data=CheckClassConflictResp} "成功返回冲突信息"
+// @Router /class/conflict/check [post]
+func (c *ClassHandler) CheckClassConflict(ctx *gin.Context, req CheckClassConflictReq, uc ijwt.UserClaims) (web.Response, error) {
+	res, err := c.ClassListClient.CheckClassConflict(ctx, &classlistv1.CheckClassConflictReq{
+		StuId:          uc.StudentId,
+		Year:           req.Year,
+		Semester:       req.Semester,
+		Day:            req.Day,
+		DurClass:       req.DurClass,
+		Weeks:          convertWeekFromArrayToInt(req.Weeks),
+		ExcludeClassId: req.ExcludeClassId,
+	})
+	if err != nil {
+		return web.Response{}, errs.CHECK_CLASS_CONFLICT_ERROR(err)
+	}
+
+	conflicts := make([]*ClassConflict, 0, len(res.Conflicts))
+	for _, conflict := range res.Conflicts {
+		slots := make([]ConflictSlot, 0, len(conflict.Slots))
+		for _, slot := range conflict.Slots {
+			slots = append(slots, ConflictSlot{
+				Week:    slot.Week,
+				Day:     slot.Day,
+				Section: slot.Section,
+			})
+		}
+		conflicts = append(conflicts, &ClassConflict{
+			Class: &ClassInfo{
+				ID:           conflict.Info.Id,
+				Day:          conflict.Info.Day,
+				Teacher:      conflict.Info.Teacher,
+				Where:        conflict.Info.Where,
+				ClassWhen:    conflict.Info.ClassWhen,
+				WeekDuration: conflict.Info.WeekDuration,
+				Classname:    conflict.Info.Classname,
+				Credit:       conflict.Info.Credit,
+				Weeks:        convertWeekFromIntToArray(conflict.Info.Weeks),
+				Semester:     conflict.Info.Semester,
+				Year:         conflict.Info.Year,
+				Note:         conflict.Info.Note,
+				IsOfficial:   conflict.Info.IsOfficial,
+				Nature:       conflict.Info.Nature,
+			},
+			Slots: slots,
+		})
+	}
+
+	return web.Response{
+		Msg: "Success",
+		Data: CheckClassConflictResp{
+			HasConflict: res.HasConflict,
+			Conflicts:   conflicts,
+		},
+	}, nil
+}
+
+// GetTerms 获取有课表数据的学期
+// @Summary 获取有课表数据的学期
+// @Description 列出当前用户有课表数据的所有学期,包含课程数量与上次刷新时间,按学年学期倒序排列
+// @Tags class
+// @Produce json
+// @Param Authorization header string true "Bearer Token"
+// @Success 200 {object} web.Response{data=GetTermsResp} "成功返回学期列表"
+// @Router /class/terms [get]
+func (c *ClassHandler) GetTerms(ctx *gin.Context, uc ijwt.UserClaims) (web.Response, error) {
+	res, err := c.ClassListClient.GetTerms(ctx, &classlistv1.GetTermsReq{
+		StuId: uc.StudentId,
+	})
+	if err != nil {
+		return web.Response{}, errs.GET_TERMS_ERROR(err)
+	}
+
+	terms := make([]*TermInfo, 0, len(res.Terms))
+	for _, term := range res.Terms {
+		terms = append(terms, &TermInfo{
+			Year:            term.Year,
+			Semester:        term.Semester,
+			ClassNum:        term.ClassNum,
+			AddedClassNum:   term.AddedClassNum,
+			LastRefreshTime: term.LastRefreshTime,
+		})
+	}
+
+	return web.Response{
+		Msg:  "Success",
+		Data: GetTermsResp{Terms: terms},
+	}, nil
+}
+
+// CopyAddedClasses 复制手动添加的课程到其他学期
+// @Summary 复制手动添加的课程到其他学期
+// @Description 将源学期中手动添加的课程(可选包含备注)复制到目标学期,目标学期已存在或时间冲突的课程会被跳过
+// @Tags class
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer Token"
+// @Param request body CopyAddedClassesReq true "复制课程请求"
+// @Success 200 {object} web.Response{data=CopyAddedClassesResp} "成功返回复制结果"
+// @Router /class/terms/copy [post]
+func (c *ClassHandler) CopyAddedClasses(ctx *gin.Context, req CopyAddedClassesReq, uc ijwt.UserClaims) (web.Response, error) {
+	res, err := c.ClassListClient.CopyAddedClasses(ctx, &classlistv1.CopyAddedClassesReq{
+		StuId:        uc.StudentId,
+		FromYear:     req.FromYear,
+		FromSemester: req.FromSemester,
+		ToYear:       req.ToYear,
+		ToSemester:   req.ToSemester,
+		WithNote:     req.WithNote,
+		Force:        req.Force,
+	})
+	if err != nil {
+		return web.Response{}, errs.COPY_ADDED_CLASSES_ERROR(err)
+	}
+
+	return web.Response{
+		Msg: "Success",
+		Data: CopyAddedClassesResp{
+			Copied:  convertClassInfos(res.Copied),
+			Skipped: convertClassInfos(res.Skipped),
+		},
+	}, nil
+}
+
+// maxImportFileSize 导入课表文件的大小上限
+const maxImportFileSize = 2 << 20
+
+// ImportClasses 导入课表
+// @Summary 导入课表
+// @Description 上传按模板填写的Excel/CSV文件或WakeUp课程表的备份文件,将其中的课程作为手动添加的课程导入,返回每一行的导入错误
+// @Tags class
+// @Accept multipart/form-data
+// @Produce json
+// @Param Authorization header string true "Bearer Token"
+// @Param year formData string true "学年"
+// @Param semester formData string true "学期"
+// @Param format formData string true "文件格式" Enums(excel, csv, wakeup)
+// @Param force formData bool false "是否忽略时间冲突"
+// @Param file formData file true "课表文件"
+// @Success 200 {object} web.Response{data=ImportClassesResp} "成功返回导入结果"
+// @Router /class/import [post]
+func (c *ClassHandler) ImportClasses(ctx *gin.Context, req ImportClassesReq, uc ijwt.UserClaims) (web.Response, error) {
+	if req.File.Size > maxImportFileSize {
+		return web.Response{}, errs.INVALID_PARAM_VALUE_ERROR(errors.New("import file too large"))
+	}
+	file, err := req.File.Open()
+	if err != nil {
+		return web.Response{}, errs.IMPORT_CLASSES_ERROR(err)
+	}
+	defer file.Close()
+	content, err := io.ReadAll(io.LimitReader(file, maxImportFileSize))
+	if err != nil {
+		return web.Response{}, errs.IMPORT_CLASSES_ERROR(err)
+	}
+
+	res, err := c.ClassListClient.ImportClasses(ctx, &classlistv1.ImportClassesReq{
+		StuId:    uc.StudentId,
+		Year:     req.Year,
+		Semester: req.Semester,
+		Format:   req.Format,
+		Content:  content,
+		Force:    req.Force,
+	})
+	if err != nil {
+		return web.Response{}, errs.IMPORT_CLASSES_ERROR(err)
+	}
+
+	rowErrs := make([]ImportRowError, 0, len(res.Errors))
+	for _, rowErr := range res.Errors {
+		rowErrs = append(rowErrs, ImportRowError{
+			Line: rowErr.Line,
+			Msg:  rowErr.Msg,
+		})
+	}
+
+	return web.Response{
+		Msg: "Success",
+		Data: ImportClassesResp{
+			Imported: convertClassInfos(res.Imported),
+			Errors:   rowErrs,
+		},
+	}, nil
+}
+
+// GetCrawlerHealth 获取课表爬虫健康状态
+// @Summary 获取课表爬虫健康状态
+// @Description 【管理员】获取各课表爬虫策略的成功率、耗时与降级状态
+// @Tags class
+// @Produce json
+// @Param Authorization header string true "Bearer Token"
+// @Success 200 {object} web.Response{data=GetCrawlerHealthResp} "成功返回爬虫健康状态"
+// @Router /class/crawler/health [get]
+func (c *ClassHandler) GetCrawlerHealth(ctx *gin.Context, uc ijwt.UserClaims) (web.Response, error) {
+	if !c.isAdmin(uc.StudentId) {
+		return web.Response{}, errs.ROLE_ERROR(fmt.Errorf("没有访问权限: %s", uc.StudentId))
+	}
+	res, err := c.ClassListClient.GetCrawlerHealth(ctx, &classlistv1.GetCrawlerHealthReq{})
+	if err != nil {
+		return web.Response{}, errs.GET_CRAWLER_HEALTH_ERROR(err)
+	}
+
+	strategies := make([]*CrawlerHealth, 0, len(res.Strategies))
+	for _, s := range res.Strategies {
+		strategies = append(strategies, &CrawlerHealth{
+			Name:                s.Name,
+			StudentType:         s.StudentType,
+			Healthy:             s.Healthy,
+			SuccessRate:         s.SuccessRate,
+			AvgLatencyMs:        s.AvgLatencyMs,
+			Total:               s.Total,
+			Failures:            s.Failures,
+			ConsecutiveFailures: s.ConsecutiveFailures,
+			DemotedUntil:        s.DemotedUntil,
+			LastError:           s.LastError,
+			LastSuccessTime:     s.LastSuccessTime,
+		})
+	}
+
+	return web.Response{
+		Msg:  "Success",
+		Data: GetCrawlerHealthResp{Strategies: strategies},
+	}, nil
+}
+
+func (c *ClassHandler) isAdmin(studentId string) bool {
+	_, exists := c.Administrators[studentId]
+	return exists
+}
+
+func convertClassInfos(infos []*classlistv1.ClassInfo) []*ClassInfo {
+	res := make([]*ClassInfo, 0, len(infos))
+	for _, info := range infos {
+		res = append(res, &ClassInfo{
+			ID:           info.Id,
+			Day:          info.Day,
+			Teacher:      info.Teacher,
+			Where:        info.Where,
+			ClassWhen:    info.ClassWhen,
+			WeekDuration: info.WeekDuration,
+			Classname:    info.Classname,
+			Credit:       info.Credit,
+			Weeks:        convertWeekFromIntToArray(info.Weeks),
+			Semester:     info.Semester,
+			Year:         info.Year,
+			Note:         info.Note,
+			IsOfficial:   info.IsOfficial,
+			Nature:       info.Nature,
+		})
+	}
+	return res
+}
+
 func convertWeekFromArrayToInt(weeks []int) int64 {
 	var res int64
 
@@ -1,5 +1,7 @@
 package class
 
+import "mime/multipart"
+
 type GetClassListRequest struct {
 	Year     string `form:"year"` // binding:"required" //学年,格式为"2024"代表"2024-2025学年"`
 	Semester string `form:"semester"`// binding:"required" // 为添加默认值处理的妥协做法
@@ -20,6 +22,7 @@ type ClassInfo struct {
 	Year         string  `json:"year" binding:"required"`          //学年
 	Note         string  `json:"note" binding:"required"`          // 备注
 	IsOfficial   bool    `json:"is_official" binding:"required"`   // 是否为官方课程
+	Nature       string  `json:"nature"`                           //课程性质,手动添加的课程为空
 }
 
 type AddClassRequest struct {
@@ -42,6 +45,8 @@ type AddClassRequest struct {
 	Day int64 `json:"day" binding:"required"`
 	// 学分
 	Credit *float64 `json:"credit"`
+	// 与已有课程时间冲突时是否仍然强制添加,默认冲突时拒绝添加
+	Force bool `json:"force"`
 }
 type DeleteClassRequest struct {
 	// 要被删的课程id
@@ -86,12 +91,24 @@ type RecoverClassRequest struct {
 }
 
 type SearchRequest struct {
-	// 搜索关键词,匹配的是课程名称和教师姓名
-	SearchKeyWords string `form:"searchKeyWords" binding:"required"`
-	Year           string `form:"year" binding:"required"`      //学年,格式为"2024"代表"2024-2025学年"
-	Semester       string `form:"semester" binding:"required"`  //学期,格式为"1"代表第一学期，"2"代表第二学期，"3"代表第三学期
-	Page           int    `form:"page" binding:"required"`      //页码
-	PageSize       int    `form:"page_size" binding:"required"` //每页大小
+	// 搜索关键词,匹配的是课程名称和教师姓名,为空时只按筛选条件查询
+	SearchKeyWords string   `form:"searchKeyWords"`
+	Year           string   `form:"year" binding:"required"`      //学年,格式为"2024"代表"2024-2025学年"
+	Semester       string   `form:"semester" binding:"required"`  //学期,格式为"1"代表第一学期，"2"代表第二学期，"3"代表第三学期
+	Page           int      `form:"page"`                         //页码,使用cursor翻页时可不填
+	PageSize       int      `form:"page_size" binding:"required"` //每页大小
+	Cursor         string   `form:"cursor"`                       //上一次返回的next_cursor,用于深度分页,不为空时忽略page
+	Days           []int64  `form:"days"`                         //星期几,可多选
+	SectionStart   int32    `form:"section_start"`                //节次范围的开始,0表示不限
+	SectionEnd     int32    `form:"section_end"`                  //节次范围的结束,0表示不限
+	Week           int32    `form:"week"`                         //第几周有课,0表示不限
+	WherePrefix    string   `form:"where_prefix"`                 //上课地点前缀,比如南湖1楼就是"n1"
+	MinCredit      *float64 `form:"min_credit"`                   //最低学分
+	MaxCredit      *float64 `form:"max_credit"`                   //最高学分
+	Natures        []string `form:"natures"`                      //课程性质,可多选
+	FitFreeTime    bool     `form:"fit_free_time"`                //只返回和自己课表不冲突的课程
+	Sort           int32    `form:"sort"`                         //排序方式:0按匹配程度,1按学分从高到低,2按学分从低到高,3按上课时间
+	WithFacets     bool     `form:"with_facets"`                  //是否返回各筛选维度的统计
 }
 
 type GetClassListResp struct {
@@ -104,7 +121,37 @@ type GetRecycleBinClassInfosReq struct {
 	Semester string `form:"semester" binding:"required"` //学期,格式为"1"代表第一学期，"2"代表第二学期，"3"代表第三学期
 }
 type SearchClassResp struct {
-	ClassInfos []*ClassInfo `json:"classInfos" binding:"required"`
+	ClassInfos []*ClassInfo   `json:"classInfos" binding:"required"`
+	Facets     []*SearchFacet `json:"facets"`                   //各筛选维度的统计,在当前筛选条件下计算
+	NextCursor string         `json:"next_cursor"`              //下一页的游标,为空表示没有更多了
+	Total      int64          `json:"total" binding:"required"` //符合条件的课程总数
+}
+
+type SuggestClassReq struct {
+	Prefix   string `form:"prefix" binding:"required"`   //已输入的内容,支持汉字、拼音全拼和首字母
+	Year     string `form:"year" binding:"required"`     //学年,格式为"2024"代表"2024-2025学年"
+	Semester string `form:"semester" binding:"required"` //学期,格式为"1"代表第一学期，"2"代表第二学期，"3"代表第三学期
+	Size     int32  `form:"size"`                        //返回的联想词数量,默认10
+}
+
+type SuggestClassResp struct {
+	Suggestions []*Suggestion `json:"suggestions" binding:"required"`
+}
+
+type Suggestion struct {
+	Text  string `json:"text" binding:"required"`  //联想词
+	Type  string `json:"type" binding:"required"`  //类型:classname表示课程名称,teacher表示教师
+	Count int64  `json:"count" binding:"required"` //包含该联想词的课程数
+}
+
+type SearchFacet struct {
+	Field   string         `json:"field" binding:"required"` //维度:day,building,nature,credit,class_when,week
+	Buckets []*FacetBucket `json:"buckets" binding:"required"`
+}
+
+type FacetBucket struct {
+	Value string `json:"value" binding:"required"`
+	Count int64  `json:"count" binding:"required"`
 }
 
 type GetRecycleBinClassInfosResp struct {
@@ -129,3 +176,94 @@ type DeleteClassNoteReq struct {
 	Year     string `json:"year" binding:"required"`     //学年
 	ClassId  string `json:"classId" binding:"required"`  //课程ID
 }
+
+type CheckClassConflictReq struct {
+	Semester string `json:"semester" binding:"required"`  //学期
+	Year     string `json:"year" binding:"required"`      //学年
+	Day      int64  `json:"day" binding:"required"`       //星期几
+	DurClass string `json:"dur_class" binding:"required"` //第几节 '形如 "1-3","1-1"'
+	Weeks    []int  `json:"weeks" binding:"required"`     //哪些周
+	// 检查时忽略的课程ID(修改课程时用于排除自身),可不填
+	ExcludeClassId string `json:"exclude_class_id"`
+}
+
+type ConflictSlot struct {
+	Week    int64 `json:"week" binding:"required"`    //第几周
+	Day     int64 `json:"day" binding:"required"`     //星期几
+	Section int64 `json:"section" binding:"required"` //第几节
+}
+
+type ClassConflict struct {
+	Class *ClassInfo     `json:"class" binding:"required"` //冲突的已有课程
+	Slots []ConflictSlot `json:"slots" binding:"required"` //重叠的时间段
+}
+
+type CheckClassConflictResp struct {
+	HasConflict bool             `json:"has_conflict" binding:"required"` //是否存在冲突
+	Conflicts   []*ClassConflict `json:"conflicts" binding:"required"`
+}
+
+type TermInfo struct {
+	Year            string `json:"year" binding:"required"`              //学年
+	Semester        string `json:"semester" binding:"required"`          //学期
+	ClassNum        int64  `json:"class_num" binding:"required"`         //官方课程数量
+	AddedClassNum   int64  `json:"added_class_num" binding:"required"`   //手动添加的课程数量
+	LastRefreshTime int64  `json:"last_refresh_time" binding:"required"` //上次成功刷新课表的时间戳,从未刷新过为0
+}
+
+type GetTermsResp struct {
+	Terms []*TermInfo `json:"terms" binding:"required"`
+}
+
+type CopyAddedClassesReq struct {
+	FromYear     string `json:"from_year" binding:"required"`     //源学年
+	FromSemester string `json:"from_semester" binding:"required"` //源学期
+	ToYear       string `json:"to_year" binding:"required"`       //目标学年
+	ToSemester   string `json:"to_semester" binding:"required"`   //目标学期
+	// 是否同时复制课程备注
+	WithNote bool `json:"with_note"`
+	// 为true时即使与目标学期已有课程时间冲突也复制
+	Force bool `json:"force"`
+}
+
+type CopyAddedClassesResp struct {
+	Copied  []*ClassInfo `json:"copied" binding:"required"`  //成功复制的课程
+	Skipped []*ClassInfo `json:"skipped" binding:"required"` //因已存在或时间冲突而跳过的课程
+}
+
+type ImportClassesReq struct {
+	Year     string `form:"year" binding:"required"`                          //学年
+	Semester string `form:"semester" binding:"required"`                      //学期
+	Format   string `form:"format" binding:"required,oneof=excel csv wakeup"` //文件格式
+	// 为true时即使与已有课程时间冲突也导入
+	Force bool                  `form:"force"`
+	File  *multipart.FileHeader `form:"file" binding:"required" swaggerignore:"true"`
+}
+
+type ImportRowError struct {
+	Line int64  `json:"line" binding:"required"` //源文件中的行号,wakeup格式为上课安排的序号
+	Msg  string `json:"msg" binding:"required"`  //失败原因
+}
+
+type ImportClassesResp struct {
+	Imported []*ClassInfo     `json:"imported" binding:"required"` //成功导入的课程
+	Errors   []ImportRowError `json:"errors" binding:"required"`   //导入失败的行
+}
+
+type CrawlerHealth struct {
+	Name                string  `json:"name" binding:"required"`                 //策略名称
+	StudentType         string  `json:"student_type" binding:"required"`         //学生类型,undergraduate或graduate
+	Healthy             bool    `json:"healthy" binding:"required"`              //是否健康,降级中的策略为false
+	SuccessRate         float64 `json:"success_rate" binding:"required"`         //滑动窗口内的成功率
+	AvgLatencyMs        int64   `json:"avg_latency_ms" binding:"required"`       //滑动窗口内的平均耗时,单位ms
+	Total               int64   `json:"total" binding:"required"`                //总请求次数
+	Failures            int64   `json:"failures" binding:"required"`             //总失败次数
+	ConsecutiveFailures int64   `json:"consecutive_failures" binding:"required"` //连续失败次数
+	DemotedUntil        int64   `json:"demoted_until" binding:"required"`        //降级截止时间戳,未降级为0
+	LastError           string  `json:"last_error" binding:"required"`           //最近一次失败原因
+	LastSuccessTime     int64   `json:"last_success_time" binding:"required"`    //最近一次成功的时间戳
+}
+
+type GetCrawlerHealthResp struct {
+	Strategies []*CrawlerHealth `json:"strategies" binding:"required"`
+}
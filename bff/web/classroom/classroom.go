@@ -1,7 +1,10 @@
 package classroom
 
 import (
+	"fmt"
+
 	cs "github.com/asynccnu/ccnubox-be/be-api/gen/proto/classService/v1"
+	"github.com/asynccnu/ccnubox-be/bff/errs"
 	"github.com/asynccnu/ccnubox-be/bff/pkg/ginx"
 	"github.com/asynccnu/ccnubox-be/bff/web"
 	"github.com/asynccnu/ccnubox-be/bff/web/ijwt"
@@ -10,17 +13,24 @@ import (
 
 type ClassRoomHandler struct {
 	ClassRoomClient cs.FreeClassroomSvcClient
+	Administrators  map[string]struct{} // 这里注入的是管理员权限验证配置
 }
 
-func NewClassRoomHandler(ClassRoomClient cs.FreeClassroomSvcClient) *ClassRoomHandler {
+func NewClassRoomHandler(ClassRoomClient cs.FreeClassroomSvcClient, administrators map[string]struct{}) *ClassRoomHandler {
 	return &ClassRoomHandler{
 		ClassRoomClient: ClassRoomClient,
+		Administrators:  administrators,
 	}
 }
 
 func (c *ClassRoomHandler) RegisterRoutes(s *gin.RouterGroup, authMiddleware gin.HandlerFunc) {
 	sg := s.Group("/classroom")
 	sg.GET("/getFreeClassRoom", authMiddleware, ginx.WrapClaimsAndReq(c.GetFreeClassRoom))
+	sg.GET("/catalog", authMiddleware, ginx.Wrap(c.GetClassRoomCatalog))
+	sg.GET("/recommendQuietClassRoom", authMiddleware, ginx.WrapClaimsAndReq(c.RecommendQuietClassRoom))
+	sg.GET("/schedule", authMiddleware, ginx.WrapReq(c.GetClassRoomSchedule))
+	sg.POST("/meta/save", authMiddleware, ginx.WrapClaimsAndReq(c.SaveClassRoomMeta))
+	sg.POST("/meta/delete", authMiddleware, ginx.WrapClaimsAndReq(c.DeleteClassRoomMeta))
 }
 
 // GetFreeClassRoom 查询空闲教室
@@ -35,7 +45,10 @@ func (c *ClassRoomHandler) RegisterRoutes(s *gin.RouterGroup, authMiddleware gin
 // @Param week query int true "第几周"
 // @Param day query int true "星期几，1-7"
 // @Param sections query []int true "第几节课（可多选）"
-// @Param wherePrefix query string true "地点前缀，如 n1 表示南湖一楼"
+// @Param wherePrefix query string false "地点前缀，如 n1 表示南湖一楼，为空时按校区、教学楼和楼层查询"
+// @Param campus query int false "校区，1:本部 2:南湖校区"
+// @Param building query string false "教学楼编号，见/classroom/catalog"
+// @Param floor query int false "楼层，需要同时指定教学楼"
 // @Success 200 {object} web.Response{data=GetFreeClassRoomResp} "查询成功"
 // @Router /classroom/getFreeClassRoom [get]
 func (c *ClassRoomHandler) GetFreeClassRoom(ctx *gin.Context, req GetFreeClassRoomReq, uc ijwt.UserClaims) (web.Response, error) {
@@ -47,6 +60,9 @@ func (c *ClassRoomHandler) GetFreeClassRoom(ctx *gin.Context, req GetFreeClassRo
 		Sections:    req.Sections,
 		WherePrefix: req.WherePrefix,
 		StuID:       uc.StudentId,
+		Campus:      req.Campus,
+		Building:    req.Building,
+		Floor:       req.Floor,
 	})
 	if err != nil {
 		return web.Response{}, err
@@ -60,3 +76,163 @@ func (c *ClassRoomHandler) GetFreeClassRoom(ctx *gin.Context, req GetFreeClassRo
 	}, nil
 
 }
+
+// GetClassRoomCatalog 获取校区、教学楼和楼层
+// @Summary 获取校区、教学楼和楼层
+// @Description 获取所有有教室的校区、教学楼和楼层，用于按位置查询空闲教室
+// @Tags classroom
+// @Produce json
+// @Param Authorization header string true "Bearer Token"
+// @Success 200 {object} web.Response{data=GetClassRoomCatalogResp} "查询成功"
+// @Router /classroom/catalog [get]
+func (c *ClassRoomHandler) GetClassRoomCatalog(ctx *gin.Context) (web.Response, error) {
+	resp, err := c.ClassRoomClient.GetClassroomCatalog(ctx, &cs.GetClassroomCatalogReq{})
+	if err != nil {
+		return web.Response{}, err
+	}
+
+	var res GetClassRoomCatalogResp
+	for _, campus := range resp.GetCampuses() {
+		cp := Campus{ID: campus.Id, Name: campus.Name}
+		for _, b := range campus.Buildings {
+			cp.Buildings = append(cp.Buildings, Building{ID: b.Id, Name: b.Name, Floors: b.Floors})
+		}
+		res.Campuses = append(res.Campuses, cp)
+	}
+	return web.Response{
+		Code: 0,
+		Msg:  "查询成功",
+		Data: res,
+	}, nil
+}
+
+// RecommendQuietClassRoom 推荐适合自习的安静教室
+// @Summary 推荐安静教室
+// @Description 根据需要自习的时段和当前位置，按连续空闲时长、当天的课程多少和距离推荐教室
+// @Tags classroom
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer Token"
+// @Param year query string true "学年，如：2024-2025"
+// @Param semester query string true "学期，如：1 或 2"
+// @Param week query int true "第几周"
+// @Param day query int true "星期几，1-7"
+// @Param startSection query int true "从第几节开始自习"
+// @Param duration query int true "需要连续空闲的节数"
+// @Param wherePrefix query string true "地点前缀，如 n1 表示南湖一楼"
+// @Param location query string false "当前所在的位置，如 n1203，为空时不考虑距离"
+// @Param limit query int false "返回的教室数量，默认5个"
+// @Success 200 {object} web.Response{data=RecommendQuietClassRoomResp} "查询成功"
+// @Router /classroom/recommendQuietClassRoom [get]
+func (c *ClassRoomHandler) RecommendQuietClassRoom(ctx *gin.Context, req RecommendQuietClassRoomReq, uc ijwt.UserClaims) (web.Response, error) {
+	resp, err := c.ClassRoomClient.RecommendQuietClassroom(ctx, &cs.RecommendQuietClassroomReq{
+		Year:         req.Year,
+		Semester:     req.Semester,
+		Week:         req.Week,
+		Day:          req.Day,
+		StartSection: req.StartSection,
+		Duration:     req.Duration,
+		WherePrefix:  req.WherePrefix,
+		Location:     req.Location,
+		StuID:        uc.StudentId,
+		Limit:        req.Limit,
+	})
+	if err != nil {
+		return web.Response{}, err
+	}
+
+	return web.Response{
+		Code: 0,
+		Msg:  "查询成功",
+		Data: convertToRecommendQuietClassRoomResp(resp),
+	}, nil
+}
+
+// GetClassRoomSchedule 获取教室一周的占用情况
+// @Summary 获取教室一周的占用情况
+// @Description 获取某个教室某一周每天每节的占用情况和占用的课程，以及座位数、设备等信息
+// @Tags classroom
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer Token"
+// @Param year query string true "学年，如：2024-2025"
+// @Param semester query string true "学期，如：1 或 2"
+// @Param classroom query string true "教室，如：n101"
+// @Param week query int true "第几周"
+// @Success 200 {object} web.Response{data=GetClassRoomScheduleResp} "查询成功"
+// @Router /classroom/schedule [get]
+func (c *ClassRoomHandler) GetClassRoomSchedule(ctx *gin.Context, req GetClassRoomScheduleReq) (web.Response, error) {
+	resp, err := c.ClassRoomClient.GetClassroomSchedule(ctx, &cs.GetClassroomScheduleReq{
+		Year:      req.Year,
+		Semester:  req.Semester,
+		Classroom: req.Classroom,
+		Week:      req.Week,
+	})
+	if err != nil {
+		return web.Response{}, errs.GET_CLASSROOM_SCHEDULE_ERROR(err)
+	}
+
+	return web.Response{
+		Code: 0,
+		Msg:  "查询成功",
+		Data: convertToGetClassRoomScheduleResp(resp),
+	}, nil
+}
+
+// SaveClassRoomMeta 保存教室信息
+// @Summary 保存教室信息
+// @Description 【管理员】保存教室的座位数、设备等信息，会覆盖该教室已有的信息
+// @Tags classroom
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer Token"
+// @Param request body SaveClassRoomMetaReq true "教室信息"
+// @Success 200 {object} web.Response "保存成功"
+// @Router /classroom/meta/save [post]
+func (c *ClassRoomHandler) SaveClassRoomMeta(ctx *gin.Context, req SaveClassRoomMetaReq, uc ijwt.UserClaims) (web.Response, error) {
+	if !c.isAdmin(uc.StudentId) {
+		return web.Response{}, errs.ROLE_ERROR(fmt.Errorf("没有访问权限: %s", uc.StudentId))
+	}
+	_, err := c.ClassRoomClient.SaveClassroomMeta(ctx, &cs.SaveClassroomMetaReq{
+		Meta: &cs.ClassroomMeta{
+			Classroom: req.Classroom,
+			Capacity:  req.Capacity,
+			Equipment: req.Equipment,
+			Note:      req.Note,
+		},
+	})
+	if err != nil {
+		return web.Response{}, errs.SAVE_CLASSROOM_META_ERROR(err)
+	}
+	return web.Response{
+		Msg: "保存成功",
+	}, nil
+}
+
+// DeleteClassRoomMeta 删除教室信息
+// @Summary 删除教室信息
+// @Description 【管理员】删除教室的座位数、设备等信息
+// @Tags classroom
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer Token"
+// @Param request body DeleteClassRoomMetaReq true "教室"
+// @Success 200 {object} web.Response "删除成功"
+// @Router /classroom/meta/delete [post]
+func (c *ClassRoomHandler) DeleteClassRoomMeta(ctx *gin.Context, req DeleteClassRoomMetaReq, uc ijwt.UserClaims) (web.Response, error) {
+	if !c.isAdmin(uc.StudentId) {
+		return web.Response{}, errs.ROLE_ERROR(fmt.Errorf("没有访问权限: %s", uc.StudentId))
+	}
+	_, err := c.ClassRoomClient.DeleteClassroomMeta(ctx, &cs.DeleteClassroomMetaReq{Classroom: req.Classroom})
+	if err != nil {
+		return web.Response{}, errs.DELETE_CLASSROOM_META_ERROR(err)
+	}
+	return web.Response{
+		Msg: "删除成功",
+	}, nil
+}
+
+func (c *ClassRoomHandler) isAdmin(studentId string) bool {
+	_, exists := c.Administrators[studentId]
+	return exists
+}
@@ -10,6 +10,9 @@ type GetFreeClassRoomReq struct {
 	Sections    []int32 `form:"sections"`    // 哪几节课（多个字段：sections=1&sections=2）
 	WherePrefix string  `form:"wherePrefix"` // 地点前缀
 	StuID       string  `form:"stuID"`       // 学号
+	Campus      int32   `form:"campus"`      // 校区,wherePrefix为空时使用
+	Building    string  `form:"building"`    // 教学楼编号
+	Floor       int32   `form:"floor"`       // 楼层
 }
 
 type ClassroomAvailableStat struct {
@@ -18,7 +21,20 @@ type ClassroomAvailableStat struct {
 }
 
 type GetFreeClassRoomResp struct {
-	Stat []ClassroomAvailableStat `json:"stat"` // 各教室的空闲情况
+	Stat      []ClassroomAvailableStat `json:"stat"`      // 各教室的空闲情况
+	Buildings []BuildingAvailableStat  `json:"buildings"` // 按教学楼和楼层分组的空闲情况
+}
+
+type BuildingAvailableStat struct {
+	Campus   int32                `json:"campus"`   // 校区
+	Building string               `json:"building"` // 教学楼编号
+	Name     string               `json:"name"`     // 教学楼名称
+	Floors   []FloorAvailableStat `json:"floors"`
+}
+
+type FloorAvailableStat struct {
+	Floor int32                    `json:"floor"`
+	Stat  []ClassroomAvailableStat `json:"stat"`
 }
 
 func convertToGetFreeClassRoomResp(protoResp *cs.QueryFreeClassroomResp) *GetFreeClassRoomResp {
@@ -36,5 +52,167 @@ func convertToGetFreeClassRoomResp(protoResp *cs.QueryFreeClassroomResp) *GetFre
 			AvailableStat: stat.AvailableStat,
 		})
 	}
+	for _, b := range protoResp.Buildings {
+		building := BuildingAvailableStat{
+			Campus:   b.Campus,
+			Building: b.Building,
+			Name:     b.Name,
+		}
+		for _, f := range b.Floors {
+			floor := FloorAvailableStat{Floor: f.Floor}
+			for _, stat := range f.Stat {
+				floor.Stat = append(floor.Stat, ClassroomAvailableStat{
+					Classroom:     stat.Classroom,
+					AvailableStat: stat.AvailableStat,
+				})
+			}
+			building.Floors = append(building.Floors, floor)
+		}
+		result.Buildings = append(result.Buildings, building)
+	}
+	return &result
+}
+
+type RecommendQuietClassRoomReq struct {
+	Year         string `form:"year"`         // 学年
+	Semester     string `form:"semester"`     // 学期
+	Week         int32  `form:"week"`         // 哪一周
+	Day          int32  `form:"day"`          // 哪一天
+	StartSection int32  `form:"startSection"` // 从第几节开始
+	Duration     int32  `form:"duration"`     // 需要连续空闲的节数
+	WherePrefix  string `form:"wherePrefix"`  // 地点前缀
+	Location     string `form:"location"`     // 当前所在的位置
+	Limit        int32  `form:"limit"`        // 返回的教室数量
+}
+
+type QuietClassRoom struct {
+	Classroom        string   `json:"classroom"`        // 教室名
+	FreeStart        int32    `json:"freeStart"`        // 连续空闲的第一节
+	FreeEnd          int32    `json:"freeEnd"`          // 连续空闲的最后一节
+	OccupiedSections int32    `json:"occupiedSections"` // 这一天有课的节数，-1表示没有数据
+	Score            float64  `json:"score"`            // 推荐分数
+	Reasons          []string `json:"reasons"`          // 推荐理由
+}
+
+type RecommendQuietClassRoomResp struct {
+	Classrooms []QuietClassRoom `json:"classrooms"` // 按推荐程度从高到低排列
+}
+
+func convertToRecommendQuietClassRoomResp(protoResp *cs.RecommendQuietClassroomResp) *RecommendQuietClassRoomResp {
+	var result = RecommendQuietClassRoomResp{Classrooms: make([]QuietClassRoom, 0)}
+	if protoResp == nil {
+		return &result
+	}
+	for _, c := range protoResp.Classrooms {
+		if c == nil {
+			continue
+		}
+		result.Classrooms = append(result.Classrooms, QuietClassRoom{
+			Classroom:        c.Classroom,
+			FreeStart:        c.FreeStart,
+			FreeEnd:          c.FreeEnd,
+			OccupiedSections: c.OccupiedSections,
+			Score:            c.Score,
+			Reasons:          c.Reasons,
+		})
+	}
+	return &result
+}
+
+type GetClassRoomScheduleReq struct {
+	Year      string `form:"year"`                         // 学年
+	Semester  string `form:"semester"`                     // 学期
+	Classroom string `form:"classroom" binding:"required"` // 教室
+	Week      int32  `form:"week" binding:"required"`      // 哪一周
+}
+
+type OccupyingClass struct {
+	ID           string `json:"id"`
+	Classname    string `json:"classname"`    // 课程名称
+	Teacher      string `json:"teacher"`      // 任课教师
+	ClassWhen    string `json:"classWhen"`    // 上课是第几节，如"1-2"
+	WeekDuration string `json:"weekDuration"` // 上课的周数
+}
+
+type ClassRoomSlot struct {
+	Day     int32            `json:"day"`     // 星期几
+	Section int32            `json:"section"` // 第几节
+	Classes []OccupyingClass `json:"classes"` // 占用该节的课程，只知道被占用但不知道是什么课时为空
+}
+
+type ClassRoomMeta struct {
+	Classroom string   `json:"classroom"` // 教室
+	Capacity  int32    `json:"capacity"`  // 座位数
+	Equipment []string `json:"equipment"` // 设备
+	Note      string   `json:"note"`      // 备注
+	UpdatedAt int64    `json:"updatedAt"` // 最后修改的时间，单位s
+}
+
+type GetClassRoomScheduleResp struct {
+	Meta  *ClassRoomMeta  `json:"meta"`  // 没有维护该教室的信息时为null
+	Slots []ClassRoomSlot `json:"slots"` // 被占用的节次，按星期和节次排序
+}
+
+type SaveClassRoomMetaReq struct {
+	Classroom string   `json:"classroom" binding:"required"` // 教室
+	Capacity  int32    `json:"capacity"`                     // 座位数
+	Equipment []string `json:"equipment"`                    // 设备
+	Note      string   `json:"note"`                         // 备注
+}
+
+type DeleteClassRoomMetaReq struct {
+	Classroom string `json:"classroom" binding:"required"` // 教室
+}
+
+func convertToGetClassRoomScheduleResp(protoResp *cs.GetClassroomScheduleResp) *GetClassRoomScheduleResp {
+	var result = GetClassRoomScheduleResp{Slots: make([]ClassRoomSlot, 0)}
+	if protoResp == nil {
+		return &result
+	}
+	if meta := protoResp.Meta; meta != nil {
+		result.Meta = &ClassRoomMeta{
+			Classroom: meta.Classroom,
+			Capacity:  meta.Capacity,
+			Equipment: meta.Equipment,
+			Note:      meta.Note,
+			UpdatedAt: meta.UpdatedAt,
+		}
+	}
+	for _, slot := range protoResp.Slots {
+		if slot == nil {
+			continue
+		}
+		var classes = make([]OccupyingClass, 0, len(slot.Classes))
+		for _, c := range slot.Classes {
+			classes = append(classes, OccupyingClass{
+				ID:           c.Id,
+				Classname:    c.Classname,
+				Teacher:      c.Teacher,
+				ClassWhen:    c.ClassWhen,
+				WeekDuration: c.WeekDuration,
+			})
+		}
+		result.Slots = append(result.Slots, ClassRoomSlot{
+			Day:     slot.Day,
+			Section: slot.Section,
+			Classes: classes,
+		})
+	}
 	return &result
 }
+
+type GetClassRoomCatalogResp struct {
+	Campuses []Campus `json:"campuses"`
+}
+
+type Campus struct {
+	ID        int32      `json:"id"`   // 校区号
+	Name      string     `json:"name"` // 校区名称
+	Buildings []Building `json:"buildings"`
+}
+
+type Building struct {
+	ID     string  `json:"id"`     // 教学楼编号,查询空闲教室时作为building传入
+	Name   string  `json:"name"`   // 教学楼名称
+	Floors []int32 `json:"floors"` // 有教室的楼层
+}
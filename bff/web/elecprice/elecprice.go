@@ -31,6 +31,8 @@ func (h *ElecPriceHandler) RegisterRoutes(s *gin.RouterGroup, authMiddleware gin
 		sg.PUT("/setStandard", authMiddleware, ginx.WrapClaimsAndReq(h.SetStandard))
 		sg.GET("/getStandardList", authMiddleware, ginx.WrapClaimsAndReq(h.GetStandardList))
 		sg.POST("/cancelStandard", authMiddleware, ginx.WrapClaimsAndReq(h.CancelStandard))
+
+		sg.GET("/getUsageHistory", authMiddleware, ginx.WrapClaimsAndReq(h.GetUsageHistory))
 	}
 }
 
@@ -234,3 +236,51 @@ func (h *ElecPriceHandler) CancelStandard(ctx *gin.Context, req CancelStandardRe
 		Msg: "取消电费提醒标准成功!",
 	}, nil
 }
+
+// GetUsageHistory
+// @Summary 获取用电历史
+// @Description 按天/周/月获取房间最近的用电, 并预测剩余电费还能使用多少天, 只有设置过提醒的房间才有记录
+// @Tags elecprice
+// @Produce json
+// @Param Authorization header string true "Bearer Token"
+// @Param request query GetUsageHistoryRequest true "获取用电历史请求参数"
+// @Success 200 {object} web.Response{msg=elecprice.GetUsageHistoryResponse} "获取成功的返回信息"
+// @Failure 500 {object} web.Response{msg=string} "系统异常"
+// @Router /elecprice/getUsageHistory [get]
+func (h *ElecPriceHandler) GetUsageHistory(ctx *gin.Context, req GetUsageHistoryRequest, uc ijwt.UserClaims) (web.Response, error) {
+	res, err := h.ElecPriceClient.GetUsageHistory(ctx, &elecpricev1.GetUsageHistoryRequest{
+		RoomId:      req.RoomId,
+		Granularity: req.Granularity,
+		Days:        req.Days,
+	})
+	if err != nil {
+		return web.Response{}, errs.ELECPRICE_GET_USAGE_HISTORY_ERROR(err)
+	}
+
+	points := make([]*UsagePoint, 0, len(res.Points))
+	for _, p := range res.Points {
+		points = append(points, &UsagePoint{
+			Date:        p.Date,
+			UseValue:    p.UseValue,
+			UseMoney:    p.UseMoney,
+			RemainMoney: p.RemainMoney,
+		})
+	}
+
+	var forecast *UsageForecast
+	if res.Forecast != nil {
+		forecast = &UsageForecast{
+			RemainMoney:   res.Forecast.RemainMoney,
+			AvgDailyMoney: res.Forecast.AvgDailyMoney,
+			DaysLeft:      res.Forecast.DaysLeft,
+			EmptyDate:     res.Forecast.EmptyDate,
+		}
+	}
+
+	return web.Response{
+		Data: GetUsageHistoryResponse{
+			Points:   points,
+			Forecast: forecast,
+		},
+	}, nil
+}
@@ -70,3 +70,28 @@ type GetStandardListResponse struct {
 type CancelStandardRequest struct {
 	RoomId string `json:"room_id" binding:"required"`
 }
+
+type GetUsageHistoryRequest struct {
+	RoomId      string `json:"room_id" form:"room_id" binding:"required"`
+	Granularity string `json:"granularity" form:"granularity"` // day/week/month, 默认 day
+	Days        int64  `json:"days" form:"days"`               // 统计最近多少天, 默认 30, 最多 366
+}
+
+type UsagePoint struct {
+	Date        string  `json:"date"`         // 统计周期的第一天
+	UseValue    float64 `json:"use_value"`    // 用电量
+	UseMoney    float64 `json:"use_money"`    // 电费
+	RemainMoney float64 `json:"remain_money"` // 周期内最后记录的剩余电费, 没有记录时为 -1
+}
+
+type UsageForecast struct {
+	RemainMoney   string  `json:"remain_money"`    // 当前剩余电费
+	AvgDailyMoney float64 `json:"avg_daily_money"` // 最近每天平均电费
+	DaysLeft      float64 `json:"days_left"`       // 预计还可使用的天数, 无法预测时为 -1
+	EmptyDate     string  `json:"empty_date"`      // 预计用完的日期
+}
+
+type GetUsageHistoryResponse struct {
+	Points   []*UsagePoint  `json:"points"`
+	Forecast *UsageForecast `json:"forecast"`
+}
@@ -128,6 +128,15 @@ func (h *FeedHandler) ReadFeedEvent(ctx *gin.Context, req ReadFeedEventReq) (web
 // @Router /feed/changeFeedAllowList [post]
 func (h *FeedHandler) ChangeFeedAllowList(ctx *gin.Context, req ChangeFeedAllowListReq, uc ijwt.UserClaims) (web.Response, error) {
 
+	if req.Library == nil {
+		current, err := h.feedClient.GetFeedAllowList(ctx, &feedv1.GetFeedAllowListReq{StudentId: uc.StudentId})
+		if err != nil {
+			return web.Response{}, errs.CHANGE_FEED_ALLOW_LIST_ERROR(err)
+		}
+		library := current.GetAllowList().GetLibrary()
+		req.Library = &library
+	}
+
 	_, err := h.feedClient.ChangeFeedAllowList(ctx, &feedv1.ChangeFeedAllowListReq{
 		AllowList: &feedv1.AllowList{
 			StudentId: uc.StudentId,
@@ -135,6 +144,7 @@ func (h *FeedHandler) ChangeFeedAllowList(ctx *gin.Context, req ChangeFeedAllowL
 			Muxi:      req.Muxi,
 			Holiday:   req.Holiday,
 			Energy:    req.Energy,
+			Library:   *req.Library,
 		},
 	})
 
@@ -169,6 +179,7 @@ func (h *FeedHandler) GetFeedAllowList(ctx *gin.Context, uc ijwt.UserClaims) (we
 			Muxi:    allowlist.AllowList.Muxi,
 			Holiday: allowlist.AllowList.Holiday,
 			Energy:  allowlist.AllowList.Energy,
+			Library: allowlist.AllowList.Library,
 		},
 	}, nil
 }
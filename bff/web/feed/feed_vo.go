@@ -43,6 +43,8 @@ type ChangeFeedAllowListReq struct {
 	Muxi    bool `json:"muxi" binding:"required"`
 	Holiday bool `json:"holiday" binding:"required"`
 	Energy  bool `json:"energy" binding:"required"`
+	// 旧版本客户端不传该字段,为空时保持原有设置
+	Library *bool `json:"library"`
 }
 
 type GetFeedAllowListResp struct {
@@ -50,6 +52,7 @@ type GetFeedAllowListResp struct {
 	Muxi    bool `json:"muxi" binding:"required"`
 	Holiday bool `json:"holiday" binding:"required"`
 	Energy  bool `json:"energy" binding:"required"`
+	Library bool `json:"library"`
 }
 type ChangeElectricityStandardReq struct {
 	ElectricityStandard bool `json:"electricity_standard" binding:"required"`
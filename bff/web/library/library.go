@@ -1,6 +1,8 @@
 package library
 
 import (
+	"fmt"
+
 	libraryv1 "github.com/asynccnu/ccnubox-be/be-api/gen/proto/library/v1"
 	"github.com/asynccnu/ccnubox-be/bff/errs"
 	"github.com/asynccnu/ccnubox-be/bff/pkg/ginx"
@@ -31,11 +33,37 @@ func (h *LibraryHandler) RegisterRoutes(s *gin.RouterGroup, authMiddleware gin.H
 	sg.POST("/get_discussion", authMiddleware, ginx.WrapClaimsAndReq(h.GetDiscussion))
 	sg.GET("/search_user", authMiddleware, ginx.WrapClaimsAndReq(h.SearchUser))
 	sg.POST("/reserve_discussion", authMiddleware, ginx.WrapClaimsAndReq(h.ReserveDiscussion))
+	sg.GET("/discussion/slots", authMiddleware, ginx.WrapClaimsAndReq(h.SearchDiscussionSlots))
+	sg.POST("/discussion/reserve_group", authMiddleware, ginx.WrapClaimsAndReq(h.ReserveDiscussionForGroup))
+	sg.POST("/study_group/save", authMiddleware, ginx.WrapClaimsAndReq(h.SaveStudyGroup))
+	sg.POST("/study_group/delete", authMiddleware, ginx.WrapClaimsAndReq(h.DeleteStudyGroup))
+	sg.GET("/study_group/list", authMiddleware, ginx.WrapClaims(h.ListStudyGroups))
 	sg.POST("/cancel_reserve", authMiddleware, ginx.WrapClaimsAndReq(h.CancelReserve))
+	sg.POST("/swap_reservation", authMiddleware, ginx.WrapClaimsAndReq(h.SwapReservation))
 	sg.POST("/create_comment", authMiddleware, ginx.WrapClaimsAndReq(h.CreateComment))
 	sg.GET("/get_comments", authMiddleware, ginx.WrapClaimsAndReq(h.GetComments))
 	sg.GET("/delete_comment", authMiddleware, ginx.WrapClaimsAndReq(h.DeleteComment))
+	sg.GET("/comment/list", authMiddleware, ginx.WrapClaimsAndReq(h.ListComments))
+	sg.GET("/comment/ratings", authMiddleware, ginx.WrapClaimsAndReq(h.GetSeatRatings))
+	sg.POST("/comment/report", authMiddleware, ginx.WrapClaimsAndReq(h.ReportComment))
+	sg.GET("/comment/reported", authMiddleware, ginx.WrapClaimsAndReq(h.ListReportedComments))
+	sg.POST("/comment/moderate", authMiddleware, ginx.WrapClaimsAndReq(h.ModerateComment))
 	sg.POST("/reserve_randomly", authMiddleware, ginx.WrapClaimsAndReq(h.ReserveSeatRandomly))
+	sg.POST("/favourite/add", authMiddleware, ginx.WrapClaimsAndReq(h.AddFavourite))
+	sg.POST("/favourite/remove", authMiddleware, ginx.WrapClaimsAndReq(h.RemoveFavourite))
+	sg.GET("/favourite/list", authMiddleware, ginx.WrapClaimsAndReq(h.ListFavourites))
+	sg.POST("/favourite/reserve", authMiddleware, ginx.WrapClaimsAndReq(h.ReserveFavourite))
+	sg.POST("/reserve_intent/save", authMiddleware, ginx.WrapClaimsAndReq(h.SaveReserveIntent))
+	sg.POST("/reserve_intent/delete", authMiddleware, ginx.WrapClaimsAndReq(h.DeleteReserveIntent))
+	sg.GET("/reserve_intent/list", authMiddleware, ginx.WrapClaims(h.ListReserveIntents))
+	sg.GET("/occupancy", authMiddleware, ginx.WrapClaimsAndReq(h.GetOccupancy))
+	sg.GET("/occupancy/heatmap", authMiddleware, ginx.WrapClaimsAndReq(h.GetOccupancyHeatmap))
+	sg.GET("/occupancy/least_busy", authMiddleware, ginx.WrapClaimsAndReq(h.GetLeastBusyRoom))
+	sg.GET("/room/list", authMiddleware, ginx.WrapClaimsAndReq(h.ListRooms))
+	sg.POST("/room/save", authMiddleware, ginx.WrapClaimsAndReq(h.SaveRoom))
+	sg.POST("/room/delete", authMiddleware, ginx.WrapClaimsAndReq(h.DeleteRoom))
+	sg.POST("/room/discover", authMiddleware, ginx.WrapClaims(h.DiscoverRooms))
+	sg.GET("/usage/analytics", authMiddleware, ginx.WrapClaimsAndReq(h.GetUsageAnalytics))
 }
 
 // GetSeatInfos 获取图书馆座位信息
@@ -379,6 +407,167 @@ func (h *LibraryHandler) ReserveDiscussion(ctx *gin.Context, req ReserveDiscussi
 	}, nil
 }
 
+// SearchDiscussionSlots 查找研讨间空闲时段
+// @Summary 查找研讨间空闲时段
+// @Description 在所有研讨间中查找某天长度不小于 duration 分钟的空闲时段，按开始时间排序。不传 class_ids 时使用服务端配置的研讨间类别，不传 from、to 时使用研讨间开放时间
+// @Tags library
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer Token"
+// @Param request query SearchDiscussionSlotsRequest true "查找条件"
+// @Success 200 {object} web.Response{data=SearchDiscussionSlotsResponse} "成功返回空闲时段"
+// @Failure 500 {object} web.Response "系统异常，获取失败"
+// @Router /library/discussion/slots [get]
+func (h *LibraryHandler) SearchDiscussionSlots(ctx *gin.Context, req SearchDiscussionSlotsRequest, uc ijwt.UserClaims) (web.Response, error) {
+	res, err := h.LibraryClient.SearchDiscussionSlots(ctx, &libraryv1.SearchDiscussionSlotsRequest{
+		StuId:    uc.StudentId,
+		Date:     req.Date,
+		Duration: req.Duration,
+		ClassIds: req.ClassIDs,
+		From:     req.From,
+		To:       req.To,
+	})
+	if err != nil {
+		return web.Response{}, errs.SEARCH_DISCUSSION_SLOTS_ERROR(err)
+	}
+
+	slots := make([]DiscussionSlot, 0, len(res.Slots))
+	for _, s := range res.Slots {
+		slots = append(slots, DiscussionSlot{
+			LabID:    s.LabId,
+			LabName:  s.LabName,
+			KindID:   s.KindId,
+			KindName: s.KindName,
+			DevID:    s.DevId,
+			DevName:  s.DevName,
+			Start:    s.Start,
+			End:      s.End,
+		})
+	}
+
+	return web.Response{
+		Msg:  "Success",
+		Data: SearchDiscussionSlotsResponse{Slots: slots},
+	}, nil
+}
+
+// SaveStudyGroup 保存学习小组
+// @Summary 保存学习小组
+// @Description 保存常用的研讨间成员，成员按学号在预约系统中查找，id 为 0 时新建。成员不包括创建者
+// @Tags library
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer Token"
+// @Param request body SaveStudyGroupRequest true "学习小组"
+// @Success 200 {object} web.Response{data=StudyGroup} "成功返回保存后的学习小组"
+// @Failure 500 {object} web.Response "系统异常，保存失败"
+// @Router /library/study_group/save [post]
+func (h *LibraryHandler) SaveStudyGroup(ctx *gin.Context, req SaveStudyGroupRequest, uc ijwt.UserClaims) (web.Response, error) {
+	res, err := h.LibraryClient.SaveStudyGroup(ctx, &libraryv1.SaveStudyGroupRequest{
+		StuId:     uc.StudentId,
+		Id:        req.ID,
+		Name:      req.Name,
+		MemberIds: req.MemberIDs,
+	})
+	if err != nil {
+		return web.Response{}, errs.SAVE_STUDY_GROUP_ERROR(err)
+	}
+
+	return web.Response{
+		Msg:  "Success",
+		Data: convStudyGroup(res.Group),
+	}, nil
+}
+
+// DeleteStudyGroup 删除学习小组
+// @Summary 删除学习小组
+// @Description 删除学习小组
+// @Tags library
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer Token"
+// @Param request body DeleteStudyGroupRequest true "学习小组 ID"
+// @Success 200 {object} web.Response "成功返回删除成功"
+// @Failure 500 {object} web.Response "系统异常，删除失败"
+// @Router /library/study_group/delete [post]
+func (h *LibraryHandler) DeleteStudyGroup(ctx *gin.Context, req DeleteStudyGroupRequest, uc ijwt.UserClaims) (web.Response, error) {
+	_, err := h.LibraryClient.DeleteStudyGroup(ctx, &libraryv1.DeleteStudyGroupRequest{
+		StuId: uc.StudentId,
+		Id:    req.ID,
+	})
+	if err != nil {
+		return web.Response{}, errs.DELETE_STUDY_GROUP_ERROR(err)
+	}
+
+	return web.Response{
+		Msg: "Success",
+	}, nil
+}
+
+// ListStudyGroups 获取学习小组
+// @Summary 获取学习小组
+// @Description 获取自己创建的学习小组
+// @Tags library
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer Token"
+// @Success 200 {object} web.Response{data=ListStudyGroupsResponse} "成功返回学习小组"
+// @Failure 500 {object} web.Response "系统异常，获取失败"
+// @Router /library/study_group/list [get]
+func (h *LibraryHandler) ListStudyGroups(ctx *gin.Context, uc ijwt.UserClaims) (web.Response, error) {
+	res, err := h.LibraryClient.ListStudyGroups(ctx, &libraryv1.ListStudyGroupsRequest{
+		StuId: uc.StudentId,
+	})
+	if err != nil {
+		return web.Response{}, errs.GET_STUDY_GROUP_ERROR(err)
+	}
+
+	groups := make([]StudyGroup, 0, len(res.Groups))
+	for _, g := range res.Groups {
+		groups = append(groups, convStudyGroup(g))
+	}
+
+	return web.Response{
+		Msg:  "Success",
+		Data: ListStudyGroupsResponse{Groups: groups},
+	}, nil
+}
+
+// ReserveDiscussionForGroup 按学习小组预约研讨间
+// @Summary 按学习小组预约研讨间
+// @Description 使用学习小组的成员预约研讨间，成功后通过消息推送邀请每个成员
+// @Tags library
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer Token"
+// @Param request body ReserveDiscussionForGroupRequest true "预约参数"
+// @Success 200 {object} web.Response{data=ReserveDiscussionForGroupResponse} "成功返回预约结果"
+// @Failure 500 {object} web.Response "系统异常，预约失败"
+// @Router /library/discussion/reserve_group [post]
+func (h *LibraryHandler) ReserveDiscussionForGroup(ctx *gin.Context, req ReserveDiscussionForGroupRequest, uc ijwt.UserClaims) (web.Response, error) {
+	res, err := h.LibraryClient.ReserveDiscussionForGroup(ctx, &libraryv1.ReserveDiscussionForGroupRequest{
+		StuId:   uc.StudentId,
+		GroupId: req.GroupID,
+		DevId:   req.DevID,
+		LabId:   req.LabID,
+		KindId:  req.KindID,
+		Title:   req.Title,
+		Start:   req.Start,
+		End:     req.End,
+	})
+	if err != nil {
+		return web.Response{}, errs.RESERVE_DISCUSSION_FOR_GROUP_ERROR(err)
+	}
+
+	return web.Response{
+		Msg: res.Message,
+		Data: ReserveDiscussionForGroupResponse{
+			Message: res.Message,
+			Invited: int(res.Invited),
+		},
+	}, nil
+}
+
 // CancelReserve 取消预约
 // @Summary 取消预约
 // @Description 取消预约
@@ -404,6 +593,43 @@ func (h *LibraryHandler) CancelReserve(ctx *gin.Context, req CancelReserveReques
 	}, nil
 }
 
+// SwapReservation 换座
+// @Summary 换座
+// @Description 先预约新的座位和时间段，成功后再取消原预约；任何一步失败都会保留原预约
+// @Tags library
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer Token"
+// @Param request body SwapReservationRequest true "换座参数"
+// @Success 200 {object} web.Response{data=SwapReservationResponse} "成功返回新预约"
+// @Failure 409 {object} web.Response "新旧两个预约都还在，需要手动取消一个"
+// @Failure 500 {object} web.Response "系统异常，换座失败"
+// @Router /library/swap_reservation [post]
+func (h *LibraryHandler) SwapReservation(ctx *gin.Context, req SwapReservationRequest, uc ijwt.UserClaims) (web.Response, error) {
+	res, err := h.LibraryClient.SwapReservation(ctx, &libraryv1.SwapReservationRequest{
+		StuId: uc.StudentId,
+		OldId: req.OldID,
+		DevId: req.DevID,
+		Start: req.Start,
+		End:   req.End,
+	})
+	switch {
+	case err == nil:
+	case libraryv1.IsSwapDoubleBooked(err):
+		return web.Response{}, errs.SWAP_DOUBLE_BOOKED_ERROR(err)
+	default:
+		return web.Response{}, errs.SWAP_RESERVATION_ERROR(err)
+	}
+
+	return web.Response{
+		Msg: res.Message,
+		Data: SwapReservationResponse{
+			Message: res.Message,
+			NewID:   res.NewId,
+		},
+	}, nil
+}
+
 // CreateComment 创建评论
 // @Summary 创建评论
 // @Description 创建座位评论
@@ -457,7 +683,7 @@ func (h *LibraryHandler) GetComments(ctx *gin.Context, req IDreq, uc ijwt.UserCl
 
 // DeleteComment 删除评论
 // @Summary 删除评论
-// @Description 通过评论 ID 删除评论
+// @Description 通过评论 ID 删除评论，只能删除自己的评论
 // @Tags library
 // @Accept json
 // @Produce json
@@ -467,7 +693,10 @@ func (h *LibraryHandler) GetComments(ctx *gin.Context, req IDreq, uc ijwt.UserCl
 // @Failure 500 {object} web.Response "系统异常，删除失败"
 // @Router /library/delete_comment [get]
 func (h *LibraryHandler) DeleteComment(ctx *gin.Context, req IDreq, uc ijwt.UserClaims) (web.Response, error) {
-	msg, err := h.LibraryClient.DeleteComment(ctx, &libraryv1.ID{Id: int64(req.ID)})
+	msg, err := h.LibraryClient.DeleteComment(ctx, &libraryv1.DeleteCommentReq{
+		Id:       int64(req.ID),
+		Username: uc.StudentId,
+	})
 	if err != nil {
 		return web.Response{}, errs.DELETE_COMMENT_ERROR(err)
 	}
@@ -477,6 +706,183 @@ func (h *LibraryHandler) DeleteComment(ctx *gin.Context, req IDreq, uc ijwt.User
 	}, nil
 }
 
+// ListComments 分页获取评论
+// @Summary 分页获取评论
+// @Description 分页获取某个座位的评论及座位的平均评分
+// @Tags library
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer Token"
+// @Param request query ListCommentsRequest true "座位 ID 和分页参数"
+// @Success 200 {object} web.Response{data=ListCommentsResponse} "成功返回评论列表"
+// @Failure 500 {object} web.Response "系统异常，获取失败"
+// @Router /library/comment/list [get]
+func (h *LibraryHandler) ListComments(ctx *gin.Context, req ListCommentsRequest, uc ijwt.UserClaims) (web.Response, error) {
+	res, err := h.LibraryClient.ListComments(ctx, &libraryv1.ListCommentsRequest{
+		SeatId:   req.SeatID,
+		Page:     int32(req.Page),
+		PageSize: int32(req.PageSize),
+	})
+	if err != nil {
+		return web.Response{}, errs.GET_COMMENT_ERROR(err)
+	}
+
+	return web.Response{
+		Msg: "Success",
+		Data: ListCommentsResponse{
+			Comments: convComments(res.Comments),
+			Total:    res.Total,
+			Rating:   convSeatRating(res.GetRating()),
+		},
+	}, nil
+}
+
+// GetSeatRatings 批量获取座位评分
+// @Summary 批量获取座位评分
+// @Description 获取多个座位的平均评分和评论数，没有评论的座位不返回
+// @Tags library
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer Token"
+// @Param request query GetSeatRatingsRequest true "座位 ID 列表"
+// @Success 200 {object} web.Response{data=[]SeatRating} "成功返回座位评分"
+// @Failure 500 {object} web.Response "系统异常，获取失败"
+// @Router /library/comment/ratings [get]
+func (h *LibraryHandler) GetSeatRatings(ctx *gin.Context, req GetSeatRatingsRequest, uc ijwt.UserClaims) (web.Response, error) {
+	res, err := h.LibraryClient.GetSeatRatings(ctx, &libraryv1.GetSeatRatingsRequest{
+		SeatIds: req.SeatIDs,
+	})
+	if err != nil {
+		return web.Response{}, errs.GET_SEAT_RATING_ERROR(err)
+	}
+
+	ratings := make([]SeatRating, 0, len(res.Ratings))
+	for _, r := range res.Ratings {
+		ratings = append(ratings, convSeatRating(r))
+	}
+
+	return web.Response{
+		Msg:  "Success",
+		Data: ratings,
+	}, nil
+}
+
+// ReportComment 举报评论
+// @Summary 举报评论
+// @Description 举报不当评论，被举报次数过多的评论会自动隐藏等待审核
+// @Tags library
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer Token"
+// @Param request body ReportCommentRequest true "举报参数"
+// @Success 200 {object} web.Response "举报成功"
+// @Failure 500 {object} web.Response "系统异常，举报失败"
+// @Router /library/comment/report [post]
+func (h *LibraryHandler) ReportComment(ctx *gin.Context, req ReportCommentRequest, uc ijwt.UserClaims) (web.Response, error) {
+	_, err := h.LibraryClient.ReportComment(ctx, &libraryv1.ReportCommentRequest{
+		Id:       req.ID,
+		Reporter: uc.StudentId,
+		Reason:   req.Reason,
+	})
+	if err != nil {
+		return web.Response{}, errs.REPORT_COMMENT_ERROR(err)
+	}
+
+	return web.Response{
+		Msg: "Success",
+	}, nil
+}
+
+// ListReportedComments 获取待审核的评论
+// @Summary 获取待审核的评论
+// @Description 【管理员】获取被举报或待审核的评论，被举报多的在前
+// @Tags library
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer Token"
+// @Param request query PageRequest false "分页参数"
+// @Success 200 {object} web.Response{data=ListCommentsResponse} "成功返回评论列表"
+// @Failure 500 {object} web.Response "系统异常，获取失败"
+// @Router /library/comment/reported [get]
+func (h *LibraryHandler) ListReportedComments(ctx *gin.Context, req PageRequest, uc ijwt.UserClaims) (web.Response, error) {
+	if !h.isAdmin(uc.StudentId) {
+		return web.Response{}, errs.ROLE_ERROR(fmt.Errorf("没有访问权限: %s", uc.StudentId))
+	}
+	res, err := h.LibraryClient.ListReportedComments(ctx, &libraryv1.ListReportedCommentsRequest{
+		Page:     int32(req.Page),
+		PageSize: int32(req.PageSize),
+	})
+	if err != nil {
+		return web.Response{}, errs.GET_COMMENT_ERROR(err)
+	}
+
+	return web.Response{
+		Msg: "Success",
+		Data: ListCommentsResponse{
+			Comments: convComments(res.Comments),
+			Total:    res.Total,
+		},
+	}, nil
+}
+
+// ModerateComment 审核评论
+// @Summary 审核评论
+// @Description 【管理员】隐藏、恢复或删除评论，恢复时清空举报
+// @Tags library
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer Token"
+// @Param request body ModerateCommentRequest true "审核参数"
+// @Success 200 {object} web.Response "审核成功"
+// @Failure 500 {object} web.Response "系统异常，审核失败"
+// @Router /library/comment/moderate [post]
+func (h *LibraryHandler) ModerateComment(ctx *gin.Context, req ModerateCommentRequest, uc ijwt.UserClaims) (web.Response, error) {
+	if !h.isAdmin(uc.StudentId) {
+		return web.Response{}, errs.ROLE_ERROR(fmt.Errorf("没有访问权限: %s", uc.StudentId))
+	}
+	_, err := h.LibraryClient.ModerateComment(ctx, &libraryv1.ModerateCommentRequest{
+		Id:     req.ID,
+		Action: req.Action,
+	})
+	if err != nil {
+		return web.Response{}, errs.MODERATE_COMMENT_ERROR(err)
+	}
+
+	return web.Response{
+		Msg: "Success",
+	}, nil
+}
+
+func (h *LibraryHandler) isAdmin(studentId string) bool {
+	_, exists := h.Administrators[studentId]
+	return exists
+}
+
+func convComments(src []*libraryv1.Comment) []Comment {
+	comments := make([]Comment, 0, len(src))
+	for _, c := range src {
+		comments = append(comments, Comment{
+			ID:          int(c.Id),
+			SeatID:      c.SeatId,
+			Username:    c.Username,
+			Content:     c.Content,
+			Rating:      int(c.Rating),
+			CreatedAt:   c.CreatedAt,
+			Status:      c.Status,
+			ReportCount: int(c.ReportCount),
+		})
+	}
+	return comments
+}
+
+func convSeatRating(r *libraryv1.SeatRating) SeatRating {
+	return SeatRating{
+		SeatID:  r.GetSeatId(),
+		Average: r.GetAverage(),
+		Count:   r.GetCount(),
+	}
+}
+
 // ReserveSeatRandomly 随机预约座位
 // @Summary 随机预约座位
 // @Description 全校随机选座（可指定楼层）
@@ -503,3 +909,626 @@ func (h *LibraryHandler) ReserveSeatRandomly(ctx *gin.Context, req ReserveSeatRa
 		Msg: msg.Message,
 	}, nil
 }
+
+// AddFavourite 收藏座位
+// @Summary 收藏座位
+// @Description 收藏座位，重复收藏不会报错
+// @Tags library
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer Token"
+// @Param request body AddFavouriteRequest true "收藏座位参数"
+// @Success 200 {object} web.Response "成功返回收藏成功"
+// @Failure 500 {object} web.Response "系统异常，收藏失败"
+// @Router /library/favourite/add [post]
+func (h *LibraryHandler) AddFavourite(ctx *gin.Context, req AddFavouriteRequest, uc ijwt.UserClaims) (web.Response, error) {
+	_, err := h.LibraryClient.AddFavourite(ctx, &libraryv1.AddFavouriteRequest{
+		StuId:  uc.StudentId,
+		DevId:  req.DevID,
+		RoomId: req.RoomID,
+	})
+	if err != nil {
+		return web.Response{}, errs.ADD_FAVOURITE_ERROR(err)
+	}
+
+	return web.Response{
+		Msg: "Success",
+	}, nil
+}
+
+// RemoveFavourite 取消收藏座位
+// @Summary 取消收藏座位
+// @Description 取消收藏座位
+// @Tags library
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer Token"
+// @Param request body RemoveFavouriteRequest true "取消收藏参数"
+// @Success 200 {object} web.Response "成功返回取消收藏成功"
+// @Failure 500 {object} web.Response "系统异常，取消收藏失败"
+// @Router /library/favourite/remove [post]
+func (h *LibraryHandler) RemoveFavourite(ctx *gin.Context, req RemoveFavouriteRequest, uc ijwt.UserClaims) (web.Response, error) {
+	_, err := h.LibraryClient.RemoveFavourite(ctx, &libraryv1.RemoveFavouriteRequest{
+		StuId: uc.StudentId,
+		DevId: req.DevID,
+	})
+	if err != nil {
+		return web.Response{}, errs.REMOVE_FAVOURITE_ERROR(err)
+	}
+
+	return web.Response{
+		Msg: "Success",
+	}, nil
+}
+
+// ListFavourites 获取收藏的座位
+// @Summary 获取收藏的座位
+// @Description 获取收藏的座位及其在指定时间段内是否空闲，不传时间段时查询当前时刻
+// @Tags library
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer Token"
+// @Param request query ListFavouritesRequest false "查询时间段"
+// @Success 200 {object} web.Response{data=ListFavouritesResponse} "成功返回收藏的座位"
+// @Failure 500 {object} web.Response "系统异常，获取失败"
+// @Router /library/favourite/list [get]
+func (h *LibraryHandler) ListFavourites(ctx *gin.Context, req ListFavouritesRequest, uc ijwt.UserClaims) (web.Response, error) {
+	res, err := h.LibraryClient.ListFavourites(ctx, &libraryv1.ListFavouritesRequest{
+		StuId: uc.StudentId,
+		Start: req.Start,
+		End:   req.End,
+	})
+	if err != nil {
+		return web.Response{}, errs.GET_FAVOURITE_ERROR(err)
+	}
+
+	seats := make([]FavouriteSeat, 0, len(res.Seats))
+	for _, seat := range res.Seats {
+		timeSlots := make([]TimeSlot, 0, len(seat.Ts))
+		for _, ts := range seat.Ts {
+			timeSlots = append(timeSlots, TimeSlot{
+				Start:  ts.Start,
+				End:    ts.End,
+				State:  ts.State,
+				Owner:  ts.Owner,
+				Occupy: ts.Occupy,
+			})
+		}
+
+		seats = append(seats, FavouriteSeat{
+			DevID:       seat.DevId,
+			DevName:     seat.DevName,
+			RoomID:      seat.RoomId,
+			RoomName:    seat.RoomName,
+			LabName:     seat.LabName,
+			CreateTime:  seat.CreateTime,
+			IsAvailable: seat.IsAvailable,
+			TimeSlots:   timeSlots,
+		})
+	}
+
+	return web.Response{
+		Msg:  "Success",
+		Data: ListFavouritesResponse{Seats: seats},
+	}, nil
+}
+
+// ReserveFavourite 预约收藏的座位
+// @Summary 预约收藏的座位
+// @Description 按收藏顺序预约空闲的收藏座位，可选在收藏座位都被占用时预约同一房间内最近的空闲座位
+// @Tags library
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer Token"
+// @Param request body ReserveFavouriteRequest true "预约收藏座位参数"
+// @Success 200 {object} web.Response{data=ReserveFavouriteResponse} "成功返回预约到的座位"
+// @Failure 500 {object} web.Response "系统异常，预约失败"
+// @Router /library/favourite/reserve [post]
+func (h *LibraryHandler) ReserveFavourite(ctx *gin.Context, req ReserveFavouriteRequest, uc ijwt.UserClaims) (web.Response, error) {
+	res, err := h.LibraryClient.ReserveFavourite(ctx, &libraryv1.ReserveFavouriteRequest{
+		StuId:             uc.StudentId,
+		Start:             req.Start,
+		End:               req.End,
+		DevId:             req.DevID,
+		FallbackNeighbour: req.FallbackNeighbour,
+	})
+	if err != nil {
+		return web.Response{}, errs.RESERVE_FAVOURITE_ERROR(err)
+	}
+
+	return web.Response{
+		Msg: res.Message,
+		Data: ReserveFavouriteResponse{
+			DevID:       res.DevId,
+			DevName:     res.DevName,
+			RoomID:      res.RoomId,
+			IsNeighbour: res.IsNeighbour,
+		},
+	}, nil
+}
+
+// SaveReserveIntent 保存自动预约意向
+// @Summary 保存自动预约意向
+// @Description 登记长期的预约意向，如每个工作日 08:00-12:00 优先预约某些房间和座位，在图书馆开放预约的时刻自动执行，结果通过消息推送。id 为 0 时新建
+// @Tags library
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer Token"
+// @Param request body ReserveIntent true "预约意向"
+// @Success 200 {object} web.Response{data=ReserveIntent} "成功返回保存后的预约意向"
+// @Failure 500 {object} web.Response "系统异常，保存失败"
+// @Router /library/reserve_intent/save [post]
+func (h *LibraryHandler) SaveReserveIntent(ctx *gin.Context, req ReserveIntent, uc ijwt.UserClaims) (web.Response, error) {
+	weekdays := make([]int32, 0, len(req.Weekdays))
+	for _, w := range req.Weekdays {
+		weekdays = append(weekdays, int32(w))
+	}
+	res, err := h.LibraryClient.SaveReserveIntent(ctx, &libraryv1.SaveReserveIntentRequest{
+		StuId: uc.StudentId,
+		Intent: &libraryv1.ReserveIntent{
+			Id:       req.ID,
+			Weekdays: weekdays,
+			Start:    req.Start,
+			End:      req.End,
+			RoomIds:  req.RoomIDs,
+			SeatIds:  req.SeatIDs,
+			Enabled:  req.Enabled,
+		},
+	})
+	if err != nil {
+		return web.Response{}, errs.SAVE_RESERVE_INTENT_ERROR(err)
+	}
+
+	return web.Response{
+		Msg:  "Success",
+		Data: convReserveIntent(res.Intent),
+	}, nil
+}
+
+// DeleteReserveIntent 删除自动预约意向
+// @Summary 删除自动预约意向
+// @Description 删除自动预约意向
+// @Tags library
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer Token"
+// @Param request body DeleteReserveIntentRequest true "预约意向 ID"
+// @Success 200 {object} web.Response "成功返回删除成功"
+// @Failure 500 {object} web.Response "系统异常，删除失败"
+// @Router /library/reserve_intent/delete [post]
+func (h *LibraryHandler) DeleteReserveIntent(ctx *gin.Context, req DeleteReserveIntentRequest, uc ijwt.UserClaims) (web.Response, error) {
+	_, err := h.LibraryClient.DeleteReserveIntent(ctx, &libraryv1.DeleteReserveIntentRequest{
+		StuId: uc.StudentId,
+		Id:    req.ID,
+	})
+	if err != nil {
+		return web.Response{}, errs.DELETE_RESERVE_INTENT_ERROR(err)
+	}
+
+	return web.Response{
+		Msg: "Success",
+	}, nil
+}
+
+// ListReserveIntents 获取自动预约意向
+// @Summary 获取自动预约意向
+// @Description 获取登记的自动预约意向及最近一次执行结果
+// @Tags library
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer Token"
+// @Success 200 {object} web.Response{data=ListReserveIntentsResponse} "成功返回预约意向"
+// @Failure 500 {object} web.Response "系统异常，获取失败"
+// @Router /library/reserve_intent/list [get]
+func (h *LibraryHandler) ListReserveIntents(ctx *gin.Context, uc ijwt.UserClaims) (web.Response, error) {
+	res, err := h.LibraryClient.ListReserveIntents(ctx, &libraryv1.ListReserveIntentsRequest{
+		StuId: uc.StudentId,
+	})
+	if err != nil {
+		return web.Response{}, errs.GET_RESERVE_INTENT_ERROR(err)
+	}
+
+	intents := make([]ReserveIntent, 0, len(res.Intents))
+	for _, intent := range res.Intents {
+		intents = append(intents, convReserveIntent(intent))
+	}
+
+	return web.Response{
+		Msg: "Success",
+		Data: ListReserveIntentsResponse{
+			Intents:   intents,
+			OpenTime:  res.OpenTime,
+			DaysAhead: int(res.DaysAhead),
+		},
+	}, nil
+}
+
+// GetOccupancy 获取座位占用统计
+// @Summary 获取座位占用统计
+// @Description 获取各房间及各楼层当前的座位占用情况，不传房间时统计全部房间
+// @Tags library
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer Token"
+// @Param request query GetOccupancyRequest false "房间 ID 列表"
+// @Success 200 {object} web.Response{data=GetOccupancyResponse} "成功返回占用统计"
+// @Failure 500 {object} web.Response "系统异常，获取失败"
+// @Router /library/occupancy [get]
+func (h *LibraryHandler) GetOccupancy(ctx *gin.Context, req GetOccupancyRequest, uc ijwt.UserClaims) (web.Response, error) {
+	res, err := h.LibraryClient.GetOccupancy(ctx, &libraryv1.GetOccupancyRequest{
+		StuId:   uc.StudentId,
+		RoomIds: req.RoomIDs,
+	})
+	if err != nil {
+		return web.Response{}, errs.GET_OCCUPANCY_ERROR(err)
+	}
+
+	rooms := make([]RoomOccupancy, 0, len(res.Rooms))
+	for _, room := range res.Rooms {
+		rooms = append(rooms, convRoomOccupancy(room))
+	}
+	floors := make([]FloorOccupancy, 0, len(res.Floors))
+	for _, floor := range res.Floors {
+		floors = append(floors, FloorOccupancy{
+			LabName: floor.GetLabName(),
+			Stat:    convSeatStatistics(floor.GetStat()),
+		})
+	}
+
+	return web.Response{
+		Msg: "Success",
+		Data: GetOccupancyResponse{
+			Rooms:  rooms,
+			Floors: floors,
+		},
+	}, nil
+}
+
+// GetOccupancyHeatmap 获取座位占用热力图
+// @Summary 获取座位占用热力图
+// @Description 按星期和小时统计最近几周的平均占用率，可按房间或楼层过滤
+// @Tags library
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer Token"
+// @Param request query GetOccupancyHeatmapRequest false "过滤条件"
+// @Success 200 {object} web.Response{data=GetOccupancyHeatmapResponse} "成功返回热力图"
+// @Failure 500 {object} web.Response "系统异常，获取失败"
+// @Router /library/occupancy/heatmap [get]
+func (h *LibraryHandler) GetOccupancyHeatmap(ctx *gin.Context, req GetOccupancyHeatmapRequest, uc ijwt.UserClaims) (web.Response, error) {
+	res, err := h.LibraryClient.GetOccupancyHeatmap(ctx, &libraryv1.GetOccupancyHeatmapRequest{
+		RoomId:  req.RoomID,
+		LabName: req.LabName,
+		Weeks:   int32(req.Weeks),
+	})
+	if err != nil {
+		return web.Response{}, errs.GET_OCCUPANCY_HEATMAP_ERROR(err)
+	}
+
+	cells := make([]HeatmapCell, 0, len(res.Cells))
+	for _, cell := range res.Cells {
+		cells = append(cells, HeatmapCell{
+			Weekday:   int(cell.Weekday),
+			Hour:      int(cell.Hour),
+			UsageRate: cell.UsageRate,
+			Samples:   cell.Samples,
+		})
+	}
+
+	return web.Response{
+		Msg:  "Success",
+		Data: GetOccupancyHeatmapResponse{Cells: cells},
+	}, nil
+}
+
+// GetLeastBusyRoom 获取最空闲的房间
+// @Summary 获取最空闲的房间
+// @Description 在指定房间中选出当前空闲座位最多的房间，不传房间时在全部房间中选择
+// @Tags library
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer Token"
+// @Param request query GetOccupancyRequest false "房间 ID 列表"
+// @Success 200 {object} web.Response{data=RoomOccupancy} "成功返回房间"
+// @Failure 500 {object} web.Response "系统异常，获取失败"
+// @Router /library/occupancy/least_busy [get]
+func (h *LibraryHandler) GetLeastBusyRoom(ctx *gin.Context, req GetOccupancyRequest, uc ijwt.UserClaims) (web.Response, error) {
+	res, err := h.LibraryClient.GetLeastBusyRoom(ctx, &libraryv1.GetLeastBusyRoomRequest{
+		StuId:   uc.StudentId,
+		RoomIds: req.RoomIDs,
+	})
+	if err != nil {
+		return web.Response{}, errs.GET_LEAST_BUSY_ROOM_ERROR(err)
+	}
+
+	return web.Response{
+		Msg:  "Success",
+		Data: convRoomOccupancy(res.GetRoom()),
+	}, nil
+}
+
+// ListRooms 获取房间目录
+// @Summary 获取房间目录
+// @Description 获取图书馆的房间及开放时间，默认只返回可预约的房间
+// @Tags library
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer Token"
+// @Param request query ListRoomsRequest false "过滤条件"
+// @Success 200 {object} web.Response{data=ListRoomsResponse} "成功返回房间列表"
+// @Failure 500 {object} web.Response "系统异常，获取失败"
+// @Router /library/room/list [get]
+func (h *LibraryHandler) ListRooms(ctx *gin.Context, req ListRoomsRequest, uc ijwt.UserClaims) (web.Response, error) {
+	res, err := h.LibraryClient.ListRooms(ctx, &libraryv1.ListRoomsRequest{
+		IncludeClosed: req.IncludeClosed,
+	})
+	if err != nil {
+		return web.Response{}, errs.LIST_ROOMS_ERROR(err)
+	}
+
+	return web.Response{
+		Msg:  "Success",
+		Data: ListRoomsResponse{Rooms: convRooms(res.Rooms)},
+	}, nil
+}
+
+// SaveRoom 新增或修改房间
+// @Summary 新增或修改房间
+// @Description 【管理员】新增或修改房间，bookable 为 false 时关闭房间。分馆和楼层为空时根据楼层名推断
+// @Tags library
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer Token"
+// @Param request body CatalogRoom true "房间"
+// @Success 200 {object} web.Response{data=CatalogRoom} "成功返回保存后的房间"
+// @Failure 500 {object} web.Response "系统异常，保存失败"
+// @Router /library/room/save [post]
+func (h *LibraryHandler) SaveRoom(ctx *gin.Context, req CatalogRoom, uc ijwt.UserClaims) (web.Response, error) {
+	if !h.isAdmin(uc.StudentId) {
+		return web.Response{}, errs.ROLE_ERROR(fmt.Errorf("没有访问权限: %s", uc.StudentId))
+	}
+	res, err := h.LibraryClient.SaveRoom(ctx, &libraryv1.SaveRoomRequest{
+		Room: &libraryv1.Room{
+			Id:        req.ID,
+			Name:      req.Name,
+			LabName:   req.LabName,
+			Branch:    req.Branch,
+			Floor:     int32(req.Floor),
+			OpenTime:  req.OpenTime,
+			CloseTime: req.CloseTime,
+			SeatCount: int32(req.SeatCount),
+			Bookable:  req.Bookable,
+		},
+	})
+	if err != nil {
+		return web.Response{}, errs.SAVE_ROOM_ERROR(err)
+	}
+
+	return web.Response{
+		Msg:  "Success",
+		Data: convRoom(res.Room),
+	}, nil
+}
+
+// DeleteRoom 删除房间
+// @Summary 删除房间
+// @Description 【管理员】从目录中删除房间，只想暂停预约时请关闭房间
+// @Tags library
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer Token"
+// @Param request body DeleteRoomRequest true "房间 ID"
+// @Success 200 {object} web.Response "删除成功"
+// @Failure 500 {object} web.Response "系统异常，删除失败"
+// @Router /library/room/delete [post]
+func (h *LibraryHandler) DeleteRoom(ctx *gin.Context, req DeleteRoomRequest, uc ijwt.UserClaims) (web.Response, error) {
+	if !h.isAdmin(uc.StudentId) {
+		return web.Response{}, errs.ROLE_ERROR(fmt.Errorf("没有访问权限: %s", uc.StudentId))
+	}
+	_, err := h.LibraryClient.DeleteRoom(ctx, &libraryv1.DeleteRoomRequest{
+		Id: req.ID,
+	})
+	if err != nil {
+		return web.Response{}, errs.DELETE_ROOM_ERROR(err)
+	}
+
+	return web.Response{
+		Msg: "Success",
+	}, nil
+}
+
+// DiscoverRooms 发现新房间
+// @Summary 发现新房间
+// @Description 【管理员】使用管理员的账号从图书馆系统拉取房间，新增目录中没有的房间并更新已有房间的座位数
+// @Tags library
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer Token"
+// @Success 200 {object} web.Response{data=DiscoverRoomsResponse} "成功返回新增的房间"
+// @Failure 500 {object} web.Response "系统异常，发现失败"
+// @Router /library/room/discover [post]
+func (h *LibraryHandler) DiscoverRooms(ctx *gin.Context, uc ijwt.UserClaims) (web.Response, error) {
+	if !h.isAdmin(uc.StudentId) {
+		return web.Response{}, errs.ROLE_ERROR(fmt.Errorf("没有访问权限: %s", uc.StudentId))
+	}
+	res, err := h.LibraryClient.DiscoverRooms(ctx, &libraryv1.DiscoverRoomsRequest{
+		StuId: uc.StudentId,
+	})
+	if err != nil {
+		return web.Response{}, errs.DISCOVER_ROOMS_ERROR(err)
+	}
+
+	return web.Response{
+		Msg: "Success",
+		Data: DiscoverRoomsResponse{
+			Added: convRooms(res.Added),
+			Total: int(res.Total),
+		},
+	}, nil
+}
+
+// GetUsageAnalytics 获取个人使用统计
+// @Summary 获取个人使用统计
+// @Description 根据已保存的预约历史统计每周、每月的学习时长、常去的房间、签到和违约情况、连续学习天数以及学期回顾。只统计查看过的预约历史，不会重新爬取
+// @Tags library
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer Token"
+// @Param request query GetUsageAnalyticsRequest false "统计范围"
+// @Success 200 {object} web.Response{data=GetUsageAnalyticsResponse} "成功返回使用统计"
+// @Failure 500 {object} web.Response "系统异常，获取失败"
+// @Router /library/usage/analytics [get]
+func (h *LibraryHandler) GetUsageAnalytics(ctx *gin.Context, req GetUsageAnalyticsRequest, uc ijwt.UserClaims) (web.Response, error) {
+	res, err := h.LibraryClient.GetUsageAnalytics(ctx, &libraryv1.GetUsageAnalyticsRequest{
+		StuId:         uc.StudentId,
+		Weeks:         int32(req.Weeks),
+		Months:        int32(req.Months),
+		SemesterStart: req.SemesterStart,
+		SemesterEnd:   req.SemesterEnd,
+	})
+	if err != nil {
+		return web.Response{}, errs.GET_USAGE_ANALYTICS_ERROR(err)
+	}
+
+	rooms := make([]FavouriteRoom, 0, len(res.GetFavouriteRooms()))
+	for _, r := range res.GetFavouriteRooms() {
+		rooms = append(rooms, FavouriteRoom{
+			Room:     r.GetRoom(),
+			Floor:    r.GetFloor(),
+			Hours:    r.GetHours(),
+			Sessions: int(r.GetSessions()),
+		})
+	}
+	review := res.GetReview()
+
+	return web.Response{
+		Msg: "Success",
+		Data: GetUsageAnalyticsResponse{
+			Weekly:         convUsagePeriods(res.GetWeekly()),
+			Monthly:        convUsagePeriods(res.GetMonthly()),
+			FavouriteRooms: rooms,
+			Attendance:     convAttendanceStat(res.GetAttendance()),
+			Streak: StudyStreak{
+				Current:      int(res.GetStreak().GetCurrent()),
+				Longest:      int(res.GetStreak().GetLongest()),
+				LongestStart: res.GetStreak().GetLongestStart(),
+				LongestEnd:   res.GetStreak().GetLongestEnd(),
+			},
+			Review: SemesterReview{
+				SemesterStart:   review.GetSemesterStart(),
+				SemesterEnd:     review.GetSemesterEnd(),
+				TotalHours:      review.GetTotalHours(),
+				Sessions:        int(review.GetSessions()),
+				StudyDays:       int(review.GetStudyDays()),
+				TopRoom:         review.GetTopRoom(),
+				BusiestWeekday:  int(review.GetBusiestWeekday()),
+				BusiestDay:      review.GetBusiestDay(),
+				BusiestDayHours: review.GetBusiestDayHours(),
+				LongestStreak:   int(review.GetLongestStreak()),
+				Attendance:      convAttendanceStat(review.GetAttendance()),
+			},
+		},
+	}, nil
+}
+
+func convUsagePeriods(src []*libraryv1.UsagePeriod) []UsagePeriod {
+	periods := make([]UsagePeriod, 0, len(src))
+	for _, p := range src {
+		periods = append(periods, UsagePeriod{
+			Period:   p.GetPeriod(),
+			Hours:    p.GetHours(),
+			Sessions: int(p.GetSessions()),
+		})
+	}
+	return periods
+}
+
+func convAttendanceStat(stat *libraryv1.AttendanceStat) AttendanceStat {
+	return AttendanceStat{
+		OnTime:     int(stat.GetOnTime()),
+		Late:       int(stat.GetLate()),
+		NoShow:     int(stat.GetNoShow()),
+		Cancelled:  int(stat.GetCancelled()),
+		OnTimeRate: stat.GetOnTimeRate(),
+		LateRate:   stat.GetLateRate(),
+		NoShowRate: stat.GetNoShowRate(),
+	}
+}
+
+func convRoom(r *libraryv1.Room) CatalogRoom {
+	return CatalogRoom{
+		ID:        r.GetId(),
+		Name:      r.GetName(),
+		LabName:   r.GetLabName(),
+		Branch:    r.GetBranch(),
+		Floor:     int(r.GetFloor()),
+		OpenTime:  r.GetOpenTime(),
+		CloseTime: r.GetCloseTime(),
+		SeatCount: int(r.GetSeatCount()),
+		Bookable:  r.GetBookable(),
+		UpdatedAt: r.GetUpdatedAt(),
+	}
+}
+
+func convRooms(src []*libraryv1.Room) []CatalogRoom {
+	rooms := make([]CatalogRoom, 0, len(src))
+	for _, r := range src {
+		rooms = append(rooms, convRoom(r))
+	}
+	return rooms
+}
+
+func convRoomOccupancy(room *libraryv1.RoomOccupancy) RoomOccupancy {
+	return RoomOccupancy{
+		RoomID:   room.GetRoomId(),
+		RoomName: room.GetRoomName(),
+		LabName:  room.GetLabName(),
+		Stat:     convSeatStatistics(room.GetStat()),
+	}
+}
+
+func convSeatStatistics(stat *libraryv1.SeatStatistics) SeatStatistics {
+	return SeatStatistics{
+		Total:     stat.GetTotal(),
+		Available: stat.GetAvailable(),
+		Partial:   stat.GetPartial(),
+		Busy:      stat.GetBusy(),
+		UsageRate: stat.GetUsageRate(),
+	}
+}
+
+func convReserveIntent(intent *libraryv1.ReserveIntent) ReserveIntent {
+	weekdays := make([]int, 0, len(intent.GetWeekdays()))
+	for _, w := range intent.GetWeekdays() {
+		weekdays = append(weekdays, int(w))
+	}
+	return ReserveIntent{
+		ID:          intent.GetId(),
+		Weekdays:    weekdays,
+		Start:       intent.GetStart(),
+		End:         intent.GetEnd(),
+		RoomIDs:     intent.GetRoomIds(),
+		SeatIDs:     intent.GetSeatIds(),
+		Enabled:     intent.GetEnabled(),
+		LastRunDate: intent.GetLastRunDate(),
+		LastResult:  intent.GetLastResult(),
+		LastSeat:    intent.GetLastSeat(),
+	}
+}
+
+func convStudyGroup(g *libraryv1.StudyGroup) StudyGroup {
+	members := make([]GroupMember, 0, len(g.Members))
+	for _, m := range g.Members {
+		members = append(members, GroupMember{
+			StudentID: m.StudentId,
+			Name:      m.Name,
+			AccountID: m.AccountId,
+		})
+	}
+	return StudyGroup{
+		ID:        g.Id,
+		Name:      g.Name,
+		Members:   members,
+		CreatedAt: g.CreatedAt,
+	}
+}
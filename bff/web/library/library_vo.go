@@ -138,10 +138,87 @@ type ReserveDiscussionRequest struct {
 	List   []string `json:"list"`
 }
 
+type SearchDiscussionSlotsRequest struct {
+	Date     string   `form:"date" binding:"required"`     // 2006-01-02
+	Duration int32    `form:"duration" binding:"required"` // 至少空闲的分钟数
+	ClassIDs []string `form:"class_ids"`                   // 为空时使用服务端配置的类别
+	From     string   `form:"from"`                        // HH:MM,为空时使用开放时间
+	To       string   `form:"to"`                          // HH:MM,为空时使用关闭时间
+}
+
+type DiscussionSlot struct {
+	LabID    string `json:"lab_id"`
+	LabName  string `json:"lab_name"`
+	KindID   string `json:"kind_id"`
+	KindName string `json:"kind_name"`
+	DevID    string `json:"dev_id"`
+	DevName  string `json:"dev_name"`
+	Start    string `json:"start"`
+	End      string `json:"end"`
+}
+
+type SearchDiscussionSlotsResponse struct {
+	Slots []DiscussionSlot `json:"slots"`
+}
+
+type GroupMember struct {
+	StudentID string `json:"student_id"`
+	Name      string `json:"name"`
+	AccountID string `json:"account_id"` // 预约系统中的账号 id
+}
+
+type StudyGroup struct {
+	ID        uint64        `json:"id"`
+	Name      string        `json:"name"`
+	Members   []GroupMember `json:"members"`
+	CreatedAt string        `json:"created_at"`
+}
+
+type SaveStudyGroupRequest struct {
+	ID        uint64   `json:"id"` // 为 0 时新建
+	Name      string   `json:"name" binding:"required"`
+	MemberIDs []string `json:"member_ids" binding:"required"` // 成员学号,不包括自己
+}
+
+type DeleteStudyGroupRequest struct {
+	ID uint64 `json:"id" binding:"required"`
+}
+
+type ListStudyGroupsResponse struct {
+	Groups []StudyGroup `json:"groups"`
+}
+
+type ReserveDiscussionForGroupRequest struct {
+	GroupID uint64 `json:"group_id" binding:"required"`
+	DevID   string `json:"dev_id" binding:"required"`
+	LabID   string `json:"lab_id" binding:"required"`
+	KindID  string `json:"kind_id" binding:"required"`
+	Title   string `json:"title"`
+	Start   string `json:"start" binding:"required"`
+	End     string `json:"end" binding:"required"`
+}
+
+type ReserveDiscussionForGroupResponse struct {
+	Message string `json:"message"`
+	Invited int    `json:"invited"` // 成功发送邀请的成员数
+}
+
 type CancelReserveRequest struct {
 	ID string `form:"id" binding:"required"`
 }
 
+type SwapReservationRequest struct {
+	OldID string `json:"old_id" binding:"required"` // 原预约的 ID
+	DevID string `json:"dev_id" binding:"required"`
+	Start string `json:"start" binding:"required"` // 2006-01-02 15:04
+	End   string `json:"end" binding:"required"`
+}
+
+type SwapReservationResponse struct {
+	Message string `json:"message"`
+	NewID   string `json:"new_id"` // 新预约的 ID，未能查到时为空
+}
+
 type ReserveSeatRandomlyRequest struct {
 	RoomIDs []string `json:"room_ids"`
 	DevID   string   `json:"dev_id"`
@@ -160,6 +237,9 @@ type Comment struct {
 	Content   string `json:"content"`    // 评论内容
 	Rating    int    `json:"rating"`     // 评分（1-5）
 	CreatedAt string `json:"created_at"` // 创建时间
+	// visible 正常展示, pending 被举报过多待审核, hidden 被管理员隐藏
+	Status      string `json:"status"`
+	ReportCount int    `json:"report_count"`
 }
 
 type CreateCommentReq struct {
@@ -172,3 +252,242 @@ type CreateCommentReq struct {
 type IDreq struct {
 	ID int `json:"id" form:"id"`
 }
+
+type AddFavouriteRequest struct {
+	DevID  string `json:"dev_id" binding:"required"`
+	RoomID string `json:"room_id"` // 可选，为空时在全部房间中查找
+}
+
+type RemoveFavouriteRequest struct {
+	DevID string `json:"dev_id" binding:"required"`
+}
+
+type ListFavouritesRequest struct {
+	Start string `form:"start"` // 2006-01-02 15:04，为空时查询当前时刻
+	End   string `form:"end"`
+}
+
+type FavouriteSeat struct {
+	DevID       string     `json:"devId"`
+	DevName     string     `json:"devName"`
+	RoomID      string     `json:"roomId"`
+	RoomName    string     `json:"roomName"`
+	LabName     string     `json:"labName"`
+	CreateTime  string     `json:"createTime"`
+	IsAvailable bool       `json:"isAvailable"`
+	TimeSlots   []TimeSlot `json:"ts"`
+}
+
+type ListFavouritesResponse struct {
+	Seats []FavouriteSeat `json:"seats"`
+}
+
+type ReserveFavouriteRequest struct {
+	Start             string `json:"start" binding:"required"`
+	End               string `json:"end" binding:"required"`
+	DevID             string `json:"dev_id"`             // 可选，为空时按收藏顺序依次尝试
+	FallbackNeighbour bool   `json:"fallback_neighbour"` // 收藏座位都被占用时预约同一房间内最近的空闲座位
+}
+
+type ReserveFavouriteResponse struct {
+	DevID       string `json:"dev_id"`
+	DevName     string `json:"dev_name"`
+	RoomID      string `json:"room_id"`
+	IsNeighbour bool   `json:"is_neighbour"`
+}
+
+type ReserveIntent struct {
+	ID       uint64   `json:"id"`                          // 为 0 时新建
+	Weekdays []int    `json:"weekdays" binding:"required"` // 1-7 对应周一到周日
+	Start    string   `json:"start" binding:"required"`    // HH:MM
+	End      string   `json:"end" binding:"required"`      // HH:MM
+	RoomIDs  []string `json:"room_ids"`                    // 优先房间，按优先级排序，为空时不限房间
+	SeatIDs  []string `json:"seat_ids"`                    // 优先座位 devID，按优先级排序
+	Enabled  bool     `json:"enabled"`
+
+	LastRunDate string `json:"last_run_date"`
+	LastResult  string `json:"last_result"`
+	LastSeat    string `json:"last_seat"`
+}
+
+type DeleteReserveIntentRequest struct {
+	ID uint64 `json:"id" binding:"required"`
+}
+
+type ListReserveIntentsResponse struct {
+	Intents   []ReserveIntent `json:"intents"`
+	OpenTime  string          `json:"open_time"`  // 每天开放预约的时刻
+	DaysAhead int             `json:"days_ahead"` // 开放预约的日期距今天的天数
+}
+
+type GetOccupancyRequest struct {
+	RoomIDs []string `form:"room_ids"` // 为空时统计全部房间
+}
+
+type SeatStatistics struct {
+	Total     int64   `json:"total"`
+	Available int64   `json:"available"` // 当前空闲且之后没有预约
+	Partial   int64   `json:"partial"`   // 当前空闲但之后有预约
+	Busy      int64   `json:"busy"`      // 当前被占用
+	UsageRate float64 `json:"usage_rate"`
+}
+
+type RoomOccupancy struct {
+	RoomID   string         `json:"room_id"`
+	RoomName string         `json:"room_name"`
+	LabName  string         `json:"lab_name"`
+	Stat     SeatStatistics `json:"stat"`
+}
+
+type FloorOccupancy struct {
+	LabName string         `json:"lab_name"`
+	Stat    SeatStatistics `json:"stat"`
+}
+
+type GetOccupancyResponse struct {
+	Rooms  []RoomOccupancy  `json:"rooms"`
+	Floors []FloorOccupancy `json:"floors"`
+}
+
+type GetOccupancyHeatmapRequest struct {
+	RoomID  string `form:"room_id"` // room_id 和 lab_name 都为空时统计整个图书馆
+	LabName string `form:"lab_name"`
+	Weeks   int    `form:"weeks"` // 统计最近几周，默认 4
+}
+
+type HeatmapCell struct {
+	Weekday   int     `json:"weekday"` // 1-7 对应周一到周日
+	Hour      int     `json:"hour"`
+	UsageRate float64 `json:"usage_rate"`
+	Samples   int64   `json:"samples"`
+}
+
+type GetOccupancyHeatmapResponse struct {
+	Cells []HeatmapCell `json:"cells"`
+}
+
+type PageRequest struct {
+	Page     int `form:"page"`      // 从 1 开始
+	PageSize int `form:"page_size"` // 默认 20，最大 100
+}
+
+type ListCommentsRequest struct {
+	SeatID string `form:"seat_id" binding:"required"`
+	PageRequest
+}
+
+type SeatRating struct {
+	SeatID  string  `json:"seat_id"`
+	Average float64 `json:"average"`
+	Count   int64   `json:"count"`
+}
+
+type ListCommentsResponse struct {
+	Comments []Comment  `json:"comments"`
+	Total    int64      `json:"total"`
+	Rating   SeatRating `json:"rating"`
+}
+
+type GetSeatRatingsRequest struct {
+	SeatIDs []string `form:"seat_ids" binding:"required"`
+}
+
+type ReportCommentRequest struct {
+	ID     int64  `json:"id" binding:"required"`
+	Reason string `json:"reason"`
+}
+
+type ModerateCommentRequest struct {
+	ID     int64  `json:"id" binding:"required"`
+	Action string `json:"action" binding:"required,oneof=hide restore delete"` // hide 隐藏, restore 恢复并清空举报, delete 删除
+}
+
+type CatalogRoom struct {
+	ID        string `json:"id" binding:"required"`
+	Name      string `json:"name" binding:"required"`
+	LabName   string `json:"lab_name"` // 主馆图书馆一楼
+	Branch    string `json:"branch"`   // 主馆、南湖分馆，为空时根据 lab_name 推断
+	Floor     int    `json:"floor"`
+	OpenTime  string `json:"open_time"` // HH:MM，为空时使用默认开放时间
+	CloseTime string `json:"close_time"`
+	SeatCount int    `json:"seat_count"`
+	Bookable  bool   `json:"bookable"` // 关闭的房间不在默认房间列表中
+	UpdatedAt string `json:"updated_at"`
+}
+
+type ListRoomsRequest struct {
+	IncludeClosed bool `form:"include_closed"` // 是否包含已关闭的房间
+}
+
+type ListRoomsResponse struct {
+	Rooms []CatalogRoom `json:"rooms"`
+}
+
+type DeleteRoomRequest struct {
+	ID string `json:"id" binding:"required"`
+}
+
+type DiscoverRoomsResponse struct {
+	Added []CatalogRoom `json:"added"` // 新增的房间
+	Total int           `json:"total"` // 图书馆系统中的房间总数
+}
+
+type GetUsageAnalyticsRequest struct {
+	Weeks         int    `form:"weeks"`          // 统计最近几周，默认 8，最多 26
+	Months        int    `form:"months"`         // 统计最近几个月，默认 6，最多 12
+	SemesterStart string `form:"semester_start"` // 学期回顾的起止日期 2006-01-02，为空时使用当前学期
+	SemesterEnd   string `form:"semester_end"`
+}
+
+type UsagePeriod struct {
+	Period   string  `json:"period"` // 2025-W36 或 2025-09
+	Hours    float64 `json:"hours"`
+	Sessions int     `json:"sessions"`
+}
+
+type FavouriteRoom struct {
+	Room     string  `json:"room"`
+	Floor    string  `json:"floor"`
+	Hours    float64 `json:"hours"`
+	Sessions int     `json:"sessions"`
+}
+
+type AttendanceStat struct {
+	OnTime     int     `json:"on_time"`
+	Late       int     `json:"late"`
+	NoShow     int     `json:"no_show"`
+	Cancelled  int     `json:"cancelled"`
+	OnTimeRate float64 `json:"on_time_rate"` // 比例不包括取消的预约
+	LateRate   float64 `json:"late_rate"`
+	NoShowRate float64 `json:"no_show_rate"`
+}
+
+type StudyStreak struct {
+	Current      int    `json:"current"`
+	Longest      int    `json:"longest"`
+	LongestStart string `json:"longest_start"`
+	LongestEnd   string `json:"longest_end"`
+}
+
+type SemesterReview struct {
+	SemesterStart   string         `json:"semester_start"`
+	SemesterEnd     string         `json:"semester_end"`
+	TotalHours      float64        `json:"total_hours"`
+	Sessions        int            `json:"sessions"`
+	StudyDays       int            `json:"study_days"`
+	TopRoom         string         `json:"top_room"`
+	BusiestWeekday  int            `json:"busiest_weekday"` // 1-7 对应周一到周日
+	BusiestDay      string         `json:"busiest_day"`
+	BusiestDayHours float64        `json:"busiest_day_hours"`
+	LongestStreak   int            `json:"longest_streak"`
+	Attendance      AttendanceStat `json:"attendance"`
+}
+
+type GetUsageAnalyticsResponse struct {
+	Weekly         []UsagePeriod   `json:"weekly"`
+	Monthly        []UsagePeriod   `json:"monthly"`
+	FavouriteRooms []FavouriteRoom `json:"favourite_rooms"`
+	Attendance     AttendanceStat  `json:"attendance"`
+	Streak         StudyStreak     `json:"streak"`
+	Review         SemesterReview  `json:"review"`
+}
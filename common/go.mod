@@ -0,0 +1,19 @@
+module github.com/asynccnu/ccnubox-be/common
+
+go 1.24.0
+
+require (
+	github.com/alicebob/miniredis/v2 v2.35.0
+	github.com/go-kratos/kratos/v2 v2.8.4
+	github.com/redis/go-redis/v9 v9.7.3
+	github.com/stretchr/testify v1.10.0
+)
+
+require (
+	github.com/cespare/xxhash/v2 v2.2.0 // indirect
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/dgryski/go-rendezvous v0.0.0-20200823014737-9f7001d12a5f // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	github.com/yuin/gopher-lua v1.1.1 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
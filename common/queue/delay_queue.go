@@ -0,0 +1,297 @@
+// Package queue 提供各个服务共用的基于redis的延迟任务队列
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+//使用redis的有序集合实现一个延迟任务队列
+//
+//  ready    有序集合,member为任务ID,score为可执行的时间(ms)
+//  inflight 有序集合,member为任务ID,score为可见性超时的截止时间(ms),超时未确认的任务会被重新放回ready
+//  jobs     哈希,任务ID -> 任务内容
+//  attempts 哈希,任务ID -> 已尝试次数
+//  dead     列表,超过最大尝试次数的任务
+
+// claimScript 原子地将过期的inflight任务放回ready,并认领到期的任务
+// KEYS: ready, inflight, attempts
+// ARGV: now, 可见性截止时间, 认领数量
+var claimScript = redis.NewScript(`
+local expired = redis.call('ZRANGEBYSCORE', KEYS[2], '-inf', ARGV[1])
+for _, id in ipairs(expired) do
+	redis.call('ZREM', KEYS[2], id)
+	redis.call('ZADD', KEYS[1], ARGV[1], id)
+end
+local ids = redis.call('ZRANGEBYSCORE', KEYS[1], '-inf', ARGV[1], 'LIMIT', 0, ARGV[3])
+for _, id in ipairs(ids) do
+	redis.call('ZREM', KEYS[1], id)
+	redis.call('ZADD', KEYS[2], ARGV[2], id)
+	redis.call('HINCRBY', KEYS[3], id, 1)
+end
+return ids
+`)
+
+type DelayJob struct {
+	ID        string    `json:"id"`
+	Key       []byte    `json:"key"`
+	Value     []byte    `json:"value"`
+	CreatedAt time.Time `json:"created_at"`
+	Attempts  int64     `json:"attempts,omitempty"`
+	LastError string    `json:"last_error,omitempty"`
+	FailedAt  time.Time `json:"failed_at,omitempty"`
+}
+
+type DelayQueueConfig struct {
+	Name         string        //队列名称,用作redis key的前缀
+	Delay        time.Duration //Send的任务首次执行前的延迟
+	MaxAttempts  int64         //最大尝试次数,超过后进入死信列表
+	Backoff      time.Duration //失败后重试的基础间隔,按2的指数增长
+	MaxBackoff   time.Duration //重试间隔的上限
+	Visibility   time.Duration //任务被认领后的可见性超时,超时未确认会被重新投递
+	PollInterval time.Duration //轮询间隔
+	BatchSize    int64         //每次认领的最大任务数
+	MaxDead      int64         //死信列表保留的最大任务数
+}
+
+// RedisDelayQueue 是基于redis有序集合的延迟任务队列,实现了各服务biz中的DelayQueue
+// 任务至少被投递一次,处理失败后按指数退避重试,超过最大尝试次数后进入死信列表
+type RedisDelayQueue struct {
+	rdb *redis.Client
+	cf  DelayQueueConfig
+	log *log.Helper
+
+	readyKey, inflightKey, jobsKey, attemptsKey, deadKey string
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewRedisDelayQueue(rdb *redis.Client, cf DelayQueueConfig, logger log.Logger) (*RedisDelayQueue, func()) {
+	ctx, cancel := context.WithCancel(context.Background())
+	prefix := "delayq:" + cf.Name + ":"
+	q := &RedisDelayQueue{
+		rdb:         rdb,
+		cf:          cf,
+		log:         log.NewHelper(logger),
+		readyKey:    prefix + "ready",
+		inflightKey: prefix + "inflight",
+		jobsKey:     prefix + "jobs",
+		attemptsKey: prefix + "attempts",
+		deadKey:     prefix + "dead",
+		ctx:         ctx,
+		cancel:      cancel,
+	}
+	return q, q.Close
+}
+
+// Send 发送任务,任务将在配置的延迟之后执行
+func (q *RedisDelayQueue) Send(key, value []byte) error {
+	return q.SendAfter(q.ctx, key, value, q.cf.Delay)
+}
+
+// SendAfter 发送任务,任务将在delay之后执行
+func (q *RedisDelayQueue) SendAfter(ctx context.Context, key, value []byte, delay time.Duration) error {
+	now := time.Now()
+	job := DelayJob{
+		ID:        fmt.Sprintf("%d-%s", now.UnixNano(), key),
+		Key:       key,
+		Value:     value,
+		CreatedAt: now,
+	}
+	val, err := json.Marshal(&job)
+	if err != nil {
+		return err
+	}
+	_, err = q.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, q.jobsKey, job.ID, val)
+		pipe.ZAdd(ctx, q.readyKey, redis.Z{Score: float64(now.Add(delay).UnixMilli()), Member: job.ID})
+		return nil
+	})
+	return err
+}
+
+// Consume 消费任务,f中发生panic视为处理失败,其余情况均视为成功
+// 同一个任务只会被投递给一个消费者,groupID仅用于日志标识
+func (q *RedisDelayQueue) Consume(groupID string, f func(key, value []byte)) error {
+	return q.ConsumeWithRetry(groupID, func(key, value []byte) error {
+		f(key, value)
+		return nil
+	})
+}
+
+// ConsumeWithRetry 消费任务,f返回错误时按指数退避重试,直到超过最大尝试次数
+// 阻塞直到队列被关闭
+func (q *RedisDelayQueue) ConsumeWithRetry(groupID string, f func(key, value []byte) error) error {
+	if groupID == "" {
+		return errors.New("groupID is required")
+	}
+	q.wg.Add(1)
+	defer q.wg.Done()
+
+	ticker := time.NewTicker(q.cf.PollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-q.ctx.Done():
+			return q.ctx.Err()
+		case <-ticker.C:
+		}
+		jobs, err := q.claim(q.ctx)
+		if err != nil {
+			if !errors.Is(err, context.Canceled) {
+				q.log.Errorf("[%s] claim delay jobs failed: %v", groupID, err)
+			}
+			continue
+		}
+		for _, job := range jobs {
+			q.handle(groupID, job, f)
+		}
+	}
+}
+
+func (q *RedisDelayQueue) claim(ctx context.Context) ([]*DelayJob, error) {
+	now := time.Now()
+	ids, err := claimScript.Run(ctx, q.rdb, []string{q.readyKey, q.inflightKey, q.attemptsKey},
+		now.UnixMilli(), now.Add(q.cf.Visibility).UnixMilli(), q.cf.BatchSize).StringSlice()
+	if err != nil || len(ids) == 0 {
+		return nil, err
+	}
+
+	vals, err := q.rdb.HMGet(ctx, q.jobsKey, ids...).Result()
+	if err != nil {
+		return nil, err
+	}
+	attempts, err := q.rdb.HMGet(ctx, q.attemptsKey, ids...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	jobs := make([]*DelayJob, 0, len(ids))
+	for i, id := range ids {
+		s, ok := vals[i].(string)
+		if !ok {
+			//任务内容已丢失,直接清理
+			q.ack(ctx, id)
+			continue
+		}
+		var job DelayJob
+		if err := json.Unmarshal([]byte(s), &job); err != nil {
+			q.log.Errorf("unmarshal delay job %s failed: %v", id, err)
+			q.ack(ctx, id)
+			continue
+		}
+		if a, ok := attempts[i].(string); ok {
+			job.Attempts, _ = strconv.ParseInt(a, 10, 64)
+		}
+		jobs = append(jobs, &job)
+	}
+	return jobs, nil
+}
+
+func (q *RedisDelayQueue) handle(groupID string, job *DelayJob, f func(key, value []byte) error) {
+	err := func() (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = fmt.Errorf("panic: %v", r)
+			}
+		}()
+		return f(job.Key, job.Value)
+	}()
+
+	ctx := context.Background()
+	if err == nil {
+		q.ack(ctx, job.ID)
+		return
+	}
+
+	job.LastError = err.Error()
+	if job.Attempts >= q.cf.MaxAttempts {
+		q.log.Errorf("[%s] delay job %s failed after %d attempts, moved to dead letter: %v", groupID, job.ID, job.Attempts, err)
+		q.bury(ctx, job)
+		return
+	}
+	next := q.backoff(job.Attempts)
+	q.log.Warnf("[%s] delay job %s failed at attempt %d, retry in %v: %v", groupID, job.ID, job.Attempts, next, err)
+	_, rerr := q.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZRem(ctx, q.inflightKey, job.ID)
+		pipe.ZAdd(ctx, q.readyKey, redis.Z{Score: float64(time.Now().Add(next).UnixMilli()), Member: job.ID})
+		return nil
+	})
+	if rerr != nil {
+		//重新入队失败时,任务仍在inflight中,可见性超时后会被重新投递
+		q.log.Errorf("[%s] requeue delay job %s failed: %v", groupID, job.ID, rerr)
+	}
+}
+
+// backoff 第attempts次失败后的重试间隔
+func (q *RedisDelayQueue) backoff(attempts int64) time.Duration {
+	d := q.cf.Backoff
+	for i := int64(1); i < attempts && d < q.cf.MaxBackoff; i++ {
+		d *= 2
+	}
+	if q.cf.MaxBackoff > 0 && d > q.cf.MaxBackoff {
+		d = q.cf.MaxBackoff
+	}
+	return d
+}
+
+func (q *RedisDelayQueue) ack(ctx context.Context, id string) {
+	_, err := q.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZRem(ctx, q.inflightKey, id)
+		pipe.HDel(ctx, q.jobsKey, id)
+		pipe.HDel(ctx, q.attemptsKey, id)
+		return nil
+	})
+	if err != nil {
+		q.log.Errorf("ack delay job %s failed: %v", id, err)
+	}
+}
+
+func (q *RedisDelayQueue) bury(ctx context.Context, job *DelayJob) {
+	job.FailedAt = time.Now()
+	val, _ := json.Marshal(job)
+	_, err := q.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.LPush(ctx, q.deadKey, val)
+		pipe.LTrim(ctx, q.deadKey, 0, q.cf.MaxDead-1)
+		pipe.ZRem(ctx, q.inflightKey, job.ID)
+		pipe.HDel(ctx, q.jobsKey, job.ID)
+		pipe.HDel(ctx, q.attemptsKey, job.ID)
+		return nil
+	})
+	if err != nil {
+		q.log.Errorf("move delay job %s to dead letter failed: %v", job.ID, err)
+	}
+}
+
+// DeadJobs 获取最近进入死信列表的至多n个任务
+func (q *RedisDelayQueue) DeadJobs(ctx context.Context, n int64) ([]*DelayJob, error) {
+	vals, err := q.rdb.LRange(ctx, q.deadKey, 0, n-1).Result()
+	if err != nil {
+		return nil, err
+	}
+	jobs := make([]*DelayJob, 0, len(vals))
+	for _, v := range vals {
+		var job DelayJob
+		if err := json.Unmarshal([]byte(v), &job); err != nil {
+			continue
+		}
+		jobs = append(jobs, &job)
+	}
+	return jobs, nil
+}
+
+func (q *RedisDelayQueue) Close() {
+	q.cancel()
+	q.wg.Wait()
+	q.log.Infof("delay queue %s closed", q.cf.Name)
+}
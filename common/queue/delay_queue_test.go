@@ -0,0 +1,76 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedisDelayQueue_backoff(t *testing.T) {
+	q := &RedisDelayQueue{cf: DelayQueueConfig{
+		Backoff:    time.Minute,
+		MaxBackoff: 10 * time.Minute,
+	}}
+	assert.Equal(t, time.Minute, q.backoff(1))
+	assert.Equal(t, 2*time.Minute, q.backoff(2))
+	assert.Equal(t, 4*time.Minute, q.backoff(3))
+	assert.Equal(t, 8*time.Minute, q.backoff(4))
+	assert.Equal(t, 10*time.Minute, q.backoff(5))
+	assert.Equal(t, 10*time.Minute, q.backoff(100))
+}
+
+func TestRedisDelayQueue(t *testing.T) {
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+
+	cf := DelayQueueConfig{
+		Name:         "test",
+		Delay:        time.Second,
+		MaxAttempts:  2,
+		Backoff:      100 * time.Millisecond,
+		MaxBackoff:   time.Second,
+		Visibility:   time.Second,
+		PollInterval: 50 * time.Millisecond,
+		BatchSize:    10,
+		MaxDead:      10,
+	}
+	q, cleanup := NewRedisDelayQueue(rdb, cf, log.DefaultLogger)
+	defer cleanup()
+
+	var okCalls, failCalls atomic.Int64
+	go func() {
+		_ = q.ConsumeWithRetry("test", func(key, value []byte) error {
+			if string(key) == "fail" {
+				failCalls.Add(1)
+				return errors.New("always fail")
+			}
+			okCalls.Add(1)
+			return nil
+		})
+	}()
+
+	start := time.Now()
+	assert.NoError(t, q.Send([]byte("ok"), []byte("1")))
+	assert.NoError(t, q.Send([]byte("fail"), []byte("2")))
+
+	assert.Eventually(t, func() bool {
+		dead, err := q.DeadJobs(context.Background(), 10)
+		return err == nil && len(dead) == 1
+	}, 5*time.Second, 50*time.Millisecond)
+	assert.GreaterOrEqual(t, time.Since(start), cf.Delay)
+
+	assert.Equal(t, int64(1), okCalls.Load())
+	assert.Equal(t, cf.MaxAttempts, failCalls.Load())
+
+	dead, _ := q.DeadJobs(context.Background(), 10)
+	assert.Equal(t, "fail", string(dead[0].Key))
+	assert.Equal(t, cf.MaxAttempts, dead[0].Attempts)
+	assert.Equal(t, "always fail", dead[0].LastError)
+}
@@ -19,6 +19,7 @@ ds=(
   "be-website"
   "be-user"
   "bff"
+  "common"
 )
 
 for d in "${ds[@]}"; do